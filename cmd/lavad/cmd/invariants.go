@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/lavanet/lava/app"
+	"github.com/spf13/cobra"
+)
+
+// invariantsAppOptions are the app options of the offline invariants check
+type invariantsAppOptions map[string]interface{}
+
+func (o invariantsAppOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+// InvariantsCmd returns a command that runs all the registered crisis invariants
+// against an exported genesis, offline (without a running node)
+func InvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants [exported-genesis-file]",
+		Short: "Run the crisis invariants against an exported genesis",
+		Long: `Load an exported genesis (e.g. the output of "lavad export") into an in-memory
+app and run all the registered crisis invariants against the resulting state.
+Broken invariants are printed and the command fails if any of them is broken.`,
+		Example: `lavad query invariants exported_genesis.json`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file: %w", err)
+			}
+
+			home, err := os.MkdirTemp("", "lavad-invariants")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(home)
+
+			appOpts := invariantsAppOptions{
+				flags.FlagHome:                   home,
+				crisis.FlagSkipGenesisInvariants: true,
+			}
+
+			lavaApp := app.New(
+				log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+				home, 0, app.MakeEncodingConfig(), appOpts, baseapp.SetChainID(genDoc.ChainID),
+			)
+
+			if err := initChainFromGenesis(lavaApp, genDoc); err != nil {
+				return fmt.Errorf("failed to load genesis: %w", err)
+			}
+
+			ctx := lavaApp.NewContext(false, tmproto.Header{
+				ChainID: genDoc.ChainID,
+				Height:  genDoc.InitialHeight,
+				Time:    genDoc.GenesisTime,
+			})
+
+			var broken int
+			for _, route := range lavaApp.CrisisKeeper.Routes() {
+				msg, stop := route.Invar(ctx)
+				if stop {
+					broken++
+					cmd.Printf("%s", msg)
+					continue
+				}
+				cmd.Printf("%s/%s: ok\n", route.ModuleName, route.Route)
+			}
+
+			if broken > 0 {
+				return fmt.Errorf("%d invariants broken", broken)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// initChainFromGenesis initializes the app's chain state from the genesis (the
+// modules' InitGenesis panic on an invalid genesis state)
+func initChainFromGenesis(lavaApp *app.LavaApp, genDoc *tmtypes.GenesisDoc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	consensusParams := genDoc.ConsensusParams.ToProto()
+	lavaApp.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+	return nil
+}
@@ -252,6 +252,7 @@ func queryCommand() *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		InvariantsCmd(),
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
@@ -12,6 +12,7 @@ import (
 	"github.com/lavanet/lava/protocol/rpcconsumer"
 	"github.com/lavanet/lava/protocol/rpcprovider"
 	"github.com/lavanet/lava/protocol/statetracker"
+	speccli "github.com/lavanet/lava/x/spec/client/cli"
 	"github.com/spf13/cobra"
 )
 
@@ -36,6 +37,8 @@ func main() {
 	rootCmd.AddCommand(cmdRPCProvider)
 	// Add Badge Generator Command
 	rootCmd.AddCommand(badgeGenerator)
+	// Add Spec Tools Command
+	rootCmd.AddCommand(speccli.CreateSpecToolsCobraCommand())
 
 	testCmd := &cobra.Command{
 		Use:   "test",
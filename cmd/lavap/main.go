@@ -11,10 +11,13 @@ import (
 	"github.com/lavanet/lava/cmd/lavad/cmd"
 	"github.com/lavanet/lava/ecosystem/cache"
 	"github.com/lavanet/lava/protocol/badgegenerator"
+	"github.com/lavanet/lava/protocol/eventindexer"
 	"github.com/lavanet/lava/protocol/monitoring"
 	"github.com/lavanet/lava/protocol/performance/connection"
 	"github.com/lavanet/lava/protocol/rpcconsumer"
 	"github.com/lavanet/lava/protocol/rpcprovider"
+	"github.com/lavanet/lava/protocol/rpcprovider/auditlog"
+	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
 	"github.com/lavanet/lava/protocol/statetracker"
 	"github.com/lavanet/lava/protocol/upgrade"
 	"github.com/spf13/cobra"
@@ -45,6 +48,12 @@ func main() {
 	rootCmd.AddCommand(cmdRPCProvider)
 	// Add Badge Generator Command
 	rootCmd.AddCommand(badgeGenerator)
+	// Add Rewards Command
+	rootCmd.AddCommand(rewardserver.CreateRewardsCobraCommand())
+	// Add Audit Replay Command
+	rootCmd.AddCommand(auditlog.CreateAuditReplayCobraCommand())
+	// Add Events Indexer Command
+	rootCmd.AddCommand(eventindexer.CreateEventsIndexerCobraCommand())
 
 	testCmd := &cobra.Command{
 		Use:   "test",
@@ -52,6 +61,7 @@ func main() {
 	}
 	rootCmd.AddCommand(testCmd)
 	testCmd.AddCommand(rpcconsumer.CreateTestRPCConsumerCobraCommand())
+	testCmd.AddCommand(rpcconsumer.CreateRelayReplayCobraCommand())
 	testCmd.AddCommand(rpcprovider.CreateTestRPCProviderCobraCommand())
 	testCmd.AddCommand(statetracker.CreateEventsCobraCommand())
 	testCmd.AddCommand(connection.CreateTestConnectionServerCobraCommand())
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -490,3 +491,30 @@ func TestCacheSetGetJsonRPCWithID(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheSetGetCompressed(t *testing.T) {
+	ctx, cacheServer := initTest()
+	request := getRequest(1230, []byte(StubSig), StubApiInterface)
+	// large responses are stored compressed and returned as they were set
+	data := []byte(`{"jsonrpc":"2.0","id":1,"result":[` + strings.Repeat(`{"address":"0xabc","topics":["0x1","0x2"],"data":"0x0"},`, 200) + `{}]}`)
+	messageSet := pairingtypes.RelayCacheSet{
+		Request:   shallowCopy(request),
+		ChainID:   StubChainID,
+		Response:  &pairingtypes.RelayReply{Data: append([]byte{}, data...)},
+		Finalized: true,
+	}
+	_, err := cacheServer.SetRelay(ctx, &messageSet)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		messageGet := pairingtypes.RelayCacheGet{
+			Request:   shallowCopy(request),
+			ChainID:   StubChainID,
+			Finalized: true,
+		}
+		cacheReply, err := cacheServer.GetRelay(ctx, &messageGet)
+		require.NoError(t, err)
+		require.Equal(t, data, cacheReply.GetReply().Data)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/dgraph-io/ristretto"
 	"github.com/lavanet/lava/ecosystem/cache/format"
 	rpcInterfaceMessages "github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/protocol/parser"
 	"github.com/lavanet/lava/utils"
@@ -42,6 +43,21 @@ type CacheValue struct {
 	Response         pairingtypes.RelayReply
 	Hash             []byte
 	OptionalMetadata []pairingtypes.Metadata
+	Compression      string // the compression of Response.Data, large responses are stored compressed
+}
+
+// decompress restores the response data of a value read from the cache, the stored value stays compressed
+func (cv *CacheValue) decompress() error {
+	if cv.Compression == "" {
+		return nil
+	}
+	data, err := common.DecompressData(cv.Compression, cv.Response.Data)
+	if err != nil {
+		return err
+	}
+	cv.Response.Data = data
+	cv.Compression = ""
+	return nil
 }
 
 func (cv *CacheValue) ToCacheReply() *pairingtypes.CacheRelayReply {
@@ -98,6 +114,9 @@ func (s *RelayerCacheServer) getRelayInner(ctx context.Context, relayCacheGet *p
 	if !found {
 		return nil, NotFoundError
 	}
+	if err := cacheVal.decompress(); err != nil {
+		return nil, utils.LavaFormatError("failed decompressing cache entry", err, utils.Attribute{Key: "compression", Value: cacheVal.Compression})
+	}
 	if cacheVal.Hash == nil {
 		// if we didn't store a hash its also always a match
 		cacheVal.Response.Data = outputFormatter(cacheVal.Response.Data)
@@ -279,20 +298,24 @@ func usedFieldsFromRequest(request *pairingtypes.RelayPrivateData, provider stri
 
 func formatCacheValue(response *pairingtypes.RelayReply, hash []byte, finalized bool, optionalMetadata []pairingtypes.Metadata) CacheValue {
 	response.Sig = []byte{} // make sure we return a signed value, as the output was modified by our outputParser
-	if !finalized {
-		// hash value is only used on non finalized entries to check for forks
-		return CacheValue{
-			Response:         *response,
-			Hash:             hash,
-			OptionalMetadata: optionalMetadata,
-		}
-	}
-	// no need to store the hash value for finalized entries
-	return CacheValue{
+	cacheValue := CacheValue{
 		Response:         *response,
-		Hash:             nil,
+		Hash:             hash,
 		OptionalMetadata: optionalMetadata,
 	}
+	if finalized {
+		// no need to store the hash value for finalized entries
+		// hash value is only used on non finalized entries to check for forks
+		cacheValue.Hash = nil
+	}
+	if uint64(len(response.Data)) >= common.RelayCompressionMinSize {
+		data, err := common.CompressData(common.CompressionZstd, response.Data)
+		if err == nil && len(data) < len(response.Data) {
+			cacheValue.Response.Data = data
+			cacheValue.Compression = common.CompressionZstd
+		}
+	}
+	return cacheValue
 }
 
 func latestBlockKey(chainID string, providerAddr string) string {
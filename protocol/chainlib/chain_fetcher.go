@@ -30,6 +30,16 @@ type ChainFetcherIf interface {
 	Validate(ctx context.Context) error
 }
 
+// VerificationsRunner runs all the spec verifications of an endpoint and reports their results
+type VerificationsRunner interface {
+	RunVerifications(ctx context.Context) []VerificationResult
+}
+
+type VerificationResult struct {
+	VerificationContainer
+	Err error
+}
+
 type ChainFetcher struct {
 	endpoint    *lavasession.RPCProviderEndpoint
 	chainRouter ChainRouter
@@ -82,6 +92,33 @@ func (cf *ChainFetcher) Validate(ctx context.Context) error {
 	return nil
 }
 
+// RunVerifications runs the verifications of all the node urls (and their addons) once,
+// and returns the result of each (unlike Validate, it does not stop on failures)
+func (cf *ChainFetcher) RunVerifications(ctx context.Context) []VerificationResult {
+	latestBlock, err := cf.FetchLatestBlockNum(ctx)
+	if err != nil {
+		utils.LavaFormatWarning("failed fetching latest block for verifications", err, utils.Attribute{Key: "endpoint", Value: cf.endpoint.String()})
+		latestBlock = 0
+	}
+	return cf.runVerifications(ctx, uint64(latestBlock))
+}
+
+func (cf *ChainFetcher) runVerifications(ctx context.Context, latestBlock uint64) []VerificationResult {
+	results := []VerificationResult{}
+	for _, url := range cf.endpoint.NodeUrls {
+		verifications, err := cf.chainParser.GetVerifications(url.Addons)
+		if err != nil {
+			utils.LavaFormatWarning("failed getting verifications for NodeUrl", err, utils.Attribute{Key: "url", Value: url.String()})
+			continue
+		}
+		for _, verification := range verifications {
+			err := cf.Verify(ctx, verification, latestBlock)
+			results = append(results, VerificationResult{VerificationContainer: verification, Err: err})
+		}
+	}
+	return results
+}
+
 func (cf *ChainFetcher) populateCache(relayData *pairingtypes.RelayPrivateData, reply *pairingtypes.RelayReply, requestedBlockHash []byte, finalized bool) {
 	if requestedBlockHash != nil || finalized {
 		new_ctx := context.Background()
@@ -109,59 +146,64 @@ func (cf *ChainFetcher) Verify(ctx context.Context, verification VerificationCon
 		return utils.LavaFormatWarning("[-] verify failed sending chainMessage", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
 	}
 
-	parserInput, err := FormatResponseForParsing(reply, chainMessage)
+	parsedResult, err := VerifyReply(reply, chainMessage, verification, latestBlock, utils.Attribute{Key: "nodeUrl", Value: cf.endpoint.UrlsString()})
 	if err != nil {
 		return err
 	}
-	parsedResult, err := parser.ParseFromReply(parserInput, parsing.ResultParsing)
+	utils.LavaFormatInfo("[+] verified successfully", utils.Attribute{Key: "endpoint", Value: cf.endpoint.String()}, utils.Attribute{Key: "verification", Value: verification.Name}, utils.Attribute{Key: "value", Value: parsedResult}, utils.Attribute{Key: "verificationKey", Value: verification.VerificationKey})
+	return nil
+}
+
+// VerifyReply checks a reply to a verification of the spec, returning the parsed value it verified
+func VerifyReply(reply *pairingtypes.RelayReply, chainMessage ChainMessageForSend, verification VerificationContainer, latestBlock uint64, attributes ...utils.Attribute) (parsedResult string, err error) {
+	parsing := &verification.ParseDirective
+	parserInput, err := FormatResponseForParsing(reply, chainMessage)
 	if err != nil {
-		return utils.LavaFormatWarning("[-] verify failed to parse result", err, []utils.Attribute{
-			{Key: "nodeUrl", Value: cf.endpoint.UrlsString()},
+		return "", err
+	}
+	parsedResult, err = parser.ParseFromReply(parserInput, parsing.ResultParsing)
+	if err != nil {
+		return "", utils.LavaFormatWarning("[-] verify failed to parse result", err, append([]utils.Attribute{
 			{Key: "Method", Value: parsing.GetApiName()},
 			{Key: "Response", Value: string(reply.Data)},
-		}...)
+		}, attributes...)...)
 	}
 	if verification.LatestDistance != 0 && latestBlock != 0 {
 		parsedResultAsNumber, err := strconv.ParseUint(parsedResult, 0, 64)
 		if err != nil {
-			return utils.LavaFormatWarning("[-] verify failed to parse result as number", err, []utils.Attribute{
-				{Key: "nodeUrl", Value: cf.endpoint.UrlsString()},
+			return "", utils.LavaFormatWarning("[-] verify failed to parse result as number", err, append([]utils.Attribute{
 				{Key: "Method", Value: parsing.GetApiName()},
 				{Key: "Response", Value: string(reply.Data)},
 				{Key: "parsedResult", Value: parsedResult},
-			}...)
+			}, attributes...)...)
 		}
 		if parsedResultAsNumber > latestBlock {
-			return utils.LavaFormatWarning("[-] verify failed parsed result is greater than latestBlock", err, []utils.Attribute{
-				{Key: "nodeUrl", Value: cf.endpoint.UrlsString()},
+			return "", utils.LavaFormatWarning("[-] verify failed parsed result is greater than latestBlock", err, append([]utils.Attribute{
 				{Key: "Method", Value: parsing.GetApiName()},
 				{Key: "latestBlock", Value: latestBlock},
 				{Key: "parsedResult", Value: parsedResultAsNumber},
-			}...)
+			}, attributes...)...)
 		}
 		if latestBlock-parsedResultAsNumber < verification.LatestDistance {
-			return utils.LavaFormatWarning("[-] verify failed expected block distance is not sufficient", err, []utils.Attribute{
-				{Key: "nodeUrl", Value: cf.endpoint.UrlsString()},
+			return "", utils.LavaFormatWarning("[-] verify failed expected block distance is not sufficient", err, append([]utils.Attribute{
 				{Key: "Method", Value: parsing.GetApiName()},
 				{Key: "latestBlock", Value: latestBlock},
 				{Key: "parsedResult", Value: parsedResultAsNumber},
 				{Key: "expected", Value: verification.LatestDistance},
-			}...)
+			}, attributes...)...)
 		}
 	}
 	// some verifications only want the response to be valid, and don't care about the value
 	if verification.Value != "*" && verification.Value != "" {
 		if parsedResult != verification.Value {
-			return utils.LavaFormatWarning("[-] verify failed expected and received are different", err, []utils.Attribute{
+			return "", utils.LavaFormatWarning("[-] verify failed expected and received are different", err, append([]utils.Attribute{
 				{Key: "parsedResult", Value: parsedResult},
 				{Key: "verification.Value", Value: verification.Value},
-				{Key: "nodeUrl", Value: cf.endpoint.UrlsString()},
 				{Key: "Method", Value: parsing.GetApiName()},
-			}...)
+			}, attributes...)...)
 		}
 	}
-	utils.LavaFormatInfo("[+] verified successfully", utils.Attribute{Key: "endpoint", Value: cf.endpoint.String()}, utils.Attribute{Key: "verification", Value: verification.Name}, utils.Attribute{Key: "value", Value: parsedResult}, utils.Attribute{Key: "verificationKey", Value: verification.VerificationKey})
-	return nil
+	return parsedResult, nil
 }
 
 func (cf *ChainFetcher) ChainFetcherMetadata() []pairingtypes.Metadata {
@@ -363,6 +405,11 @@ func (cf *DummyChainFetcher) Validate(ctx context.Context) error {
 	return nil
 }
 
+// overwrite this, no latest block to compare to
+func (cf *DummyChainFetcher) RunVerifications(ctx context.Context) []VerificationResult {
+	return cf.runVerifications(ctx, 0)
+}
+
 // overwrite this
 func (cf *DummyChainFetcher) FetchLatestBlockNum(ctx context.Context) (int64, error) {
 	return 0, nil
@@ -2,10 +2,12 @@ package chainlib
 
 import (
 	"math"
+	"net/http"
 	"time"
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/parser"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
@@ -61,6 +63,14 @@ func (pm baseChainMessageContainer) GetRPCMessage() rpcInterfaceMessages.Generic
 	return pm.msg
 }
 
+func (pm baseChainMessageContainer) GetRPCInput() parser.RPCInput {
+	rpcInput, ok := pm.msg.(parser.RPCInput)
+	if !ok {
+		return nil
+	}
+	return rpcInput
+}
+
 func (pm *baseChainMessageContainer) UpdateLatestBlockInMessage(latestBlock int64, modifyContent bool) (modifiedOnLatestReq bool) {
 	requestedBlock, _ := pm.RequestedBlock()
 	if latestBlock <= spectypes.NOT_APPLICABLE || requestedBlock != spectypes.LATEST_BLOCK {
@@ -122,12 +132,41 @@ func (pm *baseChainMessageContainer) updateCUForApi(extension *spectypes.Extensi
 	pm.api = &copyApi
 }
 
+// applyCuFormula returns the api with its compute units priced by the request parameters,
+// according to the api's cu formula. The consumer (parsing the request before sending it)
+// and the provider (parsing it when verifying the relay session) compute the same units.
+func applyCuFormula(api *spectypes.Api, rpcInput parser.RPCInput) *spectypes.Api {
+	formula := api.GetCuFormula()
+	if formula == nil || formula.Kind == spectypes.CuFormula_NONE {
+		return api
+	}
+	copyApi := *api // we can't modify this because it points to an object inside the chainParser
+	copyApi.ComputeUnits = formula.ComputeUnits(api.ComputeUnits, parser.ParseCuFormulaUnits(rpcInput, *formula))
+	return &copyApi
+}
+
 type CraftData struct {
 	Path           string
 	Data           []byte
 	ConnectionType string
 }
 
+// RequestUrlAndData returns the url and data of a relay carrying the message crafted from the craft data,
+// the same way the chain parser of the api interface crafts it
+func (cd *CraftData) RequestUrlAndData(apiInterface string) (url string, data []byte) {
+	switch apiInterface {
+	case spectypes.APIInterfaceRest:
+		if cd.ConnectionType == http.MethodPost {
+			return cd.Path, cd.Data
+		}
+		return string(cd.Data), nil
+	case spectypes.APIInterfaceGrpc:
+		return cd.Path, cd.Data
+	default:
+		return "", cd.Data
+	}
+}
+
 func CraftChainMessage(parsing *spectypes.ParseDirective, connectionType string, chainParser ChainParser, craftData *CraftData, metadata []pairingtypes.Metadata) (ChainMessageForSend, error) {
 	return chainParser.CraftMessage(parsing, connectionType, craftData, metadata)
 }
@@ -1,6 +1,9 @@
 package chainlib
 
-import "github.com/lavanet/lava/protocol/common"
+import (
+	"github.com/lavanet/lava/protocol/common"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
 
 func ShouldSendToAllProviders(chainMessage ChainMessage) bool {
 	return chainMessage.GetApi().Category.Stateful == common.CONSISTENCY_SELECT_ALLPROVIDERS
@@ -14,6 +17,12 @@ func IsSubscription(chainMessage ChainMessage) bool {
 	return chainMessage.GetApi().Category.Subscription
 }
 
+// IsGrpcServerStream returns whether the subscription is a grpc server-streaming call, only their streamed messages
+// are charged on top of the subscribe relay
+func IsGrpcServerStream(chainMessage ChainMessage) bool {
+	return IsSubscription(chainMessage) && chainMessage.GetApiCollection().CollectionData.ApiInterface == spectypes.APIInterfaceGrpc
+}
+
 func IsHangingApi(chainMessage ChainMessage) bool {
 	return chainMessage.GetApi().Category.HangingApi
 }
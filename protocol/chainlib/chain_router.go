@@ -7,8 +7,10 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type chainRouterEntry struct {
@@ -64,6 +66,12 @@ func (cri chainRouterImpl) SendNodeMsg(ctx context.Context, ch chan interface{},
 	if err != nil {
 		return nil, "", nil, err
 	}
+	ctx, span := tracing.StartSpan(ctx, "ChainProxy.SendNodeMsg",
+		attribute.String("method", chainMessage.GetApi().GetName()),
+		attribute.String("addon", addon),
+		attribute.StringSlice("extensions", extensions),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
 	return selectedChainProxy.SendNodeMsg(ctx, ch, chainMessage)
 }
 
@@ -115,13 +123,19 @@ func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavase
 			return allExtensionsRouterKey
 		}
 		routerKey := updateRouteCombinations(extensions, addons)
-		chainProxy, err := proxyConstructor(ctx, nConns, rpcProviderEndpointEntry, chainParser)
-		if err != nil {
-			// TODO: allow some urls to be down
-			return nil, err
+		// several nodes serving the same services are pooled, relays are routed between them by their stickiness token
+		nodes := splitNodePool(rpcProviderEndpointEntry)
+		chainProxies := make([]ChainProxy, 0, len(nodes))
+		for _, node := range nodes {
+			chainProxy, err := proxyConstructor(ctx, nConns, node, chainParser)
+			if err != nil {
+				// TODO: allow some urls to be down
+				return nil, err
+			}
+			chainProxies = append(chainProxies, chainProxy)
 		}
 		chainRouterEntryInst := chainRouterEntry{
-			ChainProxy:      chainProxy,
+			ChainProxy:      newNodePoolChainProxy(chainProxies, nodes),
 			addonsSupported: addonsSupportedMap,
 		}
 		if chainRouterEntries, ok := chainProxyRouter[routerKey]; !ok {
@@ -2,11 +2,15 @@ package chainlib
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	testcommon "github.com/lavanet/lava/testutil/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -341,3 +345,84 @@ func TestChainRouter(t *testing.T) {
 		})
 	}
 }
+
+type nodeUrlChainProxy struct {
+	nodeUrls []common.NodeUrl
+}
+
+func (ncp *nodeUrlChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	return &pairingtypes.RelayReply{Data: []byte(ncp.nodeUrls[0].Url)}, "", nil, nil
+}
+
+func TestChainRouterNodePool(t *testing.T) {
+	ctx := context.Background()
+	apiInterface := spectypes.APIInterfaceJsonRPC
+	chainParser, err := NewChainParser(apiInterface)
+	require.NoError(t, err)
+	spec := testcommon.CreateMockSpec()
+	spec.ApiCollections = []*spectypes.ApiCollection{{Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: apiInterface}}}
+	chainParser.SetSpec(spec)
+
+	endpoint := lavasession.RPCProviderEndpoint{
+		ChainID:      spec.Index,
+		ApiInterface: apiInterface,
+		NodeUrls:     []common.NodeUrl{{Url: "http://node1"}, {Url: "http://node2"}, {Url: "http://node3"}},
+	}
+	chainRouter, err := newChainRouter(ctx, 1, endpoint, chainParser, func(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser) (ChainProxy, error) {
+		return &nodeUrlChainProxy{nodeUrls: rpcProviderEndpoint.NodeUrls}, nil
+	})
+	require.NoError(t, err)
+
+	sendWithToken := func(token string) string {
+		msg := &rpcInterfaceMessages.JsonrpcMessage{Version: "2.0", Method: "eth_newFilter"}
+		if token != "" {
+			msg.AppendHeader([]pairingtypes.Metadata{{Name: RPCProviderStickinessHeaderName, Value: token}})
+		}
+		reply, _, _, err := chainRouter.SendNodeMsg(ctx, nil, baseChainMessageContainer{msg: msg, apiCollection: spec.ApiCollections[0]}, nil)
+		require.NoError(t, err)
+		return string(reply.Data)
+	}
+
+	// the same token always reaches the same node, different tokens are spread between the nodes
+	tokenNodes := map[string]struct{}{}
+	for i := 0; i < 30; i++ {
+		token := common.GetUniqueToken(fmt.Sprintf("consumer%d", i), "token")
+		node := sendWithToken(token)
+		for j := 0; j < 5; j++ {
+			require.Equal(t, node, sendWithToken(token))
+		}
+		tokenNodes[node] = struct{}{}
+	}
+	require.Greater(t, len(tokenNodes), 1)
+
+	// requests without a token (chain tracking) always reach the primary node
+	for i := 0; i < 2*len(endpoint.NodeUrls); i++ {
+		require.Equal(t, endpoint.NodeUrls[0].Url, sendWithToken(""))
+	}
+}
+
+func TestSplitNodePool(t *testing.T) {
+	endpoint := lavasession.RPCProviderEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceTendermintRPC}
+
+	// a single node keeps all its urls
+	endpoint.NodeUrls = []common.NodeUrl{{Url: "ws://node1"}, {Url: "http://node1"}}
+	require.Equal(t, []lavasession.RPCProviderEndpoint{endpoint}, splitNodePool(endpoint))
+
+	// each node gets a websocket and an http url
+	endpoint.NodeUrls = []common.NodeUrl{{Url: "ws://node1"}, {Url: "http://node1"}, {Url: "ws://node2"}, {Url: "http://node2"}}
+	nodes := splitNodePool(endpoint)
+	require.Len(t, nodes, 2)
+	require.Equal(t, []common.NodeUrl{{Url: "ws://node1"}, {Url: "http://node1"}}, nodes[0].NodeUrls)
+	require.Equal(t, []common.NodeUrl{{Url: "ws://node2"}, {Url: "http://node2"}}, nodes[1].NodeUrls)
+	require.Equal(t, endpoint.ChainID, nodes[1].ChainID)
+
+	// each node gets a url for every internal path
+	endpoint.NodeUrls = []common.NodeUrl{{Url: "http://node1", InternalPath: ""}, {Url: "http://node1/x", InternalPath: "/x"}, {Url: "http://node2", InternalPath: ""}, {Url: "http://node2/x", InternalPath: "/x"}}
+	nodes = splitNodePool(endpoint)
+	require.Len(t, nodes, 2)
+	require.Equal(t, []common.NodeUrl{{Url: "http://node2", InternalPath: ""}, {Url: "http://node2/x", InternalPath: "/x"}}, nodes[1].NodeUrls)
+
+	// urls can't be matched to nodes
+	endpoint.NodeUrls = []common.NodeUrl{{Url: "ws://node1"}, {Url: "http://node1"}, {Url: "http://node2"}}
+	require.Len(t, splitNodePool(endpoint), 1)
+}
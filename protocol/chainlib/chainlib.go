@@ -25,6 +25,8 @@ func NewChainParser(apiInterface string) (chainParser ChainParser, err error) {
 		return NewRestChainParser()
 	case spectypes.APIInterfaceGrpc:
 		return NewGrpcChainParser()
+	case spectypes.APIInterfaceGraphQL:
+		return NewGraphQLChainParser()
 	}
 	return nil, fmt.Errorf("chainParser for apiInterface (%s) not found", apiInterface)
 }
@@ -45,6 +47,8 @@ func NewChainListener(
 		return NewRestChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs), nil
 	case spectypes.APIInterfaceGrpc:
 		return NewGrpcChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs, chainParser), nil
+	case spectypes.APIInterfaceGraphQL:
+		return NewGraphQLChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs), nil
 	}
 	return nil, fmt.Errorf("chainListener for apiInterface (%s) not found", listenEndpoint.ApiInterface)
 }
@@ -121,6 +125,8 @@ func GetChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint *lavas
 		proxyConstructor = NewRestChainProxy
 	case spectypes.APIInterfaceGrpc:
 		proxyConstructor = NewGrpcChainProxy
+	case spectypes.APIInterfaceGraphQL:
+		proxyConstructor = NewGraphQLChainProxy
 	default:
 		return nil, fmt.Errorf("chain proxy for apiInterface (%s) not found", rpcProviderEndpoint.ApiInterface)
 	}
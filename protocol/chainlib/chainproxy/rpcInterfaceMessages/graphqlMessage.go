@@ -0,0 +1,101 @@
+package rpcInterfaceMessages
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/parser"
+	"github.com/lavanet/lava/utils"
+)
+
+type GraphQLMessage struct {
+	Query         string          `json:"query"`
+	OperationName string          `json:"operationName,omitempty"`
+	Variables     json.RawMessage `json:"variables,omitempty"`
+	Extensions    json.RawMessage `json:"extensions,omitempty"`
+	// Path is the path of the graphql endpoint on the node, for nodes serving more than one
+	Path string `json:"-"`
+	// Arguments are the arguments of the root field the api was matched by, they are the params of block parsing
+	Arguments              map[string]interface{} `json:"-"`
+	chainproxy.BaseMessage `json:"-"`
+}
+
+type GraphQLError struct {
+	Message string `json:"message"`
+}
+
+type graphQLReply struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []GraphQLError  `json:"errors,omitempty"`
+}
+
+// ParseGraphQLMsg unmarshals a graphql request body and parses the operation it executes
+func ParseGraphQLMsg(data []byte) (*GraphQLMessage, *GraphQLOperation, error) {
+	msg := &GraphQLMessage{}
+	err := json.Unmarshal(data, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if msg.Query == "" {
+		return nil, nil, fmt.Errorf("graphql request has no query")
+	}
+	variables, err := msg.GetVariables()
+	if err != nil {
+		return nil, nil, err
+	}
+	operation, err := ParseGraphQLOperation(msg.Query, msg.OperationName, variables)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, operation, nil
+}
+
+// GetVariables decodes the request variables, numbers are decoded like the params of other interfaces
+func (gm GraphQLMessage) GetVariables() (map[string]interface{}, error) {
+	variables := map[string]interface{}{}
+	if len(gm.Variables) == 0 || bytes.Equal(gm.Variables, []byte("null")) {
+		return variables, nil
+	}
+	err := json.Unmarshal(gm.Variables, &variables)
+	if err != nil {
+		return nil, fmt.Errorf("graphql variables are not a json object: %w", err)
+	}
+	return variables, nil
+}
+
+func (gm *GraphQLMessage) UpdateLatestBlockInMessage(latestBlock uint64, modifyContent bool) (success bool) {
+	return false
+}
+
+func (gm GraphQLMessage) NewParsableRPCInput(input json.RawMessage) (parser.RPCInput, error) {
+	reply := &graphQLReply{}
+	err := json.Unmarshal(input, reply)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed unmarshaling graphql reply", err, utils.Attribute{Key: "input", Value: input})
+	}
+
+	// Make sure the response does not have an error
+	if len(reply.Errors) > 0 && (len(reply.Data) == 0 || bytes.Equal(reply.Data, []byte("null"))) {
+		return nil, utils.LavaFormatError("response is an error message", nil, utils.Attribute{Key: "errors", Value: reply.Errors})
+	}
+	return ParsableRPCInput{Result: reply.Data}, nil
+}
+
+// GetParams returns the arguments of the root field, with the operation variables resolved. a field without
+// arguments has empty params so block parsing falls back to the default value
+func (gm GraphQLMessage) GetParams() interface{} {
+	if gm.Arguments == nil {
+		return map[string]interface{}{}
+	}
+	return gm.Arguments
+}
+
+func (gm GraphQLMessage) GetResult() json.RawMessage {
+	return nil
+}
+
+func (gm GraphQLMessage) ParseBlock(inp string) (int64, error) {
+	return parser.ParseDefaultBlockParameter(inp)
+}
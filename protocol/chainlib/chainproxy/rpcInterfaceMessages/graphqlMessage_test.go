@@ -0,0 +1,196 @@
+package rpcInterfaceMessages
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGraphQLOperation(t *testing.T) {
+	t.Parallel()
+
+	fieldNames := func(operation *GraphQLOperation) []string {
+		names := []string{}
+		for _, field := range operation.Fields {
+			names = append(names, field.Name)
+		}
+		return names
+	}
+
+	testTable := []struct {
+		name          string
+		query         string
+		operationName string
+		variables     map[string]interface{}
+		operationType string
+		fields        []string
+		arguments     map[string]interface{}
+		valid         bool
+	}{
+		{
+			name:          "shorthand query",
+			query:         `{ block(number: 100) { hash } }`,
+			operationType: GraphQLOperationQuery,
+			fields:        []string{"block"},
+			arguments:     map[string]interface{}{"number": int64(100)},
+			valid:         true,
+		},
+		{
+			name:          "aliases and multiple root fields",
+			query:         `query Blocks { first: block(number: 1) { hash } second: block(number: 2) { hash } transactions { id } }`,
+			operationType: GraphQLOperationQuery,
+			fields:        []string{"block", "block", "transactions"},
+			arguments:     map[string]interface{}{"number": int64(1)},
+			valid:         true,
+		},
+		{
+			name:          "variables and defaults",
+			query:         `query ($number: Int!, $hash: String = "0x1") { block(number: $number, hash: $hash, filter: {min: $number, list: [1, $number]}) { hash } }`,
+			variables:     map[string]interface{}{"number": float64(7)},
+			operationType: GraphQLOperationQuery,
+			fields:        []string{"block"},
+			arguments: map[string]interface{}{
+				"number": float64(7),
+				"hash":   "0x1",
+				"filter": map[string]interface{}{"min": float64(7), "list": []interface{}{int64(1), float64(7)}},
+			},
+			valid: true,
+		},
+		{
+			name:          "selected operation",
+			query:         `query A { accounts { id } } mutation B { transfer(amount: 1.5, memo: """multi "quoted" line""") { id } }`,
+			operationName: "B",
+			operationType: GraphQLOperationMutation,
+			fields:        []string{"transfer"},
+			arguments:     map[string]interface{}{"amount": 1.5, "memo": `multi "quoted" line`},
+			valid:         true,
+		},
+		{
+			name:          "root fragments",
+			query:         "# comment\n{ ...Root ... on Query { pools { id } } } fragment Root on Query { block(tag: LATEST, final: true, skip: null) @include(if: true) { hash } }",
+			operationType: GraphQLOperationQuery,
+			fields:        []string{"block", "pools"},
+			arguments:     map[string]interface{}{"tag": "LATEST", "final": true, "skip": nil},
+			valid:         true,
+		},
+		{
+			name:          "escaped string",
+			query:         `{ account(id: "a\"bA") { id } }`,
+			operationType: GraphQLOperationQuery,
+			fields:        []string{"account"},
+			arguments:     map[string]interface{}{"id": `a"bA`},
+			valid:         true,
+		},
+		{
+			name:  "multiple operations without a name",
+			query: `query A { accounts { id } } query B { pools { id } }`,
+		},
+		{
+			name:          "unknown operation name",
+			query:         `query A { accounts { id } }`,
+			operationName: "B",
+		},
+		{
+			name:  "undefined fragment",
+			query: `{ ...Missing }`,
+		},
+		{
+			name:  "recursive fragment",
+			query: `{ ...A } fragment A on Query { ...A }`,
+		},
+		{
+			name:  "unterminated selection set",
+			query: `{ block(number: 1) { hash }`,
+		},
+		{
+			name:  "empty selection set",
+			query: `{ }`,
+		},
+		{
+			name:  "invalid character",
+			query: `{ block(number: %) }`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			operation, err := ParseGraphQLOperation(testCase.query, testCase.operationName, testCase.variables)
+			if !testCase.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.operationType, operation.Type)
+			require.Equal(t, testCase.fields, fieldNames(operation))
+			require.Equal(t, testCase.arguments, operation.Fields[0].Arguments)
+		})
+	}
+}
+
+func TestParseGraphQLOperationExponentialFragments(t *testing.T) {
+	// every fragment spreads the next one twice, expanding F0 visits 2^30 spreads without a budget
+	const fragmentsCount = 30
+	var query strings.Builder
+	query.WriteString("{ ...F0 }")
+	for i := 0; i < fragmentsCount; i++ {
+		fmt.Fprintf(&query, " fragment F%d on Query { ...F%d ...F%d }", i, i+1, i+1)
+	}
+	fmt.Fprintf(&query, " fragment F%d on Query { block { hash } }", fragmentsCount)
+
+	_, err := ParseGraphQLOperation(query.String(), "", nil)
+	require.ErrorContains(t, err, "selections")
+}
+
+func TestParseGraphQLOperationDeepNesting(t *testing.T) {
+	// every nesting level recurses in the parser, a body under the listener's limit must fail instead of overflowing the stack
+	const levels = 2000000
+	queries := map[string]string{
+		"selection sets": "{" + strings.Repeat("a{", levels),
+		"list values":    "{ a(b: " + strings.Repeat("[", levels) + ") }",
+		"object values":  "{ a(b: " + strings.Repeat("{c: ", levels) + ") }",
+		"list types":     "query($b: " + strings.Repeat("[", levels) + ") { a }",
+	}
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseGraphQLOperation(query, "", nil)
+			require.ErrorContains(t, err, "nesting")
+		})
+	}
+
+	// nesting within the limit is still accepted
+	operation, err := ParseGraphQLOperation("{ a"+strings.Repeat("{ b", 60)+strings.Repeat("}", 60)+" }", "", nil)
+	require.NoError(t, err)
+	require.Len(t, operation.Fields, 1)
+}
+
+func TestGraphQLMessage(t *testing.T) {
+	msg, operation, err := ParseGraphQLMsg([]byte(`{"query":"query Block($number: Int) { block(number: $number) { hash } }","variables":{"number":123},"operationName":"Block"}`))
+	require.NoError(t, err)
+	require.Equal(t, "Block", operation.Name)
+	require.Len(t, operation.Fields, 1)
+	require.Equal(t, float64(123), operation.Fields[0].Arguments["number"])
+
+	// variables are forwarded to the node as sent
+	msg.Arguments = operation.Fields[0].Arguments
+	encoded, err := json.Marshal(msg)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"query":"query Block($number: Int) { block(number: $number) { hash } }","variables":{"number":123},"operationName":"Block"}`, string(encoded))
+	require.Equal(t, msg.Arguments, msg.GetParams())
+	require.Nil(t, msg.GetResult())
+
+	_, _, err = ParseGraphQLMsg([]byte(`{"variables":{}}`))
+	require.Error(t, err)
+	_, _, err = ParseGraphQLMsg([]byte(`{"query":"{ block }","variables":[1]}`))
+	require.Error(t, err)
+
+	parsable, err := msg.NewParsableRPCInput([]byte(`{"data":{"block":{"number":"0x10"}},"errors":[{"message":"partial"}]}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"block":{"number":"0x10"}}`, string(parsable.GetResult()))
+	_, err = msg.NewParsableRPCInput([]byte(`{"data":null,"errors":[{"message":"failed"}]}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,723 @@
+package rpcInterfaceMessages
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	GraphQLOperationQuery        = "query"
+	GraphQLOperationMutation     = "mutation"
+	GraphQLOperationSubscription = "subscription"
+	graphQLFragment              = "fragment"
+	maxGraphQLFragmentDepth      = 32
+	// maxGraphQLExpandedSelections bounds the selections (fields, fragment spreads and inline fragments) visited while
+	// expanding the fragments of an operation, fragments spreading each other repeatedly expand exponentially
+	maxGraphQLExpandedSelections = 1000
+	// maxGraphQLNestingDepth bounds nested selection sets, list and object values and list types, the parser recurses on each level
+	maxGraphQLNestingDepth = 64
+	byteOrderMark          = "\ufeff"
+)
+
+// GraphQLField is a root field of a graphql operation, its name is the api matched in the spec
+type GraphQLField struct {
+	Name  string
+	Alias string
+	// Arguments holds the field arguments with the operation variables resolved
+	Arguments map[string]interface{}
+}
+
+// GraphQLOperation is the operation of a graphql document executed by a request
+type GraphQLOperation struct {
+	Type   string
+	Name   string
+	Fields []GraphQLField
+}
+
+type graphQLTokenKind int
+
+const (
+	graphQLTokenEOF graphQLTokenKind = iota
+	graphQLTokenPunctuator
+	graphQLTokenName
+	graphQLTokenInt
+	graphQLTokenFloat
+	graphQLTokenString
+)
+
+type graphQLToken struct {
+	kind  graphQLTokenKind
+	value string
+	pos   int
+}
+
+type graphQLSelection struct {
+	field *GraphQLField
+	// fragmentSpread is the fragment name of a spread, the fields of inline fragments are in inline
+	fragmentSpread string
+	inline         []graphQLSelection
+}
+
+type graphQLDefinition struct {
+	operationType string
+	name          string
+	defaults      map[string]interface{}
+	selections    []graphQLSelection
+}
+
+// ParseGraphQLOperation parses the graphql document and returns the root fields of the operation it executes,
+// operationName selects the operation when the document has more than one
+func ParseGraphQLOperation(query, operationName string, variables map[string]interface{}) (*GraphQLOperation, error) {
+	gqlParser := &graphQLParser{source: query}
+	if err := gqlParser.advance(); err != nil {
+		return nil, err
+	}
+	operations := []*graphQLDefinition{}
+	fragments := map[string]*graphQLDefinition{}
+	for gqlParser.token.kind != graphQLTokenEOF {
+		definition, err := gqlParser.parseDefinition()
+		if err != nil {
+			return nil, err
+		}
+		if definition.operationType == graphQLFragment {
+			if _, ok := fragments[definition.name]; ok {
+				return nil, fmt.Errorf("graphql fragment %s is defined more than once", definition.name)
+			}
+			fragments[definition.name] = definition
+			continue
+		}
+		operations = append(operations, definition)
+	}
+
+	var selected *graphQLDefinition
+	switch {
+	case len(operations) == 0:
+		return nil, fmt.Errorf("graphql document has no operation")
+	case operationName != "":
+		for _, operation := range operations {
+			if operation.name == operationName {
+				selected = operation
+				break
+			}
+		}
+		if selected == nil {
+			return nil, fmt.Errorf("graphql operation %s not found in document", operationName)
+		}
+	case len(operations) == 1:
+		selected = operations[0]
+	default:
+		return nil, fmt.Errorf("graphql document has multiple operations, operationName is required")
+	}
+
+	// resolve variables and their defaults of the selected operation only, the root fields were parsed before it was known
+	operation := &GraphQLOperation{Type: selected.operationType, Name: selected.name}
+	budget := maxGraphQLExpandedSelections
+	err := collectGraphQLFields(selected.selections, fragments, 0, &budget, func(field *GraphQLField) {
+		resolved := make(map[string]interface{}, len(field.Arguments))
+		for name, value := range field.Arguments {
+			resolved[name] = resolveGraphQLValue(value, variables, selected.defaults)
+		}
+		operation.Fields = append(operation.Fields, GraphQLField{Name: field.Name, Alias: field.Alias, Arguments: resolved})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(operation.Fields) == 0 {
+		return nil, fmt.Errorf("graphql operation has no fields")
+	}
+	return operation, nil
+}
+
+// collectGraphQLFields expands the fragments of the selections and calls onField for each root field,
+// every visited selection consumes the budget, the expansion fails once the budget is exhausted
+func collectGraphQLFields(selections []graphQLSelection, fragments map[string]*graphQLDefinition, depth int, budget *int, onField func(*GraphQLField)) error {
+	if depth > maxGraphQLFragmentDepth {
+		return fmt.Errorf("graphql fragments are nested too deep")
+	}
+	for _, selection := range selections {
+		*budget--
+		if *budget < 0 {
+			return fmt.Errorf("graphql operation expands to more than %d selections", maxGraphQLExpandedSelections)
+		}
+		switch {
+		case selection.field != nil:
+			onField(selection.field)
+		case selection.fragmentSpread != "":
+			fragment, ok := fragments[selection.fragmentSpread]
+			if !ok {
+				return fmt.Errorf("graphql fragment %s is not defined", selection.fragmentSpread)
+			}
+			if err := collectGraphQLFields(fragment.selections, fragments, depth+1, budget, onField); err != nil {
+				return err
+			}
+		default:
+			if err := collectGraphQLFields(selection.inline, fragments, depth+1, budget, onField); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// graphQLVariable is an unresolved variable reference in an argument value
+type graphQLVariable string
+
+func resolveGraphQLValue(value interface{}, variables, defaults map[string]interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case graphQLVariable:
+		if resolved, ok := variables[string(typedValue)]; ok {
+			return resolved
+		}
+		return defaults[string(typedValue)]
+	case []interface{}:
+		resolved := make([]interface{}, len(typedValue))
+		for idx, item := range typedValue {
+			resolved[idx] = resolveGraphQLValue(item, variables, defaults)
+		}
+		return resolved
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(typedValue))
+		for key, item := range typedValue {
+			resolved[key] = resolveGraphQLValue(item, variables, defaults)
+		}
+		return resolved
+	default:
+		return value
+	}
+}
+
+type graphQLParser struct {
+	source string
+	offset int
+	token  graphQLToken
+	depth  int
+}
+
+// nest enters a nested level of the document, callers leave it with unnest
+func (gp *graphQLParser) nest() error {
+	if gp.depth >= maxGraphQLNestingDepth {
+		return gp.errorf("nesting exceeds %d levels", maxGraphQLNestingDepth)
+	}
+	gp.depth++
+	return nil
+}
+
+func (gp *graphQLParser) unnest() {
+	gp.depth--
+}
+
+func (gp *graphQLParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("graphql syntax error at %d: %s", gp.token.pos, fmt.Sprintf(format, args...))
+}
+
+func (gp *graphQLParser) peek(kind graphQLTokenKind, value string) bool {
+	return gp.token.kind == kind && gp.token.value == value
+}
+
+func (gp *graphQLParser) skip(kind graphQLTokenKind, value string) (bool, error) {
+	if !gp.peek(kind, value) {
+		return false, nil
+	}
+	return true, gp.advance()
+}
+
+func (gp *graphQLParser) expect(kind graphQLTokenKind, value string) error {
+	if !gp.peek(kind, value) {
+		return gp.errorf("expected %q, got %q", value, gp.token.value)
+	}
+	return gp.advance()
+}
+
+func (gp *graphQLParser) expectName() (string, error) {
+	if gp.token.kind != graphQLTokenName {
+		return "", gp.errorf("expected a name, got %q", gp.token.value)
+	}
+	name := gp.token.value
+	return name, gp.advance()
+}
+
+func (gp *graphQLParser) parseDefinition() (*graphQLDefinition, error) {
+	definition := &graphQLDefinition{operationType: GraphQLOperationQuery, defaults: map[string]interface{}{}}
+	if gp.peek(graphQLTokenPunctuator, "{") {
+		// shorthand query
+		selections, err := gp.parseSelectionSet(true)
+		definition.selections = selections
+		return definition, err
+	}
+	if gp.token.kind != graphQLTokenName {
+		return nil, gp.errorf("expected a definition, got %q", gp.token.value)
+	}
+	switch gp.token.value {
+	case GraphQLOperationQuery, GraphQLOperationMutation, GraphQLOperationSubscription:
+		definition.operationType = gp.token.value
+		if err := gp.advance(); err != nil {
+			return nil, err
+		}
+		if gp.token.kind == graphQLTokenName {
+			definition.name = gp.token.value
+			if err := gp.advance(); err != nil {
+				return nil, err
+			}
+		}
+		if err := gp.parseVariableDefinitions(definition.defaults); err != nil {
+			return nil, err
+		}
+	case graphQLFragment:
+		definition.operationType = graphQLFragment
+		if err := gp.advance(); err != nil {
+			return nil, err
+		}
+		name, err := gp.expectName()
+		if err != nil {
+			return nil, err
+		}
+		definition.name = name
+		if err := gp.expect(graphQLTokenName, "on"); err != nil {
+			return nil, err
+		}
+		if _, err := gp.expectName(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, gp.errorf("unsupported definition %q", gp.token.value)
+	}
+	if err := gp.parseDirectives(); err != nil {
+		return nil, err
+	}
+	selections, err := gp.parseSelectionSet(true)
+	definition.selections = selections
+	return definition, err
+}
+
+func (gp *graphQLParser) parseVariableDefinitions(defaults map[string]interface{}) error {
+	if found, err := gp.skip(graphQLTokenPunctuator, "("); !found || err != nil {
+		return err
+	}
+	for {
+		if found, err := gp.skip(graphQLTokenPunctuator, ")"); found || err != nil {
+			return err
+		}
+		if err := gp.expect(graphQLTokenPunctuator, "$"); err != nil {
+			return err
+		}
+		name, err := gp.expectName()
+		if err != nil {
+			return err
+		}
+		if err := gp.expect(graphQLTokenPunctuator, ":"); err != nil {
+			return err
+		}
+		if err := gp.parseType(); err != nil {
+			return err
+		}
+		if found, err := gp.skip(graphQLTokenPunctuator, "="); err != nil {
+			return err
+		} else if found {
+			value, err := gp.parseValue(true)
+			if err != nil {
+				return err
+			}
+			defaults[name] = value
+		}
+		if err := gp.parseDirectives(); err != nil {
+			return err
+		}
+	}
+}
+
+func (gp *graphQLParser) parseType() error {
+	if found, err := gp.skip(graphQLTokenPunctuator, "["); err != nil {
+		return err
+	} else if found {
+		if err := gp.nest(); err != nil {
+			return err
+		}
+		defer gp.unnest()
+		if err := gp.parseType(); err != nil {
+			return err
+		}
+		if err := gp.expect(graphQLTokenPunctuator, "]"); err != nil {
+			return err
+		}
+	} else if _, err := gp.expectName(); err != nil {
+		return err
+	}
+	_, err := gp.skip(graphQLTokenPunctuator, "!")
+	return err
+}
+
+func (gp *graphQLParser) parseDirectives() error {
+	for gp.peek(graphQLTokenPunctuator, "@") {
+		if err := gp.advance(); err != nil {
+			return err
+		}
+		if _, err := gp.expectName(); err != nil {
+			return err
+		}
+		if _, err := gp.parseArguments(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSelectionSet parses a selection set, only the root selection set is kept, nested ones are validated and dropped
+func (gp *graphQLParser) parseSelectionSet(keep bool) ([]graphQLSelection, error) {
+	if err := gp.expect(graphQLTokenPunctuator, "{"); err != nil {
+		return nil, err
+	}
+	if err := gp.nest(); err != nil {
+		return nil, err
+	}
+	defer gp.unnest()
+	selections := []graphQLSelection{}
+	for empty := true; ; empty = false {
+		if found, err := gp.skip(graphQLTokenPunctuator, "}"); err != nil {
+			return nil, err
+		} else if found {
+			if empty {
+				return nil, gp.errorf("empty selection set")
+			}
+			return selections, nil
+		}
+		selection, err := gp.parseSelection(keep)
+		if err != nil {
+			return nil, err
+		}
+		if keep {
+			selections = append(selections, selection)
+		}
+	}
+}
+
+func (gp *graphQLParser) parseSelection(keep bool) (graphQLSelection, error) {
+	if found, err := gp.skip(graphQLTokenPunctuator, "..."); err != nil {
+		return graphQLSelection{}, err
+	} else if found {
+		if gp.token.kind == graphQLTokenName && gp.token.value != "on" {
+			name := gp.token.value
+			if err := gp.advance(); err != nil {
+				return graphQLSelection{}, err
+			}
+			return graphQLSelection{fragmentSpread: name}, gp.parseDirectives()
+		}
+		if found, err := gp.skip(graphQLTokenName, "on"); err != nil {
+			return graphQLSelection{}, err
+		} else if found {
+			if _, err := gp.expectName(); err != nil {
+				return graphQLSelection{}, err
+			}
+		}
+		if err := gp.parseDirectives(); err != nil {
+			return graphQLSelection{}, err
+		}
+		inline, err := gp.parseSelectionSet(keep)
+		return graphQLSelection{inline: inline}, err
+	}
+
+	name, err := gp.expectName()
+	if err != nil {
+		return graphQLSelection{}, err
+	}
+	field := &GraphQLField{Name: name}
+	if found, err := gp.skip(graphQLTokenPunctuator, ":"); err != nil {
+		return graphQLSelection{}, err
+	} else if found {
+		field.Alias = name
+		if field.Name, err = gp.expectName(); err != nil {
+			return graphQLSelection{}, err
+		}
+	}
+	if field.Arguments, err = gp.parseArguments(); err != nil {
+		return graphQLSelection{}, err
+	}
+	if err := gp.parseDirectives(); err != nil {
+		return graphQLSelection{}, err
+	}
+	if gp.peek(graphQLTokenPunctuator, "{") {
+		if _, err := gp.parseSelectionSet(false); err != nil {
+			return graphQLSelection{}, err
+		}
+	}
+	return graphQLSelection{field: field}, nil
+}
+
+func (gp *graphQLParser) parseArguments() (map[string]interface{}, error) {
+	arguments := map[string]interface{}{}
+	if found, err := gp.skip(graphQLTokenPunctuator, "("); !found || err != nil {
+		return arguments, err
+	}
+	for {
+		if found, err := gp.skip(graphQLTokenPunctuator, ")"); found || err != nil {
+			return arguments, err
+		}
+		name, err := gp.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := gp.expect(graphQLTokenPunctuator, ":"); err != nil {
+			return nil, err
+		}
+		if arguments[name], err = gp.parseValue(false); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseValue parses an argument value to the types encoding/json decodes to, int literals are parsed to int64
+func (gp *graphQLParser) parseValue(constant bool) (interface{}, error) {
+	token := gp.token
+	switch token.kind {
+	case graphQLTokenPunctuator:
+		switch token.value {
+		case "$":
+			if constant {
+				return nil, gp.errorf("variables are not allowed in default values")
+			}
+			if err := gp.advance(); err != nil {
+				return nil, err
+			}
+			name, err := gp.expectName()
+			return graphQLVariable(name), err
+		case "[":
+			if err := gp.nest(); err != nil {
+				return nil, err
+			}
+			defer gp.unnest()
+			if err := gp.advance(); err != nil {
+				return nil, err
+			}
+			list := []interface{}{}
+			for {
+				if found, err := gp.skip(graphQLTokenPunctuator, "]"); found || err != nil {
+					return list, err
+				}
+				item, err := gp.parseValue(constant)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, item)
+			}
+		case "{":
+			if err := gp.nest(); err != nil {
+				return nil, err
+			}
+			defer gp.unnest()
+			if err := gp.advance(); err != nil {
+				return nil, err
+			}
+			object := map[string]interface{}{}
+			for {
+				if found, err := gp.skip(graphQLTokenPunctuator, "}"); found || err != nil {
+					return object, err
+				}
+				name, err := gp.expectName()
+				if err != nil {
+					return nil, err
+				}
+				if err := gp.expect(graphQLTokenPunctuator, ":"); err != nil {
+					return nil, err
+				}
+				if object[name], err = gp.parseValue(constant); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case graphQLTokenInt:
+		value, err := strconv.ParseInt(token.value, 10, 64)
+		if err != nil {
+			// out of int64 range, keep the precision json numbers have
+			floatValue, floatErr := strconv.ParseFloat(token.value, 64)
+			if floatErr != nil {
+				return nil, gp.errorf("invalid int %q", token.value)
+			}
+			return floatValue, gp.advance()
+		}
+		return value, gp.advance()
+	case graphQLTokenFloat:
+		value, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return nil, gp.errorf("invalid float %q", token.value)
+		}
+		return value, gp.advance()
+	case graphQLTokenString:
+		return token.value, gp.advance()
+	case graphQLTokenName:
+		var value interface{}
+		switch token.value {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+			value = nil
+		default:
+			// enum values are kept as their names
+			value = token.value
+		}
+		return value, gp.advance()
+	}
+	return nil, gp.errorf("unexpected %q", token.value)
+}
+
+// advance reads the next token, ignoring whitespace, commas and comments
+func (gp *graphQLParser) advance() error {
+	source := gp.source
+	for gp.offset < len(source) {
+		char := source[gp.offset]
+		if char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == ',' {
+			gp.offset++
+		} else if char == '#' {
+			for gp.offset < len(source) && source[gp.offset] != '\n' && source[gp.offset] != '\r' {
+				gp.offset++
+			}
+		} else if strings.HasPrefix(source[gp.offset:], byteOrderMark) {
+			gp.offset += len(byteOrderMark)
+		} else {
+			break
+		}
+	}
+	start := gp.offset
+	if start >= len(source) {
+		gp.token = graphQLToken{kind: graphQLTokenEOF, pos: start}
+		return nil
+	}
+	char := source[start]
+	switch {
+	case strings.HasPrefix(source[start:], "..."):
+		gp.offset += 3
+		gp.token = graphQLToken{kind: graphQLTokenPunctuator, value: "...", pos: start}
+	case strings.IndexByte("!$&():=@[]{}|", char) >= 0:
+		gp.offset++
+		gp.token = graphQLToken{kind: graphQLTokenPunctuator, value: string(char), pos: start}
+	case char == '_' || isGraphQLLetter(char):
+		for gp.offset < len(source) && (source[gp.offset] == '_' || isGraphQLLetter(source[gp.offset]) || isGraphQLDigit(source[gp.offset])) {
+			gp.offset++
+		}
+		gp.token = graphQLToken{kind: graphQLTokenName, value: source[start:gp.offset], pos: start}
+	case char == '-' || isGraphQLDigit(char):
+		return gp.readNumber(start)
+	case strings.HasPrefix(source[start:], `"""`):
+		return gp.readBlockString(start)
+	case char == '"':
+		return gp.readString(start)
+	default:
+		return fmt.Errorf("graphql syntax error at %d: unexpected character %q", start, char)
+	}
+	return nil
+}
+
+func (gp *graphQLParser) readNumber(start int) error {
+	source := gp.source
+	kind := graphQLTokenInt
+	if source[gp.offset] == '-' {
+		gp.offset++
+	}
+	digits := func() int {
+		count := 0
+		for gp.offset < len(source) && isGraphQLDigit(source[gp.offset]) {
+			gp.offset++
+			count++
+		}
+		return count
+	}
+	if digits() == 0 {
+		return fmt.Errorf("graphql syntax error at %d: invalid number", start)
+	}
+	if gp.offset < len(source) && source[gp.offset] == '.' {
+		kind = graphQLTokenFloat
+		gp.offset++
+		if digits() == 0 {
+			return fmt.Errorf("graphql syntax error at %d: invalid number", start)
+		}
+	}
+	if gp.offset < len(source) && (source[gp.offset] == 'e' || source[gp.offset] == 'E') {
+		kind = graphQLTokenFloat
+		gp.offset++
+		if gp.offset < len(source) && (source[gp.offset] == '+' || source[gp.offset] == '-') {
+			gp.offset++
+		}
+		if digits() == 0 {
+			return fmt.Errorf("graphql syntax error at %d: invalid number", start)
+		}
+	}
+	gp.token = graphQLToken{kind: kind, value: source[start:gp.offset], pos: start}
+	return nil
+}
+
+func (gp *graphQLParser) readString(start int) error {
+	source := gp.source
+	gp.offset++
+	value := strings.Builder{}
+	for gp.offset < len(source) {
+		char := source[gp.offset]
+		switch char {
+		case '"':
+			gp.offset++
+			gp.token = graphQLToken{kind: graphQLTokenString, value: value.String(), pos: start}
+			return nil
+		case '\n', '\r':
+			return fmt.Errorf("graphql syntax error at %d: unterminated string", start)
+		case '\\':
+			if gp.offset+1 >= len(source) {
+				return fmt.Errorf("graphql syntax error at %d: unterminated string", start)
+			}
+			escaped := source[gp.offset+1]
+			gp.offset += 2
+			switch escaped {
+			case '"', '\\', '/':
+				value.WriteByte(escaped)
+			case 'b':
+				value.WriteByte('\b')
+			case 'f':
+				value.WriteByte('\f')
+			case 'n':
+				value.WriteByte('\n')
+			case 'r':
+				value.WriteByte('\r')
+			case 't':
+				value.WriteByte('\t')
+			case 'u':
+				if gp.offset+4 > len(source) {
+					return fmt.Errorf("graphql syntax error at %d: invalid unicode escape", start)
+				}
+				code, err := strconv.ParseUint(source[gp.offset:gp.offset+4], 16, 32)
+				if err != nil {
+					return fmt.Errorf("graphql syntax error at %d: invalid unicode escape", start)
+				}
+				value.WriteRune(rune(code))
+				gp.offset += 4
+			default:
+				return fmt.Errorf("graphql syntax error at %d: invalid escape %q", start, escaped)
+			}
+		default:
+			_, size := utf8.DecodeRuneInString(source[gp.offset:])
+			value.WriteString(source[gp.offset : gp.offset+size])
+			gp.offset += size
+		}
+	}
+	return fmt.Errorf("graphql syntax error at %d: unterminated string", start)
+}
+
+func (gp *graphQLParser) readBlockString(start int) error {
+	source := gp.source
+	gp.offset += 3
+	end := strings.Index(strings.ReplaceAll(source[gp.offset:], `\"""`, "xxxx"), `"""`)
+	if end < 0 {
+		return fmt.Errorf("graphql syntax error at %d: unterminated block string", start)
+	}
+	raw := strings.ReplaceAll(source[gp.offset:gp.offset+end], `\"""`, `"""`)
+	gp.offset += end + 3
+	gp.token = graphQLToken{kind: graphQLTokenString, value: raw, pos: start}
+	return nil
+}
+
+func isGraphQLLetter(char byte) bool {
+	return (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+}
+
+func isGraphQLDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
@@ -154,6 +154,62 @@ func (ss ServerSource) AllExtensionsForType(typeName string) ([]*desc.FieldDescr
 	return exts, nil
 }
 
+// DescriptorSourceFromSources resolves descriptors from the first source that has them
+func DescriptorSourceFromSources(sources ...grpcurl.DescriptorSource) grpcurl.DescriptorSource {
+	return MultiSource{Sources: sources}
+}
+
+type MultiSource struct {
+	Sources []grpcurl.DescriptorSource
+}
+
+func (ms MultiSource) ListServices() ([]string, error) {
+	services := []string{}
+	seen := map[string]struct{}{}
+	var lastErr error
+	for _, source := range ms.Sources {
+		sourceServices, err := source.ListServices()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		for _, service := range sourceServices {
+			if _, ok := seen[service]; !ok {
+				seen[service] = struct{}{}
+				services = append(services, service)
+			}
+		}
+	}
+	if len(services) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return services, nil
+}
+
+func (ms MultiSource) FindSymbol(fullyQualifiedName string) (desc.Descriptor, error) {
+	var err error
+	for _, source := range ms.Sources {
+		var descriptor desc.Descriptor
+		descriptor, err = source.FindSymbol(fullyQualifiedName)
+		if err == nil {
+			return descriptor, nil
+		}
+	}
+	return nil, err
+}
+
+func (ms MultiSource) AllExtensionsForType(typeName string) ([]*desc.FieldDescriptor, error) {
+	var err error
+	for _, source := range ms.Sources {
+		var extensions []*desc.FieldDescriptor
+		extensions, err = source.AllExtensionsForType(typeName)
+		if err == nil {
+			return extensions, nil
+		}
+	}
+	return nil, err
+}
+
 func ReflectionSupport(err error) error {
 	if err == nil {
 		return nil
@@ -246,6 +246,29 @@ func newClientSubscription(c *Client, namespace string, channel reflect.Value) *
 	return sub
 }
 
+// NewStreamSubscription creates a subscription for a stream that is not served by a Client, such as a
+// grpc server stream. onUnsubscribe is called when the subscription is unsubscribed, and the returned
+// end function finishes the subscription, delivering err on the error channel.
+func NewStreamSubscription(onUnsubscribe func()) (sub *ClientSubscription, end func(err error)) {
+	sub = &ClientSubscription{
+		quit:        make(chan error),
+		forwardDone: make(chan struct{}),
+		unsubDone:   make(chan struct{}),
+		err:         make(chan error, 1),
+	}
+	go func() {
+		defer close(sub.unsubDone)
+		err := <-sub.quit
+		close(sub.forwardDone)
+		if err == errUnsubscribed {
+			onUnsubscribe()
+			return
+		}
+		sub.err <- err
+	}()
+	return sub, sub.close
+}
+
 // Err returns the subscription error channel. The intended use of Err is to schedule
 // resubscription when the client connection is closed unexpectedly.
 //
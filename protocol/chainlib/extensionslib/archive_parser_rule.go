@@ -9,6 +9,10 @@ type ArchiveParserRule struct {
 }
 
 func (apr ArchiveParserRule) isPassingRule(extensionChainMessage ExtensionsChainMessage, latestBlock uint64) bool {
+	if apr.extension.Rule.HasContentRules() && !isPassingContentRules(apr.extension.Rule, extensionChainMessage) {
+		// archive is limited to the apis (and params) in its content rules
+		return false
+	}
 	_, earliestRequestedBlock := extensionChainMessage.RequestedBlock()
 	if earliestRequestedBlock < 0 {
 		// if asking for the latest block, or an api that doesn't have a specific block requested then it's not archive
@@ -0,0 +1,55 @@
+package extensionslib
+
+import (
+	"strings"
+
+	"github.com/lavanet/lava/protocol/parser"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+// BatchApiNameSeparator joins the names of the apis of a batch into the batch api name,
+// it is defined here (and aliased by chainlib.SEP) since chainlib imports this package
+const BatchApiNameSeparator = "&"
+
+// ContentParserRule routes requests to an extension by their content: the api name
+// (a set of names or method prefixes) and predicates on the parsed request params
+type ContentParserRule struct {
+	extension *spectypes.Extension
+}
+
+func (cpr ContentParserRule) isPassingRule(extensionChainMessage ExtensionsChainMessage, latestBlock uint64) bool {
+	return isPassingContentRules(cpr.extension.Rule, extensionChainMessage)
+}
+
+func isPassingContentRules(rule *spectypes.Rule, extensionChainMessage ExtensionsChainMessage) bool {
+	api := extensionChainMessage.GetApi()
+	if api == nil {
+		return false
+	}
+	// a batch needs the extension if any of its apis does
+	matchedName := false
+	for _, apiName := range strings.Split(api.Name, BatchApiNameSeparator) {
+		if rule.MatchApiName(apiName) {
+			matchedName = true
+			break
+		}
+	}
+	if !matchedName {
+		return false
+	}
+	if len(rule.Params) == 0 {
+		return true
+	}
+
+	rpcInput := extensionChainMessage.GetRPCInput()
+	if rpcInput == nil {
+		return false
+	}
+	for _, paramRule := range rule.Params {
+		value, err := parser.ParseFromParams(rpcInput, paramRule.Parser)
+		if !paramRule.Match(value, err == nil) {
+			return false
+		}
+	}
+	return true
+}
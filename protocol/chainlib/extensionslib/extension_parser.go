@@ -1,12 +1,15 @@
 package extensionslib
 
 import (
+	"github.com/lavanet/lava/protocol/parser"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
 type ExtensionsChainMessage interface {
 	SetExtension(*spectypes.Extension)
 	RequestedBlock() (latest int64, earliest int64)
+	GetApi() *spectypes.Api
+	GetRPCInput() parser.RPCInput // nil if the message can't be parsed
 }
 
 type ExtensionKey struct {
@@ -59,7 +62,7 @@ func (ep *ExtensionParser) ExtensionParsing(addon string, extensionsChainMessage
 			continue
 		}
 		extensionParserRule := NewExtensionParserRule(extension)
-		if extensionParserRule.isPassingRule(extensionsChainMessage, latestBlock) {
+		if extensionParserRule != nil && extensionParserRule.isPassingRule(extensionsChainMessage, latestBlock) {
 			extensionsChainMessage.SetExtension(extension)
 		}
 	}
@@ -70,6 +73,9 @@ func NewExtensionParserRule(extension *spectypes.Extension) ExtensionParserRule
 	case "archive":
 		return ArchiveParserRule{extension: extension}
 	default:
+		if extension.Rule.HasContentRules() {
+			return ContentParserRule{extension: extension}
+		}
 		// unsupported rule
 		return nil
 	}
@@ -0,0 +1,95 @@
+package extensionslib
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/parser"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExtensionsChainMessage struct {
+	api        *spectypes.Api
+	rpcInput   parser.RPCInput
+	earliest   int64
+	extensions []*spectypes.Extension
+}
+
+func (m *mockExtensionsChainMessage) SetExtension(extension *spectypes.Extension) {
+	m.extensions = append(m.extensions, extension)
+}
+
+func (m *mockExtensionsChainMessage) RequestedBlock() (latest int64, earliest int64) {
+	return m.earliest, m.earliest
+}
+
+func (m *mockExtensionsChainMessage) GetApi() *spectypes.Api {
+	return m.api
+}
+
+func (m *mockExtensionsChainMessage) GetRPCInput() parser.RPCInput {
+	return m.rpcInput
+}
+
+func TestExtensionParsingContentRules(t *testing.T) {
+	tracerParser := spectypes.BlockParser{ParserArg: []string{"$[1].tracer"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_PATH}
+	configured := map[ExtensionKey]*spectypes.Extension{
+		{Extension: "archive"}: {Name: "archive", Rule: &spectypes.Rule{Block: 100}},
+		{Extension: "debug"}:   {Name: "debug", Rule: &spectypes.Rule{MethodPrefixes: []string{"debug_"}}},
+		{Extension: "logs"}:    {Name: "logs", Rule: &spectypes.Rule{ApiNames: []string{"eth_getLogs"}}},
+		{Extension: "tracer"}: {Name: "tracer", Rule: &spectypes.Rule{
+			ApiNames: []string{"debug_traceCall"},
+			Params:   []spectypes.ParamRule{{Parser: tracerParser, Operator: spectypes.ParamRule_EQUAL, Value: "callTracer"}},
+		}},
+		// no rule, selected only by the consumer's headers
+		{Extension: "other"}: {Name: "other"},
+	}
+	extensionParser := ExtensionParser{}
+	extensionParser.SetConfiguredExtensions(configured)
+
+	playbook := []struct {
+		name       string
+		apiName    string
+		params     string
+		earliest   int64
+		extensions []string
+	}{
+		{"NoExtension", "eth_call", `[]`, spectypes.LATEST_BLOCK, nil},
+		{"Archive", "eth_call", `[]`, 10, []string{"archive"}},
+		{"ApiName", "eth_getLogs", `[]`, spectypes.LATEST_BLOCK, []string{"logs"}},
+		{"Prefix", "debug_traceTransaction", `["0x1"]`, spectypes.LATEST_BLOCK, []string{"debug"}},
+		{"ParamMatch", "debug_traceCall", `[{}, {"tracer": "callTracer"}]`, spectypes.LATEST_BLOCK, []string{"debug", "tracer"}},
+		{"ParamMismatch", "debug_traceCall", `[{}, {"tracer": "prestateTracer"}]`, spectypes.LATEST_BLOCK, []string{"debug"}},
+		{"ParamMissing", "debug_traceCall", `[{}]`, spectypes.LATEST_BLOCK, []string{"debug"}},
+		{"Batch", "eth_call&eth_getLogs", `[]`, spectypes.LATEST_BLOCK, []string{"logs"}},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			var params interface{}
+			require.NoError(t, json.Unmarshal([]byte(play.params), &params))
+			msg := &mockExtensionsChainMessage{
+				api:      &spectypes.Api{Name: play.apiName},
+				rpcInput: rpcInterfaceMessages.JsonrpcMessage{Params: params},
+				earliest: play.earliest,
+			}
+			extensionParser.ExtensionParsing("", msg, 1000)
+			names := []string{}
+			for _, extension := range msg.extensions {
+				names = append(names, extension.Name)
+			}
+			require.ElementsMatch(t, play.extensions, names)
+		})
+	}
+}
+
+func TestArchiveContentRules(t *testing.T) {
+	archive := &spectypes.Extension{Name: "archive", Rule: &spectypes.Rule{Block: 100, ApiNames: []string{"eth_getBalance"}}}
+	rule := NewExtensionParserRule(archive)
+	require.True(t, rule.isPassingRule(&mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getBalance"}, earliest: 10}, 1000))
+	require.False(t, rule.isPassingRule(&mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_call"}, earliest: 10}, 1000))
+	require.False(t, rule.isPassingRule(&mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getBalance"}, earliest: 990}, 1000))
+
+	require.Nil(t, NewExtensionParserRule(&spectypes.Extension{Name: "other"}))
+}
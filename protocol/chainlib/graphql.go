@@ -0,0 +1,477 @@
+package chainlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/favicon"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/protocol/parser"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const graphQLContentType = "application/graphql"
+
+type GraphQLChainParser struct {
+	BaseChainParser
+}
+
+// NewGraphQLChainParser creates a new instance of GraphQLChainParser
+func NewGraphQLChainParser() (chainParser *GraphQLChainParser, err error) {
+	return &GraphQLChainParser{}, nil
+}
+
+func (bcp *GraphQLChainParser) GetUniqueName() string {
+	return "graphql_chain_parser"
+}
+
+func (apip *GraphQLChainParser) getApiCollection(connectionType, internalPath, addon string) (*spectypes.ApiCollection, error) {
+	if apip == nil {
+		return nil, errors.New("ChainParser not defined")
+	}
+	return apip.BaseChainParser.getApiCollection(connectionType, internalPath, addon)
+}
+
+// apis of the graphql interface are the root fields of the schema, matched by the field name
+func (apip *GraphQLChainParser) getSupportedApi(name, connectionType string) (*ApiContainer, error) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return nil, errors.New("GraphQLChainParser not defined")
+	}
+	return apip.BaseChainParser.getSupportedApi(name, connectionType)
+}
+
+func (apip *GraphQLChainParser) CraftMessage(parsing *spectypes.ParseDirective, connectionType string, craftData *CraftData, metadata []pairingtypes.Metadata) (ChainMessageForSend, error) {
+	if craftData != nil {
+		// the api name of a graphql parse directive is the root field, the template is the whole request
+		chainMessage, err := apip.ParseMsg("", craftData.Data, craftData.ConnectionType, metadata, 0)
+		if err == nil {
+			chainMessage.AppendHeader(metadata)
+		}
+		return chainMessage, err
+	}
+
+	msg := &rpcInterfaceMessages.GraphQLMessage{
+		Query:       "{ " + parsing.ApiName + " }",
+		BaseMessage: chainproxy.BaseMessage{Headers: metadata},
+	}
+	apiCont, err := apip.getSupportedApi(parsing.ApiName, connectionType)
+	if err != nil {
+		return nil, err
+	}
+	apiCollection, err := apip.getApiCollection(connectionType, apiCont.collectionKey.InternalPath, apiCont.collectionKey.Addon)
+	if err != nil {
+		return nil, err
+	}
+	return apip.newChainMessage(apiCont.api, spectypes.NOT_APPLICABLE, 0, msg, apiCollection), nil
+}
+
+// ParseMsg parses a graphql request into a chain message, every root field of the executed operation is an api
+// and the request is priced like a batch of them
+func (apip *GraphQLChainParser) ParseMsg(urlPath string, data []byte, connectionType string, metadata []pairingtypes.Metadata, latestBlock uint64) (ChainMessage, error) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return nil, errors.New("GraphQLChainParser not defined")
+	}
+	msg, operation, err := rpcInterfaceMessages.ParseGraphQLMsg(data)
+	if err != nil {
+		return nil, err
+	}
+	if operation.Type == rpcInterfaceMessages.GraphQLOperationSubscription {
+		return nil, utils.LavaFormatWarning("graphql subscriptions are not supported", nil, utils.Attribute{Key: "operation", Value: operation.Name})
+	}
+	if urlPath != "" {
+		urlObj, err := url.Parse(urlPath)
+		if err != nil {
+			return nil, err
+		}
+		msg.Path = urlObj.Path
+	}
+
+	var api *spectypes.Api
+	var apiCollection *spectypes.ApiCollection
+	var latestRequestedBlock, earliestRequestedBlock int64 = 0, 0
+	requestedBlocks := make([]int64, len(operation.Fields))
+	for idx, field := range operation.Fields {
+		// Check api is supported
+		apiCont, err := apip.getSupportedApi(field.Name, connectionType)
+		if err != nil {
+			return nil, utils.LavaFormatInfo("getSupportedApi graphql failed", utils.LogAttr("reason", err), utils.Attribute{Key: "field", Value: field.Name})
+		}
+		apiCollectionForField, err := apip.getApiCollection(connectionType, apiCont.collectionKey.InternalPath, apiCont.collectionKey.Addon)
+		if err != nil {
+			return nil, fmt.Errorf("could not find the interface %s in the service %s, %w", connectionType, apiCont.api.Name, err)
+		}
+		fieldMsg := *msg
+		fieldMsg.Arguments = field.Arguments
+		requestedBlocks[idx], err = parser.ParseBlockFromParams(fieldMsg, apiCont.api.BlockParsing)
+		if err != nil {
+			utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
+			requestedBlocks[idx] = spectypes.NOT_APPLICABLE
+		}
+		fieldApi := applyCuFormula(apiCont.api, fieldMsg)
+		if idx == 0 {
+			api = fieldApi
+			apiCollection = apiCollectionForField
+			continue
+		}
+		// an operation with several root fields is priced like a batch, sum the compute units, take the strictest
+		// category and the addon the fields require
+		if apiCollectionForField.CollectionData.AddOn != "" && apiCollectionForField.CollectionData.AddOn != apiCollection.CollectionData.AddOn {
+			if apiCollection.CollectionData.AddOn != "" {
+				return nil, utils.LavaFormatError("unable to parse graphql operation with fields from multiple addons", nil,
+					utils.Attribute{Key: "first addon", Value: apiCollection.CollectionData.AddOn},
+					utils.Attribute{Key: "second addon", Value: apiCollectionForField.CollectionData.AddOn})
+			}
+			apiCollection = apiCollectionForField
+		}
+		api = &spectypes.Api{
+			Enabled:           api.Enabled && apiCont.api.Enabled,
+			Name:              api.Name + SEP + apiCont.api.Name,
+			ComputeUnits:      api.ComputeUnits + fieldApi.ComputeUnits,
+			ExtraComputeUnits: api.ExtraComputeUnits + apiCont.api.ExtraComputeUnits,
+			Category:          api.GetCategory().Combine(apiCont.api.GetCategory()),
+			BlockParsing: spectypes.BlockParser{
+				ParserArg:    []string{},
+				ParserFunc:   spectypes.PARSER_FUNC_EMPTY,
+				DefaultValue: "",
+				Encoding:     "",
+			},
+		}
+	}
+
+	metadata, overwriteReqBlock, _ := apip.HandleHeaders(metadata, apiCollection, spectypes.Header_pass_send)
+	settingHeaderDirective, _, _ := apip.GetParsingByTag(spectypes.FUNCTION_TAG_SET_LATEST_IN_METADATA)
+	msg.BaseMessage = chainproxy.BaseMessage{Headers: metadata, LatestBlockHeaderSetter: settingHeaderDirective}
+	if len(operation.Fields) == 1 {
+		msg.Arguments = operation.Fields[0].Arguments
+	}
+
+	if overwriteReqBlock == "" {
+		latestRequestedBlock = requestedBlocks[0]
+		if len(requestedBlocks) > 1 {
+			earliestRequestedBlock = requestedBlocks[0]
+			for _, requestedBlock := range requestedBlocks[1:] {
+				var earlierBlock int64
+				latestRequestedBlock, earlierBlock = CompareRequestedBlockInBatch(latestRequestedBlock, requestedBlock)
+				_, earliestRequestedBlock = CompareRequestedBlockInBatch(earliestRequestedBlock, earlierBlock)
+			}
+		}
+	} else {
+		latestRequestedBlock, err = msg.ParseBlock(overwriteReqBlock)
+		if err != nil {
+			utils.LavaFormatError("failed parsing block from an overwrite header", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "overwriteReqBlock", Value: overwriteReqBlock})
+			latestRequestedBlock = spectypes.NOT_APPLICABLE
+		}
+	}
+
+	nodeMsg := apip.newChainMessage(api, latestRequestedBlock, earliestRequestedBlock, msg, apiCollection)
+	apip.BaseChainParser.ExtensionParsing(apiCollection.CollectionData.AddOn, nodeMsg, latestBlock)
+	return nodeMsg, nil
+}
+
+func (*GraphQLChainParser) newChainMessage(serviceApi *spectypes.Api, requestedBlock int64, earliestRequestedBlock int64, msg *rpcInterfaceMessages.GraphQLMessage, apiCollection *spectypes.ApiCollection) *baseChainMessageContainer {
+	nodeMsg := &baseChainMessageContainer{
+		api:                    serviceApi,
+		apiCollection:          apiCollection,
+		latestRequestedBlock:   requestedBlock,
+		earliestRequestedBlock: earliestRequestedBlock,
+		msg:                    msg,
+	}
+	return nodeMsg
+}
+
+// SetSpec sets the spec for the GraphQLChainParser
+func (apip *GraphQLChainParser) SetSpec(spec spectypes.Spec) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return
+	}
+
+	// Add a read-write lock to ensure thread safety
+	apip.rwLock.Lock()
+	defer apip.rwLock.Unlock()
+
+	// extract server and tagged apis from spec
+	serverApis, taggedApis, apiCollections, headers, verifications := getServiceApis(spec, spectypes.APIInterfaceGraphQL)
+	apip.BaseChainParser.Construct(spec, taggedApis, serverApis, apiCollections, headers, verifications)
+}
+
+// DataReliabilityParams returns data reliability params from spec (spec.enabled and spec.dataReliabilityThreshold)
+func (apip *GraphQLChainParser) DataReliabilityParams() (enabled bool, dataReliabilityThreshold uint32) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return false, 0
+	}
+
+	// Acquire read lock
+	apip.rwLock.RLock()
+	defer apip.rwLock.RUnlock()
+
+	// Return enabled and data reliability threshold from spec
+	return apip.spec.DataReliabilityEnabled, apip.spec.GetReliabilityThreshold()
+}
+
+// ChainBlockStats returns block stats from spec
+// (spec.AllowedBlockLagForQosSync, spec.AverageBlockTime, spec.BlockDistanceForFinalizedData)
+func (apip *GraphQLChainParser) ChainBlockStats() (allowedBlockLagForQosSync int64, averageBlockTime time.Duration, blockDistanceForFinalizedData, blocksInFinalizationProof uint32) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return 0, 0, 0, 0
+	}
+
+	// Acquire read lock
+	apip.rwLock.RLock()
+	defer apip.rwLock.RUnlock()
+
+	// Convert average block time from int64 -> time.Duration
+	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Millisecond
+
+	// Return values
+	return apip.spec.AllowedBlockLagForQosSync, averageBlockTime, apip.spec.BlockDistanceForFinalizedData, apip.spec.BlocksInFinalizationProof
+}
+
+type GraphQLChainListener struct {
+	endpoint    *lavasession.RPCEndpoint
+	relaySender RelaySender
+	logger      *metrics.RPCConsumerLogs
+}
+
+// NewGraphQLChainListener creates a new instance of GraphQLChainListener
+func NewGraphQLChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint, relaySender RelaySender, rpcConsumerLogs *metrics.RPCConsumerLogs) (chainListener *GraphQLChainListener) {
+	chainListener = &GraphQLChainListener{
+		listenEndpoint,
+		relaySender,
+		rpcConsumerLogs,
+	}
+
+	return chainListener
+}
+
+// Serve http server for GraphQLChainListener, requests are accepted on any path as a json POST, an
+// application/graphql POST or a GET with the request in the url query
+func (apil *GraphQLChainListener) Serve(ctx context.Context) {
+	// Guard that the GraphQLChainListener instance exists
+	if apil == nil {
+		return
+	}
+
+	// Setup HTTP Server
+	app := fiber.New(fiber.Config{})
+
+	app.Use(favicon.New())
+
+	app.Post("/*", func(fiberCtx *fiber.Ctx) error {
+		requestBody := fiberCtx.Body()
+		if strings.HasPrefix(string(fiberCtx.Request().Header.ContentType()), graphQLContentType) {
+			var err error
+			requestBody, err = json.Marshal(rpcInterfaceMessages.GraphQLMessage{Query: string(requestBody)})
+			if err != nil {
+				return err
+			}
+		}
+		return apil.handleRequest(fiberCtx, string(requestBody))
+	})
+
+	app.Get("/*", func(fiberCtx *fiber.Ctx) error {
+		graphQLMessage := rpcInterfaceMessages.GraphQLMessage{
+			Query:         fiberCtx.Query("query"),
+			OperationName: fiberCtx.Query("operationName"),
+		}
+		if variables := fiberCtx.Query("variables"); variables != "" {
+			graphQLMessage.Variables = json.RawMessage(variables)
+		}
+		if extensions := fiberCtx.Query("extensions"); extensions != "" {
+			graphQLMessage.Extensions = json.RawMessage(extensions)
+		}
+		requestBody, err := json.Marshal(graphQLMessage)
+		if err != nil {
+			fiberCtx.Status(fiber.StatusBadRequest)
+			return fiberCtx.SendString(convertToGraphQLError("invalid graphql request in url query: " + err.Error()))
+		}
+		return apil.handleRequest(fiberCtx, string(requestBody))
+	})
+
+	// Go
+	ListenWithRetry(app, apil.endpoint.NetworkAddress)
+}
+
+func (apil *GraphQLChainListener) handleRequest(fiberCtx *fiber.Ctx, requestBody string) error {
+	// Set response header content-type to application/json
+	fiberCtx.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	startTime := time.Now()
+	endTx := apil.logger.LogStartTransaction("graphql-http")
+	defer endTx()
+
+	path := "/" + fiberCtx.Params("*")
+	dappID := extractDappIDFromFiberContext(fiberCtx)
+	analytics := metrics.NewRelayAnalytics(dappID, apil.endpoint.ChainID, apil.endpoint.ApiInterface)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel() // incase there's a problem make sure to cancel the connection
+	guid := utils.GenerateUniqueIdentifier()
+	ctx = utils.WithUniqueIdentifier(ctx, guid)
+	msgSeed := strconv.FormatUint(guid, 10)
+	utils.LavaFormatInfo("in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "dappID", Value: dappID}, utils.Attribute{Key: "msgSeed", Value: msgSeed})
+
+	headers := convertToMetadataMap(fiberCtx.GetReqHeaders())
+	relayResult, err := apil.relaySender.SendRelay(ctx, path, requestBody, http.MethodPost, dappID, fiberCtx.Get(common.IP_FORWARDING_HEADER_NAME, fiberCtx.IP()), analytics, headers)
+	reply := relayResult.GetReply()
+	go apil.logger.AddMetricForHttp(analytics, err, fiberCtx.GetReqHeaders())
+	if err != nil {
+		// Get unique GUID response
+		errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
+
+		// Log request and response
+		apil.logger.LogRequestAndResponse("graphql http", true, fiberCtx.Method(), path, requestBody, errMasking, msgSeed, time.Since(startTime), err)
+
+		// Set status to internal error
+		if relayResult.GetStatusCode() != 0 {
+			fiberCtx.Status(relayResult.StatusCode)
+		} else {
+			fiberCtx.Status(fiber.StatusInternalServerError)
+		}
+
+		// Return error in the graphql response format
+		return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), convertToGraphQLError(errMasking))
+	}
+	// Log request and response
+	apil.logger.LogRequestAndResponse("graphql http", false, fiberCtx.Method(), path, requestBody, string(reply.Data), msgSeed, time.Since(startTime), nil)
+	if relayResult.GetStatusCode() != 0 {
+		fiberCtx.Status(relayResult.StatusCode)
+	}
+	// Return json response
+	return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), string(reply.Data))
+}
+
+func convertToGraphQLError(errorMsg string) string {
+	jsonResponse, err := json.Marshal(fiber.Map{
+		"errors": []rpcInterfaceMessages.GraphQLError{{Message: errorMsg}},
+	})
+	if err != nil {
+		return `{"errors": [{"message": "Failed to marshal error response to json"}]}`
+	}
+
+	return string(jsonResponse)
+}
+
+type GraphQLChainProxy struct {
+	BaseChainProxy
+	httpClient *http.Client
+}
+
+func NewGraphQLChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser) (ChainProxy, error) {
+	if len(rpcProviderEndpoint.NodeUrls) == 0 {
+		return nil, utils.LavaFormatError("rpcProviderEndpoint.NodeUrl list is empty missing node url", nil, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "ApiInterface", Value: rpcProviderEndpoint.ApiInterface})
+	}
+	_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
+	nodeUrl := rpcProviderEndpoint.NodeUrls[0]
+	nodeUrl.Url = strings.TrimSuffix(nodeUrl.Url, "/")
+	gcp := &GraphQLChainProxy{
+		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: nodeUrl, ErrorHandler: &GraphQLErrorHandler{}, ChainID: rpcProviderEndpoint.ChainID},
+		httpClient: &http.Client{
+			Timeout: 5 * time.Minute, // we are doing a timeout by request
+		},
+	}
+	return gcp, nil
+}
+
+func (gcp *GraphQLChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	if ch != nil {
+		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on graphql", nil)
+	}
+	rpcInputMessage := chainMessage.GetRPCMessage()
+	nodeMessage, ok := rpcInputMessage.(*rpcInterfaceMessages.GraphQLMessage)
+	if !ok {
+		return nil, "", nil, utils.LavaFormatError("invalid message type in graphql, failed to cast RPCInput from chainMessage", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "rpcMessage", Value: rpcInputMessage})
+	}
+	body, err := json.Marshal(nodeMessage)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	relayTimeout := common.LocalNodeTimePerCu(chainMessage.GetApi().ComputeUnits)
+	// check if this API is hanging (waiting for block confirmation)
+	if chainMessage.GetApi().Category.HangingApi {
+		relayTimeout += gcp.averageBlockTime
+	}
+	connectCtx, cancel := gcp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(connectCtx, http.MethodPost, gcp.NodeUrl.AuthConfig.AddAuthPath(gcp.NodeUrl.Url+nodeMessage.Path), bytes.NewReader(body))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for _, metadata := range nodeMessage.GetHeaders() {
+		req.Header.Set(metadata.Name, metadata.Value)
+	}
+	gcp.NodeUrl.SetAuthHeaders(ctx, req.Header.Set)
+	gcp.NodeUrl.SetIpForwardingIfNecessary(ctx, req.Header.Set)
+
+	if debug {
+		utils.LavaFormatDebug("provider sending node message",
+			utils.Attribute{Key: "method", Value: chainMessage.GetApi().Name},
+			utils.Attribute{Key: "headers", Value: req.Header},
+			utils.Attribute{Key: "apiInterface", Value: spectypes.APIInterfaceGraphQL},
+		)
+	}
+	res, err := gcp.httpClient.Do(req)
+	if res != nil {
+		// resp can be non nil on error
+		trailer := metadata.Pairs(common.StatusCodeMetadataKey, strconv.Itoa(res.StatusCode))
+		grpc.SetTrailer(ctx, trailer) // we ignore this error here since this code can be triggered not from grpc
+	}
+	if err != nil {
+		// Validate if the error is related to the provider connection to the node or it is a valid error
+		// in case the error is valid (e.g. bad input parameters) the error will return in the form of a valid error reply
+		if parsedError := gcp.HandleNodeError(ctx, err); parsedError != nil {
+			return nil, "", nil, parsedError
+		}
+		// always return a lava error in this case
+		return nil, "", nil, err
+	}
+	defer res.Body.Close()
+
+	// here we received a response that can be an error response, graphql errors in the body are relayed as a valid reply
+	err = gcp.HandleStatusError(res.StatusCode, nodeMessage.GetDisableErrorHandling())
+	if err != nil {
+		return nil, "", nil, utils.LavaFormatWarning("Received invalid status code", nil, utils.Attribute{Key: "Status Code", Value: res.StatusCode}, utils.Attribute{Key: "chainID", Value: gcp.BaseChainProxy.ChainID}, utils.Attribute{Key: "apiName", Value: chainMessage.GetApi().Name})
+	}
+
+	body, err = io.ReadAll(res.Body)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	reply := &pairingtypes.RelayReply{
+		Data:     body,
+		Metadata: convertToMetadataMapOfSlices(res.Header),
+	}
+
+	// checking if graphql reply data is in json format
+	err = gcp.HandleJSONFormatError(reply.Data)
+	if err != nil {
+		return nil, "", nil, utils.LavaFormatError("GraphQL reply is not a JSON object", nil, utils.Attribute{Key: "reply.Data", Value: string(reply.Data)})
+	}
+
+	return reply, "", nil, nil
+}
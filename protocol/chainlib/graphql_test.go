@@ -0,0 +1,136 @@
+package chainlib
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func graphQLTestSpec() spectypes.Spec {
+	blockParsing := spectypes.BlockParser{ParserArg: []string{"number"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL, DefaultValue: "latest"}
+	return spectypes.Spec{
+		Index:            "GQL",
+		Enabled:          true,
+		AverageBlockTime: 1000,
+		ApiCollections: []*spectypes.ApiCollection{
+			{
+				Enabled:        true,
+				CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceGraphQL, Type: http.MethodPost},
+				Apis: []*spectypes.Api{
+					{Name: "block", Enabled: true, ComputeUnits: 10, BlockParsing: blockParsing, Category: spectypes.SpecCategory{Deterministic: true}},
+					{Name: "transactions", Enabled: true, ComputeUnits: 20, BlockParsing: blockParsing, Category: spectypes.SpecCategory{Deterministic: true}},
+					{Name: "pending", Enabled: false, ComputeUnits: 10},
+				},
+			},
+		},
+	}
+}
+
+func TestGraphQLChainParser_NilGuard(t *testing.T) {
+	var apip *GraphQLChainParser
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("apip methods missing nill guard, panicked with: %v", r)
+		}
+	}()
+
+	apip.SetSpec(spectypes.Spec{})
+	apip.DataReliabilityParams()
+	apip.ChainBlockStats()
+	apip.getSupportedApi("", "")
+	apip.ParseMsg("", []byte{}, "", nil, 0)
+}
+
+func TestGraphQLParseMsg(t *testing.T) {
+	apip, err := NewGraphQLChainParser()
+	require.NoError(t, err)
+	apip.SetSpec(graphQLTestSpec())
+
+	t.Run("single field", func(t *testing.T) {
+		chainMessage, err := apip.ParseMsg("/graphql", []byte(`{"query":"query ($n: Int) { block(number: $n) { hash } }","variables":{"n":100}}`), http.MethodPost, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, "block", chainMessage.GetApi().Name)
+		require.Equal(t, uint64(10), chainMessage.GetApi().ComputeUnits)
+		latest, earliest := chainMessage.RequestedBlock()
+		require.Equal(t, int64(100), latest)
+		require.Equal(t, int64(100), earliest)
+		graphQLMessage, ok := chainMessage.GetRPCMessage().(*rpcInterfaceMessages.GraphQLMessage)
+		require.True(t, ok)
+		require.Equal(t, "/graphql", graphQLMessage.Path)
+	})
+
+	t.Run("operation fields are priced like a batch", func(t *testing.T) {
+		chainMessage, err := apip.ParseMsg("", []byte(`{"query":"{ a: block(number: 100) { hash } b: block(number: 300) { hash } transactions(number: 200) { id } }"}`), http.MethodPost, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, "block"+SEP+"block"+SEP+"transactions", chainMessage.GetApi().Name)
+		require.Equal(t, uint64(40), chainMessage.GetApi().ComputeUnits)
+		latest, earliest := chainMessage.RequestedBlock()
+		require.Equal(t, int64(300), latest)
+		require.Equal(t, int64(100), earliest)
+	})
+
+	t.Run("default block", func(t *testing.T) {
+		chainMessage, err := apip.ParseMsg("", []byte(`{"query":"{ block { hash } }"}`), http.MethodPost, nil, 0)
+		require.NoError(t, err)
+		latest, _ := chainMessage.RequestedBlock()
+		require.Equal(t, spectypes.LATEST_BLOCK, latest)
+	})
+
+	for name, body := range map[string]string{
+		"unsupported field": `{"query":"{ accounts { id } }"}`,
+		"disabled field":    `{"query":"{ pending { id } }"}`,
+		"subscription":      `{"query":"subscription { block { hash } }"}`,
+		"invalid query":     `{"query":"{ block( }"}`,
+		"not json":          `{ block { hash } }`,
+	} {
+		body := body
+		t.Run(name, func(t *testing.T) {
+			_, err := apip.ParseMsg("", []byte(body), http.MethodPost, nil, 0)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGraphQLChainProxy(t *testing.T) {
+	ctx := context.Background()
+	apip, err := NewGraphQLChainParser()
+	require.NoError(t, err)
+	apip.SetSpec(graphQLTestSpec())
+
+	var receivedPath, receivedBody, receivedContentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receivedPath = r.URL.Path
+		receivedBody = string(body)
+		receivedContentType = r.Header.Get("Content-Type")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"block":{"hash":"0x1"}}}`))
+	}))
+	defer server.Close()
+
+	chainProxy, err := NewGraphQLChainProxy(ctx, 1, lavasession.RPCProviderEndpoint{ChainID: "GQL", ApiInterface: spectypes.APIInterfaceGraphQL, NodeUrls: []common.NodeUrl{{Url: server.URL + "/"}}}, apip)
+	require.NoError(t, err)
+
+	request := `{"query":"query Block($n: Int) { block(number: $n) { hash } }","variables":{"n":100},"operationName":"Block"}`
+	chainMessage, err := apip.ParseMsg("/subgraphs/name/test?ignored=1", []byte(request), http.MethodPost, []pairingtypes.Metadata{}, 0)
+	require.NoError(t, err)
+	reply, _, _, err := chainProxy.SendNodeMsg(ctx, nil, chainMessage)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"block":{"hash":"0x1"}}}`, string(reply.Data))
+	require.Equal(t, "/subgraphs/name/test", receivedPath)
+	require.JSONEq(t, request, receivedBody)
+	require.Equal(t, "application/json", receivedContentType)
+
+	_, _, _, err = chainProxy.SendNodeMsg(ctx, make(chan interface{}), chainMessage)
+	require.Error(t, err)
+}
@@ -39,6 +39,7 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	reflectionpbo "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 type GrpcNodeErrorResponse struct {
@@ -96,18 +97,28 @@ func (apip *GrpcChainParser) getSupportedApi(name, connectionType string) (*ApiC
 	return apip.BaseChainParser.getSupportedApi(name, connectionType)
 }
 
-func (apip *GrpcChainParser) setupForConsumer(relayer grpcproxy.ProxyCallBack) {
-	apip.registry = dyncodec.NewRegistry(dyncodec.NewRelayerRemote(relayer))
+func (apip *GrpcChainParser) setupForConsumer(relayer grpcproxy.ProxyCallBack, descriptorSet *descriptorpb.FileDescriptorSet) {
+	remote := withFileDescriptorSet(dyncodec.NewRelayerRemote(relayer), descriptorSet)
+	apip.registry = dyncodec.NewRegistry(remote)
 	apip.codec = dyncodec.NewCodec(apip.registry)
 }
 
-func (apip *GrpcChainParser) setupForProvider(reflectionConnection *grpc.ClientConn) error {
-	remote := dyncodec.NewGRPCReflectionProtoFileRegistryFromConn(reflectionConnection)
+func (apip *GrpcChainParser) setupForProvider(reflectionConnection *grpc.ClientConn, descriptorSet *descriptorpb.FileDescriptorSet) error {
+	remote := withFileDescriptorSet(dyncodec.NewGRPCReflectionProtoFileRegistryFromConn(reflectionConnection), descriptorSet)
 	apip.registry = dyncodec.NewRegistry(remote)
 	apip.codec = dyncodec.NewCodec(apip.registry)
 	return nil
 }
 
+// withFileDescriptorSet resolves proto files from the configured descriptor set first, and from reflection
+// for anything the set doesn't describe
+func withFileDescriptorSet(reflectionRemote dyncodec.ProtoFileRegistry, descriptorSet *descriptorpb.FileDescriptorSet) dyncodec.ProtoFileRegistry {
+	if descriptorSet == nil {
+		return reflectionRemote
+	}
+	return dyncodec.NewChainedProtoFileRegistry(dyncodec.NewFileDescriptorSetProtoFileRegistry(descriptorSet), reflectionRemote)
+}
+
 func (apip *GrpcChainParser) CraftMessage(parsing *spectypes.ParseDirective, connectionType string, craftData *CraftData, metadata []pairingtypes.Metadata) (ChainMessageForSend, error) {
 	if craftData != nil {
 		chainMessage, err := apip.ParseMsg(craftData.Path, craftData.Data, craftData.ConnectionType, metadata, 0)
@@ -183,7 +194,7 @@ func (apip *GrpcChainParser) ParseMsg(url string, data []byte, connectionType st
 		}
 	}
 
-	nodeMsg := apip.newChainMessage(apiCont.api, requestedBlock, &grpcMessage, apiCollection)
+	nodeMsg := apip.newChainMessage(applyCuFormula(apiCont.api, grpcMessage), requestedBlock, &grpcMessage, apiCollection)
 	apip.BaseChainParser.ExtensionParsing(apiCollection.CollectionData.AddOn, nodeMsg, latestBlock)
 	return nodeMsg, nil
 }
@@ -283,7 +294,7 @@ func (apil *GrpcChainListener) Serve(ctx context.Context) {
 
 	lis := GetListenerWithRetryGrpc("tcp", apil.endpoint.NetworkAddress)
 	apiInterface := apil.endpoint.ApiInterface
-	sendRelayCallback := func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error) {
+	sendRelay := func(ctx context.Context, method string, reqBody []byte) (*common.RelayResult, error) {
 		guid := utils.GenerateUniqueIdentifier()
 		ctx = utils.WithUniqueIdentifier(ctx, guid)
 		msgSeed := strconv.FormatUint(guid, 10)
@@ -297,33 +308,50 @@ func (apil *GrpcChainListener) Serve(ctx context.Context) {
 		metricsData := metrics.NewRelayAnalytics(dappID, apil.endpoint.ChainID, apiInterface)
 		consumerIp := common.GetIpFromGrpcContext(ctx)
 		relayResult, err := apil.relaySender.SendRelay(ctx, method, string(reqBody), "", dappID, consumerIp, metricsData, grpcHeaders)
-		relayReply := relayResult.GetReply()
 		go apil.logger.AddMetricForGrpc(metricsData, err, &metadataValues)
 
 		if err != nil {
 			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
 			apil.logger.LogRequestAndResponse("http in/out", true, method, string(reqBody), "", errMasking, msgSeed, time.Since(startTime), err)
-			return nil, nil, utils.LavaFormatError("Failed to SendRelay", fmt.Errorf(errMasking))
+			return nil, utils.LavaFormatError("Failed to SendRelay", fmt.Errorf(errMasking))
 		}
 		apil.logger.LogRequestAndResponse("http in/out", false, method, string(reqBody), "", "", msgSeed, time.Since(startTime), nil)
-
-		// try checking for node errors.
-		nodeError := &GrpcNodeErrorResponse{}
-		unMarshalingError := json.Unmarshal(relayReply.Data, nodeError)
-		metadataToReply := relayReply.Metadata
-		if unMarshalingError == nil {
-			return nil, convertRelayMetaDataToMDMetaData(metadataToReply), status.Error(codes.Code(nodeError.ErrorCode), nodeError.ErrorMessage)
+		return relayResult, nil
+	}
+	sendRelayCallback := func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error) {
+		relayResult, err := sendRelay(ctx, method, reqBody)
+		if err != nil {
+			return nil, nil, err
 		}
-		return relayReply.Data, convertRelayMetaDataToMDMetaData(metadataToReply), nil
+		return parseGrpcRelayReply(relayResult.GetReply())
+	}
+	streamRelayCallback := func(ctx context.Context, method string, reqBody []byte, stream grpcproxy.ProxyStream) error {
+		relayResult, err := sendRelay(ctx, method, reqBody)
+		if err != nil {
+			return err
+		}
+		if replyServer := relayResult.GetReplyServer(); replyServer != nil {
+			return relayGrpcStream(ctx, *replyServer, stream)
+		}
+		respBytes, md, err := parseGrpcRelayReply(relayResult.GetReply())
+		if err != nil {
+			return err
+		}
+		stream.SetHeader(md)
+		return stream.Send(respBytes)
 	}
 
-	_, httpServer, err := grpcproxy.NewGRPCProxy(sendRelayCallback)
+	_, httpServer, err := grpcproxy.NewGRPCStreamProxy(streamRelayCallback)
 	if err != nil {
 		utils.LavaFormatFatal("provider failure RegisterServer", err, utils.Attribute{Key: "listenAddr", Value: apil.endpoint.NetworkAddress})
 	}
 
 	// setup chain parser
-	apil.chainParser.setupForConsumer(sendRelayCallback)
+	descriptorSet, err := dyncodec.LoadFileDescriptorSet(apil.endpoint.ProtoDescriptorSets...)
+	if err != nil {
+		utils.LavaFormatFatal("failed loading proto descriptor sets", err, utils.Attribute{Key: "ChainID", Value: apil.endpoint.ChainID}, utils.Attribute{Key: "files", Value: apil.endpoint.ProtoDescriptorSets})
+	}
+	apil.chainParser.setupForConsumer(sendRelayCallback, descriptorSet)
 
 	utils.LavaFormatInfo("Server listening", utils.Attribute{Key: "Address", Value: lis.Addr()})
 
@@ -332,10 +360,54 @@ func (apil *GrpcChainListener) Serve(ctx context.Context) {
 	}
 }
 
+// parseGrpcRelayReply converts a relay reply to a grpc response, replies holding a node error are returned as the node's status
+func parseGrpcRelayReply(relayReply *pairingtypes.RelayReply) ([]byte, metadata.MD, error) {
+	// try checking for node errors.
+	nodeError := &GrpcNodeErrorResponse{}
+	unMarshalingError := json.Unmarshal(relayReply.GetData(), nodeError)
+	metadataToReply := relayReply.GetMetadata()
+	if unMarshalingError == nil {
+		return nil, convertRelayMetaDataToMDMetaData(metadataToReply), status.Error(codes.Code(nodeError.ErrorCode), nodeError.ErrorMessage)
+	}
+	return relayReply.GetData(), convertRelayMetaDataToMDMetaData(metadataToReply), nil
+}
+
+// relayGrpcStream writes the replies of a server-streaming relay to the client. the first reply of the subscription
+// carries the node's headers, every reply after it is a message of the stream
+func relayGrpcStream(ctx context.Context, replyServer pairingtypes.Relayer_RelaySubscribeClient, stream grpcproxy.ProxyStream) error {
+	reply, err := replyServer.Recv()
+	if err != nil {
+		return utils.LavaFormatError("failed receiving subscription reply", err, utils.Attribute{Key: "GUID", Value: ctx})
+	}
+	_, md, err := parseGrpcRelayReply(reply)
+	if err != nil {
+		return err
+	}
+	stream.SetHeader(md)
+	for {
+		reply, err = replyServer.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return utils.LavaFormatError("failed receiving stream message", err, utils.Attribute{Key: "GUID", Value: ctx})
+		}
+		respBytes, _, err := parseGrpcRelayReply(reply)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(respBytes)
+		if err != nil {
+			return err
+		}
+	}
+}
+
 type GrpcChainProxy struct {
 	BaseChainProxy
 	conn             grpcConnectorInterface
 	descriptorsCache *grpcDescriptorCache
+	fileDescriptors  grpcurl.DescriptorSource // nil unless descriptor sets are configured
 }
 type grpcConnectorInterface interface {
 	Close()
@@ -350,14 +422,18 @@ func NewGrpcChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint lav
 	_, averageBlockTime, _, _ := parser.ChainBlockStats()
 	nodeUrl := rpcProviderEndpoint.NodeUrls[0]
 	nodeUrl.Url = strings.TrimSuffix(nodeUrl.Url, "/") // remove suffix if exists
+	descriptorSet, err := dyncodec.LoadFileDescriptorSet(rpcProviderEndpoint.ProtoDescriptorSets...)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed loading proto descriptor sets", err, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "files", Value: rpcProviderEndpoint.ProtoDescriptorSets})
+	}
 	conn, err := chainproxy.NewGRPCConnector(ctx, nConns, nodeUrl)
 	if err != nil {
 		return nil, err
 	}
-	return newGrpcChainProxy(ctx, nodeUrl.Url, averageBlockTime, parser, conn)
+	return newGrpcChainProxy(ctx, nodeUrl.Url, averageBlockTime, parser, conn, descriptorSet)
 }
 
-func newGrpcChainProxy(ctx context.Context, nodeUrl string, averageBlockTime time.Duration, parser ChainParser, conn grpcConnectorInterface) (ChainProxy, error) {
+func newGrpcChainProxy(ctx context.Context, nodeUrl string, averageBlockTime time.Duration, parser ChainParser, conn grpcConnectorInterface, descriptorSet *descriptorpb.FileDescriptorSet) (ChainProxy, error) {
 	cp := &GrpcChainProxy{
 		BaseChainProxy:   BaseChainProxy{averageBlockTime: averageBlockTime, ErrorHandler: &GRPCErrorHandler{}},
 		descriptorsCache: &grpcDescriptorCache{},
@@ -366,6 +442,13 @@ func newGrpcChainProxy(ctx context.Context, nodeUrl string, averageBlockTime tim
 	if cp.conn == nil {
 		return nil, utils.LavaFormatError("g_conn == nil", nil)
 	}
+	if descriptorSet != nil {
+		fileDescriptors, err := grpcurl.DescriptorSourceFromFileDescriptorSet(descriptorSet)
+		if err != nil {
+			return nil, utils.LavaFormatError("invalid proto descriptor sets, they must include their imports", err)
+		}
+		cp.fileDescriptors = fileDescriptors
+	}
 
 	reflectionConnection, err := conn.GetRpc(context.Background(), true)
 	if err != nil {
@@ -378,7 +461,7 @@ func newGrpcChainProxy(ctx context.Context, nodeUrl string, averageBlockTime tim
 		conn.ReturnRpc(reflectionConnection)
 	}()
 
-	err = parser.(*GrpcChainParser).setupForProvider(reflectionConnection)
+	err = parser.(*GrpcChainParser).setupForProvider(reflectionConnection, descriptorSet)
 	if err != nil {
 		return nil, fmt.Errorf("grpc chain proxy: failed to setup parser: %w", err)
 	}
@@ -386,14 +469,17 @@ func newGrpcChainProxy(ctx context.Context, nodeUrl string, averageBlockTime tim
 }
 
 func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
-	if ch != nil {
-		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on grpc", nil, utils.Attribute{Key: "GUID", Value: ctx})
-	}
 	conn, err := cp.conn.GetRpc(ctx, true)
 	if err != nil {
 		return nil, "", nil, utils.LavaFormatError("grpc get connection failed ", err, utils.Attribute{Key: "GUID", Value: ctx})
 	}
-	defer cp.conn.ReturnRpc(conn)
+	// a server stream keeps using the connection after we return, it's returned when the stream ends
+	streaming := false
+	defer func() {
+		if !streaming {
+			cp.conn.ReturnRpc(conn)
+		}
+	}()
 
 	rpcInputMessage := chainMessage.GetRPCMessage()
 	nodeMessage, ok := rpcInputMessage.(*rpcInterfaceMessages.GrpcMessage)
@@ -419,6 +505,9 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 
 	cl := grpcreflect.NewClient(ctx, reflectionpbo.NewServerReflectionClient(conn))
 	descriptorSource := rpcInterfaceMessages.DescriptorSourceFromServer(cl)
+	if cp.fileDescriptors != nil {
+		descriptorSource = rpcInterfaceMessages.DescriptorSourceFromSources(cp.fileDescriptors, descriptorSource)
+	}
 	svc, methodName := rpcInterfaceMessages.ParseSymbol(nodeMessage.Path)
 
 	// check if we have method descriptor already cached.
@@ -440,6 +529,12 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		// add the descriptor to the chainProxy cache
 		cp.descriptorsCache.setDescriptor(methodName, methodDescriptor)
 	}
+	if methodDescriptor.IsClientStreaming() {
+		return nil, "", nil, utils.LavaFormatError("client streaming grpc methods are not supported", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: nodeMessage.Path})
+	}
+	if methodDescriptor.IsServerStreaming() != (ch != nil) {
+		return nil, "", nil, utils.LavaFormatError("grpc server streaming methods must be relayed as a subscription, and only them", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: nodeMessage.Path}, utils.Attribute{Key: "subscription", Value: ch != nil})
+	}
 
 	msgFactory := dynamic.NewMessageFactoryWithDefaults()
 
@@ -490,6 +585,10 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 			utils.Attribute{Key: "apiInterface", Value: "grpc"},
 		)
 	}
+	if ch != nil {
+		streaming = true
+		return cp.subscribeNodeStream(ctx, conn, ch, nodeMessage, methodDescriptor, msg, msgFactory)
+	}
 	var respHeaders metadata.MD
 	response := msgFactory.NewMessage(methodDescriptor.GetOutputType())
 	connectCtx, cancel := cp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
@@ -526,6 +625,84 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	return reply, "", nil, nil
 }
 
+// subscribeNodeStream opens a server stream on the node and forwards its messages to ch until it ends, the returned reply carries
+// the stream headers. conn is owned by the stream and returned to the connector when the stream ends
+func (cp *GrpcChainProxy) subscribeNodeStream(ctx context.Context, conn *grpc.ClientConn, ch chan interface{}, nodeMessage *rpcInterfaceMessages.GrpcMessage, methodDescriptor *desc.MethodDescriptor, msg proto.Message, msgFactory *dynamic.MessageFactory) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	var respHeaders metadata.MD
+	stream, err := conn.NewStream(streamCtx, &grpc.StreamDesc{ServerStreams: true}, "/"+nodeMessage.Path)
+	if err == nil {
+		err = stream.SendMsg(msg)
+	}
+	if err == nil {
+		err = stream.CloseSend()
+	}
+	if err == nil {
+		// blocks until the node accepts the stream, a rejected stream returns its error here
+		respHeaders, err = stream.Header()
+	}
+	if err != nil {
+		cancel()
+		cp.conn.ReturnRpc(conn)
+		if parsedError := cp.HandleNodeError(ctx, err); parsedError != nil {
+			return nil, "", nil, parsedError
+		}
+		// the node rejected the stream, reply with its error like a unary call does
+		respBytes, handlingError := parseGrpcNodeErrorToReply(ctx, err)
+		if handlingError != nil {
+			return nil, "", nil, handlingError
+		}
+		reply := &pairingtypes.RelayReply{
+			Data:     respBytes,
+			Metadata: convertToMetadataMapOfSlices(respHeaders),
+		}
+		return reply, "", nil, nil
+	}
+
+	sub, endSubscription := rpcclient.NewStreamSubscription(cancel)
+	go func() {
+		defer cp.conn.ReturnRpc(conn)
+		for {
+			response := msgFactory.NewMessage(methodDescriptor.GetOutputType())
+			err := stream.RecvMsg(response)
+			if err == io.EOF {
+				endSubscription(nil)
+				return
+			}
+			var respBytes []byte
+			if err != nil {
+				if streamCtx.Err() != nil {
+					// unsubscribed
+					endSubscription(streamCtx.Err())
+					return
+				}
+				// the node failed the stream, forward its error as the last message
+				respBytes, _ = parseGrpcNodeErrorToReply(ctx, err)
+			} else {
+				respBytes, err = proto.Marshal(response)
+				if err != nil {
+					endSubscription(utils.LavaFormatError("proto.Marshal(response) Failed", err, utils.Attribute{Key: "GUID", Value: ctx}))
+					return
+				}
+			}
+			select {
+			case ch <- respBytes:
+			case <-streamCtx.Done():
+				endSubscription(streamCtx.Err())
+				return
+			}
+			if err != nil {
+				endSubscription(err)
+				return
+			}
+		}
+	}()
+	reply := &pairingtypes.RelayReply{
+		Metadata: convertToMetadataMapOfSlices(respHeaders),
+	}
+	return reply, strconv.FormatUint(utils.GenerateUniqueIdentifier(), 10), sub, nil
+}
+
 // This method assumes that the error is due to misuse of the request arguments, meaning the user would like to get
 // the response from the server to fix the request arguments. this method will make sure the user will get the response
 // from the node in the same format as expected.
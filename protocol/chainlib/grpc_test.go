@@ -3,19 +3,31 @@ package chainlib
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	"github.com/golang/protobuf/proto"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/parser"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const (
@@ -154,6 +166,104 @@ func TestGrpcChainProxy(t *testing.T) {
 	}
 }
 
+func TestGrpcChainProxyServerStreaming(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	grpcServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	gogoreflection.Register(grpcServer)
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	chainParser, err := NewGrpcChainParser()
+	require.NoError(t, err)
+	endpoint := lavasession.RPCProviderEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceGrpc, NodeUrls: []common.NodeUrl{{Url: lis.Addr().String()}}}
+	chainProxy, err := NewGrpcChainProxy(ctx, 1, endpoint, chainParser)
+	require.NoError(t, err)
+
+	watchMessage := func() ChainMessageForSend {
+		return &baseChainMessageContainer{
+			api: &spectypes.Api{Name: "grpc.health.v1.Health/Watch", ComputeUnits: 10},
+			msg: &rpcInterfaceMessages.GrpcMessage{Path: "grpc.health.v1.Health/Watch", Msg: []byte(`{"service":""}`)},
+		}
+	}
+	ch := make(chan interface{})
+	reply, subscriptionID, sub, err := chainProxy.SendNodeMsg(ctx, ch, watchMessage())
+	require.NoError(t, err)
+	require.NotNil(t, sub)
+	require.NotEmpty(t, subscriptionID)
+	require.Empty(t, reply.Data)
+
+	receive := func() grpc_health_v1.HealthCheckResponse_ServingStatus {
+		select {
+		case data := <-ch:
+			response := &grpc_health_v1.HealthCheckResponse{}
+			require.NoError(t, proto.Unmarshal(data.([]byte), response))
+			return response.Status
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no stream message")
+			return grpc_health_v1.HealthCheckResponse_UNKNOWN
+		}
+	}
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, receive())
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, receive())
+
+	// a server streaming method can't be relayed as a unary call
+	_, _, _, err = chainProxy.SendNodeMsg(ctx, nil, watchMessage())
+	require.Error(t, err)
+
+	sub.Unsubscribe()
+	_, open := <-sub.Err()
+	require.False(t, open)
+}
+
+func TestGrpcChainProxyDescriptorSets(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the node doesn't serve reflection
+	grpcServer := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	set := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(grpc_health_v1.File_grpc_health_v1_health_proto)}}
+	setBytes, err := proto.Marshal(set)
+	require.NoError(t, err)
+	descriptorSetPath := filepath.Join(t.TempDir(), "health.pb")
+	require.NoError(t, os.WriteFile(descriptorSetPath, setBytes, 0o600))
+
+	chainParser, err := NewGrpcChainParser()
+	require.NoError(t, err)
+	endpoint := lavasession.RPCProviderEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceGrpc, NodeUrls: []common.NodeUrl{{Url: lis.Addr().String()}}}
+	_, err = NewGrpcChainProxy(ctx, 1, lavasession.RPCProviderEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceGrpc, NodeUrls: endpoint.NodeUrls, ProtoDescriptorSets: []string{descriptorSetPath + ".missing"}}, chainParser)
+	require.Error(t, err)
+	endpoint.ProtoDescriptorSets = []string{descriptorSetPath}
+	chainProxy, err := NewGrpcChainProxy(ctx, 1, endpoint, chainParser)
+	require.NoError(t, err)
+
+	chainMessage := &baseChainMessageContainer{
+		api: &spectypes.Api{Name: "grpc.health.v1.Health/Check", ComputeUnits: 10},
+		msg: &rpcInterfaceMessages.GrpcMessage{Path: "grpc.health.v1.Health/Check", Msg: []byte(`{"service":""}`)},
+	}
+	reply, _, _, err := chainProxy.SendNodeMsg(ctx, nil, chainMessage)
+	require.NoError(t, err)
+	response := &grpc_health_v1.HealthCheckResponse{}
+	require.NoError(t, proto.Unmarshal(reply.Data, response))
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, response.Status)
+
+	// the parser's codec resolves the same descriptors
+	grpcMessage := rpcInterfaceMessages.GrpcMessage{Path: "grpc.health.v1.Health/Check", Registry: chainParser.registry, Codec: chainParser.codec}
+	grpcMessage.Msg, err = proto.Marshal(&grpc_health_v1.HealthCheckRequest{Service: "lava"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"service": "lava"}, grpcMessage.GetParams())
+}
+
 func TestParsingRequestedBlocksHeadersGrpc(t *testing.T) {
 	ctx := context.Background()
 	callbackHeaderNameToCheck := ""
@@ -0,0 +1,179 @@
+package dyncodec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var (
+	_ ProtoFileRegistry = (*FileDescriptorSetProtoFileRegistry)(nil)
+	_ ProtoFileRegistry = ChainedProtoFileRegistry(nil)
+)
+
+// LoadFileDescriptorSet reads FileDescriptorSet files, as written by protoc --descriptor_set_out or buf build,
+// and merges them into a single set. it returns nil when no paths are given.
+func LoadFileDescriptorSet(paths ...string) (*descriptorpb.FileDescriptorSet, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	merged := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]struct{}{}
+	for _, path := range paths {
+		setBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read file descriptor set: %w", err)
+		}
+		set := &descriptorpb.FileDescriptorSet{}
+		// buf images are file descriptor sets with extra fields, those are discarded
+		err = proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(setBytes, set)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal file descriptor set %s: %w", path, err)
+		}
+		for _, file := range set.File {
+			if _, ok := seen[file.GetName()]; ok {
+				continue
+			}
+			seen[file.GetName()] = struct{}{}
+			merged.File = append(merged.File, file)
+		}
+	}
+	return merged, nil
+}
+
+func NewFileDescriptorSetProtoFileRegistry(set *descriptorpb.FileDescriptorSet) *FileDescriptorSetProtoFileRegistry {
+	registry := &FileDescriptorSetProtoFileRegistry{
+		files:   map[string]*descriptorpb.FileDescriptorProto{},
+		symbols: map[protoreflect.FullName]*descriptorpb.FileDescriptorProto{},
+	}
+	for _, file := range set.GetFile() {
+		registry.files[file.GetName()] = file
+		registry.indexFile(file)
+	}
+	return registry
+}
+
+// FileDescriptorSetProtoFileRegistry is a ProtoFileRegistry
+// which resolves files from a FileDescriptorSet, for nodes that don't serve grpc reflection.
+type FileDescriptorSetProtoFileRegistry struct {
+	files   map[string]*descriptorpb.FileDescriptorProto
+	symbols map[protoreflect.FullName]*descriptorpb.FileDescriptorProto
+}
+
+func (f *FileDescriptorSetProtoFileRegistry) ProtoFileByPath(path string) (*descriptorpb.FileDescriptorProto, error) {
+	file, ok := f.files[path]
+	if !ok {
+		return nil, fmt.Errorf("proto file by path: %s: %w", path, protoregistry.NotFound)
+	}
+	return file, nil
+}
+
+func (f *FileDescriptorSetProtoFileRegistry) ProtoFileContainingSymbol(name protoreflect.FullName) (*descriptorpb.FileDescriptorProto, error) {
+	file, ok := f.symbols[name]
+	if !ok {
+		return nil, fmt.Errorf("proto file containing symbol: %s: %w", name, protoregistry.NotFound)
+	}
+	return file, nil
+}
+
+func (f *FileDescriptorSetProtoFileRegistry) Close() error { return nil }
+
+// indexFile maps every symbol declared in the file to it, like grpc reflection resolves symbols
+func (f *FileDescriptorSetProtoFileRegistry) indexFile(file *descriptorpb.FileDescriptorProto) {
+	pkg := protoreflect.FullName(file.GetPackage())
+	for _, message := range file.GetMessageType() {
+		f.indexMessage(file, pkg, message)
+	}
+	for _, enum := range file.GetEnumType() {
+		f.indexEnum(file, pkg, enum)
+	}
+	for _, extension := range file.GetExtension() {
+		f.symbols[pkg.Append(protoreflect.Name(extension.GetName()))] = file
+	}
+	for _, service := range file.GetService() {
+		serviceName := pkg.Append(protoreflect.Name(service.GetName()))
+		f.symbols[serviceName] = file
+		for _, method := range service.GetMethod() {
+			f.symbols[serviceName.Append(protoreflect.Name(method.GetName()))] = file
+		}
+	}
+}
+
+func (f *FileDescriptorSetProtoFileRegistry) indexMessage(file *descriptorpb.FileDescriptorProto, scope protoreflect.FullName, message *descriptorpb.DescriptorProto) {
+	messageName := scope.Append(protoreflect.Name(message.GetName()))
+	f.symbols[messageName] = file
+	for _, field := range message.GetField() {
+		f.symbols[messageName.Append(protoreflect.Name(field.GetName()))] = file
+	}
+	for _, extension := range message.GetExtension() {
+		f.symbols[messageName.Append(protoreflect.Name(extension.GetName()))] = file
+	}
+	for _, oneof := range message.GetOneofDecl() {
+		f.symbols[messageName.Append(protoreflect.Name(oneof.GetName()))] = file
+	}
+	for _, nested := range message.GetNestedType() {
+		f.indexMessage(file, messageName, nested)
+	}
+	for _, enum := range message.GetEnumType() {
+		f.indexEnum(file, messageName, enum)
+	}
+}
+
+func (f *FileDescriptorSetProtoFileRegistry) indexEnum(file *descriptorpb.FileDescriptorProto, scope protoreflect.FullName, enum *descriptorpb.EnumDescriptorProto) {
+	f.symbols[scope.Append(protoreflect.Name(enum.GetName()))] = file
+	// enum values are scoped as siblings of their enum
+	for _, value := range enum.GetValue() {
+		f.symbols[scope.Append(protoreflect.Name(value.GetName()))] = file
+	}
+}
+
+func NewChainedProtoFileRegistry(registries ...ProtoFileRegistry) ChainedProtoFileRegistry {
+	return registries
+}
+
+// ChainedProtoFileRegistry is a ProtoFileRegistry
+// which resolves files from the first of its registries that has them.
+type ChainedProtoFileRegistry []ProtoFileRegistry
+
+func (c ChainedProtoFileRegistry) ProtoFileByPath(path string) (*descriptorpb.FileDescriptorProto, error) {
+	var errs []error
+	for _, registry := range c {
+		file, err := registry.ProtoFileByPath(path)
+		if err == nil {
+			return file, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil, protoregistry.NotFound
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (c ChainedProtoFileRegistry) ProtoFileContainingSymbol(name protoreflect.FullName) (*descriptorpb.FileDescriptorProto, error) {
+	var errs []error
+	for _, registry := range c {
+		file, err := registry.ProtoFileContainingSymbol(name)
+		if err == nil {
+			return file, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil, protoregistry.NotFound
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (c ChainedProtoFileRegistry) Close() error {
+	var errs []error
+	for _, registry := range c {
+		errs = append(errs, registry.Close())
+	}
+	return errors.Join(errs...)
+}
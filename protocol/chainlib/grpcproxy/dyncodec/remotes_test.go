@@ -2,6 +2,8 @@ package dyncodec
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/lavanet/lava/protocol/chainlib/grpcproxy"
@@ -13,7 +15,9 @@ import (
 	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/dynamicpb"
 )
@@ -82,4 +86,43 @@ func TestRemotes(t *testing.T) {
 		})
 		testRemote(remote)
 	})
+
+	writeDescriptorSet := func(files ...protoreflect.FileDescriptor) string {
+		set := &descriptorpb.FileDescriptorSet{}
+		for _, file := range files {
+			set.File = append(set.File, protodesc.ToFileDescriptorProto(file))
+		}
+		setBytes, err := proto.Marshal(set)
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), "descriptors.pb")
+		require.NoError(t, os.WriteFile(path, setBytes, 0o600))
+		return path
+	}
+
+	t.Run("test file descriptor set remote", func(t *testing.T) {
+		set, err := LoadFileDescriptorSet(
+			writeDescriptorSet(grpc_reflection_v1alpha.File_grpc_reflection_v1alpha_reflection_proto),
+			writeDescriptorSet(descriptorpb.File_google_protobuf_descriptor_proto, grpc_reflection_v1alpha.File_grpc_reflection_v1alpha_reflection_proto),
+		)
+		require.NoError(t, err)
+		require.Len(t, set.File, 2)
+		remote := NewFileDescriptorSetProtoFileRegistry(set)
+		testRemote(remote)
+
+		// nested and enum symbols resolve to their file
+		for _, symbol := range []protoreflect.FullName{"grpc.reflection.v1alpha.ServerReflection.ServerReflectionInfo", "google.protobuf.FieldDescriptorProto.Type", "google.protobuf.FieldDescriptorProto.TYPE_STRING"} {
+			_, err = remote.ProtoFileContainingSymbol(symbol)
+			require.NoError(t, err, symbol)
+		}
+		_, err = remote.ProtoFileContainingSymbol("lavanet.lava.Missing")
+		require.ErrorIs(t, err, protoregistry.NotFound)
+	})
+
+	t.Run("test chained remote", func(t *testing.T) {
+		// files missing from the descriptor set are resolved by reflection
+		set, err := LoadFileDescriptorSet(writeDescriptorSet(descriptorpb.File_google_protobuf_descriptor_proto))
+		require.NoError(t, err)
+		remote := NewChainedProtoFileRegistry(NewFileDescriptorSetProtoFileRegistry(set), NewGRPCReflectionProtoFileRegistryFromConn(conn))
+		testRemote(remote)
+	})
 }
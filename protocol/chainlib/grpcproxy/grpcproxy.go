@@ -16,7 +16,29 @@ import (
 
 type ProxyCallBack = func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error)
 
+// ProxyStreamCallBack handles calls that can reply with more than one message, as server-streaming methods do
+type ProxyStreamCallBack = func(ctx context.Context, method string, reqBody []byte, stream ProxyStream) error
+
+// ProxyStream is where a ProxyStreamCallBack writes its replies, headers must be set before the first message is sent
+type ProxyStream interface {
+	SetHeader(md metadata.MD) error
+	Send(respBytes []byte) error
+}
+
+type proxyStream struct {
+	grpc.ServerStream
+}
+
+func (ps proxyStream) Send(respBytes []byte) error {
+	return ps.SendMsg(respBytes)
+}
+
 func NewGRPCProxy(cb ProxyCallBack) (*grpc.Server, *http.Server, error) {
+	return NewGRPCStreamProxy(unaryProxyCallBack(cb))
+}
+
+// NewGRPCStreamProxy is NewGRPCProxy for callbacks that support server-streaming methods
+func NewGRPCStreamProxy(cb ProxyStreamCallBack) (*grpc.Server, *http.Server, error) {
 	s := grpc.NewServer(grpc.UnknownServiceHandler(makeProxyFunc(cb)), grpc.ForceServerCodec(RawBytesCodec{}))
 	wrappedServer := grpcweb.WrapServer(s)
 	handler := func(resp http.ResponseWriter, req *http.Request) {
@@ -33,7 +55,19 @@ func NewGRPCProxy(cb ProxyCallBack) (*grpc.Server, *http.Server, error) {
 	return s, httpServer, nil
 }
 
-func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
+// unaryProxyCallBack adapts a callback that replies with a single message to the stream proxy
+func unaryProxyCallBack(callBack ProxyCallBack) ProxyStreamCallBack {
+	return func(ctx context.Context, method string, reqBody []byte, stream ProxyStream) error {
+		respBytes, md, err := callBack(ctx, method, reqBody)
+		if err != nil {
+			return err
+		}
+		stream.SetHeader(md)
+		return stream.Send(respBytes)
+	}
+}
+
+func makeProxyFunc(callBack ProxyStreamCallBack) grpc.StreamHandler {
 	return func(srv interface{}, stream grpc.ServerStream) error {
 		// currently the callback function does not account for headers.
 		methodName, ok := grpc.MethodFromServerStream(stream)
@@ -45,12 +79,7 @@ func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
 		if err != nil {
 			return err
 		}
-		respBytes, md, err := callBack(stream.Context(), methodName[1:], reqBytes) // strip first '/' of the method name
-		if err != nil {
-			return err
-		}
-		stream.SetHeader(md)
-		return stream.SendMsg(respBytes)
+		return callBack(stream.Context(), methodName[1:], reqBytes, proxyStream{stream}) // strip first '/' of the method name
 	}
 }
 
@@ -2,10 +2,13 @@ package grpcproxy
 
 import (
 	"context"
+	"io"
+	"strconv"
 	"testing"
 
 	"github.com/lavanet/lava/protocol/chainlib/grpcproxy/testproto"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -36,3 +39,41 @@ func TestGRPCProxy(t *testing.T) {
 	do()
 	do()
 }
+
+func TestGRPCStreamProxy(t *testing.T) {
+	const messages = 3
+	proxyGRPCSrv, _, err := NewGRPCStreamProxy(func(ctx context.Context, method string, reqBody []byte, stream ProxyStream) error {
+		require.Equal(t, "lavanet.lava.test.Test/Stream", method)
+		req := new(testproto.TestRequest)
+		err := req.Unmarshal(reqBody)
+		require.NoError(t, err)
+		err = stream.SetHeader(metadata.Pairs("test-headers", "55"))
+		require.NoError(t, err)
+		for i := 0; i < messages; i++ {
+			respBytes, err := (&testproto.TestResponse{Response: req.Request + "-" + strconv.Itoa(i)}).Marshal()
+			require.NoError(t, err)
+			err = stream.Send(respBytes)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	conn := testproto.InMemoryClientConn(t, proxyGRPCSrv)
+	stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{ServerStreams: true}, "/lavanet.lava.test.Test/Stream")
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&testproto.TestRequest{Request: "echo"}))
+	require.NoError(t, stream.CloseSend())
+
+	header, err := stream.Header()
+	require.NoError(t, err)
+	require.Equal(t, []string{"55"}, header.Get("test-headers"))
+	for i := 0; i < messages; i++ {
+		resp := new(testproto.TestResponse)
+		require.NoError(t, stream.RecvMsg(resp))
+		require.Equal(t, "echo-"+strconv.Itoa(i), resp.Response)
+	}
+	require.ErrorIs(t, stream.RecvMsg(new(testproto.TestResponse)), io.EOF)
+}
@@ -20,12 +20,14 @@ import (
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
-const SEP = "&"
+// SEP joins the names of the apis of a batch
+const SEP = extensionslib.BatchApiNameSeparator
 
 type JsonRPCChainParser struct {
 	BaseChainParser
@@ -132,9 +134,10 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 				requestedBlockForMessage = spectypes.NOT_APPLICABLE
 			}
 		}
+		msgApi := applyCuFormula(apiCont.api, msg)
 		if idx == 0 {
 			// on the first entry store them
-			api = apiCont.api
+			api = msgApi
 			apiCollection = apiCollectionForMessage
 			latestRequestedBlock = requestedBlockForMessage
 		} else {
@@ -161,7 +164,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 			api = &spectypes.Api{
 				Enabled:           api.Enabled && apiCont.api.Enabled,
 				Name:              api.Name + SEP + apiCont.api.Name,
-				ComputeUnits:      api.ComputeUnits + apiCont.api.ComputeUnits,
+				ComputeUnits:      api.ComputeUnits + msgApi.ComputeUnits,
 				ExtraComputeUnits: api.ExtraComputeUnits + apiCont.api.ExtraComputeUnits,
 				Category:          category,
 				BlockParsing: spectypes.BlockParser{
@@ -118,6 +118,12 @@ func (tendermintErrorHandler *TendermintRPCErrorHandler) HandleNodeError(ctx con
 	return tendermintErrorHandler.handleGenericErrors(ctx, nodeError)
 }
 
+type GraphQLErrorHandler struct{ genericErrorHandler }
+
+func (gqleh *GraphQLErrorHandler) HandleNodeError(ctx context.Context, nodeError error) error {
+	return gqleh.handleGenericErrors(ctx, nodeError)
+}
+
 type GRPCErrorHandler struct{ genericErrorHandler }
 
 func (geh *GRPCErrorHandler) HandleNodeError(ctx context.Context, nodeError error) error {
@@ -0,0 +1,116 @@
+package chainlib
+
+import (
+	"context"
+	"hash/fnv"
+	"strings"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// RPCProviderStickinessHeaderName is the header the provider adds to node requests with a token unique to the consumer,
+// node pools route requests with the same token to the same node
+var RPCProviderStickinessHeaderName = "X-Node-Sticky"
+
+// splitNodePool splits node urls serving the same addons into the endpoints of interchangeable nodes.
+// a node is the set of urls with different roles (internal path, websocket or http), so the urls are split
+// only when every role has the same number of urls, the i-th url of each role belonging to the i-th node
+func splitNodePool(rpcProviderEndpoint lavasession.RPCProviderEndpoint) []lavasession.RPCProviderEndpoint {
+	roles := []string{}
+	urlsByRole := map[string][]common.NodeUrl{}
+	for _, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+		role := nodeUrl.InternalPath
+		if strings.HasPrefix(nodeUrl.Url, "ws://") || strings.HasPrefix(nodeUrl.Url, "wss://") {
+			role += "|ws"
+		}
+		if _, ok := urlsByRole[role]; !ok {
+			roles = append(roles, role)
+		}
+		urlsByRole[role] = append(urlsByRole[role], nodeUrl)
+	}
+	poolSize := len(urlsByRole[roles[0]])
+	for _, role := range roles {
+		if len(urlsByRole[role]) != poolSize {
+			// can't tell which urls belong to the same node, keep them together
+			return []lavasession.RPCProviderEndpoint{rpcProviderEndpoint}
+		}
+	}
+	nodes := make([]lavasession.RPCProviderEndpoint, poolSize)
+	for idx := range nodes {
+		nodes[idx] = rpcProviderEndpoint
+		nodes[idx].NodeUrls = make([]common.NodeUrl, 0, len(roles))
+		for _, role := range roles {
+			nodes[idx].NodeUrls = append(nodes[idx].NodeUrls, urlsByRole[role][idx])
+		}
+	}
+	return nodes
+}
+
+// nodePoolChainProxy sends relays to one of several nodes serving the same addons and extensions.
+// relays carrying a stickiness token are always sent to the same node so stateful node features
+// (filters, pending transactions) keep working. requests without one are the provider's own (chain
+// tracking, subscriptions), they are all sent to the primary node so the blocks and hashes they read
+// come from a single node
+type nodePoolChainProxy struct {
+	chainProxies []ChainProxy
+	nodeUrls     []string
+}
+
+func newNodePoolChainProxy(chainProxies []ChainProxy, nodes []lavasession.RPCProviderEndpoint) ChainProxy {
+	if len(chainProxies) == 1 {
+		return chainProxies[0]
+	}
+	nodeUrls := make([]string, len(nodes))
+	for idx, node := range nodes {
+		// hashing the urls and not the index keeps tokens on their node when nodes are added or removed
+		nodeUrls[idx] = node.NodeUrls[0].Url
+	}
+	return &nodePoolChainProxy{chainProxies: chainProxies, nodeUrls: nodeUrls}
+}
+
+// primaryNode is the node of requests without a stickiness token, the first configured node
+const primaryNode = 0
+
+// chooseNode picks the node with the highest hash of the token and its url (rendezvous hashing)
+func (npcp *nodePoolChainProxy) chooseNode(stickinessToken string) int {
+	if stickinessToken == "" {
+		return primaryNode
+	}
+	chosen := 0
+	var chosenWeight uint64
+	for idx, nodeUrl := range npcp.nodeUrls {
+		hash := fnv.New64a()
+		hash.Write([]byte(stickinessToken))
+		hash.Write([]byte(nodeUrl))
+		if weight := hash.Sum64(); idx == 0 || weight > chosenWeight {
+			chosen, chosenWeight = idx, weight
+		}
+	}
+	return chosen
+}
+
+func (npcp *nodePoolChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	stickinessToken := getStickinessToken(chainMessage)
+	node := npcp.chooseNode(stickinessToken)
+	if debug {
+		utils.LavaFormatDebug("node pool chose node", utils.Attribute{Key: "node", Value: node}, utils.Attribute{Key: "sticky", Value: stickinessToken != ""})
+	}
+	return npcp.chainProxies[node].SendNodeMsg(ctx, ch, chainMessage)
+}
+
+func getStickinessToken(chainMessage ChainMessageForSend) string {
+	rpcMessage := chainMessage.GetRPCMessage()
+	if rpcMessage == nil {
+		return ""
+	}
+	for _, header := range rpcMessage.GetHeaders() {
+		if header.Name == RPCProviderStickinessHeaderName {
+			return header.Value
+		}
+	}
+	return ""
+}
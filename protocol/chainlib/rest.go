@@ -129,7 +129,7 @@ func (apip *RestChainParser) ParseMsg(urlPath string, data []byte, connectionTyp
 		}
 	}
 
-	nodeMsg := apip.newChainMessage(apiCont.api, requestedBlock, &restMessage, apiCollection)
+	nodeMsg := apip.newChainMessage(applyCuFormula(apiCont.api, restMessage), requestedBlock, &restMessage, apiCollection)
 	apip.BaseChainParser.ExtensionParsing(apiCollection.CollectionData.AddOn, nodeMsg, latestBlock)
 	return nodeMsg, nil
 }
@@ -163,9 +163,10 @@ func (apip *TendermintChainParser) ParseMsg(urlPath string, data []byte, connect
 				requestedBlockForMessage = spectypes.NOT_APPLICABLE
 			}
 		}
+		msgApi := applyCuFormula(apiCont.api, msg)
 		if idx == 0 {
 			// on the first entry store them
-			api = apiCont.api
+			api = msgApi
 			apiCollection = apiCollectionForMessage
 			latestRequestedBlock = requestedBlockForMessage
 		} else {
@@ -192,7 +193,7 @@ func (apip *TendermintChainParser) ParseMsg(urlPath string, data []byte, connect
 			api = &spectypes.Api{
 				Enabled:           api.Enabled && apiCont.api.Enabled,
 				Name:              api.Name + SEP + apiCont.api.Name,
-				ComputeUnits:      api.ComputeUnits + apiCont.api.ComputeUnits,
+				ComputeUnits:      api.ComputeUnits + msgApi.ComputeUnits,
 				ExtraComputeUnits: api.ExtraComputeUnits + apiCont.api.ExtraComputeUnits,
 				Category:          category,
 				BlockParsing: spectypes.BlockParser{
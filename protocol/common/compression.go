@@ -0,0 +1,135 @@
+package common
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/cobra"
+)
+
+// relay payloads are compressed only on the wire, signatures, content hashes and finalization proofs are always
+// computed over the uncompressed data so they stay verifiable by anyone holding the relay and the reply
+
+const (
+	RelayCompressionFlagName        = "relay-compression"
+	RelayCompressionMinSizeFlagName = "relay-compression-min-size"
+	CompressionGzip                 = "gzip"
+	CompressionZstd                 = "zstd"
+)
+
+var (
+	// RelayCompressions are the relay payload compressions this process supports, by preference. empty disables compression
+	RelayCompressions = []string{CompressionZstd, CompressionGzip}
+	// RelayCompressionMinSize is the minimal payload size worth compressing
+	RelayCompressionMinSize uint64 = 1024
+	// MaxDecompressedRelaySize protects from payloads that decompress to more than any relay can hold
+	MaxDecompressedRelaySize int64 = 512 << 20
+
+	zstdEncoderOnce sync.Once
+	zstdEncoder     *zstd.Encoder
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+)
+
+func getZstdEncoder() *zstd.Encoder {
+	zstdEncoderOnce.Do(func() {
+		zstdEncoder, _ = zstd.NewWriter(nil)
+	})
+	return zstdEncoder
+}
+
+func getZstdDecoder() *zstd.Decoder {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(uint64(MaxDecompressedRelaySize)))
+	})
+	return zstdDecoder
+}
+
+func AddRelayCompressionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&RelayCompressions, RelayCompressionFlagName, RelayCompressions, "the relay payload compressions to negotiate with the other side, by preference ("+CompressionZstd+","+CompressionGzip+"), empty disables compression")
+	cmd.Flags().Uint64Var(&RelayCompressionMinSize, RelayCompressionMinSizeFlagName, RelayCompressionMinSize, "the minimal relay payload size in bytes to compress")
+}
+
+func IsSupportedCompression(compression string) bool {
+	return compression == CompressionGzip || compression == CompressionZstd
+}
+
+// ParseCompressions parses a comma separated compressions list, dropping the unsupported ones
+func ParseCompressions(values []string) (compressions []string) {
+	for _, value := range values {
+		for _, compression := range strings.Split(value, ",") {
+			compression = strings.ToLower(strings.TrimSpace(compression))
+			if IsSupportedCompression(compression) {
+				compressions = append(compressions, compression)
+			}
+		}
+	}
+	return compressions
+}
+
+// NegotiateCompression returns the first of our compressions the other side accepts, empty if there is none
+func NegotiateCompression(accepted []string) string {
+	for _, compression := range RelayCompressions {
+		for _, acceptedCompression := range accepted {
+			if compression == acceptedCompression {
+				return compression
+			}
+		}
+	}
+	return ""
+}
+
+// ShouldCompress returns the compression to use on a payload the other side accepts, empty to send it as is
+func ShouldCompress(data []byte, accepted []string) string {
+	if uint64(len(data)) < RelayCompressionMinSize {
+		return ""
+	}
+	return NegotiateCompression(accepted)
+}
+
+func CompressData(compression string, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionZstd:
+		return getZstdEncoder().EncodeAll(data, make([]byte, 0, len(data)/2)), nil
+	case CompressionGzip:
+		buffer := bytes.Buffer{}
+		writer := gzip.NewWriter(&buffer)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	default:
+		return nil, utils.LavaFormatWarning("unsupported relay compression", nil, utils.Attribute{Key: "compression", Value: compression})
+	}
+}
+
+func DecompressData(compression string, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionZstd:
+		return getZstdDecoder().DecodeAll(data, nil)
+	case CompressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		decompressed, err := io.ReadAll(io.LimitReader(reader, MaxDecompressedRelaySize+1))
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(decompressed)) > MaxDecompressedRelaySize {
+			return nil, utils.LavaFormatWarning("decompressed relay payload is too large", nil, utils.Attribute{Key: "max_size", Value: MaxDecompressedRelaySize})
+		}
+		return decompressed, nil
+	default:
+		return nil, utils.LavaFormatWarning("unsupported relay compression", nil, utils.Attribute{Key: "compression", Value: compression})
+	}
+}
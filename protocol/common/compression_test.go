@@ -0,0 +1,56 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressDecompress(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"number":"0x10","hash":"0xabcdef"}`), 100)
+	for _, compression := range []string{CompressionZstd, CompressionGzip} {
+		t.Run(compression, func(t *testing.T) {
+			compressed, err := CompressData(compression, data)
+			require.NoError(t, err)
+			require.Less(t, len(compressed), len(data))
+			decompressed, err := DecompressData(compression, compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, decompressed)
+
+			_, err = DecompressData(compression, data)
+			require.Error(t, err)
+		})
+	}
+	_, err := CompressData("brotli", data)
+	require.Error(t, err)
+}
+
+func TestDecompressSizeLimit(t *testing.T) {
+	prev := MaxDecompressedRelaySize
+	MaxDecompressedRelaySize = 1000
+	defer func() { MaxDecompressedRelaySize = prev }()
+
+	compressed, err := CompressData(CompressionGzip, make([]byte, 1001))
+	require.NoError(t, err)
+	_, err = DecompressData(CompressionGzip, compressed)
+	require.Error(t, err)
+}
+
+func TestNegotiateCompression(t *testing.T) {
+	prev, prevMinSize := RelayCompressions, RelayCompressionMinSize
+	defer func() { RelayCompressions, RelayCompressionMinSize = prev, prevMinSize }()
+	RelayCompressions = []string{CompressionZstd, CompressionGzip}
+	RelayCompressionMinSize = 10
+
+	require.Equal(t, []string{CompressionGzip, CompressionZstd}, ParseCompressions([]string{" GZIP,brotli", "zstd"}))
+	require.Equal(t, CompressionZstd, NegotiateCompression([]string{CompressionGzip, CompressionZstd}))
+	require.Equal(t, CompressionGzip, NegotiateCompression([]string{CompressionGzip}))
+	require.Equal(t, "", NegotiateCompression(nil))
+	require.Equal(t, "", ShouldCompress([]byte("short"), []string{CompressionGzip}))
+	require.Equal(t, CompressionGzip, ShouldCompress([]byte("long enough data"), []string{CompressionGzip}))
+
+	// compression disabled
+	RelayCompressions = []string{}
+	require.Equal(t, "", NegotiateCompression([]string{CompressionGzip}))
+}
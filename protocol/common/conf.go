@@ -18,6 +18,12 @@ const (
 	MaximumConcurrentProvidersFlagName = "concurrent-providers"
 	StatusCodeMetadataKey              = "status-code"
 	VersionMetadataKey                 = "lavap-version"
+	// the relay payload compressions a side accepts, sent by the consumer on relays and by the provider on probes
+	RelayCompressionsMetadataKey = "lava-relay-compressions"
+	// the compression of RelayRequest.RelayData.Data, sent by the consumer
+	RelayDataCompressionMetadataKey = "lava-relay-data-compression"
+	// the compression of RelayReply.Data, sent by the provider
+	ReplyDataCompressionMetadataKey = "lava-reply-data-compression"
 )
 
 func ParseEndpointArgs(endpoint_strings, yaml_config_properties []string, endpointsConfigName string) (viper_endpoints *viper.Viper, err error) {
@@ -21,9 +21,11 @@ func FindSequenceNumber(sequence string) (int, error) {
 }
 
 type TxResultData struct {
-	RawLog string
-	Txhash []byte
-	Code   int
+	RawLog    string
+	Txhash    []byte
+	Code      int
+	GasWanted int64
+	GasUsed   int64
 }
 
 func ParseTransactionResult(parsedValues map[string]any) (retData TxResultData, err error) {
@@ -0,0 +1,96 @@
+package eventindexer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagListenAddress = "listen-address"
+	FlagFromBlock     = "from-block"
+	FlagPollInterval  = "poll-interval"
+
+	DefaultListenAddress = "127.0.0.1:3390"
+)
+
+func CreateEventsIndexerCobraCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events-indexer [database-path]",
+		Short: "Follow the lava chain and index its events in a local sqlite database with a query api",
+		Long: `Follows the lava chain block by block and stores the decoded lava events (relay payments, conflicts and votes,
+freezes and jails, stake and delegation changes, subscription buys and expiries) in a local sqlite database.
+Relay payment events batching several relays are stored as an event per relay.
+The indexer resumes from the last indexed block on restart. An empty database starts from --from-block, or from the latest block.
+
+The events are served over http:
+  GET /events  filters: category, name (comma separated), provider, consumer, chain_id, tx_hash, from_height, to_height,
+               attr.<attribute>=<value>, paging: limit, after_id (the last_id of the previous page), order=desc
+  GET /status  the last indexed block`,
+		Example: `lavap events-indexer events.db --node tcp://127.0.0.1:26657 --from-block 1
+curl "127.0.0.1:3390/events?category=payment&provider=lava@1abc...&from_height=1000&limit=50"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read log level flag", err)
+			}
+			utils.SetGlobalLoggingLevel(logLevel)
+			listenAddress, err := cmd.Flags().GetString(FlagListenAddress)
+			if err != nil {
+				return err
+			}
+			fromBlock, err := cmd.Flags().GetInt64(FlagFromBlock)
+			if err != nil {
+				return err
+			}
+			pollInterval, err := cmd.Flags().GetDuration(FlagPollInterval)
+			if err != nil {
+				return err
+			}
+			fetcher, ok := clientCtx.Client.(BlockFetcher)
+			if !ok {
+				return fmt.Errorf("client does not support fetching block results: %T", clientCtx.Client)
+			}
+			utils.LavaFormatInfo("lavap Binary Version: " + version.Version)
+
+			store, err := NewStore(args[0])
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			serverErr := make(chan error, 1)
+			go func() {
+				serverErr <- ServeQueryAPI(ctx, store, listenAddress)
+				cancel()
+			}()
+			err = NewIndexer(store, fetcher, pollInterval).Start(ctx, fromBlock)
+			cancel()
+			if err != nil {
+				return err
+			}
+			return <-serverErr
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagListenAddress, DefaultListenAddress, "the address the query api listens on")
+	cmd.Flags().Int64(FlagFromBlock, 0, "the block to start indexing from on an empty database (default: the latest block)")
+	cmd.Flags().Duration(FlagPollInterval, 5*time.Second, "the interval to check for new blocks")
+	return cmd
+}
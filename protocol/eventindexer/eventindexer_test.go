@@ -0,0 +1,296 @@
+package eventindexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func lavaEvent(name string, attributes ...string) abci.Event {
+	event := abci.Event{Type: "lava_" + name}
+	for i := 0; i+1 < len(attributes); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attributes[i], Value: attributes[i+1]})
+	}
+	return event
+}
+
+func TestDecodeEvent(t *testing.T) {
+	// relays batched in a single event are split by their index
+	payment := lavaEvent("relay_payment",
+		"chainID.7", "LAV1", "client.7", "consumer1", "provider.7", "provider1", "CU.7", "10",
+		"chainID.12", "ETH1", "client.12", "consumer2", "provider.12", "provider1", "CU.12", "20",
+		"extra", "shared")
+	events := DecodeEvent(payment)
+	require.Len(t, events, 2)
+	require.Equal(t, CategoryPayment, events[0].Category)
+	require.Equal(t, "relay_payment", events[0].Name)
+	require.Equal(t, "consumer1", events[0].Consumer)
+	require.Equal(t, "LAV1", events[0].ChainID)
+	require.Equal(t, map[string]string{"chainID": "LAV1", "client": "consumer1", "provider": "provider1", "CU": "10", "extra": "shared"}, events[0].Attributes)
+	require.Equal(t, "ETH1", events[1].ChainID)
+	require.Equal(t, "20", events[1].Attributes["CU"])
+
+	freeze := DecodeEvent(lavaEvent("freeze_provider", "providerAddress", "provider1", "chainIDs", "LAV1,ETH1", "freezeReason", "maintenance"))
+	require.Len(t, freeze, 1)
+	require.Equal(t, CategoryFreeze, freeze[0].Category)
+	require.Equal(t, "provider1", freeze[0].Provider)
+	require.Equal(t, "LAV1,ETH1", freeze[0].ChainID)
+
+	stake := DecodeEvent(lavaEvent("stake_new_provider", "spec", "LAV1", "provider", "provider1", "stake", "100ulava"))
+	require.Equal(t, CategoryStake, stake[0].Category)
+	require.Equal(t, "LAV1", stake[0].ChainID)
+
+	vote := DecodeEvent(lavaEvent("conflict_vote_got_commit", "voteID", "1", "provider", "provider1"))
+	require.Equal(t, CategoryVote, vote[0].Category)
+
+	subscription := DecodeEvent(lavaEvent("buy_subscription_event", "consumer", "consumer1", "plan", "free"))
+	require.Equal(t, CategorySubscription, subscription[0].Category)
+	require.Equal(t, "consumer1", subscription[0].Consumer)
+
+	// not lava events or not indexed ones
+	require.Empty(t, DecodeEvent(abci.Event{Type: "relay_payment"}))
+	require.Empty(t, DecodeEvent(lavaEvent("new_epoch", "height", "10")))
+	require.Empty(t, DecodeEvent(abci.Event{Type: "transfer"}))
+}
+
+func TestDecodeBlockEvents(t *testing.T) {
+	events := DecodeBlockEvents(5,
+		[]abci.Event{lavaEvent("conflict_vote_reveal_started", "voteID", "1"), {Type: "mint"}},
+		[]*abci.ResponseDeliverTx{
+			{Events: []abci.Event{{Type: "message"}, lavaEvent("relay_payment", "provider.1", "p", "provider.2", "p")}},
+			nil,
+			{Events: []abci.Event{lavaEvent("unfreeze_provider", "providerAddress", "p")}},
+		},
+		[]abci.Event{lavaEvent("conflict_detection_vote_resolved", "voteID", "1")},
+		[]string{"AA", "BB", "CC"},
+	)
+	names := []string{}
+	for idx, event := range events {
+		require.Equal(t, int64(5), event.Height)
+		require.Equal(t, int64(idx), event.Position)
+		names = append(names, event.Name)
+	}
+	require.Equal(t, []string{"conflict_vote_reveal_started", "relay_payment", "relay_payment", "unfreeze_provider", "conflict_detection_vote_resolved"}, names)
+	require.Equal(t, "", events[0].TxHash)
+	require.Equal(t, "AA", events[1].TxHash)
+	require.Equal(t, "CC", events[3].TxHash)
+}
+
+func TestStore(t *testing.T) {
+	store, err := NewStore(filepath.Join(t.TempDir(), "events.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	height, err := store.LastIndexedHeight()
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	for block := int64(1); block <= 10; block++ {
+		events := []IndexedEvent{}
+		for _, event := range DecodeEvent(lavaEvent("relay_payment",
+			"provider.1", fmt.Sprintf("provider%d", block%2), "client.1", "consumer", "chainID.1", "LAV1", "epoch.1", fmt.Sprint(block),
+			"provider.2", "provider2", "client.2", "consumer", "chainID.2", "ETH1", "epoch.2", fmt.Sprint(block))) {
+			event.Position = int64(len(events))
+			events = append(events, event)
+		}
+		stake := DecodeEvent(lavaEvent("stake_update_provider", "provider", "provider1", "spec", "LAV1"))[0]
+		stake.Position = int64(len(events))
+		stake.TxHash = fmt.Sprintf("HASH%d", block)
+		events = append(events, stake)
+		require.NoError(t, store.SaveBlock(block, events))
+		// saving a block again doesn't duplicate it
+		require.NoError(t, store.SaveBlock(block, events))
+	}
+	// the last height doesn't go back
+	require.NoError(t, store.SaveBlock(3, nil))
+	height, err = store.LastIndexedHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+
+	count := func(filter EventsFilter) int {
+		filter.Limit = MaxQueryLimit
+		events, err := store.Events(filter)
+		require.NoError(t, err)
+		return len(events)
+	}
+	require.Equal(t, 30, count(EventsFilter{}))
+	require.Equal(t, 20, count(EventsFilter{Categories: []EventCategory{CategoryPayment}}))
+	require.Equal(t, 30, count(EventsFilter{Categories: []EventCategory{CategoryPayment, CategoryStake}}))
+	require.Equal(t, 10, count(EventsFilter{Names: []string{"stake_update_provider"}}))
+	require.Equal(t, 15, count(EventsFilter{Provider: "provider1"}))
+	require.Equal(t, 5, count(EventsFilter{Provider: "provider1", Categories: []EventCategory{CategoryPayment}}))
+	require.Equal(t, 20, count(EventsFilter{Consumer: "consumer"}))
+	require.Equal(t, 10, count(EventsFilter{ChainID: "ETH1"}))
+	require.Equal(t, 9, count(EventsFilter{FromHeight: 3, ToHeight: 5}))
+	require.Equal(t, 1, count(EventsFilter{TxHash: "HASH4"}))
+	require.Equal(t, 2, count(EventsFilter{Attributes: map[string]string{"epoch": "7"}}))
+	require.Equal(t, 1, count(EventsFilter{Attributes: map[string]string{"epoch": "7", "chainID": "LAV1"}}))
+	require.Zero(t, count(EventsFilter{Attributes: map[string]string{"missing": "7"}}))
+
+	// paging
+	page, err := store.Events(EventsFilter{Limit: 4})
+	require.NoError(t, err)
+	require.Len(t, page, 4)
+	require.Equal(t, int64(1), page[0].Height)
+	next, err := store.Events(EventsFilter{Limit: 4, AfterID: page[3].ID})
+	require.NoError(t, err)
+	require.Equal(t, page[3].ID+1, next[0].ID)
+	latest, err := store.Events(EventsFilter{Limit: 1, Descending: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), latest[0].Height)
+	require.Equal(t, "stake_update_provider", latest[0].Name)
+}
+
+type mockBlockFetcher struct {
+	lock   sync.Mutex
+	latest int64
+	failAt int64
+	blocks map[int64]*coretypes.ResultBlockResults
+}
+
+func (m *mockBlockFetcher) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: m.latest}}, nil
+}
+
+func (m *mockBlockFetcher) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	txs := tmtypes.Txs{}
+	for range m.blocks[*height].TxsResults {
+		txs = append(txs, tmtypes.Tx(fmt.Sprintf("tx%d", *height)))
+	}
+	return &coretypes.ResultBlock{Block: &tmtypes.Block{Data: tmtypes.Data{Txs: txs}}}, nil
+}
+
+func (m *mockBlockFetcher) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if *height == m.failAt {
+		m.failAt = 0
+		return nil, fmt.Errorf("block results unavailable")
+	}
+	if results, ok := m.blocks[*height]; ok {
+		return results, nil
+	}
+	return &coretypes.ResultBlockResults{Height: *height}, nil
+}
+
+func TestIndexer(t *testing.T) {
+	fetcher := &mockBlockFetcher{latest: 5, failAt: 3, blocks: map[int64]*coretypes.ResultBlockResults{}}
+	for height := int64(1); height <= 8; height++ {
+		fetcher.blocks[height] = &coretypes.ResultBlockResults{
+			Height:     height,
+			TxsResults: []*abci.ResponseDeliverTx{{Events: []abci.Event{lavaEvent("buy_subscription_event", "consumer", fmt.Sprintf("consumer%d", height))}}},
+		}
+	}
+	store, err := NewStore(filepath.Join(t.TempDir(), "events.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- NewIndexer(store, fetcher, 10*time.Millisecond).Start(ctx, 2)
+	}()
+	waitForHeight := func(height int64) {
+		require.Eventually(t, func() bool {
+			indexed, err := store.LastIndexedHeight()
+			return err == nil && indexed == height
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	// the failed block is retried, blocks are never skipped
+	waitForHeight(5)
+	fetcher.lock.Lock()
+	fetcher.latest = 8
+	fetcher.lock.Unlock()
+	waitForHeight(8)
+	cancel()
+	require.NoError(t, <-done)
+
+	events, err := store.Events(EventsFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 7)
+	require.Equal(t, "consumer2", events[0].Consumer)
+	require.Equal(t, fmt.Sprintf("%X", tmtypes.Tx("tx2").Hash()), events[0].TxHash)
+
+	// restarting resumes from the last indexed block
+	fetcher.latest = 9
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		done <- NewIndexer(store, fetcher, 10*time.Millisecond).Start(ctx, 1)
+	}()
+	waitForHeight(9)
+	cancel()
+	require.NoError(t, <-done)
+	events, err = store.Events(EventsFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 7)
+}
+
+func TestQueryHandler(t *testing.T) {
+	store, err := NewStore(filepath.Join(t.TempDir(), "events.db"))
+	require.NoError(t, err)
+	defer store.Close()
+	events := DecodeBlockEvents(7, nil, []*abci.ResponseDeliverTx{{Events: []abci.Event{
+		lavaEvent("relay_payment", "provider.1", "provider1", "chainID.1", "LAV1", "CU.1", "10", "provider.2", "provider1", "chainID.2", "ETH1", "CU.2", "20"),
+		lavaEvent("conflict_vote_got_reveal", "provider", "provider2", "voteID", "3"),
+	}}}, nil, []string{"ABCD"})
+	require.NoError(t, store.SaveBlock(7, events))
+
+	handler := QueryHandler(store)
+	query := func(url string) (int, EventsResponse) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+		response := EventsResponse{}
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		}
+		return recorder.Code, response
+	}
+
+	code, response := query("/events")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, response.Events, 3)
+	require.Equal(t, response.Events[2].ID, response.LastID)
+
+	_, response = query("/events?category=payment&chain_id=ETH1")
+	require.Len(t, response.Events, 1)
+	require.Equal(t, "20", response.Events[0].Attributes["CU"])
+
+	_, response = query("/events?name=relay_payment,conflict_vote_got_reveal&provider=provider2&tx_hash=abcd")
+	require.Len(t, response.Events, 1)
+	require.Equal(t, CategoryVote, response.Events[0].Category)
+
+	_, response = query("/events?attr.CU=10")
+	require.Len(t, response.Events, 1)
+
+	_, response = query("/events?from_height=8")
+	require.Empty(t, response.Events)
+	require.Zero(t, response.LastID)
+
+	_, response = query(fmt.Sprintf("/events?limit=1&after_id=%d", response.LastID))
+	require.Len(t, response.Events, 1)
+
+	code, _ = query("/events?from_height=abc")
+	require.Equal(t, http.StatusBadRequest, code)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/status", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	status := StatusResponse{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+	require.Equal(t, int64(7), status.LastIndexedHeight)
+}
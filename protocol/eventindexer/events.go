@@ -0,0 +1,179 @@
+package eventindexer
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	dualstakingtypes "github.com/lavanet/lava/x/dualstaking/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
+)
+
+type EventCategory string
+
+const (
+	CategoryPayment      EventCategory = "payment"
+	CategoryConflict     EventCategory = "conflict"
+	CategoryVote         EventCategory = "vote"
+	CategoryFreeze       EventCategory = "freeze"
+	CategoryStake        EventCategory = "stake"
+	CategorySubscription EventCategory = "subscription"
+)
+
+// the lava events the indexer stores (names without the lava_ prefix)
+var indexedEvents = map[string]EventCategory{
+	pairingtypes.RelayPaymentEventName: CategoryPayment,
+
+	conflicttypes.ConflictVoteDetectionEventName:     CategoryConflict,
+	conflicttypes.ConflictDetectionRecievedEventName: CategoryConflict,
+	conflicttypes.ConflictVoteResolvedEventName:      CategoryConflict,
+	conflicttypes.ConflictVoteUnresolvedEventName:    CategoryConflict,
+	conflicttypes.ConflictUnstakeFraudVoterEventName: CategoryConflict,
+
+	conflicttypes.ConflictVoteRevealEventName:    CategoryVote,
+	conflicttypes.ConflictVoteGotCommitEventName: CategoryVote,
+	conflicttypes.ConflictVoteGotRevealEventName: CategoryVote,
+
+	"freeze_provider":                    CategoryFreeze,
+	"unfreeze_provider":                  CategoryFreeze,
+	pairingtypes.ProviderJailedEventName: CategoryFreeze,
+
+	pairingtypes.ProviderStakeEventName:       CategoryStake,
+	pairingtypes.ProviderStakeUpdateEventName: CategoryStake,
+	pairingtypes.ProviderUnstakeEventName:     CategoryStake,
+	dualstakingtypes.DelegateEventName:        CategoryStake,
+	dualstakingtypes.UnbondingEventName:       CategoryStake,
+	dualstakingtypes.RedelegateEventName:      CategoryStake,
+
+	subscriptiontypes.BuySubscriptionEventName:    CategorySubscription,
+	subscriptiontypes.ExpireSubscriptionEventName: CategorySubscription,
+}
+
+// attribute keys holding the common fields, in order of precedence
+var (
+	providerKeys = []string{"provider", "providerAddress", "provider_address", "address"}
+	consumerKeys = []string{"client", "consumer", "delegator"}
+	chainIDKeys  = []string{"chainID", "chain_id", "spec", "chainIDs"}
+)
+
+type IndexedEvent struct {
+	ID         int64             `json:"id"`
+	Height     int64             `json:"height"`
+	TxHash     string            `json:"tx_hash,omitempty"`
+	Position   int64             `json:"position"`
+	Name       string            `json:"name"`
+	Category   EventCategory     `json:"category"`
+	Provider   string            `json:"provider,omitempty"`
+	Consumer   string            `json:"consumer,omitempty"`
+	ChainID    string            `json:"chain_id,omitempty"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// DecodeBlockEvents decodes the lava events of a block. txHashes are the hashes of the block transactions,
+// in the order of txsResults, and may be nil. events batching several relays in indexed attributes
+// (key.idx) are split to an event per index
+func DecodeBlockEvents(height int64, beginBlockEvents []abci.Event, txsResults []*abci.ResponseDeliverTx, endBlockEvents []abci.Event, txHashes []string) []IndexedEvent {
+	decoded := []IndexedEvent{}
+	// position orders the events of the block as the chain emitted them
+	position := int64(0)
+	appendEvents := func(events []abci.Event, txHash string) {
+		for _, event := range events {
+			for _, indexed := range DecodeEvent(event) {
+				indexed.Height = height
+				indexed.TxHash = txHash
+				indexed.Position = position
+				decoded = append(decoded, indexed)
+				position++
+			}
+		}
+	}
+	appendEvents(beginBlockEvents, "")
+	for txIdx, txResult := range txsResults {
+		if txResult == nil {
+			continue
+		}
+		txHash := ""
+		if txIdx < len(txHashes) {
+			txHash = txHashes[txIdx]
+		}
+		appendEvents(txResult.Events, txHash)
+	}
+	appendEvents(endBlockEvents, "")
+	return decoded
+}
+
+// DecodeEvent decodes a single lava event, returns nothing if the event is not indexed
+func DecodeEvent(event abci.Event) []IndexedEvent {
+	name := strings.TrimPrefix(event.Type, utils.EventPrefix)
+	category, ok := indexedEvents[name]
+	if !ok || name == event.Type {
+		return nil
+	}
+
+	// split indexed attributes: key.idx belongs to the event of idx, plain keys to all of them
+	common := map[string]string{}
+	indexed := map[string]map[string]string{}
+	for _, attribute := range event.Attributes {
+		key, idx, found := strings.Cut(attribute.Key, ".")
+		if found {
+			if _, err := strconv.ParseUint(idx, 10, 64); err == nil {
+				if _, ok := indexed[idx]; !ok {
+					indexed[idx] = map[string]string{}
+				}
+				indexed[idx][key] = attribute.Value
+				continue
+			}
+		}
+		common[attribute.Key] = attribute.Value
+	}
+
+	attributesList := []map[string]string{}
+	if len(indexed) == 0 {
+		attributesList = append(attributesList, common)
+	} else {
+		indices := make([]string, 0, len(indexed))
+		for idx := range indexed {
+			indices = append(indices, idx)
+		}
+		sort.Slice(indices, func(i, j int) bool {
+			first, _ := strconv.ParseUint(indices[i], 10, 64)
+			second, _ := strconv.ParseUint(indices[j], 10, 64)
+			return first < second
+		})
+		for _, idx := range indices {
+			attributes := indexed[idx]
+			for key, value := range common {
+				if _, ok := attributes[key]; !ok {
+					attributes[key] = value
+				}
+			}
+			attributesList = append(attributesList, attributes)
+		}
+	}
+
+	events := make([]IndexedEvent, 0, len(attributesList))
+	for _, attributes := range attributesList {
+		events = append(events, IndexedEvent{
+			Name:       name,
+			Category:   category,
+			Provider:   firstAttribute(attributes, providerKeys),
+			Consumer:   firstAttribute(attributes, consumerKeys),
+			ChainID:    firstAttribute(attributes, chainIDKeys),
+			Attributes: attributes,
+		})
+	}
+	return events
+}
+
+func firstAttribute(attributes map[string]string, keys []string) string {
+	for _, key := range keys {
+		if value, ok := attributes[key]; ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package eventindexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/lavanet/lava/utils"
+)
+
+// BlockFetcher is the part of the tendermint rpc client the indexer uses
+type BlockFetcher interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// Indexer follows the chain and stores the lava events of every block, in order, without skipping blocks
+type Indexer struct {
+	store        *Store
+	fetcher      BlockFetcher
+	pollInterval time.Duration
+}
+
+func NewIndexer(store *Store, fetcher BlockFetcher, pollInterval time.Duration) *Indexer {
+	return &Indexer{store: store, fetcher: fetcher, pollInterval: pollInterval}
+}
+
+// IndexBlock fetches, decodes and stores the events of a single block
+func (ix *Indexer) IndexBlock(ctx context.Context, height int64) (int, error) {
+	blockResults, err := ix.fetcher.BlockResults(ctx, &height)
+	if err != nil {
+		return 0, utils.LavaFormatWarning("failed fetching block results", err, utils.Attribute{Key: "height", Value: height})
+	}
+	var txHashes []string
+	if len(blockResults.TxsResults) > 0 {
+		block, err := ix.fetcher.Block(ctx, &height)
+		if err != nil {
+			return 0, utils.LavaFormatWarning("failed fetching block", err, utils.Attribute{Key: "height", Value: height})
+		}
+		for _, tx := range block.Block.Txs {
+			txHashes = append(txHashes, fmt.Sprintf("%X", tx.Hash()))
+		}
+	}
+	events := DecodeBlockEvents(height, blockResults.BeginBlockEvents, blockResults.TxsResults, blockResults.EndBlockEvents, txHashes)
+	return len(events), ix.store.SaveBlock(height, events)
+}
+
+// Start indexes blocks until ctx is done. it resumes after the last indexed block, on an empty database it
+// starts from fromHeight, or from the latest block when fromHeight is not positive
+func (ix *Indexer) Start(ctx context.Context, fromHeight int64) error {
+	next, err := ix.store.LastIndexedHeight()
+	if err != nil {
+		return utils.LavaFormatError("failed reading last indexed height", err)
+	}
+	next++
+	if next == 1 && fromHeight > 0 {
+		next = fromHeight
+	}
+	utils.LavaFormatInfo("events indexer started", utils.Attribute{Key: "from", Value: next})
+
+	for {
+		status, err := ix.fetcher.Status(ctx)
+		if err != nil {
+			utils.LavaFormatWarning("failed fetching latest block", err)
+		} else {
+			latest := status.SyncInfo.LatestBlockHeight
+			if next == 1 && fromHeight <= 0 {
+				next = latest
+			}
+			for next <= latest && ctx.Err() == nil {
+				count, err := ix.IndexBlock(ctx, next)
+				if err != nil {
+					utils.LavaFormatWarning("failed indexing block, retrying", err, utils.Attribute{Key: "height", Value: next})
+					break
+				}
+				if count > 0 {
+					utils.LavaFormatDebug("indexed block events", utils.Attribute{Key: "height", Value: next}, utils.Attribute{Key: "events", Value: count})
+				}
+				next++
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(ix.pollInterval):
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package eventindexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const attributeQueryPrefix = "attr."
+
+type EventsResponse struct {
+	Events []IndexedEvent `json:"events"`
+	// pass as after_id to get the next page, 0 when there are no results
+	LastID int64 `json:"last_id"`
+}
+
+type StatusResponse struct {
+	LastIndexedHeight int64 `json:"last_indexed_height"`
+}
+
+// QueryHandler serves the indexed events:
+//
+//	GET /events?category=payment,stake&name=relay_payment&provider=&consumer=&chain_id=&tx_hash=
+//	            &from_height=&to_height=&attr.<key>=<value>&after_id=&limit=&order=desc
+//	GET /status
+func QueryHandler(store *Store) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		filter, err := parseEventsFilter(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		events, err := store.Events(filter)
+		if err != nil {
+			utils.LavaFormatError("failed querying events", err)
+			http.Error(w, "failed querying events", http.StatusInternalServerError)
+			return
+		}
+		response := EventsResponse{Events: events}
+		if len(events) > 0 {
+			response.LastID = events[len(events)-1].ID
+		}
+		writeJSON(w, response)
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		height, err := store.LastIndexedHeight()
+		if err != nil {
+			http.Error(w, "failed reading indexer status", http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, StatusResponse{LastIndexedHeight: height})
+	})
+	return mux
+}
+
+func parseEventsFilter(r *http.Request) (EventsFilter, error) {
+	query := r.URL.Query()
+	splitList := func(key string) []string {
+		values := []string{}
+		for _, value := range query[key] {
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					values = append(values, item)
+				}
+			}
+		}
+		return values
+	}
+	parseInt := func(key string) (int64, error) {
+		value := query.Get(key)
+		if value == "" {
+			return 0, nil
+		}
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 0 {
+			return 0, utils.LavaFormatWarning("invalid query parameter", err, utils.Attribute{Key: key, Value: value})
+		}
+		return parsed, nil
+	}
+
+	filter := EventsFilter{
+		Names:      splitList("name"),
+		Provider:   query.Get("provider"),
+		Consumer:   query.Get("consumer"),
+		ChainID:    query.Get("chain_id"),
+		TxHash:     strings.ToUpper(query.Get("tx_hash")),
+		Descending: query.Get("order") == "desc",
+		Attributes: map[string]string{},
+	}
+	for _, category := range splitList("category") {
+		filter.Categories = append(filter.Categories, EventCategory(category))
+	}
+	for key := range query {
+		if attribute, ok := strings.CutPrefix(key, attributeQueryPrefix); ok && attribute != "" {
+			filter.Attributes[attribute] = query.Get(key)
+		}
+	}
+	var err error
+	if filter.FromHeight, err = parseInt("from_height"); err != nil {
+		return filter, err
+	}
+	if filter.ToHeight, err = parseInt("to_height"); err != nil {
+		return filter, err
+	}
+	if filter.AfterID, err = parseInt("after_id"); err != nil {
+		return filter, err
+	}
+	limit, err := parseInt("limit")
+	if err != nil {
+		return filter, err
+	}
+	filter.Limit = int(limit)
+	return filter, nil
+}
+
+func writeJSON(w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		utils.LavaFormatWarning("failed writing response", err)
+	}
+}
+
+// ServeQueryAPI serves the query api on listenAddress until ctx is done
+func ServeQueryAPI(ctx context.Context, store *Store, listenAddress string) error {
+	server := &http.Server{
+		Addr:              listenAddress,
+		Handler:           QueryHandler(store),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	utils.LavaFormatInfo("events query api listening", utils.Attribute{Key: "address", Value: listenAddress})
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package eventindexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lavanet/lava/utils"
+	_ "modernc.org/sqlite" // pure go driver, the indexer runs in binaries built without cgo
+)
+
+const (
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+
+	lastHeightKey = "last_indexed_height"
+)
+
+var storeSchema = []string{
+	`CREATE TABLE IF NOT EXISTS events (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		height INTEGER NOT NULL,
+		position INTEGER NOT NULL,
+		tx_hash TEXT NOT NULL DEFAULT '',
+		name TEXT NOT NULL,
+		category TEXT NOT NULL,
+		provider TEXT NOT NULL DEFAULT '',
+		consumer TEXT NOT NULL DEFAULT '',
+		chain_id TEXT NOT NULL DEFAULT '',
+		attributes TEXT NOT NULL,
+		UNIQUE(height, position)
+	)`,
+	`CREATE INDEX IF NOT EXISTS events_name ON events (name, height)`,
+	`CREATE INDEX IF NOT EXISTS events_category ON events (category, height)`,
+	`CREATE INDEX IF NOT EXISTS events_provider ON events (provider, height)`,
+	`CREATE INDEX IF NOT EXISTS events_consumer ON events (consumer, height)`,
+	`CREATE INDEX IF NOT EXISTS events_chain_id ON events (chain_id, height)`,
+	`CREATE INDEX IF NOT EXISTS events_tx_hash ON events (tx_hash)`,
+	`CREATE TABLE IF NOT EXISTS indexer_state (
+		key TEXT PRIMARY KEY,
+		value INTEGER NOT NULL
+	)`,
+}
+
+// EventsFilter selects stored events, empty fields match everything
+type EventsFilter struct {
+	Names      []string
+	Categories []EventCategory
+	Provider   string
+	Consumer   string
+	ChainID    string
+	TxHash     string
+	FromHeight int64
+	ToHeight   int64
+	// exact attribute values, keys as emitted without the relay index
+	Attributes map[string]string
+	// return events with id bigger than AfterID, used to page through results
+	AfterID int64
+	Limit   int
+	// newest events first
+	Descending bool
+}
+
+// Store keeps the indexed events in an embedded sqlite database
+type Store struct {
+	db *sql.DB
+}
+
+func NewStore(path string) (*Store, error) {
+	// a single writer, readers don't block it in wal mode
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, utils.LavaFormatError("failed opening events database", err, utils.Attribute{Key: "path", Value: path})
+	}
+	for _, statement := range storeSchema {
+		if _, err := db.Exec(statement); err != nil {
+			db.Close()
+			return nil, utils.LavaFormatError("failed creating events database schema", err, utils.Attribute{Key: "path", Value: path})
+		}
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// LastIndexedHeight returns the last block that was fully indexed, 0 if none
+func (s *Store) LastIndexedHeight() (int64, error) {
+	var height int64
+	err := s.db.QueryRow(`SELECT value FROM indexer_state WHERE key = ?`, lastHeightKey).Scan(&height)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return height, err
+}
+
+// SaveBlock stores the events of a block and marks it as indexed, in a single transaction so a block is never
+// partially indexed. saving a block again is a no-op for the events that were already stored
+func (s *Store) SaveBlock(height int64, events []IndexedEvent) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	insert, err := tx.Prepare(`INSERT OR IGNORE INTO events
+		(height, position, tx_hash, name, category, provider, consumer, chain_id, attributes)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+	for _, event := range events {
+		attributes, err := json.Marshal(event.Attributes)
+		if err != nil {
+			return err
+		}
+		_, err = insert.Exec(height, event.Position, event.TxHash, event.Name, string(event.Category), event.Provider, event.Consumer, event.ChainID, string(attributes))
+		if err != nil {
+			return utils.LavaFormatError("failed storing event", err, utils.Attribute{Key: "height", Value: height}, utils.Attribute{Key: "name", Value: event.Name})
+		}
+	}
+	_, err = tx.Exec(`INSERT INTO indexer_state (key, value) VALUES (?, ?)
+		ON CONFLICT(key) DO UPDATE SET value = excluded.value WHERE excluded.value > indexer_state.value`, lastHeightKey, height)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *Store) Events(filter EventsFilter) ([]IndexedEvent, error) {
+	conditions := []string{}
+	args := []interface{}{}
+	addIn := func(column string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		conditions = append(conditions, column+" IN ("+strings.TrimSuffix(strings.Repeat("?,", len(values)), ",")+")")
+		for _, value := range values {
+			args = append(args, value)
+		}
+	}
+	addEqual := func(column string, value string) {
+		if value == "" {
+			return
+		}
+		conditions = append(conditions, column+" = ?")
+		args = append(args, value)
+	}
+
+	addIn("name", filter.Names)
+	categories := make([]string, 0, len(filter.Categories))
+	for _, category := range filter.Categories {
+		categories = append(categories, string(category))
+	}
+	addIn("category", categories)
+	addEqual("provider", filter.Provider)
+	addEqual("consumer", filter.Consumer)
+	addEqual("chain_id", filter.ChainID)
+	addEqual("tx_hash", filter.TxHash)
+	if filter.FromHeight > 0 {
+		conditions = append(conditions, "height >= ?")
+		args = append(args, filter.FromHeight)
+	}
+	if filter.ToHeight > 0 {
+		conditions = append(conditions, "height <= ?")
+		args = append(args, filter.ToHeight)
+	}
+	// sorted for a deterministic query
+	attributeKeys := make([]string, 0, len(filter.Attributes))
+	for key := range filter.Attributes {
+		attributeKeys = append(attributeKeys, key)
+	}
+	sort.Strings(attributeKeys)
+	for _, key := range attributeKeys {
+		conditions = append(conditions, "json_extract(attributes, ?) = ?")
+		args = append(args, fmt.Sprintf("$.%q", key), filter.Attributes[key])
+	}
+	order := "ASC"
+	if filter.AfterID > 0 {
+		if filter.Descending {
+			conditions = append(conditions, "id < ?")
+		} else {
+			conditions = append(conditions, "id > ?")
+		}
+		args = append(args, filter.AfterID)
+	}
+	if filter.Descending {
+		order = "DESC"
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		limit = MaxQueryLimit
+	}
+
+	query := `SELECT id, height, position, tx_hash, name, category, provider, consumer, chain_id, attributes FROM events`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id " + order + " LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	events := []IndexedEvent{}
+	for rows.Next() {
+		event := IndexedEvent{}
+		var category, attributes string
+		err := rows.Scan(&event.ID, &event.Height, &event.Position, &event.TxHash, &event.Name, &category, &event.Provider, &event.Consumer, &event.ChainID, &attributes)
+		if err != nil {
+			return nil, err
+		}
+		event.Category = EventCategory(category)
+		if err := json.Unmarshal([]byte(attributes), &event.Attributes); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
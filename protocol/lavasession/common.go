@@ -57,11 +57,24 @@ func IsSessionSyncLoss(err error) bool {
 	return code == codes.Code(SessionOutOfSyncError.ABCICode())
 }
 
+func IsProviderThrottled(err error) bool {
+	code := status.Code(err)
+	return code == codes.Code(ProviderRelayThrottledError.ABCICode())
+}
+
+func IsProviderDraining(err error) bool {
+	code := status.Code(err)
+	return code == codes.Code(ProviderDrainingError.ABCICode())
+}
+
 func ConnectgRPCClient(ctx context.Context, address string, allowInsecure bool) (*grpc.ClientConn, error) {
 	var tlsConf tls.Config
 	if allowInsecure {
 		tlsConf.InsecureSkipVerify = true // this will allow us to use self signed certificates in development.
 	}
+	if ClientCertificateReloader != nil {
+		tlsConf.GetClientCertificate = ClientCertificateReloader.GetClientCertificate
+	}
 	credentials := credentials.NewTLS(&tlsConf)
 	conn, err := grpc.DialContext(ctx, address, grpc.WithBlock(), grpc.WithTransportCredentials(credentials))
 	return conn, err
@@ -101,34 +114,22 @@ func GenerateSelfSignedCertificate() (tls.Certificate, error) {
 	return cert, nil
 }
 
-func GetCaCertificate(serverCertPath, serverKeyPath string) (*tls.Config, error) {
-	serverCert, err := tls.LoadX509KeyPair(serverCertPath, serverKeyPath)
-	if err != nil {
-		return nil, err
-	}
-	return &tls.Config{
-		ClientAuth:   tls.NoClientCert,
-		Certificates: []tls.Certificate{serverCert},
-	}, nil
-}
-
-func GetTlsConfig(networkAddress NetworkAddressData) *tls.Config {
-	var tlsConfig *tls.Config
-	var err error
+func GetTlsConfig(ctx context.Context, networkAddress NetworkAddressData) *tls.Config {
 	if networkAddress.CertPem != "" {
 		utils.LavaFormatInfo("Running with TLS certificate", utils.Attribute{Key: "cert", Value: networkAddress.CertPem}, utils.Attribute{Key: "key", Value: networkAddress.KeyPem})
-		tlsConfig, err = GetCaCertificate(networkAddress.CertPem, networkAddress.KeyPem)
-		if err != nil {
-			utils.LavaFormatFatal("failed to generate TLS certificate", err)
-		}
-	} else {
-		cert, err := GenerateSelfSignedCertificate()
-		if err != nil {
-			utils.LavaFormatFatal("failed to generate TLS certificate", err)
-		}
-		tlsConfig = &tls.Config{
-			Certificates: []tls.Certificate{cert},
-		}
+	}
+	reloader, err := NewCertificateReloader(ctx, networkAddress.CertPem, networkAddress.KeyPem, networkAddress.ClientCaPem)
+	if err != nil {
+		utils.LavaFormatFatal("failed to generate TLS certificate", err)
+	}
+	tlsConfig := &tls.Config{
+		ClientAuth:     tls.NoClientCert,
+		GetCertificate: reloader.GetCertificate,
+	}
+	if reloader.RequiresClientCertificate() {
+		utils.LavaFormatInfo("Running with mutual TLS, requiring client certificates", utils.Attribute{Key: "clientCA", Value: networkAddress.ClientCaPem})
+		tlsConfig.ClientAuth = tls.RequireAnyClientCert
+		tlsConfig.VerifyPeerCertificate = reloader.VerifyClientCertificate
 	}
 	return tlsConfig
 }
@@ -44,6 +44,7 @@ type ConsumerSessionManager struct {
 	pairingPurge           map[string]*ConsumerSessionsWithProvider
 	providerOptimizer      ProviderOptimizer
 	consumerMetricsManager *metrics.ConsumerMetricsManager
+	healthChecker          ProviderHealthChecker // optional, runs with the probes
 }
 
 // this is being read in multiple locations and but never changes so no need to lock.
@@ -154,12 +155,19 @@ func (csm *ConsumerSessionManager) probeProviders(ctx context.Context, pairingLi
 	// Create a wait group to synchronize the goroutines
 	wg := sync.WaitGroup{}
 	wg.Add(len(pairingList)) // increment by this and not by 1 for each go routine because we don;t want a race finishing the go routine before the next invocation
+	csm.lock.RLock()
+	healthChecker := csm.healthChecker
+	csm.lock.RUnlock()
 	for _, consumerSessionWithProvider := range pairingList {
 		// Start a new goroutine for each provider
 		go func(consumerSessionsWithProvider *ConsumerSessionsWithProvider) {
 			// Call the probeProvider function and defer the WaitGroup Done call
 			defer wg.Done()
 			latency, providerAddress, err := csm.probeProvider(ctx, consumerSessionsWithProvider, epoch)
+			if err == nil && healthChecker != nil {
+				// the provider answers probes, check its node serves relays as well
+				err = csm.checkProviderHealth(ctx, healthChecker, providerAddress, epoch)
+			}
 			success := err == nil // if failure then regard it in availability
 			csm.providerOptimizer.AppendProbeRelayData(providerAddress, latency, success)
 		}(consumerSessionWithProvider)
@@ -184,6 +192,43 @@ func (csm *ConsumerSessionManager) probeProviders(ctx context.Context, pairingLi
 	}
 }
 
+// SetProviderHealthChecker sets the synthetic checks providers must pass when they are probed, on pairing updates and on RunHealthChecks
+func (csm *ConsumerSessionManager) SetProviderHealthChecker(healthChecker ProviderHealthChecker) {
+	csm.lock.Lock()
+	defer csm.lock.Unlock()
+	csm.healthChecker = healthChecker
+}
+
+// RunHealthChecks probes the providers that are still valid this epoch and runs the health checks on them,
+// providers failing them are blocked until the next epoch
+func (csm *ConsumerSessionManager) RunHealthChecks(ctx context.Context) error {
+	csm.lock.RLock()
+	epoch := csm.atomicReadCurrentEpoch()
+	pairingList := make(map[uint64]*ConsumerSessionsWithProvider, len(csm.validAddresses))
+	for idx, providerAddress := range csm.validAddresses {
+		pairingList[uint64(idx)] = csm.pairing[providerAddress]
+	}
+	csm.lock.RUnlock()
+	if len(pairingList) == 0 {
+		return nil
+	}
+	return csm.probeProviders(ctx, pairingList, epoch)
+}
+
+// this code needs to be thread safe
+func (csm *ConsumerSessionManager) checkProviderHealth(ctx context.Context, healthChecker ProviderHealthChecker, providerAddress string, epoch uint64) error {
+	err := healthChecker.CheckProviderHealth(ctx, providerAddress)
+	if err == nil {
+		return nil
+	}
+	utils.LavaFormatWarning("provider failed health checks, blocking it this epoch", err, utils.Attribute{Key: "provider", Value: providerAddress}, utils.Attribute{Key: "endpoint", Value: csm.rpcEndpoint.Key()}, utils.Attribute{Key: "epoch", Value: epoch})
+	errBlock := csm.blockProvider(providerAddress, false, epoch, 0, 0, nil)
+	if errBlock != nil && !EpochMismatchError.Is(errBlock) {
+		utils.LavaFormatError("failed blocking provider that failed health checks", errBlock, utils.Attribute{Key: "provider", Value: providerAddress})
+	}
+	return err
+}
+
 // this code needs to be thread safe
 func (csm *ConsumerSessionManager) probeProvider(ctx context.Context, consumerSessionsWithProvider *ConsumerSessionsWithProvider, epoch uint64) (latency time.Duration, providerAddress string, err error) {
 	// TODO: fetch all endpoints not just one
@@ -218,8 +263,14 @@ func (csm *ConsumerSessionManager) probeProvider(ctx context.Context, consumerSe
 	versions := trailer.Get(common.VersionMetadataKey)
 	relayLatency := time.Since(relaySentTime)
 	if err != nil {
+		if IsProviderDraining(err) {
+			// the provider is about to shut down, stop sending it relays this epoch without reporting it
+			csm.blockProvider(providerAddress, false, epoch, 0, 0, nil)
+			return 0, providerAddress, utils.LavaFormatInfo("provider is draining", utils.Attribute{Key: "provider", Value: providerAddress})
+		}
 		return 0, providerAddress, utils.LavaFormatError("probe call error", err, utils.Attribute{Key: "provider", Value: providerAddress})
 	}
+	endpoint.SetRelayCompressions(common.ParseCompressions(trailer.Get(common.RelayCompressionsMetadataKey)))
 	providerGuid := probeResp.GetGuid()
 	if providerGuid != guid {
 		return 0, providerAddress, utils.LavaFormatWarning("mismatch probe response", nil, utils.Attribute{Key: "provider", Value: providerAddress}, utils.Attribute{Key: "provider Guid", Value: providerGuid}, utils.Attribute{Key: "sent guid", Value: guid})
@@ -462,6 +513,42 @@ func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForS
 	}
 }
 
+// GetSessionForProvider returns a session with a specific provider of the current pairing, bypassing the optimizer.
+// used for relays that must reach a given provider, such as health checks. the session is released like the ones of GetSessions
+func (csm *ConsumerSessionManager) GetSessionForProvider(ctx context.Context, providerAddress string, cuNeededForSession uint64, virtualEpoch uint64) (*SessionInfo, error) {
+	csm.lock.RLock()
+	consumerSessionsWithProvider, ok := csm.pairing[providerAddress]
+	sessionEpoch := csm.atomicReadCurrentEpoch()
+	csm.lock.RUnlock()
+	if !ok {
+		return nil, utils.LavaFormatWarning("provider is not in the current pairing", nil, utils.Attribute{Key: "provider", Value: providerAddress}, utils.Attribute{Key: "epoch", Value: sessionEpoch})
+	}
+	err := consumerSessionsWithProvider.validateComputeUnits(cuNeededForSession, virtualEpoch)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := csm.fetchEndpointFromConsumerSessionsWithProviderWithRetry(ctx, consumerSessionsWithProvider, sessionEpoch)
+	if err != nil {
+		return nil, err
+	}
+	consumerSession, pairingEpoch, err := consumerSessionsWithProvider.GetConsumerSessionInstanceFromEndpoint(endpoint, csm.atomicReadNumberOfResets())
+	if err != nil {
+		return nil, err
+	}
+	err = consumerSessionsWithProvider.addUsedComputeUnits(cuNeededForSession, virtualEpoch)
+	if err != nil {
+		consumerSession.lock.Unlock()
+		return nil, err
+	}
+	consumerSession.LatestRelayCu = cuNeededForSession
+	consumerSession.RelayNum += RelayNumberIncrement
+	return &SessionInfo{
+		Session:           consumerSession,
+		Epoch:             pairingEpoch,
+		ReportedProviders: csm.GetReportedProviders(pairingEpoch),
+	}, nil
+}
+
 // Get a valid provider address.
 func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersList map[string]struct{}, cu uint64, requestedBlock int64, addon string, extensions []string, stateful uint32) (addresses []string, err error) {
 	// cs.Lock must be Rlocked here.
@@ -677,8 +764,18 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 		return sdkerrors.Wrapf(SessionIsAlreadyBlockListedError, "trying to report a session failure of a blocklisted consumer session")
 	}
 
+	// a throttled relay was rejected by a healthy provider that is busy or rate limiting this consumer, it is not a failure
+	// of the provider: the session stays usable, the provider's score is kept and the relay should be retried elsewhere
+	if IsProviderThrottled(errorReceived) {
+		return csm.OnSessionUnUsed(consumerSession)
+	}
+
 	consumerSession.QoSInfo.TotalRelays++
-	consumerSession.ConsecutiveNumberOfFailures += 1 // increase number of failures for this session
+	// a draining provider is shutting down gracefully, it is blocked for the epoch without counting against its session
+	draining := IsProviderDraining(errorReceived)
+	if !draining {
+		consumerSession.ConsecutiveNumberOfFailures += 1 // increase number of failures for this session
+	}
 	consumerSession.errosCount += 1
 	// if this session failed more than MaximumNumberOfFailuresAllowedPerConsumerSession times or session went out of sync we block it.
 	var consumerSessionBlockListed bool
@@ -708,7 +805,7 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 	if ReportAndBlockProviderError.Is(errorReceived) {
 		blockProvider = true
 		reportProvider = true
-	} else if BlockProviderError.Is(errorReceived) {
+	} else if BlockProviderError.Is(errorReceived) || draining {
 		blockProvider = true
 	}
 
@@ -942,6 +1039,21 @@ func (csm *ConsumerSessionManager) OnSessionDoneIncreaseCUOnly(consumerSession *
 	return nil
 }
 
+// OnSubscriptionMessage charges the session a subscription was opened on for a message streamed on it.
+// the subscription holds its session locked until it ends, so no other relay interleaves with the streamed messages,
+// the provider charges its session the same way and the next relay on the session proves the streamed messages
+func (csm *ConsumerSessionManager) OnSubscriptionMessage(consumerSession *SingleConsumerSession, cu, virtualEpoch uint64) error {
+	if err := csm.verifyLock(consumerSession); err != nil {
+		return sdkerrors.Wrapf(err, "OnSubscriptionMessage consumerSession.lock must be locked before accessing this method")
+	}
+	err := consumerSession.Parent.addUsedComputeUnits(cu, virtualEpoch)
+	if err != nil {
+		return err
+	}
+	consumerSession.CuSum += cu
+	return nil
+}
+
 // On a failed DataReliability session we don't decrease the cu unlike a normal session, we just unlock and verify if we need to block this session or provider.
 func (csm *ConsumerSessionManager) OnDataReliabilitySessionFailure(consumerSession *SingleConsumerSession, errorReceived error) error {
 	// consumerSession must be locked when getting here.
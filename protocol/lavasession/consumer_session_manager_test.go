@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/status"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/provideroptimizer"
 	"github.com/lavanet/lava/utils"
@@ -18,6 +19,7 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 )
 
@@ -45,7 +47,7 @@ func CreateConsumerSessionManager() *ConsumerSessionManager {
 	AllowInsecureConnectionToProviders = true // set to allow insecure for tests purposes
 	rand.InitRandomSeed()
 	baseLatency := common.AverageWorldLatency / 2 // we want performance to be half our timeout or better
-	return NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", 0}, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, baseLatency, 1), nil)
+	return NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", 0, nil, nil}, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, baseLatency, 1), nil)
 }
 
 var grpcServer *grpc.Server
@@ -84,7 +86,7 @@ func createGRPCServer(serverStarted chan struct{}) error {
 	grpcListener = lis.Addr().String()
 
 	// Create a new server with insecure credentials
-	tlsConfig := GetTlsConfig(NetworkAddressData{})
+	tlsConfig := GetTlsConfig(context.Background(), NetworkAddressData{})
 	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
 
 	go func() {
@@ -177,6 +179,37 @@ func TestHappyFlowVirtualEpoch(t *testing.T) {
 	}
 }
 
+func TestSubscriptionMessageCU(t *testing.T) {
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+	css, err := csm.GetSessions(ctx, cuForFirstRequest, nil, servicedBlockNumber, "", nil, common.NOSTATE, 0) // get a session
+	require.Nil(t, err)
+
+	for _, cs := range css {
+		usedComputeUnits := cs.Session.Parent.UsedComputeUnits
+		// every streamed message is charged to the session the subscription holds
+		err = csm.OnSubscriptionMessage(cs.Session, cuForFirstRequest, 0)
+		require.Nil(t, err)
+		require.Equal(t, cuForFirstRequest, cs.Session.CuSum)
+		require.Equal(t, usedComputeUnits+cuForFirstRequest, cs.Session.Parent.UsedComputeUnits)
+		// streamed messages can't exceed the provider's cu limit
+		err = csm.OnSubscriptionMessage(cs.Session, cs.Session.Parent.MaxComputeUnits, 0)
+		require.True(t, MaxComputeUnitsExceededError.Is(err))
+		require.Equal(t, cuForFirstRequest, cs.Session.CuSum)
+		// the session is released when the subscription ends and includes the subscribe relay's cu
+		err = csm.OnSessionDoneIncreaseCUOnly(cs.Session)
+		require.Nil(t, err)
+		require.Equal(t, 2*cuForFirstRequest, cs.Session.CuSum)
+		// a released session can't be charged for streamed messages
+		err = csm.OnSubscriptionMessage(cs.Session, cuForFirstRequest, 0)
+		require.True(t, LockMisUseDetectedError.Is(err))
+		require.Equal(t, 2*cuForFirstRequest, cs.Session.CuSum)
+	}
+}
+
 // Test exceeding maxCu
 func TestVirtualEpochWithFailure(t *testing.T) {
 	ctx := context.Background()
@@ -794,3 +827,99 @@ func TestPairingWithStateful(t *testing.T) {
 		require.Equal(t, allProviders-1, len(css))
 	})
 }
+
+type mockHealthChecker struct {
+	failing map[string]struct{}
+}
+
+func (mhc *mockHealthChecker) CheckProviderHealth(ctx context.Context, providerAddress string) error {
+	if _, ok := mhc.failing[providerAddress]; ok {
+		return fmt.Errorf("node error")
+	}
+	return nil
+}
+
+func TestProviderHealthChecks(t *testing.T) {
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.Nil(t, err)
+	healthChecker := &mockHealthChecker{failing: map[string]struct{}{"provider5": {}}}
+
+	// a provider failing the checks is blocked for the epoch
+	err = csm.checkProviderHealth(ctx, healthChecker, "provider5", firstEpochHeight)
+	require.Error(t, err)
+	require.NotContains(t, csm.getValidAddresses("", nil), "provider5")
+	err = csm.checkProviderHealth(ctx, healthChecker, "provider6", firstEpochHeight)
+	require.NoError(t, err)
+	require.Contains(t, csm.getValidAddresses("", nil), "provider6")
+
+	// checks reach the provider they are meant for, not the one the optimizer picks
+	sessionInfo, err := csm.GetSessionForProvider(ctx, "provider6", cuForFirstRequest, virtualEpoch)
+	require.NoError(t, err)
+	require.Equal(t, "provider6", sessionInfo.Session.Parent.PublicLavaAddress)
+	require.Equal(t, uint64(firstEpochHeight), sessionInfo.Epoch)
+	require.Equal(t, cuForFirstRequest, sessionInfo.Session.LatestRelayCu)
+	err = csm.OnSessionDone(sessionInfo.Session, servicedBlockNumber, cuForFirstRequest, time.Millisecond, sessionInfo.Session.CalculateExpectedLatency(2*time.Millisecond), servicedBlockNumber-1, numberOfProviders, numberOfProviders, false)
+	require.NoError(t, err)
+	require.Equal(t, cuForFirstRequest, sessionInfo.Session.CuSum)
+
+	_, err = csm.GetSessionForProvider(ctx, "unknown", cuForFirstRequest, virtualEpoch)
+	require.Error(t, err)
+}
+
+func TestSessionFailureProviderDraining(t *testing.T) {
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.Nil(t, err)
+	sessionInfo, err := csm.GetSessionForProvider(ctx, "provider6", cuForFirstRequest, virtualEpoch)
+	require.NoError(t, err)
+
+	// a draining provider is blocked for the epoch, without being reported or failing the session
+	err = csm.OnSessionFailure(sessionInfo.Session, status.Error(codes.Code(ProviderDrainingError.ABCICode()), "provider is draining"))
+	require.NoError(t, err)
+	require.Zero(t, sessionInfo.Session.ConsecutiveNumberOfFailures)
+	require.False(t, sessionInfo.Session.BlockListed)
+	require.NotContains(t, csm.getValidAddresses("", nil), "provider6")
+	require.False(t, csm.reportedProviders.IsReported("provider6"))
+}
+
+// relayOnlyOptimizer ignores probes, so the scores change only by relays
+type relayOnlyOptimizer struct {
+	*provideroptimizer.ProviderOptimizer
+}
+
+func (relayOnlyOptimizer) AppendProbeRelayData(providerAddress string, latency time.Duration, success bool) {
+}
+
+func TestSessionFailureProviderThrottled(t *testing.T) {
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	csm.providerOptimizer = relayOnlyOptimizer{csm.providerOptimizer.(*provideroptimizer.ProviderOptimizer)}
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList)
+	require.Nil(t, err)
+	sessionInfo, err := csm.GetSessionForProvider(ctx, "provider6", cuForFirstRequest, virtualEpoch)
+	require.NoError(t, err)
+	err = csm.OnSessionDone(sessionInfo.Session, servicedBlockNumber, cuForFirstRequest, time.Millisecond, sessionInfo.Session.CalculateExpectedLatency(2*time.Millisecond), servicedBlockNumber-1, numberOfProviders, numberOfProviders, false)
+	require.NoError(t, err)
+	var qosReport *pairingtypes.QualityOfServiceReport
+	require.Eventually(t, func() bool {
+		qosReport = csm.providerOptimizer.GetExcellenceQoSReportForProvider("provider6")
+		return qosReport != nil
+	}, time.Second, time.Millisecond)
+
+	// a throttled relay doesn't fail the session nor lower the provider's score
+	sessionInfo, err = csm.GetSessionForProvider(ctx, "provider6", cuForFirstRequest, virtualEpoch)
+	require.NoError(t, err)
+	err = csm.OnSessionFailure(sessionInfo.Session, status.Error(codes.Code(ProviderRelayThrottledError.ABCICode()), "relay throttled"))
+	require.NoError(t, err)
+	require.Zero(t, sessionInfo.Session.ConsecutiveNumberOfFailures)
+	require.False(t, sessionInfo.Session.BlockListed)
+	require.Contains(t, csm.getValidAddresses("", nil), "provider6")
+	time.Sleep(10 * time.Millisecond) // the optimizer is updated asynchronously
+	require.Equal(t, qosReport, csm.providerOptimizer.GetExcellenceQoSReportForProvider("provider6"))
+}
@@ -39,6 +39,11 @@ type ProviderOptimizer interface {
 	Strategy() provideroptimizer.Strategy
 }
 
+// ProviderHealthChecker sends synthetic checks to a provider, failing when the provider or its node can't serve them
+type ProviderHealthChecker interface {
+	CheckProviderHealth(ctx context.Context, providerAddress string) error
+}
+
 type ignoredProviders struct {
 	providers    map[string]struct{}
 	currentEpoch uint64
@@ -85,6 +90,21 @@ type Endpoint struct {
 	Addons             map[string]struct{}
 	Extensions         map[string]struct{}
 	Geolocation        planstypes.Geolocation
+	relayCompressions  atomic.Value // []string the provider advertised on its last probe
+}
+
+// SetRelayCompressions records the relay payload compressions the provider supports
+func (e *Endpoint) SetRelayCompressions(compressions []string) {
+	if compressions == nil {
+		compressions = []string{}
+	}
+	e.relayCompressions.Store(compressions)
+}
+
+// RelayCompressions returns the relay payload compressions the provider supports, none until it was probed
+func (e *Endpoint) RelayCompressions() []string {
+	compressions, _ := e.relayCompressions.Load().([]string)
+	return compressions
 }
 
 type SessionWithProvider struct {
@@ -99,6 +119,30 @@ type RPCEndpoint struct {
 	ChainID        string `yaml:"chain-id,omitempty" json:"chain-id,omitempty" mapstructure:"chain-id"`                      // spec chain identifier
 	ApiInterface   string `yaml:"api-interface,omitempty" json:"api-interface,omitempty" mapstructure:"api-interface"`
 	Geolocation    uint64 `yaml:"geolocation,omitempty" json:"geolocation,omitempty" mapstructure:"geolocation"`
+	// FileDescriptorSet files describing the chain's grpc services, used before relaying reflection requests to providers
+	ProtoDescriptorSets []string `yaml:"proto-descriptor-sets,omitempty" json:"proto-descriptor-sets,omitempty" mapstructure:"proto-descriptor-sets"`
+	// synthetic checks sent to the providers of the endpoint, see HealthChecks
+	HealthChecks *HealthChecks `yaml:"health-checks,omitempty" json:"health-checks,omitempty" mapstructure:"health-checks"`
+}
+
+// HealthChecks configures the synthetic checks the consumer sends to its providers on pairing and periodically.
+// unlike probes the checks are relayed to the node, so providers whose node fails calls are blocked before users hit them
+type HealthChecks struct {
+	// send the verifications of the spec, the ones providers verify their nodes with on startup
+	SpecVerifications bool                 `yaml:"spec-verifications,omitempty" json:"spec-verifications,omitempty" mapstructure:"spec-verifications"`
+	Requests          []HealthCheckRequest `yaml:"requests,omitempty" json:"requests,omitempty" mapstructure:"requests"`
+}
+
+func (hc *HealthChecks) Enabled() bool {
+	return hc != nil && (hc.SpecVerifications || len(hc.Requests) > 0)
+}
+
+// HealthCheckRequest is a request sent as a check, in the form the consumer receives it from users
+type HealthCheckRequest struct {
+	Name           string `yaml:"name,omitempty" json:"name,omitempty" mapstructure:"name"`
+	ConnectionType string `yaml:"connection-type,omitempty" json:"connection-type,omitempty" mapstructure:"connection-type"`
+	Url            string `yaml:"url,omitempty" json:"url,omitempty" mapstructure:"url"`
+	Data           string `yaml:"data,omitempty" json:"data,omitempty" mapstructure:"data"`
 }
 
 func (endpoint *RPCEndpoint) String() (retStr string) {
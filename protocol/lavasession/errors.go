@@ -48,4 +48,6 @@ var ( // Provider Side Errors
 	CouldNotFindIndexAsConsumerNotYetRegisteredError = sdkerrors.New("CouldNotFindIndexAsConsumerNotYetRegistered Error", 897, "fetching provider index from psm failed")
 	ProviderIndexMisMatchError                       = sdkerrors.New("ProviderIndexMisMatch Error", 898, "provider index mismatch")
 	SessionIdNotFoundError                           = sdkerrors.New("SessionIdNotFound Error", 899, "Session Id not found")
+	ProviderRelayThrottledError                      = sdkerrors.New("ProviderRelayThrottled Error", 900, "Provider is throttling relays, try another provider")
+	ProviderDrainingError                            = sdkerrors.New("ProviderDraining Error", 901, "Provider is draining before shutting down, try another provider")
 )
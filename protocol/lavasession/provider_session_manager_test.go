@@ -742,6 +742,38 @@ func TestPSMSubscribeEpochChange(t *testing.T) {
 	require.Empty(t, psm.sessionsWithAllConsumers[epoch2])
 }
 
+func TestPSMSubscriptionMessageCU(t *testing.T) {
+	ctx := context.Background()
+	// init test
+	psm, sps := prepareSession(t, ctx)
+	subscription := &RPCSubscription{Id: subscriptionID}
+	err := psm.ReleaseSessionAndCreateSubscription(sps, subscription, consumerOneAddress, epoch1, relayNumber)
+	require.Nil(t, err)
+
+	// every streamed message is charged to the session the subscription was opened on
+	for cuSum := 2 * relayCu; cuSum <= maxCu; cuSum += relayCu {
+		err = sps.AddSubscriptionMessageCU(ctx, relayCu, 0)
+		require.Nil(t, err)
+		require.Equal(t, cuSum, sps.CuSum)
+		require.Equal(t, cuSum, sps.userSessionsParent.epochData.UsedComputeUnits)
+	}
+	// the session is released after charging
+	require.True(t, LockMisUseDetectedError.Is(sps.VerifyLock()))
+
+	// streamed messages can't exceed the consumer's cu limit
+	err = sps.AddSubscriptionMessageCU(ctx, relayCu, 0)
+	require.True(t, MaximumCULimitReachedByConsumer.Is(err))
+	require.Equal(t, maxCu, sps.CuSum)
+	require.Equal(t, maxCu, sps.userSessionsParent.epochData.UsedComputeUnits)
+
+	// the next relay on the session includes the streamed cu
+	sps, err = psm.GetSession(ctx, consumerOneAddress, epoch1, sessionId, relayNumber+1, nil)
+	require.Nil(t, err)
+	err = sps.PrepareSessionForUsage(ctx, relayCu, maxCu+relayCu, 0, 1)
+	require.Nil(t, err)
+	require.Equal(t, relayCu, sps.LatestRelayCu)
+}
+
 type testSessionData struct {
 	currentCU uint64
 	inUse     bool
@@ -23,6 +23,8 @@ type NetworkAddressData struct {
 	KeyPem     string `yaml:"key-pem,omitempty" json:"key-pem,omitempty" mapstructure:"key-pem"`
 	CertPem    string `yaml:"cert-pem,omitempty" json:"cert-pem,omitempty" mapstructure:"cert-pem"`
 	DisableTLS bool   `yaml:"disable-tls,omitempty" json:"disable-tls,omitempty" mapstructure:"disable-tls"`
+	// CA certificates of the clients allowed to connect, set to require client certificates (mutual TLS)
+	ClientCaPem string `yaml:"client-ca-pem,omitempty" json:"client-ca-pem,omitempty" mapstructure:"client-ca-pem"`
 }
 
 type RPCProviderEndpoint struct {
@@ -31,6 +33,8 @@ type RPCProviderEndpoint struct {
 	ApiInterface   string             `yaml:"api-interface,omitempty" json:"api-interface,omitempty" mapstructure:"api-interface"`
 	Geolocation    uint64             `yaml:"geolocation,omitempty" json:"geolocation,omitempty" mapstructure:"geolocation"`
 	NodeUrls       []common.NodeUrl   `yaml:"node-urls,omitempty" json:"node-urls,omitempty" mapstructure:"node-urls"`
+	// FileDescriptorSet files describing the node's grpc services, for nodes with grpc reflection disabled
+	ProtoDescriptorSets []string `yaml:"proto-descriptor-sets,omitempty" json:"proto-descriptor-sets,omitempty" mapstructure:"proto-descriptor-sets"`
 }
 
 func (endpoint *RPCProviderEndpoint) UrlsString() string {
@@ -202,6 +202,28 @@ func (sps *SingleProviderSession) PrepareSessionForUsage(ctx context.Context, cu
 	return nil
 }
 
+// AddSubscriptionMessageCU charges the session a subscription was opened on for a message streamed on it.
+// the consumer charges its session the same way, so the next relay on the session proves the streamed messages
+func (sps *SingleProviderSession) AddSubscriptionMessageCU(ctx context.Context, cu, virtualEpoch uint64) error {
+	sps.lockForUse(ctx)
+	defer sps.lock.Unlock()
+	maxCu := sps.userSessionsParent.atomicReadMaxComputeUnits()
+	err := sps.validateAndAddUsedCU(cu, maxCu, virtualEpoch)
+	if err != nil {
+		return err
+	}
+	if sps.IsBadgeSession() {
+		maxCuBadge := atomicReadBadgeMaxComputeUnits(sps.BadgeUserData)
+		err = sps.validateAndAddBadgeUsedCU(cu, maxCuBadge, virtualEpoch, sps.BadgeUserData)
+		if err != nil {
+			sps.validateAndSubUsedCU(cu)
+			return err
+		}
+	}
+	sps.CuSum += cu
+	return nil
+}
+
 func (sps *SingleProviderSession) DisbandSession() error {
 	if sps.lock.TryLock() { // verify.
 		// if we managed to lock throw an error for misuse.
@@ -0,0 +1,234 @@
+package lavasession
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	ClientCertPemFlag = "client-cert-pem"
+	ClientKeyPemFlag  = "client-key-pem"
+	// writes of a certificate, its key and the client CA are not atomic, wait for them to settle before reloading
+	CertificateReloadDelay = 500 * time.Millisecond
+)
+
+// ClientCertificateReloader holds the certificate the consumer presents to providers that require mutual TLS, nil to present none
+var ClientCertificateReloader *CertificateReloader
+
+// CertificateReloader keeps a TLS certificate (and optionally a client CA pool for mutual TLS)
+// loaded from files, and reloads them when the files change so certificates can be rotated
+// without restarting and dropping the open connections
+type CertificateReloader struct {
+	certPath     string
+	keyPath      string
+	clientCaPath string
+
+	lock        sync.RWMutex
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+	// the raw files of the loaded state, to detect actual changes
+	certPem     []byte
+	keyPem      []byte
+	clientCaPem []byte
+}
+
+// NewCertificateReloader loads the certificate, key and client CA files and watches them for
+// changes until the context is done. Without a certificate path a self signed certificate is
+// used (and not reloaded). An empty client CA path disables client certificates verification
+func NewCertificateReloader(ctx context.Context, certPath, keyPath, clientCaPath string) (*CertificateReloader, error) {
+	cr := &CertificateReloader{
+		certPath:     certPath,
+		keyPath:      keyPath,
+		clientCaPath: clientCaPath,
+	}
+
+	if certPath == "" {
+		cert, err := GenerateSelfSignedCertificate()
+		if err != nil {
+			return nil, err
+		}
+		cr.certificate = &cert
+	}
+
+	if _, err := cr.load(); err != nil {
+		return nil, err
+	}
+
+	if err := cr.watch(ctx); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+// RequiresClientCertificate returns whether the reloader verifies client certificates
+func (cr *CertificateReloader) RequiresClientCertificate() bool {
+	return cr.clientCaPath != ""
+}
+
+// GetCertificate returns the current certificate (for tls.Config.GetCertificate)
+func (cr *CertificateReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.lock.RLock()
+	defer cr.lock.RUnlock()
+	return cr.certificate, nil
+}
+
+// GetClientCertificate returns the current certificate (for tls.Config.GetClientCertificate)
+func (cr *CertificateReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	cr.lock.RLock()
+	defer cr.lock.RUnlock()
+	return cr.certificate, nil
+}
+
+// VerifyClientCertificate verifies the client certificate chain against the current client CA pool
+// (for tls.Config.VerifyPeerCertificate, with ClientAuth set to tls.RequireAnyClientCert so the pool
+// can change between handshakes)
+func (cr *CertificateReloader) VerifyClientCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return utils.LavaFormatWarning("client did not provide a certificate", nil)
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for idx, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return utils.LavaFormatWarning("failed to parse client certificate", err)
+		}
+		certs[idx] = cert
+	}
+
+	cr.lock.RLock()
+	clientCAs := cr.clientCAs
+	cr.lock.RUnlock()
+
+	opts := x509.VerifyOptions{
+		Roots:         clientCAs,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(opts); err != nil {
+		return utils.LavaFormatWarning("client certificate verification failed", err, utils.Attribute{Key: "subject", Value: certs[0].Subject.String()})
+	}
+	return nil
+}
+
+// load reads the files and replaces the loaded state if they changed and are valid, keeping
+// the current state otherwise
+func (cr *CertificateReloader) load() (changed bool, err error) {
+	var certPem, keyPem, clientCaPem []byte
+	if cr.certPath != "" {
+		if certPem, err = os.ReadFile(cr.certPath); err != nil {
+			return false, err
+		}
+		if keyPem, err = os.ReadFile(cr.keyPath); err != nil {
+			return false, err
+		}
+	}
+	if cr.clientCaPath != "" {
+		if clientCaPem, err = os.ReadFile(cr.clientCaPath); err != nil {
+			return false, err
+		}
+	}
+
+	cr.lock.RLock()
+	unchanged := cr.certificate != nil && bytes.Equal(certPem, cr.certPem) && bytes.Equal(keyPem, cr.keyPem) && bytes.Equal(clientCaPem, cr.clientCaPem)
+	cr.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	var certificate *tls.Certificate
+	if cr.certPath != "" {
+		cert, err := tls.X509KeyPair(certPem, keyPem)
+		if err != nil {
+			return false, err
+		}
+		certificate = &cert
+	}
+
+	var clientCAs *x509.CertPool
+	if cr.clientCaPath != "" {
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(clientCaPem) {
+			return false, fmt.Errorf("no certificates found in client CA file %s", cr.clientCaPath)
+		}
+	}
+
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+	if certificate != nil {
+		cr.certificate = certificate
+	}
+	cr.clientCAs = clientCAs
+	cr.certPem, cr.keyPem, cr.clientCaPem = certPem, keyPem, clientCaPem
+	return true, nil
+}
+
+// watch reloads the files when their directories change. The directories (and not the files) are
+// watched to follow files that are replaced rather than written, e.g. symlink swaps of mounted secrets
+func (cr *CertificateReloader) watch(ctx context.Context) error {
+	dirs := map[string]struct{}{}
+	for _, path := range []string{cr.certPath, cr.keyPath, cr.clientCaPath} {
+		if path != "" {
+			dirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+	if len(dirs) == 0 {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return utils.LavaFormatError("failed to watch TLS certificate directory", err, utils.Attribute{Key: "dir", Value: dir})
+		}
+	}
+
+	go func() {
+		defer watcher.Close()
+		reload := time.NewTimer(CertificateReloadDelay)
+		reload.Stop()
+		defer reload.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				reload.Reset(CertificateReloadDelay)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				utils.LavaFormatWarning("TLS certificate watcher error", err)
+			case <-reload.C:
+				changed, err := cr.load()
+				if err != nil {
+					utils.LavaFormatError("failed to reload TLS certificate, keeping the current one", err,
+						utils.Attribute{Key: "cert", Value: cr.certPath}, utils.Attribute{Key: "clientCA", Value: cr.clientCaPath})
+				} else if changed {
+					utils.LavaFormatInfo("Reloaded TLS certificate",
+						utils.Attribute{Key: "cert", Value: cr.certPath}, utils.Attribute{Key: "clientCA", Value: cr.clientCaPath})
+				}
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,183 @@
+package lavasession
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPem []byte
+	keyPem  []byte
+}
+
+// createTestCertificate creates a certificate signed by the parent, or a self signed CA without a parent
+func createTestCertificate(t *testing.T, commonName string, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPem:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
+
+func writeTestCertificate(t *testing.T, dir string, cert *testCertificate) (certPath, keyPath string) {
+	certPath, keyPath = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certPath, cert.certPem, 0o600))
+	require.NoError(t, os.WriteFile(keyPath, cert.keyPem, 0o600))
+	return certPath, keyPath
+}
+
+func TestCertificateReloader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+
+	cert1 := createTestCertificate(t, "cert1", nil)
+	certPath, keyPath := writeTestCertificate(t, dir, cert1)
+
+	reloader, err := NewCertificateReloader(ctx, certPath, keyPath, "")
+	require.NoError(t, err)
+	require.False(t, reloader.RequiresClientCertificate())
+
+	leaf := func() string {
+		cert, err := reloader.GetCertificate(nil)
+		require.NoError(t, err)
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		return parsed.Subject.CommonName
+	}
+	require.Equal(t, "cert1", leaf())
+
+	// rotate the certificate
+	cert2 := createTestCertificate(t, "cert2", nil)
+	writeTestCertificate(t, dir, cert2)
+	require.Eventually(t, func() bool { return leaf() == "cert2" }, 5*time.Second, 50*time.Millisecond)
+
+	// an invalid certificate keeps the current one
+	require.NoError(t, os.WriteFile(certPath, []byte("invalid"), 0o600))
+	time.Sleep(2 * CertificateReloadDelay)
+	require.Equal(t, "cert2", leaf())
+
+	// a missing certificate fails
+	_, err = NewCertificateReloader(ctx, filepath.Join(dir, "missing.pem"), keyPath, "")
+	require.Error(t, err)
+}
+
+func TestMutualTLS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ca := createTestCertificate(t, "ca", nil)
+	server := createTestCertificate(t, "server", ca)
+	client := createTestCertificate(t, "client", ca)
+	otherCa := createTestCertificate(t, "other-ca", nil)
+	otherClient := createTestCertificate(t, "other-client", otherCa)
+
+	dir := t.TempDir()
+	certPath, keyPath := writeTestCertificate(t, dir, server)
+	clientCaPath := filepath.Join(dir, "client-ca.pem")
+	require.NoError(t, os.WriteFile(clientCaPath, ca.certPem, 0o600))
+
+	serverConfig := GetTlsConfig(ctx, NetworkAddressData{CertPem: certPath, KeyPem: keyPath, ClientCaPem: clientCaPath})
+	require.Equal(t, tls.RequireAnyClientCert, serverConfig.ClientAuth)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	handshakes := make(chan error)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			handshakes <- conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	dial := func(clientCert *testCertificate) error {
+		clientConfig := &tls.Config{InsecureSkipVerify: true} //nolint:gosec // testing the server side verification
+		if clientCert != nil {
+			cert, err := tls.X509KeyPair(clientCert.certPem, clientCert.keyPem)
+			require.NoError(t, err)
+			clientConfig.Certificates = []tls.Certificate{cert}
+		}
+		conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+		if err == nil {
+			conn.Close()
+		}
+		return <-handshakes
+	}
+
+	require.NoError(t, dial(client))
+	require.Error(t, dial(nil))
+	require.Error(t, dial(otherClient))
+
+	// rotate the client CA
+	require.NoError(t, os.WriteFile(clientCaPath, otherCa.certPem, 0o600))
+	require.Eventually(t, func() bool { return dial(otherClient) == nil }, 5*time.Second, 100*time.Millisecond)
+	require.Error(t, dial(client))
+}
+
+func TestVerifyClientCertificate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ca := createTestCertificate(t, "ca", nil)
+	client := createTestCertificate(t, "client", ca)
+
+	dir := t.TempDir()
+	clientCaPath := filepath.Join(dir, "client-ca.pem")
+	require.NoError(t, os.WriteFile(clientCaPath, ca.certPem, 0o600))
+
+	// self signed server certificate with client verification
+	reloader, err := NewCertificateReloader(ctx, "", "", clientCaPath)
+	require.NoError(t, err)
+	require.True(t, reloader.RequiresClientCertificate())
+
+	require.NoError(t, reloader.VerifyClientCertificate([][]byte{client.cert.Raw}, nil))
+	require.Error(t, reloader.VerifyClientCertificate(nil, nil))
+	require.Error(t, reloader.VerifyClientCertificate([][]byte{[]byte("invalid")}, nil))
+}
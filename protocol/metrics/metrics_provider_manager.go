@@ -14,6 +14,8 @@ import (
 const (
 	MetricsListenFlagName = "metrics-listen-address"
 	DisabledFlagOption    = "disabled"
+
+	providerMetricsListenAttempts = 60
 )
 
 type ProviderMetricsManager struct {
@@ -33,6 +35,8 @@ type ProviderMetricsManager struct {
 	fetchBlockSuccessMetric     *prometheus.CounterVec
 	protocolVersionMetric       *prometheus.GaugeVec
 	virtualEpochMetric          *prometheus.GaugeVec
+	claimFeeMetric              *prometheus.CounterVec
+	claimGasMetric              *prometheus.CounterVec
 }
 
 func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
@@ -112,6 +116,16 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		Name: "lava_provider_protocol_version",
 		Help: "The current running lavap version for the process. major := version / 1000000, minor := (version / 1000) % 1000 patch := version % 1000",
 	}, []string{"version"})
+
+	claimFeeMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lava_provider_claim_fee",
+		Help: "The total fee of relay payment claims in ulava, expected by simulation before sending and actually paid.",
+	}, []string{"type"})
+
+	claimGasMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lava_provider_claim_gas",
+		Help: "The total gas of relay payment claims, expected by simulation before sending, wanted and used on chain.",
+	}, []string{"type"})
 	// Register the metrics with the Prometheus registry.
 	prometheus.MustRegister(totalCUServicedMetric)
 	prometheus.MustRegister(totalCUPaidMetric)
@@ -127,11 +141,20 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 	prometheus.MustRegister(fetchBlockSuccessMetric)
 	prometheus.MustRegister(virtualEpochMetric)
 	prometheus.MustRegister(protocolVersionMetric)
+	prometheus.MustRegister(claimFeeMetric)
+	prometheus.MustRegister(claimGasMetric)
 
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
 		utils.LavaFormatInfo("prometheus endpoint listening", utils.Attribute{Key: "Listen Address", Value: networkAddress})
-		http.ListenAndServe(networkAddress, nil)
+		// a provider restarted by a listener handoff starts while the previous process still holds the address until it exits
+		var err error
+		for attempt := 0; attempt < providerMetricsListenAttempts; attempt++ {
+			err = http.ListenAndServe(networkAddress, nil)
+			utils.LavaFormatDebug("prometheus endpoint failed listening, retrying", utils.Attribute{Key: "Listen Address", Value: networkAddress}, utils.Attribute{Key: "attempt", Value: attempt + 1}, utils.Attribute{Key: "error", Value: err})
+			time.Sleep(time.Second)
+		}
+		utils.LavaFormatWarning("prometheus endpoint failed listening, giving up", err, utils.Attribute{Key: "Listen Address", Value: networkAddress}, utils.Attribute{Key: "attempts", Value: providerMetricsListenAttempts})
 	}()
 	return &ProviderMetricsManager{
 		providerMetrics:             map[string]*ProviderMetrics{},
@@ -149,6 +172,8 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		fetchBlockSuccessMetric:     fetchBlockSuccessMetric,
 		virtualEpochMetric:          virtualEpochMetric,
 		protocolVersionMetric:       protocolVersionMetric,
+		claimFeeMetric:              claimFeeMetric,
+		claimGasMetric:              claimGasMetric,
 	}
 }
 
@@ -196,6 +221,7 @@ func (pme *ProviderMetricsManager) AddPayment(specID string, cu uint64) {
 		spectypes.APIInterfaceTendermintRPC,
 		spectypes.APIInterfaceRest,
 		spectypes.APIInterfaceGrpc,
+		spectypes.APIInterfaceGraphQL,
 	}
 	for _, apiInterface := range availableAPIInterface {
 		providerMetrics := pme.getProviderMetric(specID, apiInterface)
@@ -268,3 +294,14 @@ func (pme *ProviderMetricsManager) SetVersion(version string) {
 	}
 	SetVersionInner(pme.protocolVersionMetric, version)
 }
+
+func (pme *ProviderMetricsManager) AddClaimFee(expectedFee, actualFee float64, expectedGas, gasWanted, gasUsed uint64) {
+	if pme == nil {
+		return
+	}
+	pme.claimFeeMetric.WithLabelValues("expected").Add(expectedFee)
+	pme.claimFeeMetric.WithLabelValues("actual").Add(actualFee)
+	pme.claimGasMetric.WithLabelValues("expected").Add(float64(expectedGas))
+	pme.claimGasMetric.WithLabelValues("wanted").Add(float64(gasWanted))
+	pme.claimGasMetric.WithLabelValues("used").Add(float64(gasUsed))
+}
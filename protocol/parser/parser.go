@@ -10,6 +10,7 @@ import (
 
 	sdkerrors "cosmossdk.io/errors"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/jsonpath"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
@@ -66,6 +67,81 @@ func ParseBlockFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser)
 	return rpcInput.ParseBlock(resString)
 }
 
+// ParseFromParams returns the (string) value of the parameter located by the block parser
+func ParseFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser) (string, error) {
+	result, err := parse(rpcInput, blockParser, PARSE_PARAMS)
+	if err != nil {
+		return "", err
+	}
+	if len(result) == 0 {
+		return "", ValueNotSetError
+	}
+	return blockInterfaceToString(result[0]), nil
+}
+
+// ParseArrayLengthFromParams returns the length of the array parameter located by the block parser
+func ParseArrayLengthFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser) (int, error) {
+	result, err := parseWithOptions(rpcInput, blockParser, PARSE_PARAMS, cuFormulaParseOptions)
+	if err != nil || result == nil {
+		return 0, fmt.Errorf("failed parsing array from params: %w", err)
+	}
+	resString, ok := result[0].(string)
+	if !ok {
+		return 0, fmt.Errorf("ParseArrayLengthFromParams - result[0].(string) - type assertion failed, type: %T", result[0])
+	}
+	var array []interface{}
+	if err := json.Unmarshal([]byte(resString), &array); err != nil {
+		return 0, fmt.Errorf("parsed value is not an array: %s, error: %w", resString, err)
+	}
+	return len(array), nil
+}
+
+// ParseCuFormulaUnits returns the units of a request according to the cu formula of its
+// api (e.g. the number of blocks in the requested range). Requests that can't be resolved
+// from their parameters (e.g. a range from a block number to "latest") get MaxUnits.
+func ParseCuFormulaUnits(rpcInput RPCInput, formula spectypes.CuFormula) uint64 {
+	switch formula.Kind {
+	case spectypes.CuFormula_BLOCK_RANGE:
+		from, err := parseCuFormulaBlock(rpcInput, formula.From)
+		if err != nil {
+			return formula.MaxUnits
+		}
+		to, err := parseCuFormulaBlock(rpcInput, formula.To)
+		if err != nil {
+			return formula.MaxUnits
+		}
+		if from == to {
+			// a single block, including the same tag (e.g. latest to latest)
+			return 1
+		}
+		if from < 0 || to < from {
+			return formula.MaxUnits
+		}
+		return uint64(to-from) + 1
+	case spectypes.CuFormula_ARRAY_LENGTH:
+		length, err := ParseArrayLengthFromParams(rpcInput, formula.From)
+		if err != nil {
+			return formula.MaxUnits
+		}
+		return uint64(length)
+	default:
+		return 0
+	}
+}
+
+// parseCuFormulaBlock returns the block located by a cu formula parser (see cuFormulaParseOptions)
+func parseCuFormulaBlock(rpcInput RPCInput, blockParser spectypes.BlockParser) (int64, error) {
+	result, err := parseWithOptions(rpcInput, blockParser, PARSE_PARAMS, cuFormulaParseOptions)
+	if err != nil || result == nil {
+		return spectypes.NOT_APPLICABLE, err
+	}
+	resString, ok := result[0].(string)
+	if !ok {
+		return spectypes.NOT_APPLICABLE, fmt.Errorf("parseCuFormulaBlock - result[0].(string) - type assertion failed, type: %T", result[0])
+	}
+	return rpcInput.ParseBlock(resString)
+}
+
 // This returns the parsed response without decoding
 func ParseFromReply(rpcInput RPCInput, blockParser spectypes.BlockParser) (string, error) {
 	result, err := parse(rpcInput, blockParser, PARSE_RESULT)
@@ -106,7 +182,36 @@ func ParseFromReplyAndDecode(rpcInput RPCInput, resultParser spectypes.BlockPars
 	return parseResponseByEncoding([]byte(response), resultParser.Encoding)
 }
 
+// parseOptions changes how the parser functions locate and format values, the zero value is the
+// behavior all the spec parsers rely on
+type parseOptions struct {
+	// a missing field of a canonical parser is an unset optional value (that falls back to the default value)
+	optionalFields bool
+	// nested values (arrays and objects) are formatted as json, so they can be parsed further
+	nestedAsJSON bool
+}
+
+// cuFormulaParseOptions are used to parse the params of cu formulas: their parameters are usually
+// optional (e.g. a range that defaults to the latest block) or arrays (e.g. a list of hashes)
+var cuFormulaParseOptions = parseOptions{optionalFields: true, nestedAsJSON: true}
+
+func (po parseOptions) valueToString(value interface{}) string {
+	if po.nestedAsJSON {
+		switch value.(type) {
+		case []interface{}, map[string]interface{}:
+			if encoded, err := json.Marshal(value); err == nil {
+				return string(encoded)
+			}
+		}
+	}
+	return blockInterfaceToString(value)
+}
+
 func parse(rpcInput RPCInput, blockParser spectypes.BlockParser, dataSource int) ([]interface{}, error) {
+	return parseWithOptions(rpcInput, blockParser, dataSource, parseOptions{})
+}
+
+func parseWithOptions(rpcInput RPCInput, blockParser spectypes.BlockParser, dataSource int, options parseOptions) ([]interface{}, error) {
 	var retval []interface{}
 	var err error
 
@@ -114,15 +219,17 @@ func parse(rpcInput RPCInput, blockParser spectypes.BlockParser, dataSource int)
 	case spectypes.PARSER_FUNC_EMPTY:
 		return nil, nil
 	case spectypes.PARSER_FUNC_PARSE_BY_ARG:
-		retval, err = parseByArg(rpcInput, blockParser.ParserArg, dataSource)
+		retval, err = parseByArg(rpcInput, blockParser.ParserArg, dataSource, options)
 	case spectypes.PARSER_FUNC_PARSE_CANONICAL:
-		retval, err = parseCanonical(rpcInput, blockParser.ParserArg, dataSource)
+		retval, err = parseCanonical(rpcInput, blockParser.ParserArg, dataSource, options)
 	case spectypes.PARSER_FUNC_PARSE_DICTIONARY:
 		retval, err = parseDictionary(rpcInput, blockParser.ParserArg, dataSource)
 	case spectypes.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED:
 		retval, err = parseDictionaryOrOrdered(rpcInput, blockParser.ParserArg, dataSource)
 	case spectypes.PARSER_FUNC_DEFAULT:
 		retval = parseDefault(rpcInput, blockParser.ParserArg, dataSource)
+	case spectypes.PARSER_FUNC_PARSE_PATH:
+		retval, err = parsePath(rpcInput, blockParser.ParserArg, dataSource)
 	default:
 		return nil, fmt.Errorf("unsupported block parser parserFunc")
 	}
@@ -208,7 +315,7 @@ func blockInterfaceToString(block interface{}) string {
 	}
 }
 
-func parseByArg(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+func parseByArg(rpcInput RPCInput, input []string, dataSource int, options parseOptions) ([]interface{}, error) {
 	// specified block is one of the direct parameters, input should be one string defining the location of the block
 	if len(input) != 1 {
 		return nil, utils.LavaFormatProduction("invalid input format, input length", nil, utils.Attribute{Key: "input_len", Value: strconv.Itoa(len(input))})
@@ -232,7 +339,7 @@ func parseByArg(rpcInput RPCInput, input []string, dataSource int) ([]interface{
 		// TODO: turn this into type assertion instead
 
 		retArr := make([]interface{}, 0)
-		retArr = append(retArr, blockInterfaceToString(block))
+		retArr = append(retArr, options.valueToString(block))
 		return retArr, nil
 	default:
 		// Parse by arg can be only list as we dont have the name of the height property.
@@ -251,7 +358,7 @@ func parseByArg(rpcInput RPCInput, input []string, dataSource int) ([]interface{
 //	}
 //
 // should output an interface array with "wanted result" in first index 0
-func parseCanonical(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+func parseCanonical(rpcInput RPCInput, input []string, dataSource int, options parseOptions) ([]interface{}, error) {
 	unmarshalledData, err := getDataToParse(rpcInput, dataSource)
 	if err != nil {
 		return nil, fmt.Errorf("invalid input format, data is not json: %s, error: %s", unmarshalledData, err)
@@ -278,11 +385,14 @@ func parseCanonical(rpcInput RPCInput, input []string, dataSource int) ([]interf
 			if container, ok := blockContainer.(map[string]interface{})[key]; ok {
 				blockContainer = container
 			} else {
+				if options.optionalFields {
+					return nil, ValueNotSetError
+				}
 				return nil, fmt.Errorf("invalid input format, blockContainer %s does not have field inside: %s, unmarshaledDataTyped: %s", blockContainer, key, unmarshaledDataTyped)
 			}
 		}
 		retArr := make([]interface{}, 0)
-		retArr = append(retArr, blockInterfaceToString(blockContainer))
+		retArr = append(retArr, options.valueToString(blockContainer))
 		return retArr, nil
 	case map[string]interface{}:
 		inp := input[0]
@@ -297,7 +407,7 @@ func parseCanonical(rpcInput RPCInput, input []string, dataSource int) ([]interf
 			if val, ok := unmarshaledDataTyped[key]; ok {
 				if idx == (len(relevantInput) - 1) {
 					retArr := make([]interface{}, 0)
-					retArr = append(retArr, blockInterfaceToString(val))
+					retArr = append(retArr, options.valueToString(val))
 					return retArr, nil
 				}
 				// if we didn't get to the last element continue deeper by changing unmarshaledDataTyped
@@ -318,6 +428,40 @@ func parseCanonical(rpcInput RPCInput, input []string, dataSource int) ([]interf
 	return nil, fmt.Errorf("should not get here, parsing failed %s", unmarshalledData)
 }
 
+// parsePath returns the value located by the first path expression in input that is found,
+// the other expressions are fallbacks. The root of the paths is the params or the result.
+func parsePath(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+	var data interface{}
+	switch dataSource {
+	case PARSE_PARAMS:
+		data = rpcInput.GetParams()
+	case PARSE_RESULT:
+		result := rpcInput.GetResult()
+		if len(result) == 0 {
+			return nil, fmt.Errorf("parsePath failure Get.Result is empty")
+		}
+		if err := json.Unmarshal(result, &data); err != nil {
+			// not json, the data itself can only be located by the root path
+			data = string(result)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported block parser parserFunc")
+	}
+
+	for _, expr := range input {
+		path, err := jsonpath.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range path.Find(data) {
+			if value != nil {
+				return appendInterfaceToInterfaceArray(blockInterfaceToString(value)), nil
+			}
+		}
+	}
+	return nil, ValueNotSetError
+}
+
 // parseDictionary return a value of prop specified in args if exists in dictionary
 // if not return an error
 func parseDictionary(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
@@ -334,6 +334,59 @@ func TestParseBlockFromParamsHappyFlow(t *testing.T) {
 			},
 			expectedBlock: 103,
 		},
+		{
+			name: "ParsePath__Nested__Case",
+			message: RPCInputTest{
+				Params: []interface{}{
+					map[string]interface{}{"block_id": map[string]interface{}{"block_number": float64(77)}},
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$[0].block_id.block_number", "$[0].block_id"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_PATH,
+			},
+			expectedBlock: 77,
+		},
+		{
+			name: "ParsePath__Fallback__Case",
+			message: RPCInputTest{
+				Params: []interface{}{
+					map[string]interface{}{"block_id": "latest"},
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$[0].block_id.block_number", "$[0].block_id"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_PATH,
+			},
+			expectedBlock: spectypes.LATEST_BLOCK,
+		},
+		{
+			name: "ParsePath__Wildcard__Case",
+			message: RPCInputTest{
+				Params: []interface{}{
+					"address",
+					map[string]interface{}{"commitment": "finalized", "minContextSlot": nil},
+					map[string]interface{}{"minContextSlot": float64(88)},
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$[*].minContextSlot"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_PATH,
+			},
+			expectedBlock: 88,
+		},
+		{
+			name: "ParsePath__DefaultValue__Case",
+			message: RPCInputTest{
+				Params: []interface{}{"address"},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:    []string{"$[1].minContextSlot"},
+				ParserFunc:   spectypes.PARSER_FUNC_PARSE_PATH,
+				DefaultValue: "latest",
+			},
+			expectedBlock: spectypes.LATEST_BLOCK,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -394,6 +447,30 @@ func TestParseBlockFromReplyHappyFlow(t *testing.T) {
 			},
 			expectedBlock: 25,
 		},
+		{
+			name: "ParsePath",
+			message: RPCInputTest{
+				Result: []byte(
+					"{\"context\": {\"slot\": 99}, \"value\": [{\"block\": 25}]}",
+				),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$.value[-1].block", "$.context.slot"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_PATH,
+			},
+			expectedBlock: 25,
+		},
+		{
+			name: "ParsePath__NonObjectResult",
+			message: RPCInputTest{
+				Result: []byte("\"0x1a\""),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_PATH,
+			},
+			expectedBlock: 26,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -404,3 +481,123 @@ func TestParseBlockFromReplyHappyFlow(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCuFormulaUnits(t *testing.T) {
+	rangeFormula := spectypes.CuFormula{
+		Kind: spectypes.CuFormula_BLOCK_RANGE,
+		From: spectypes.BlockParser{
+			ParserArg:    []string{"0", "fromBlock"},
+			ParserFunc:   spectypes.PARSER_FUNC_PARSE_CANONICAL,
+			DefaultValue: "latest",
+		},
+		To: spectypes.BlockParser{
+			ParserArg:    []string{"0", "toBlock"},
+			ParserFunc:   spectypes.PARSER_FUNC_PARSE_CANONICAL,
+			DefaultValue: "latest",
+		},
+		CuPerUnit: 1,
+		MaxUnits:  1000,
+	}
+	arrayFormula := spectypes.CuFormula{
+		Kind: spectypes.CuFormula_ARRAY_LENGTH,
+		From: spectypes.BlockParser{
+			ParserArg:  []string{"0"},
+			ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG,
+		},
+		CuPerUnit: 1,
+		MaxUnits:  1000,
+	}
+	tests := []struct {
+		name     string
+		params   interface{}
+		formula  spectypes.CuFormula
+		expected uint64
+	}{
+		{
+			name:     "BlockRange",
+			params:   []interface{}{map[string]interface{}{"fromBlock": "0x10", "toBlock": "0x19"}},
+			formula:  rangeFormula,
+			expected: 10,
+		},
+		{
+			name:     "BlockRangeWide",
+			params:   []interface{}{map[string]interface{}{"fromBlock": "0x0", "toBlock": "0x100000"}},
+			formula:  rangeFormula,
+			expected: 0x100001, // capped when computing the compute units
+		},
+		{
+			name:     "BlockRangeDefaultLatest",
+			params:   []interface{}{map[string]interface{}{}},
+			formula:  rangeFormula,
+			expected: 1,
+		},
+		{
+			name:     "BlockRangeToLatest",
+			params:   []interface{}{map[string]interface{}{"fromBlock": "0x10"}},
+			formula:  rangeFormula,
+			expected: 1000,
+		},
+		{
+			name:     "BlockRangeReversed",
+			params:   []interface{}{map[string]interface{}{"fromBlock": "0x19", "toBlock": "0x10"}},
+			formula:  rangeFormula,
+			expected: 1000,
+		},
+		{
+			name:     "ArrayLength",
+			params:   []interface{}{[]interface{}{"a", "b", "c"}},
+			formula:  arrayFormula,
+			expected: 3,
+		},
+		{
+			name:     "NotAnArray",
+			params:   []interface{}{"a"},
+			formula:  arrayFormula,
+			expected: 1000,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			units := ParseCuFormulaUnits(&RPCInputTest{Params: test.params}, test.formula)
+			require.Equal(t, test.expected, units)
+		})
+	}
+}
+
+// TestParseCuFormulaOptionsScope checks that the cu formula parsing options (optional canonical
+// fields, nested values as json) don't change the parsing of the spec parsers
+func TestParseCuFormulaOptionsScope(t *testing.T) {
+	canonical := spectypes.BlockParser{
+		ParserArg:    []string{"0", "toBlock"},
+		ParserFunc:   spectypes.PARSER_FUNC_PARSE_CANONICAL,
+		DefaultValue: "latest",
+	}
+	missingField := &RPCInputTest{Params: []interface{}{map[string]interface{}{"fromBlock": "0x10"}}}
+
+	// a missing canonical field is an error, not the default value
+	_, err := ParseBlockFromParams(missingField, canonical)
+	require.Error(t, err)
+	require.False(t, ValueNotSetError.Is(err))
+	_, err = ParseFromParams(missingField, canonical)
+	require.Error(t, err)
+
+	// while cu formulas fall back to the default value
+	block, err := parseCuFormulaBlock(missingField, canonical)
+	require.NoError(t, err)
+	require.Equal(t, spectypes.LATEST_BLOCK, block)
+
+	// nested values are not formatted as json
+	byArg := spectypes.BlockParser{
+		ParserArg:  []string{"0"},
+		ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG,
+	}
+	nested := &RPCInputTest{Params: []interface{}{[]interface{}{"a", "b"}}}
+	value, err := ParseFromParams(nested, byArg)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%s", []interface{}{"a", "b"}), value)
+
+	// while cu formulas get the json array
+	length, err := ParseArrayLengthFromParams(nested, byArg)
+	require.NoError(t, err)
+	require.Equal(t, 2, length)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/lavanet/lava/protocol/tracing"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -44,8 +46,13 @@ func (cache *Cache) GetEntry(ctx context.Context, request *pairingtypes.RelayPri
 	if cache.client == nil {
 		return nil, NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
 	}
+	ctx, span := tracing.StartSpan(ctx, "Cache.GetEntry", attribute.String("chain_id", chainID), attribute.Bool("finalized", finalized))
+	defer func() {
+		span.SetAttributes(attribute.Bool("hit", err == nil && reply.GetReply() != nil))
+		tracing.EndSpan(span, err)
+	}()
 	// TODO: handle disconnections and error types here
-	return cache.client.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: request, BlockHash: blockHash, ChainID: chainID, Finalized: finalized, Provider: provider})
+	return cache.client.GetRelay(tracing.InjectToOutgoingContext(ctx), &pairingtypes.RelayCacheGet{Request: request, BlockHash: blockHash, ChainID: chainID, Finalized: finalized, Provider: provider})
 }
 
 func (cache *Cache) SetEntry(ctx context.Context, request *pairingtypes.RelayPrivateData, blockHash []byte, chainID string, reply *pairingtypes.RelayReply, finalized bool, provider string, optionalMetadata []pairingtypes.Metadata) error {
@@ -56,8 +63,9 @@ func (cache *Cache) SetEntry(ctx context.Context, request *pairingtypes.RelayPri
 	if cache.client == nil {
 		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
 	}
+	ctx, span := tracing.StartSpan(ctx, "Cache.SetEntry", attribute.String("chain_id", chainID), attribute.Bool("finalized", finalized))
 	// TODO: handle disconnections and SetRelay error types here
-	_, err := cache.client.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+	_, err := cache.client.SetRelay(tracing.InjectToOutgoingContext(ctx), &pairingtypes.RelayCacheSet{
 		Request:          request,
 		BlockHash:        blockHash,
 		ChainID:          chainID,
@@ -66,5 +74,6 @@ func (cache *Cache) SetEntry(ctx context.Context, request *pairingtypes.RelayPri
 		Provider:         provider,
 		OptionalMetadata: optionalMetadata,
 	})
+	tracing.EndSpan(span, err)
 	return err
 }
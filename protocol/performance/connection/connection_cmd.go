@@ -69,7 +69,7 @@ func CreateTestConnectionServerCobraCommand() *cobra.Command {
 				serveExecutor = func() error { return httpServer.Serve(lis) }
 			} else {
 				NetworkAddressData := lavasession.NetworkAddressData{}
-				httpServer.TLSConfig = lavasession.GetTlsConfig(NetworkAddressData)
+				httpServer.TLSConfig = lavasession.GetTlsConfig(ctx, NetworkAddressData)
 				serveExecutor = func() error { return httpServer.ServeTLS(lis, "", "") }
 			}
 
@@ -0,0 +1,170 @@
+package rpcconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/tracing"
+	"github.com/lavanet/lava/utils"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	HealthCheckIntervalFlagName = "health-check-interval"
+	DefaultHealthCheckInterval  = 5 * time.Minute
+	healthCheckDappID           = "-health-check-"
+)
+
+// healthCheck is a relay sent to every provider to check it, and the way its reply is verified
+type healthCheck struct {
+	name           string
+	connectionType string
+	url            string
+	data           []byte
+	verification   *chainlib.VerificationContainer // set for spec verifications, requests only need a reply without a node error
+}
+
+// buildHealthChecks creates the checks configured for the endpoint, validating they are requests of the spec
+func (rpccs *RPCConsumerServer) buildHealthChecks(healthChecksConfig *lavasession.HealthChecks) ([]*healthCheck, error) {
+	healthChecks := []*healthCheck{}
+	if healthChecksConfig.SpecVerifications {
+		// only the verifications of the base apis, providers aren't required to support the addons and extensions of the consumer
+		verifications, err := rpccs.chainParser.GetVerifications(nil)
+		if err != nil {
+			return nil, err
+		}
+		for idx := range verifications {
+			verification := verifications[idx]
+			craftData := &chainlib.CraftData{Path: verification.ParseDirective.ApiName, Data: []byte(verification.ParseDirective.FunctionTemplate), ConnectionType: verification.ConnectionType}
+			url, data := craftData.RequestUrlAndData(rpccs.listenEndpoint.ApiInterface)
+			healthChecks = append(healthChecks, &healthCheck{name: verification.Name, connectionType: verification.ConnectionType, url: url, data: data, verification: &verification})
+		}
+	}
+	for _, request := range healthChecksConfig.Requests {
+		healthChecks = append(healthChecks, &healthCheck{name: request.Name, connectionType: request.ConnectionType, url: request.Url, data: []byte(request.Data)})
+	}
+	for _, check := range healthChecks {
+		_, err := rpccs.chainParser.ParseMsg(check.url, check.data, check.connectionType, nil, 0)
+		if err != nil {
+			return nil, utils.LavaFormatError("invalid health check", err, utils.Attribute{Key: "name", Value: check.name}, utils.Attribute{Key: "endpoint", Value: rpccs.listenEndpoint.Key()})
+		}
+	}
+	return healthChecks, nil
+}
+
+// CheckProviderHealth sends the health checks to the provider, failing on the first check that fails.
+// implements lavasession.ProviderHealthChecker
+func (rpccs *RPCConsumerServer) CheckProviderHealth(ctx context.Context, providerAddress string) error {
+	for _, check := range rpccs.healthChecks {
+		err := rpccs.sendHealthCheck(ctx, providerAddress, check)
+		if err != nil {
+			return utils.LavaFormatWarning("health check failed", err, utils.Attribute{Key: "check", Value: check.name}, utils.Attribute{Key: "provider", Value: providerAddress})
+		}
+	}
+	return nil
+}
+
+func (rpccs *RPCConsumerServer) sendHealthCheck(ctx context.Context, providerAddress string, check *healthCheck) (errRet error) {
+	ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+	ctx, span := tracing.StartSpan(ctx, "rpcconsumer.healthCheck", attribute.String("check", check.name), attribute.String("provider", providerAddress))
+	defer func() { tracing.EndSpan(span, errRet) }()
+	chainMessage, err := rpccs.chainParser.ParseMsg(check.url, check.data, check.connectionType, nil, 0)
+	if err != nil {
+		return err
+	}
+	reqBlock, _ := chainMessage.RequestedBlock()
+	relayRequestData := lavaprotocol.NewRelayData(ctx, check.connectionType, check.url, check.data, 0, reqBlock, rpccs.listenEndpoint.ApiInterface, chainMessage.GetRPCMessage().GetHeaders(), chainlib.GetAddon(chainMessage), common.GetExtensionNames(chainMessage.GetExtensions()))
+	cu := chainlib.GetComputeUnits(chainMessage)
+	sessionInfo, err := rpccs.consumerSessionManager.GetSessionForProvider(ctx, providerAddress, cu, rpccs.consumerTxSender.GetLatestVirtualEpoch())
+	if err != nil {
+		return err
+	}
+	singleConsumerSession := sessionInfo.Session
+	relayRequest, err := lavaprotocol.ConstructRelayRequest(ctx, rpccs.privKey, rpccs.lavaChainID, rpccs.listenEndpoint.ChainID, relayRequestData, providerAddress, singleConsumerSession, int64(sessionInfo.Epoch), sessionInfo.ReportedProviders)
+	if err != nil {
+		if errUnUsed := rpccs.consumerSessionManager.OnSessionUnUsed(singleConsumerSession); errUnUsed != nil {
+			utils.LavaFormatError("failed releasing health check session", errUnUsed, utils.Attribute{Key: "GUID", Value: ctx})
+		}
+		return err
+	}
+	relayResult := &common.RelayResult{
+		ProviderAddress: providerAddress,
+		Request:         relayRequest,
+		ConflictHandler: singleConsumerSession.Parent,
+	}
+	relayTimeout := chainlib.GetRelayTimeout(chainMessage, rpccs.chainParser, 0)
+	relayResult, relayLatency, err, _ := rpccs.relayInner(ctx, singleConsumerSession, relayResult, relayTimeout, chainMessage, common.GetUniqueToken(healthCheckDappID, ""))
+	if err != nil {
+		if errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, err); errReport != nil {
+			utils.LavaFormatError("health check onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "original error", Value: err.Error()})
+		}
+		return err
+	}
+	expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
+	err = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, relayResult.Reply.LatestBlock, cu, relayLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), expectedBH, numOfProviders, rpccs.consumerSessionManager.GetAtomicPairingAddressesLength(), chainMessage.GetApi().Category.HangingApi)
+	if err != nil {
+		utils.LavaFormatError("health check onSessionDone errored", err, utils.Attribute{Key: "GUID", Value: ctx})
+	}
+	latestBlock := uint64(0)
+	if expectedBH > 0 && numOfProviders > 0 {
+		latestBlock = uint64(expectedBH)
+	}
+	return check.verifyReply(relayResult, chainMessage, latestBlock)
+}
+
+func (check *healthCheck) verifyReply(relayResult *common.RelayResult, chainMessage chainlib.ChainMessage, latestBlock uint64) error {
+	if check.verification != nil {
+		_, err := chainlib.VerifyReply(relayResult.Reply, chainMessage, *check.verification, latestBlock, utils.Attribute{Key: "provider", Value: relayResult.ProviderAddress})
+		return err
+	}
+	if relayResult.StatusCode >= http.StatusBadRequest {
+		return utils.LavaFormatWarning("health check replied with an error status", nil, utils.Attribute{Key: "status", Value: relayResult.StatusCode}, utils.Attribute{Key: "reply", Value: string(relayResult.Reply.Data)})
+	}
+	return nodeErrorInReply(relayResult.Reply.Data)
+}
+
+// nodeErrorInReply finds the errors json-rpc and graphql nodes reply with alongside a successful status
+func nodeErrorInReply(data []byte) error {
+	var reply struct {
+		Error  json.RawMessage `json:"error"`
+		Errors json.RawMessage `json:"errors"`
+	}
+	if json.Unmarshal(data, &reply) != nil {
+		// not a json object, there's no error field to look for
+		return nil
+	}
+	for _, nodeError := range []json.RawMessage{reply.Error, reply.Errors} {
+		if len(nodeError) > 0 && string(nodeError) != "null" {
+			return utils.LavaFormatWarning("health check replied with a node error", nil, utils.Attribute{Key: "error", Value: string(nodeError)})
+		}
+	}
+	return nil
+}
+
+// runPeriodicHealthChecks checks the providers that are still valid every interval. the first pairing is probed before
+// the health checker is set, so providers are checked right away once it exists
+func (rpccs *RPCConsumerServer) runPeriodicHealthChecks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if rpccs.consumerSessionManager.Initialized() {
+			checkCtx, cancel := context.WithTimeout(ctx, interval)
+			err := rpccs.consumerSessionManager.RunHealthChecks(checkCtx)
+			cancel()
+			if err != nil {
+				utils.LavaFormatWarning("periodic health checks did not finish", err, utils.Attribute{Key: "endpoint", Value: rpccs.listenEndpoint.Key()})
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
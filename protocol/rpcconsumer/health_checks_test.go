@@ -0,0 +1,68 @@
+package rpcconsumer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/rpcconsumer/relayrecorder"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthChecks(t *testing.T) {
+	ctx := context.Background()
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+	// the replay providers serve the reply of the current relay, or fail the way they were recorded failing
+	replayer, err := NewRelayReplayer(ctx, spec, "ETH1", spectypes.APIInterfaceJsonRPC, replayRecords(1, true), RelayReplayConfig{Seed: 1})
+	require.NoError(t, err)
+	defer replayer.Close()
+	consumerServer := replayer.consumerServer
+	servingProvider := replayer.providers[replayServingProvider].lavaAddress.String()
+	failingProvider := replayer.providers[replayFailingProvider].lavaAddress.String()
+
+	_, err = consumerServer.buildHealthChecks(&lavasession.HealthChecks{Requests: []lavasession.HealthCheckRequest{{Name: "invalid", ConnectionType: http.MethodPost, Data: `{"jsonrpc":"2.0","id":1,"method":"eth_notAnApi","params":[]}`}}})
+	require.Error(t, err)
+
+	t.Run("spec verifications", func(t *testing.T) {
+		healthChecks, err := consumerServer.buildHealthChecks(&lavasession.HealthChecks{SpecVerifications: true})
+		require.NoError(t, err)
+		// the mock providers reply the same to every check, keep the verification the reply is meant for
+		consumerServer.healthChecks = nil
+		for _, check := range healthChecks {
+			if check.name == "chain-id" {
+				consumerServer.healthChecks = append(consumerServer.healthChecks, check)
+			}
+		}
+		require.Len(t, consumerServer.healthChecks, 1)
+		replayer.setCurrent(&relayrecorder.RelayRecord{Reply: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)})
+		require.NoError(t, consumerServer.CheckProviderHealth(ctx, servingProvider))
+		require.Error(t, consumerServer.CheckProviderHealth(ctx, failingProvider))
+		// a node of another chain answers, but fails the verification
+		replayer.setCurrent(&relayrecorder.RelayRecord{Reply: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x5"}`)})
+		require.Error(t, consumerServer.CheckProviderHealth(ctx, servingProvider))
+	})
+
+	t.Run("requests", func(t *testing.T) {
+		consumerServer.healthChecks, err = consumerServer.buildHealthChecks(&lavasession.HealthChecks{Requests: []lavasession.HealthCheckRequest{{Name: "blockNumber", ConnectionType: http.MethodPost, Data: `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`}}})
+		require.NoError(t, err)
+		require.Len(t, consumerServer.healthChecks, 1)
+		replayer.setCurrent(&relayrecorder.RelayRecord{Reply: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x64"}`)})
+		require.NoError(t, consumerServer.CheckProviderHealth(ctx, servingProvider))
+		// the provider relays successfully, but its node fails the call
+		replayer.setCurrent(&relayrecorder.RelayRecord{Reply: []byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`)})
+		require.Error(t, consumerServer.CheckProviderHealth(ctx, servingProvider))
+	})
+	replayer.setCurrent(nil)
+}
+
+func TestNodeErrorInReply(t *testing.T) {
+	require.NoError(t, nodeErrorInReply([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x64"}`)))
+	require.NoError(t, nodeErrorInReply([]byte(`{"jsonrpc":"2.0","id":1,"result":null,"error":null}`)))
+	require.NoError(t, nodeErrorInReply([]byte(`[{"jsonrpc":"2.0","id":1,"result":"0x64"}]`)))
+	require.Error(t, nodeErrorInReply([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`)))
+	require.Error(t, nodeErrorInReply([]byte(`{"data":null,"errors":[{"message":"unknown field"}]}`)))
+}
@@ -0,0 +1,513 @@
+package rpcconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/protocol/rpcconsumer/relayrecorder"
+	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/rand"
+	"github.com/lavanet/lava/utils/sigs"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	plantypes "github.com/lavanet/lava/x/plans/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	ReplaySeedFlagName = "seed"
+	// replayed providers have no compute units limit, the recording decides what they serve
+	replayProviderMaxComputeUnits = 1 << 40
+)
+
+// RelayReplayConfig overrides the routing configuration recorded with the relays, zero values keep the recorded ones
+type RelayReplayConfig struct {
+	Strategy               *provideroptimizer.Strategy
+	MaxConcurrentProviders uint
+	Seed                   int64
+}
+
+// RelayReplayResult is a recorded relay and how the consumer served it when replayed
+type RelayReplayResult struct {
+	Recorded *relayrecorder.RelayRecord
+	Provider string // recorded address of the provider that replied, on failure all the providers that failed
+	Retries  uint64
+	Reply    []byte
+	Err      error
+}
+
+// Match returns whether the replayed relay was routed and resolved like the recorded one
+func (rrr *RelayReplayResult) Match() bool {
+	recordedRetries := uint64(0)
+	if rrr.Recorded.Rounds > 1 {
+		recordedRetries = uint64(rrr.Recorded.Rounds - 1)
+	}
+	return rrr.Provider == rrr.Recorded.Provider && (rrr.Err == nil) == (rrr.Recorded.Error == "") && rrr.Retries == recordedRetries
+}
+
+type replayOutcome struct {
+	latency     time.Duration
+	latestBlock int64
+	reply       []byte
+	err         string
+}
+
+// replayProvider is a mock provider answering relays and probes the way a recorded provider did
+type replayProvider struct {
+	pairingtypes.UnimplementedRelayerServer
+	recordedAddress string
+	privKey         *btcec.PrivateKey
+	lavaAddress     sdk.AccAddress
+	replayer        *RelayReplayer
+	server          *grpc.Server
+	listener        net.Listener
+	profile         replayOutcome // served when the recording has no attempt for the relay
+	probe           *relayrecorder.OptimizerRecord
+}
+
+func (rp *replayProvider) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	outcome := rp.replayer.nextOutcome(rp)
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(outcome.latency):
+	}
+	if outcome.err != "" {
+		return nil, errors.New(outcome.err)
+	}
+	reply := &pairingtypes.RelayReply{
+		Data:                  outcome.reply,
+		LatestBlock:           outcome.latestBlock,
+		FinalizedBlocksHashes: rp.replayer.finalizationProof(outcome.latestBlock),
+	}
+	return lavaprotocol.SignRelayResponse(rp.replayer.consumerAddress, *request, rp.privKey, reply, true)
+}
+
+func (rp *replayProvider) Probe(ctx context.Context, probeReq *pairingtypes.ProbeRequest) (*pairingtypes.ProbeReply, error) {
+	if rp.probe != nil {
+		time.Sleep(rp.probe.Latency)
+		if !rp.probe.Success {
+			return nil, fmt.Errorf("recorded probe failed")
+		}
+	}
+	return &pairingtypes.ProbeReply{Guid: probeReq.Guid, LatestBlock: rp.profile.latestBlock}, nil
+}
+
+func (rp *replayProvider) RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error {
+	return fmt.Errorf("subscriptions are not replayed")
+}
+
+// replayTxSender stands in for the consumer state tracker, no transactions are sent when replaying
+type replayTxSender struct{}
+
+func (replayTxSender) TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict, conflictHandler common.ConflictHandlerInterface) error {
+	return nil
+}
+
+func (replayTxSender) GetConsumerPolicy(ctx context.Context, consumerAddress, chainID string) (*plantypes.Policy, error) {
+	return &plantypes.Policy{}, nil
+}
+
+func (replayTxSender) GetLatestVirtualEpoch() uint64 {
+	return 0
+}
+
+// RelayReplayer feeds recorded relays through a consumer server whose providers are mocks serving the
+// recorded outcomes (latency, latest block, reply or error), to reproduce the consumer's routing offline
+type RelayReplayer struct {
+	consumerServer  *RPCConsumerServer
+	consumerAddress sdk.AccAddress
+	relays          []*relayrecorder.RelayRecord
+	providers       map[string]*replayProvider // key is the recorded address
+	recordedAddress map[string]string          // replay provider address to recorded address
+	chainParser     chainlib.ChainParser
+
+	lock     sync.Mutex
+	current  *relayrecorder.RelayRecord
+	consumed map[string]int // attempts of the current relay served per provider
+}
+
+// NewRelayReplayer starts mock providers for the providers in the recording of the chain and api interface
+// and a consumer server paired with them
+func NewRelayReplayer(ctx context.Context, spec spectypes.Spec, chainID, apiInterface string, records []*relayrecorder.Record, replayConfig RelayReplayConfig) (*RelayReplayer, error) {
+	config := &relayrecorder.ConfigRecord{Strategy: strategyNames[provideroptimizer.STRATEGY_BALANCED], MaxConcurrentProviders: 1, RequiredResponses: 1}
+	rr := &RelayReplayer{providers: map[string]*replayProvider{}, recordedAddress: map[string]string{}}
+	probes := map[string]*relayrecorder.OptimizerRecord{}
+	addresses := map[string]struct{}{}
+	for _, record := range records {
+		if record.ChainID != chainID || record.ApiInterface != apiInterface {
+			continue
+		}
+		switch record.Type {
+		case relayrecorder.RecordTypeConfig:
+			config = record.Config
+		case relayrecorder.RecordTypeRelay:
+			rr.relays = append(rr.relays, record.Relay)
+			for _, attempt := range record.Relay.Attempts {
+				if attempt.Provider != "" {
+					addresses[attempt.Provider] = struct{}{}
+				}
+			}
+		case relayrecorder.RecordTypeChooseProvider:
+			for _, address := range record.Optimizer.AllAddresses {
+				addresses[address] = struct{}{}
+			}
+		case relayrecorder.RecordTypeProbe:
+			if record.Optimizer.Provider == "" {
+				continue
+			}
+			if _, ok := probes[record.Optimizer.Provider]; !ok {
+				probes[record.Optimizer.Provider] = record.Optimizer
+			}
+			addresses[record.Optimizer.Provider] = struct{}{}
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, utils.LavaFormatError("no providers recorded", nil, utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "apiInterface", Value: apiInterface})
+	}
+
+	strategy := provideroptimizer.STRATEGY_BALANCED
+	recordedStrategy := strategyValue{}
+	if err := recordedStrategy.Set(config.Strategy); err == nil {
+		strategy = recordedStrategy.Strategy
+	}
+	if replayConfig.Strategy != nil {
+		strategy = *replayConfig.Strategy
+	}
+	maxConcurrentProviders := config.MaxConcurrentProviders
+	if replayConfig.MaxConcurrentProviders != 0 {
+		maxConcurrentProviders = replayConfig.MaxConcurrentProviders
+	}
+	requiredResponses := config.RequiredResponses
+	if requiredResponses <= 0 {
+		requiredResponses = 1
+	}
+	rand.SetSpecificSeed(replayConfig.Seed)
+
+	chainParser, err := chainlib.NewChainParser(apiInterface)
+	if err != nil {
+		return nil, err
+	}
+	chainParser.SetSpec(spec)
+	rr.chainParser = chainParser
+
+	privKey, consumerAddress := sigs.GenerateFloatingKey()
+	rr.consumerAddress = consumerAddress
+
+	// the mock providers use a self signed certificate
+	lavasession.AllowInsecureConnectionToProviders = true
+	tlsConfig := lavasession.GetTlsConfig(ctx, lavasession.NetworkAddressData{})
+	profiles, defaultProfile := replayProfiles(rr.relays)
+	sortedAddresses := make([]string, 0, len(addresses))
+	for address := range addresses {
+		sortedAddresses = append(sortedAddresses, address)
+	}
+	sort.Strings(sortedAddresses)
+
+	epoch := uint64(1)
+	for _, relay := range rr.relays {
+		for _, attempt := range relay.Attempts {
+			if attempt.Epoch != 0 {
+				epoch = attempt.Epoch
+				break
+			}
+		}
+	}
+	pairingList := map[uint64]*lavasession.ConsumerSessionsWithProvider{}
+	for idx, address := range sortedAddresses {
+		profile, ok := profiles[address]
+		if !ok {
+			profile = defaultProfile
+		}
+		provider := &replayProvider{recordedAddress: address, replayer: rr, profile: profile, probe: probes[address]}
+		provider.privKey, provider.lavaAddress = sigs.GenerateFloatingKey()
+		provider.listener, err = net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			rr.Close()
+			return nil, err
+		}
+		provider.server = grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+		pairingtypes.RegisterRelayerServer(provider.server, provider)
+		go provider.server.Serve(provider.listener)
+		rr.providers[address] = provider
+		rr.recordedAddress[provider.lavaAddress.String()] = address
+		pairingList[uint64(idx)] = &lavasession.ConsumerSessionsWithProvider{
+			PublicLavaAddress: provider.lavaAddress.String(),
+			Endpoints:         []*lavasession.Endpoint{{NetworkAddress: provider.listener.Addr().String(), Enabled: true}},
+			Sessions:          map[int64]*lavasession.SingleConsumerSession{},
+			MaxComputeUnits:   replayProviderMaxComputeUnits,
+			PairingEpoch:      epoch,
+		}
+	}
+
+	_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
+	baseLatency := common.AverageWorldLatency / 2
+	optimizer := provideroptimizer.NewProviderOptimizer(strategy, averageBlockTime, baseLatency, maxConcurrentProviders)
+	rpcEndpoint := &lavasession.RPCEndpoint{ChainID: chainID, ApiInterface: apiInterface}
+	consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer, nil)
+	if err := consumerSessionManager.UpdateAllProviders(epoch, pairingList); err != nil {
+		rr.Close()
+		return nil, err
+	}
+
+	rr.consumerServer = &RPCConsumerServer{
+		chainParser:            chainParser,
+		consumerSessionManager: consumerSessionManager,
+		listenEndpoint:         rpcEndpoint,
+		privKey:                privKey,
+		consumerTxSender:       replayTxSender{},
+		requiredResponses:      requiredResponses,
+		finalizationConsensus:  lavaprotocol.NewFinalizationConsensus(chainID),
+		lavaChainID:            "replay",
+		consumerAddress:        consumerAddress,
+		consumerServices:       map[string]struct{}{"": {}},
+		consumerConsistency:    NewConsumerConsistency(chainID),
+	}
+	return rr, nil
+}
+
+// replayProfiles returns how each provider usually served relays: its average latency and latest block
+// when it succeeded, or its last error if it never did. Providers that were paired but never relayed to
+// get the default profile of all the providers
+func replayProfiles(relays []*relayrecorder.RelayRecord) (profiles map[string]replayOutcome, defaultProfile replayOutcome) {
+	type stats struct {
+		latencySum  time.Duration
+		successes   int64
+		latestBlock int64
+		err         string
+	}
+	providerStats := map[string]*stats{}
+	total := &stats{}
+	for _, relay := range relays {
+		for _, attempt := range relay.Attempts {
+			if attempt.Provider == "" {
+				continue
+			}
+			providerStat, ok := providerStats[attempt.Provider]
+			if !ok {
+				providerStat = &stats{}
+				providerStats[attempt.Provider] = providerStat
+			}
+			if attempt.Error != "" {
+				providerStat.err = attempt.Error
+				continue
+			}
+			for _, stat := range []*stats{providerStat, total} {
+				stat.latencySum += attempt.Latency
+				stat.successes++
+				if attempt.LatestBlock > stat.latestBlock {
+					stat.latestBlock = attempt.LatestBlock
+				}
+			}
+		}
+	}
+	// probes fail on a zero latest block
+	latestBlock := total.latestBlock
+	if latestBlock == 0 {
+		latestBlock = 1
+	}
+	profile := func(stat *stats) replayOutcome {
+		if stat.successes == 0 {
+			return replayOutcome{latestBlock: latestBlock, err: stat.err}
+		}
+		return replayOutcome{latency: stat.latencySum / time.Duration(stat.successes), latestBlock: stat.latestBlock}
+	}
+	defaultProfile = profile(total)
+	if defaultProfile.err == "" && total.successes == 0 {
+		defaultProfile.err = "no successful relays recorded"
+	}
+	profiles = map[string]replayOutcome{}
+	for provider, stat := range providerStats {
+		profiles[provider] = profile(stat)
+	}
+	return profiles, defaultProfile
+}
+
+// finalizationProof returns the finalized blocks a provider at the latest block proves, with hashes all
+// the replay providers agree on
+func (rr *RelayReplayer) finalizationProof(latestBlock int64) []byte {
+	_, _, blockDistanceForFinalizedData, blocksInFinalizationProof := rr.chainParser.ChainBlockStats()
+	finalizedBlockHashes := map[int64]string{}
+	toBlock := latestBlock - int64(blockDistanceForFinalizedData)
+	for block := toBlock - int64(blocksInFinalizationProof) + 1; block <= toBlock; block++ {
+		if block >= 0 {
+			finalizedBlockHashes[block] = "replay-" + strconv.FormatInt(block, 10)
+		}
+	}
+	proof, err := json.Marshal(finalizedBlockHashes)
+	if err != nil {
+		utils.LavaFormatError("failed marshaling finalization proof", err)
+	}
+	return proof
+}
+
+// nextOutcome returns the recorded outcome of the provider's next attempt in the current relay, or its
+// profile if the recorded consumer made no such attempt
+func (rr *RelayReplayer) nextOutcome(provider *replayProvider) replayOutcome {
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+	outcome := provider.profile
+	if rr.current == nil {
+		return outcome
+	}
+	outcome.reply = rr.current.Reply
+	attempts := rr.current.ProviderAttempts(provider.recordedAddress)
+	served := rr.consumed[provider.recordedAddress]
+	if served < len(attempts) {
+		rr.consumed[provider.recordedAddress]++
+		attempt := attempts[served]
+		outcome.latency = attempt.Latency
+		outcome.err = attempt.Error
+		if attempt.LatestBlock != 0 {
+			outcome.latestBlock = attempt.LatestBlock
+		}
+	}
+	return outcome
+}
+
+func (rr *RelayReplayer) setCurrent(relay *relayrecorder.RelayRecord) {
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+	rr.current = relay
+	rr.consumed = map[string]int{}
+}
+
+// toRecordedAddresses maps a comma separated list of replay provider addresses to the recorded ones
+func (rr *RelayReplayer) toRecordedAddresses(addresses string) string {
+	if addresses == "" {
+		return ""
+	}
+	recorded := strings.Split(addresses, ",")
+	for idx, address := range recorded {
+		if recordedAddress, ok := rr.recordedAddress[address]; ok {
+			recorded[idx] = recordedAddress
+		}
+	}
+	return strings.Join(recorded, ",")
+}
+
+// Replay sends the recorded relays through the consumer server one after the other
+func (rr *RelayReplayer) Replay(ctx context.Context) []*RelayReplayResult {
+	results := make([]*RelayReplayResult, 0, len(rr.relays))
+	for _, relay := range rr.relays {
+		rr.setCurrent(relay)
+		relayCtx := utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+		relayResult, err := rr.consumerServer.SendRelay(relayCtx, relay.Url, string(relay.Data), relay.ConnectionType, relay.DappID, relay.ConsumerIp, nil, relay.Metadata)
+		result := &RelayReplayResult{Recorded: relay, Err: err}
+		if relayResult != nil {
+			result.Provider = rr.toRecordedAddresses(relayResult.ProviderAddress)
+			for _, header := range relayResult.GetReply().GetMetadata() {
+				if header.Name == common.RETRY_COUNT_HEADER_NAME {
+					result.Retries, _ = strconv.ParseUint(header.Value, 10, 64)
+				}
+			}
+			if err == nil {
+				result.Reply = relayResult.GetReply().GetData()
+			}
+		}
+		results = append(results, result)
+	}
+	rr.setCurrent(nil)
+	return results
+}
+
+func (rr *RelayReplayer) Close() {
+	for _, provider := range rr.providers {
+		provider.server.Stop()
+	}
+}
+
+func CreateRelayReplayCobraCommand() *cobra.Command {
+	replayStrategy := strategyValue{Strategy: provideroptimizer.STRATEGY_BALANCED}
+	cmdRelayReplay := &cobra.Command{
+		Use:   `replay-relays [recording-file] [spec-chain-id] [api-interface]`,
+		Short: `replay relays recorded by an rpcconsumer against mock providers and compare the routing`,
+		Long: `reads the relays of the chain and api interface recorded by rpcconsumer --` + relayrecorder.RecordRelaysFlagName + `, starts a mock provider for every recorded provider
+serving the recorded latencies, latest blocks, replies and errors, and sends the relays one after the other through a consumer paired with them.
+prints, for every relay, whether the replayed consumer replied from the same provider with the same number of retries as recorded.
+the routing configuration is taken from the recording unless overridden, the spec is fetched from the lava node`,
+		Example: `replay-relays relays.rec ETH1 jsonrpc --node https://lava-rpc:26657
+replay-relays relays.rec ETH1 jsonrpc --strategy latency --max-concurrent-providers 2 --seed 7 --node https://lava-rpc:26657`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recordingPath, chainID, apiInterface := args[0], args[1], args[2]
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			replayConfig := RelayReplayConfig{}
+			if cmd.Flags().Changed("strategy") {
+				replayConfig.Strategy = &replayStrategy.Strategy
+			}
+			replayConfig.MaxConcurrentProviders, err = cmd.Flags().GetUint(common.MaximumConcurrentProvidersFlagName)
+			if err != nil {
+				return err
+			}
+			replayConfig.Seed, err = cmd.Flags().GetInt64(ReplaySeedFlagName)
+			if err != nil {
+				return err
+			}
+
+			records, err := relayrecorder.ReadRecords(recordingPath, nil)
+			if err != nil {
+				return utils.LavaFormatError("failed reading relay recording", err, utils.Attribute{Key: "path", Value: recordingPath})
+			}
+			spec, err := statetracker.NewConsumerStateQuery(ctx, clientCtx).GetSpec(ctx, chainID)
+			if err != nil {
+				return utils.LavaFormatError("failed fetching spec", err, utils.Attribute{Key: "chainID", Value: chainID})
+			}
+			replayer, err := NewRelayReplayer(ctx, *spec, chainID, apiInterface, records, replayConfig)
+			if err != nil {
+				return err
+			}
+			defer replayer.Close()
+
+			results := replayer.Replay(ctx)
+			mismatches := 0
+			for _, result := range results {
+				status := "match"
+				if !result.Match() {
+					mismatches++
+					status = "MISMATCH"
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s guid %d: recorded provider %s rounds %d error %q, replayed provider %s retries %d error %v\n",
+					status, result.Recorded.GUID, result.Recorded.Provider, result.Recorded.Rounds, result.Recorded.Error, result.Provider, result.Retries, result.Err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "replayed %d relays, %d mismatches\n", len(results), mismatches)
+			if mismatches > 0 {
+				return fmt.Errorf("%d of %d replayed relays were routed differently than recorded", mismatches, len(results))
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmdRelayReplay)
+	cmdRelayReplay.Flags().Var(&replayStrategy, "strategy", fmt.Sprintf("override the recorded strategy (%s)", strings.Join(strategyNames, "|")))
+	cmdRelayReplay.Flags().Uint(common.MaximumConcurrentProvidersFlagName, 0, "override the recorded max number of concurrent providers")
+	cmdRelayReplay.Flags().Int64(ReplaySeedFlagName, 1, "random seed of the replay, replays with the same seed make the same random choices")
+	return cmdRelayReplay
+}
@@ -0,0 +1,93 @@
+package rpcconsumer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/rpcconsumer/relayrecorder"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	replayFailingProvider = "lava@failingprovider"
+	replayServingProvider = "lava@servingprovider"
+)
+
+func replayRecords(relays int, serving bool) []*relayrecorder.Record {
+	records := []*relayrecorder.Record{
+		{Type: relayrecorder.RecordTypeConfig, ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC, Config: &relayrecorder.ConfigRecord{Strategy: "balanced", MaxConcurrentProviders: 1, RequiredResponses: 1}},
+		{Type: relayrecorder.RecordTypeProbe, ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC, Optimizer: &relayrecorder.OptimizerRecord{Provider: replayFailingProvider, Latency: time.Millisecond, Success: true}},
+		{Type: relayrecorder.RecordTypeProbe, ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC, Optimizer: &relayrecorder.OptimizerRecord{Provider: replayServingProvider, Latency: time.Millisecond, Success: true}},
+		// another chain's relays are not replayed
+		{Type: relayrecorder.RecordTypeRelay, ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceRest, Relay: &relayrecorder.RelayRecord{Url: "/blocks/latest"}},
+	}
+	for i := 0; i < relays; i++ {
+		relay := &relayrecorder.RelayRecord{
+			Data:           []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`),
+			ConnectionType: http.MethodPost,
+			Rounds:         2,
+			Attempts: []*relayrecorder.AttemptRecord{
+				{Round: 0, Provider: replayFailingProvider, Epoch: 20, Latency: time.Millisecond, Error: "node error"},
+			},
+		}
+		if serving {
+			relay.Attempts = append(relay.Attempts, &relayrecorder.AttemptRecord{Round: 1, Provider: replayServingProvider, Epoch: 20, Latency: 2 * time.Millisecond, LatestBlock: 100})
+			relay.Provider = replayServingProvider
+			relay.Reply = []byte(`{"jsonrpc":"2.0","id":1,"result":"0x64"}`)
+		} else {
+			relay.Attempts = append(relay.Attempts, &relayrecorder.AttemptRecord{Round: 1, Provider: replayServingProvider, Epoch: 20, Latency: 2 * time.Millisecond, Error: "node error"})
+			relay.Provider = replayFailingProvider + "," + replayServingProvider
+			relay.Error = "Failed all retries"
+		}
+		records = append(records, &relayrecorder.Record{Type: relayrecorder.RecordTypeRelay, ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC, Relay: relay})
+	}
+	return records
+}
+
+func TestRelayReplay(t *testing.T) {
+	ctx := context.Background()
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+
+	replayer, err := NewRelayReplayer(ctx, spec, "ETH1", spectypes.APIInterfaceJsonRPC, replayRecords(5, true), RelayReplayConfig{Seed: 1})
+	require.NoError(t, err)
+	defer replayer.Close()
+	require.Len(t, replayer.providers, 2)
+
+	results := replayer.Replay(ctx)
+	require.Len(t, results, 5)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		// whichever provider the optimizer tries first, the relay is served by the provider that served it when recorded
+		require.Equal(t, replayServingProvider, result.Provider)
+		require.Equal(t, result.Recorded.Reply, result.Reply)
+		require.LessOrEqual(t, result.Retries, uint64(1))
+		require.Equal(t, result.Retries == 1, result.Match())
+	}
+}
+
+func TestRelayReplayFailures(t *testing.T) {
+	ctx := context.Background()
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+
+	replayer, err := NewRelayReplayer(ctx, spec, "ETH1", spectypes.APIInterfaceJsonRPC, replayRecords(2, false), RelayReplayConfig{Seed: 1})
+	require.NoError(t, err)
+	defer replayer.Close()
+
+	results := replayer.Replay(ctx)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.Error(t, result.Err)
+		require.Contains(t, result.Provider, replayFailingProvider)
+		require.Contains(t, result.Provider, replayServingProvider)
+		require.Nil(t, result.Reply)
+	}
+
+	_, err = NewRelayReplayer(ctx, spec, "ETH1", spectypes.APIInterfaceRest, replayRecords(1, true), RelayReplayConfig{})
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package relayrecorder
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+)
+
+// RecordingOptimizer records the samples a provider optimizer learns from and the providers it chooses
+type RecordingOptimizer struct {
+	lavasession.ProviderOptimizer
+	recorder     *Recorder
+	chainID      string
+	apiInterface string
+}
+
+// NewRecordingOptimizer wraps the optimizer to record its inputs, the optimizer is returned as is when recording is disabled
+func NewRecordingOptimizer(optimizer lavasession.ProviderOptimizer, recorder *Recorder, chainID, apiInterface string) lavasession.ProviderOptimizer {
+	if !recorder.Enabled() {
+		return optimizer
+	}
+	return &RecordingOptimizer{ProviderOptimizer: optimizer, recorder: recorder, chainID: chainID, apiInterface: apiInterface}
+}
+
+func (ro *RecordingOptimizer) AppendProbeRelayData(providerAddress string, latency time.Duration, success bool) {
+	ro.ProviderOptimizer.AppendProbeRelayData(providerAddress, latency, success)
+	ro.recorder.RecordOptimizer(RecordTypeProbe, ro.chainID, ro.apiInterface, &OptimizerRecord{Provider: providerAddress, Latency: latency, Success: success})
+}
+
+func (ro *RecordingOptimizer) AppendRelayFailure(providerAddress string) {
+	ro.ProviderOptimizer.AppendRelayFailure(providerAddress)
+	ro.recorder.RecordOptimizer(RecordTypeRelayFailure, ro.chainID, ro.apiInterface, &OptimizerRecord{Provider: providerAddress})
+}
+
+func (ro *RecordingOptimizer) AppendRelayData(providerAddress string, latency time.Duration, isHangingApi bool, cu, syncBlock uint64) {
+	ro.ProviderOptimizer.AppendRelayData(providerAddress, latency, isHangingApi, cu, syncBlock)
+	ro.recorder.RecordOptimizer(RecordTypeRelayData, ro.chainID, ro.apiInterface, &OptimizerRecord{Provider: providerAddress, Latency: latency, Success: true, HangingApi: isHangingApi, Cu: cu, SyncBlock: syncBlock})
+}
+
+func (ro *RecordingOptimizer) ChooseProvider(allAddresses []string, ignoredProviders map[string]struct{}, cu uint64, requestedBlock int64, perturbationPercentage float64) (addresses []string) {
+	addresses = ro.ProviderOptimizer.ChooseProvider(allAddresses, ignoredProviders, cu, requestedBlock, perturbationPercentage)
+	ignored := make([]string, 0, len(ignoredProviders))
+	for provider := range ignoredProviders {
+		ignored = append(ignored, provider)
+	}
+	sort.Strings(ignored)
+	ro.recorder.RecordOptimizer(RecordTypeChooseProvider, ro.chainID, ro.apiInterface, &OptimizerRecord{
+		AllAddresses:     append([]string{}, allAddresses...),
+		IgnoredProviders: ignored,
+		Cu:               cu,
+		RequestedBlock:   requestedBlock,
+		Perturbation:     perturbationPercentage,
+		Chosen:           append([]string{}, addresses...),
+	})
+	return addresses
+}
@@ -0,0 +1,305 @@
+package relayrecorder
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	RecordRelaysFlagName = "record-relays"
+
+	RecordTypeConfig         = "config"
+	RecordTypeRelay          = "relay"
+	RecordTypeChooseProvider = "choose_provider"
+	RecordTypeRelayData      = "relay_data"
+	RecordTypeRelayFailure   = "relay_failure"
+	RecordTypeProbe          = "probe"
+
+	// recorded requests and replies can be large, allow lines well above the default scanner limit
+	maxRecordLineSize = 64 * 1024 * 1024
+)
+
+type relayRecordCtxKey struct{}
+
+// Record is a line of a relay recording. Relays and optimizer inputs of all the endpoints are written
+// to the same file in the order they happen, each with the chain and api interface it belongs to
+type Record struct {
+	Time         time.Time        `json:"time"`
+	Type         string           `json:"type"`
+	ChainID      string           `json:"chain_id"`
+	ApiInterface string           `json:"api_interface"`
+	Config       *ConfigRecord    `json:"config,omitempty"`
+	Relay        *RelayRecord     `json:"relay,omitempty"`
+	Optimizer    *OptimizerRecord `json:"optimizer,omitempty"`
+}
+
+// ConfigRecord is the routing configuration of an endpoint, written when it starts serving
+type ConfigRecord struct {
+	Strategy               string `json:"strategy"`
+	MaxConcurrentProviders uint   `json:"max_concurrent_providers"`
+	RequiredResponses      int    `json:"required_responses"`
+}
+
+// OptimizerRecord is a call to the provider optimizer, either a sample it learns from (relay data,
+// relay failure or probe) or a provider selection with its inputs and result
+type OptimizerRecord struct {
+	Provider   string        `json:"provider,omitempty"`
+	Latency    time.Duration `json:"latency,omitempty"`
+	Success    bool          `json:"success,omitempty"`
+	HangingApi bool          `json:"hanging_api,omitempty"`
+	Cu         uint64        `json:"cu,omitempty"`
+	SyncBlock  uint64        `json:"sync_block,omitempty"`
+
+	AllAddresses     []string `json:"all_addresses,omitempty"`
+	IgnoredProviders []string `json:"ignored_providers,omitempty"`
+	RequestedBlock   int64    `json:"requested_block,omitempty"`
+	Perturbation     float64  `json:"perturbation,omitempty"`
+	Chosen           []string `json:"chosen,omitempty"`
+}
+
+// RelayRecord is a request received by the consumer and every attempt made to serve it
+type RelayRecord struct {
+	GUID           uint64                  `json:"guid,omitempty"`
+	Url            string                  `json:"url,omitempty"`
+	Data           []byte                  `json:"data,omitempty"`
+	ConnectionType string                  `json:"connection_type,omitempty"`
+	Metadata       []pairingtypes.Metadata `json:"metadata,omitempty"`
+	DappID         string                  `json:"dapp_id,omitempty"`
+	ConsumerIp     string                  `json:"consumer_ip,omitempty"`
+
+	Attempts []*AttemptRecord `json:"attempts,omitempty"`
+	Rounds   int              `json:"rounds"`
+
+	// the result returned to the client
+	Provider string        `json:"provider,omitempty"` // on failure, all the providers that failed
+	Reply    []byte        `json:"reply,omitempty"`
+	Latency  time.Duration `json:"latency"`
+	Error    string        `json:"error,omitempty"`
+
+	lock sync.Mutex
+}
+
+// AttemptRecord is a relay sent to a single provider, or a failure to get a session when the provider is empty
+type AttemptRecord struct {
+	Round        int           `json:"round"`
+	Provider     string        `json:"provider,omitempty"`
+	Epoch        uint64        `json:"epoch,omitempty"`
+	RequestBlock int64         `json:"request_block"`
+	Latency      time.Duration `json:"latency"`
+	Cached       bool          `json:"cached,omitempty"`
+	LatestBlock  int64         `json:"latest_block,omitempty"`
+	StatusCode   int           `json:"status_code,omitempty"`
+	Error        string        `json:"error,omitempty"`
+}
+
+// WithRelayRecord returns a context carrying the record of the relay it serves, nil records are not added
+func WithRelayRecord(ctx context.Context, relayRecord *RelayRecord) context.Context {
+	if relayRecord == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, relayRecordCtxKey{}, relayRecord)
+}
+
+// GetRelayRecord returns the record of the relay served with the context, or nil if it isn't recorded
+func GetRelayRecord(ctx context.Context) *RelayRecord {
+	relayRecord, _ := ctx.Value(relayRecordCtxKey{}).(*RelayRecord)
+	return relayRecord
+}
+
+// NextRound starts a round of sending the relay to providers and returns its index
+func (rr *RelayRecord) NextRound() int {
+	if rr == nil {
+		return 0
+	}
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+	rr.Rounds++
+	return rr.Rounds - 1
+}
+
+// AddAttempt records the result of sending the relay to a provider in a round
+func (rr *RelayRecord) AddAttempt(round int, provider string, epoch uint64, relayResult *common.RelayResult, latency time.Duration, cached bool, err error) {
+	if rr == nil {
+		return
+	}
+	attempt := &AttemptRecord{
+		Round:    round,
+		Provider: provider,
+		Epoch:    epoch,
+		Latency:  latency,
+		Cached:   cached,
+	}
+	if relayResult != nil {
+		if relayResult.Request != nil {
+			attempt.RequestBlock = relayResult.Request.RelayData.RequestBlock
+		}
+		attempt.LatestBlock = relayResult.Reply.GetLatestBlock()
+		attempt.StatusCode = relayResult.StatusCode
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+	rr.Attempts = append(rr.Attempts, attempt)
+}
+
+// Finish records the result returned to the client
+func (rr *RelayRecord) Finish(relayResult *common.RelayResult, latency time.Duration, err error) {
+	if rr == nil {
+		return
+	}
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+	rr.Latency = latency
+	if relayResult != nil {
+		rr.Provider = relayResult.ProviderAddress
+		if err == nil {
+			rr.Reply = relayResult.Reply.GetData()
+		}
+	}
+	if err != nil {
+		rr.Error = err.Error()
+	}
+}
+
+// ProviderAttempts returns the attempts made to a provider in the order they were made
+func (rr *RelayRecord) ProviderAttempts(provider string) (attempts []*AttemptRecord) {
+	for _, attempt := range rr.Attempts {
+		if attempt.Provider == provider {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts
+}
+
+// Recorder appends relay records to a file. A nil Recorder is disabled
+type Recorder struct {
+	lock    sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// NewRecorder returns a recorder appending to the file at path, or nil if the path is empty
+func NewRecorder(path string) (*Recorder, error) {
+	if path == "" {
+		return nil, nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed opening relay recording file", err, utils.Attribute{Key: "path", Value: path})
+	}
+	utils.LavaFormatInfo("Recording relays", utils.Attribute{Key: "path", Value: path})
+	return &Recorder{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+func (r *Recorder) Enabled() bool {
+	return r != nil
+}
+
+func (r *Recorder) write(record *Record) {
+	if r == nil {
+		return
+	}
+	record.Time = time.Now().UTC()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.encoder.Encode(record); err != nil {
+		utils.LavaFormatWarning("failed writing relay record", err, utils.Attribute{Key: "type", Value: record.Type}, utils.Attribute{Key: "chainID", Value: record.ChainID})
+	}
+}
+
+func (r *Recorder) RecordConfig(chainID, apiInterface string, config *ConfigRecord) {
+	r.write(&Record{Type: RecordTypeConfig, ChainID: chainID, ApiInterface: apiInterface, Config: config})
+}
+
+// NewRelayRecord starts the record of a relay received by the consumer, nil when recording is disabled
+func (r *Recorder) NewRelayRecord(ctx context.Context, url string, data []byte, connectionType string, metadata []pairingtypes.Metadata, dappID, consumerIp string) *RelayRecord {
+	if r == nil {
+		return nil
+	}
+	guid, _ := utils.GetUniqueIdentifier(ctx)
+	return &RelayRecord{
+		GUID:           guid,
+		Url:            url,
+		Data:           data,
+		ConnectionType: connectionType,
+		Metadata:       metadata,
+		DappID:         dappID,
+		ConsumerIp:     consumerIp,
+	}
+}
+
+func (r *Recorder) RecordRelay(chainID, apiInterface string, relayRecord *RelayRecord) {
+	if r == nil || relayRecord == nil {
+		return
+	}
+	// attempts of data reliability relays may still be added, write a consistent copy
+	relayRecord.lock.Lock()
+	record := &Record{Type: RecordTypeRelay, ChainID: chainID, ApiInterface: apiInterface, Relay: &RelayRecord{
+		GUID:           relayRecord.GUID,
+		Url:            relayRecord.Url,
+		Data:           relayRecord.Data,
+		ConnectionType: relayRecord.ConnectionType,
+		Metadata:       relayRecord.Metadata,
+		DappID:         relayRecord.DappID,
+		ConsumerIp:     relayRecord.ConsumerIp,
+		Attempts:       append([]*AttemptRecord{}, relayRecord.Attempts...),
+		Rounds:         relayRecord.Rounds,
+		Provider:       relayRecord.Provider,
+		Reply:          relayRecord.Reply,
+		Latency:        relayRecord.Latency,
+		Error:          relayRecord.Error,
+	}}
+	relayRecord.lock.Unlock()
+	r.write(record)
+}
+
+func (r *Recorder) RecordOptimizer(recordType, chainID, apiInterface string, optimizerRecord *OptimizerRecord) {
+	r.write(&Record{Type: recordType, ChainID: chainID, ApiInterface: apiInterface, Optimizer: optimizerRecord})
+}
+
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.file.Close()
+}
+
+// ReadRecords reads the records of a recording that pass the filter, a nil filter passes all records
+func ReadRecords(path string, filter func(*Record) bool) ([]*Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordLineSize)
+	var records []*Record
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, utils.LavaFormatError("invalid relay record", err, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "line", Value: line})
+		}
+		if filter == nil || filter(record) {
+			records = append(records, record)
+		}
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,107 @@
+package relayrecorder
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/rand"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordRelays(t *testing.T) {
+	rand.InitRandomSeed()
+	path := filepath.Join(t.TempDir(), "relays.rec")
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+	require.True(t, recorder.Enabled())
+
+	recorder.RecordConfig("LAV1", "rest", &ConfigRecord{Strategy: "balanced", MaxConcurrentProviders: 2, RequiredResponses: 1})
+
+	optimizer := NewRecordingOptimizer(provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, time.Second, common.AverageWorldLatency/2, 1), recorder, "LAV1", "rest")
+	optimizer.AppendProbeRelayData("provider1", 10*time.Millisecond, true)
+	optimizer.AppendRelayFailure("provider2")
+	chosen := optimizer.ChooseProvider([]string{"provider1", "provider2"}, map[string]struct{}{"provider2": {}}, 10, 100, 0)
+	require.Equal(t, []string{"provider1"}, chosen)
+
+	ctx := utils.WithUniqueIdentifier(context.Background(), 7)
+	relayRecord := recorder.NewRelayRecord(ctx, "/blocks/latest", nil, "GET", []pairingtypes.Metadata{{Name: "a", Value: "b"}}, "dapp", "1.2.3.4")
+	ctx = WithRelayRecord(ctx, relayRecord)
+	require.Equal(t, relayRecord, GetRelayRecord(ctx))
+
+	round := GetRelayRecord(ctx).NextRound()
+	GetRelayRecord(ctx).AddAttempt(round, "provider2", 20, &common.RelayResult{StatusCode: 500}, 50*time.Millisecond, false, fmt.Errorf("node error"))
+	round = GetRelayRecord(ctx).NextRound()
+	relayResult := &common.RelayResult{
+		ProviderAddress: "provider1",
+		Request:         &pairingtypes.RelayRequest{RelayData: &pairingtypes.RelayPrivateData{RequestBlock: 99}},
+		Reply:           &pairingtypes.RelayReply{Data: []byte("reply"), LatestBlock: 100},
+	}
+	GetRelayRecord(ctx).AddAttempt(round, "provider1", 20, relayResult, 30*time.Millisecond, true, nil)
+	relayRecord.Finish(relayResult, 90*time.Millisecond, nil)
+	recorder.RecordRelay("LAV1", "rest", relayRecord)
+	require.NoError(t, recorder.Close())
+
+	records, err := ReadRecords(path, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	require.Equal(t, RecordTypeConfig, records[0].Type)
+	require.Equal(t, uint(2), records[0].Config.MaxConcurrentProviders)
+	require.Equal(t, RecordTypeProbe, records[1].Type)
+	require.Equal(t, 10*time.Millisecond, records[1].Optimizer.Latency)
+	require.Equal(t, RecordTypeRelayFailure, records[2].Type)
+	require.Equal(t, "provider2", records[2].Optimizer.Provider)
+	require.Equal(t, RecordTypeChooseProvider, records[3].Type)
+	require.Equal(t, []string{"provider2"}, records[3].Optimizer.IgnoredProviders)
+	require.Equal(t, []string{"provider1"}, records[3].Optimizer.Chosen)
+	require.Equal(t, int64(100), records[3].Optimizer.RequestedBlock)
+
+	relay := records[4].Relay
+	require.Equal(t, "LAV1", records[4].ChainID)
+	require.Equal(t, "rest", records[4].ApiInterface)
+	require.Equal(t, uint64(7), relay.GUID)
+	require.Equal(t, "/blocks/latest", relay.Url)
+	require.Equal(t, "dapp", relay.DappID)
+	require.Equal(t, 2, relay.Rounds)
+	require.Equal(t, "provider1", relay.Provider)
+	require.Equal(t, []byte("reply"), relay.Reply)
+	require.Empty(t, relay.Error)
+	require.Len(t, relay.Attempts, 2)
+	require.Equal(t, "node error", relay.Attempts[0].Error)
+	require.Equal(t, 500, relay.Attempts[0].StatusCode)
+	require.Equal(t, 1, relay.Attempts[1].Round)
+	require.True(t, relay.Attempts[1].Cached)
+	require.Equal(t, int64(99), relay.Attempts[1].RequestBlock)
+	require.Equal(t, int64(100), relay.Attempts[1].LatestBlock)
+	require.Len(t, relay.ProviderAttempts("provider1"), 1)
+
+	records, err = ReadRecords(path, func(record *Record) bool { return record.Type == RecordTypeRelay })
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+}
+
+func TestRecordRelaysDisabled(t *testing.T) {
+	recorder, err := NewRecorder("")
+	require.NoError(t, err)
+	require.False(t, recorder.Enabled())
+
+	optimizer := provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, time.Second, common.AverageWorldLatency/2, 1)
+	require.Equal(t, optimizer, NewRecordingOptimizer(optimizer, recorder, "LAV1", "rest"))
+
+	ctx := context.Background()
+	relayRecord := recorder.NewRelayRecord(ctx, "", nil, "", nil, "", "")
+	require.Nil(t, relayRecord)
+	require.Equal(t, ctx, WithRelayRecord(ctx, relayRecord))
+	require.Nil(t, GetRelayRecord(ctx))
+	require.Equal(t, 0, relayRecord.NextRound())
+	relayRecord.AddAttempt(0, "provider", 0, nil, 0, false, nil)
+	relayRecord.Finish(nil, 0, nil)
+	recorder.RecordRelay("LAV1", "rest", relayRecord)
+	require.NoError(t, recorder.Close())
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
@@ -22,7 +23,9 @@ import (
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
 	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/protocol/rpcconsumer/relayrecorder"
 	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/protocol/upgrade"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
@@ -96,7 +99,7 @@ type RPCConsumer struct {
 }
 
 // spawns a new RPCConsumer server with all it's processes and internals ready for communications
-func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcEndpoints []*lavasession.RPCEndpoint, requiredResponses int, cache *performance.Cache, strategy provideroptimizer.Strategy, metricsListenAddress string, maxConcurrentProviders uint) (err error) {
+func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcEndpoints []*lavasession.RPCEndpoint, requiredResponses int, cache *performance.Cache, strategy provideroptimizer.Strategy, metricsListenAddress string, maxConcurrentProviders uint, relayRecorder *relayrecorder.Recorder, healthCheckInterval time.Duration) (err error) {
 	if common.IsTestMode(ctx) {
 		testModeWarn("RPCConsumer running tests")
 	}
@@ -240,12 +243,17 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 			}
 
 			// Register For Updates
-			consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer, consumerMetricsManager)
+			relayRecorder.RecordConfig(chainID, rpcEndpoint.ApiInterface, &relayrecorder.ConfigRecord{
+				Strategy:               (&strategyValue{Strategy: strategy}).String(),
+				MaxConcurrentProviders: maxConcurrentProviders,
+				RequiredResponses:      requiredResponses,
+			})
+			consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, relayrecorder.NewRecordingOptimizer(optimizer, relayRecorder, chainID, rpcEndpoint.ApiInterface), consumerMetricsManager)
 			rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
 
 			rpcConsumerServer := &RPCConsumerServer{}
 			utils.LavaFormatInfo("RPCConsumer Listening", utils.Attribute{Key: "endpoints", Value: rpcEndpoint.String()})
-			err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, requiredResponses, privKey, lavaChainID, cache, rpcConsumerMetrics, consumerAddr, consumerConsistency)
+			err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, requiredResponses, privKey, lavaChainID, cache, rpcConsumerMetrics, consumerAddr, consumerConsistency, relayRecorder, healthCheckInterval)
 			if err != nil {
 				err = utils.LavaFormatError("failed serving rpc requests", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
 				errCh <- err
@@ -337,7 +345,6 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			if lavasession.AllowInsecureConnectionToProviders {
 				utils.LavaFormatWarning("AllowInsecureConnectionToProviders is set to true, this should be used only in development", nil, utils.Attribute{Key: lavasession.AllowInsecureConnectionToProvidersFlag, Value: lavasession.AllowInsecureConnectionToProviders})
 			}
-
 			var rpcEndpoints []*lavasession.RPCEndpoint
 			var viper_endpoints *viper.Viper
 			if len(args) > 1 {
@@ -370,6 +377,18 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			// handle flags, pass necessary fields
 			ctx := context.Background()
 
+			// client certificate for providers that require mutual TLS
+			clientCertPem, clientKeyPem := viper.GetString(lavasession.ClientCertPemFlag), viper.GetString(lavasession.ClientKeyPemFlag)
+			if clientCertPem != "" || clientKeyPem != "" {
+				if clientCertPem == "" || clientKeyPem == "" {
+					return utils.LavaFormatError("both --"+lavasession.ClientCertPemFlag+" and --"+lavasession.ClientKeyPemFlag+" must be set", nil)
+				}
+				lavasession.ClientCertificateReloader, err = lavasession.NewCertificateReloader(ctx, clientCertPem, clientKeyPem, "")
+				if err != nil {
+					return utils.LavaFormatError("failed to load client certificate", err, utils.Attribute{Key: "cert", Value: clientCertPem})
+				}
+			}
+
 			networkChainId := viper.GetString(flags.FlagChainID)
 			if networkChainId == app.Name {
 				clientTomlConfig, err := config.ReadFromClientConfig(clientCtx)
@@ -421,6 +440,7 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			requiredResponses := 1 // TODO: handle secure flag, for a majority between providers
 			utils.LavaFormatInfo("lavap Binary Version: " + upgrade.GetCurrentVersion().ConsumerVersion)
 			rand.InitRandomSeed()
+			common.RelayCompressions = common.ParseCompressions(common.RelayCompressions)
 
 			var cache *performance.Cache = nil
 			cacheAddr, err := cmd.Flags().GetString(performance.CacheFlagName)
@@ -439,7 +459,17 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			}
 			prometheusListenAddr := viper.GetString(metrics.MetricsListenFlagName)
 			maxConcurrentProviders := viper.GetUint(common.MaximumConcurrentProvidersFlagName)
-			err = rpcConsumer.Start(ctx, txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, prometheusListenAddr, maxConcurrentProviders)
+			shutdownTracing, err := tracing.InitTracing(ctx, "rpcconsumer", viper.GetString(tracing.OtlpEndpointFlagName), viper.GetBool(tracing.OtlpInsecureFlagName), viper.GetFloat64(tracing.TracingSampleRatioFlagName))
+			if err != nil {
+				return err
+			}
+			defer shutdownTracing(context.Background())
+			relayRecorder, err := relayrecorder.NewRecorder(viper.GetString(relayrecorder.RecordRelaysFlagName))
+			if err != nil {
+				return err
+			}
+			defer relayRecorder.Close()
+			err = rpcConsumer.Start(ctx, txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, prometheusListenAddr, maxConcurrentProviders, relayRecorder, viper.GetDuration(HealthCheckIntervalFlagName))
 			return err
 		},
 	}
@@ -452,13 +482,21 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.MarkFlagRequired(common.GeolocationFlag)
 	cmdRPCConsumer.Flags().Bool("secure", false, "secure sends reliability on every message")
 	cmdRPCConsumer.Flags().Bool(lavasession.AllowInsecureConnectionToProvidersFlag, false, "allow insecure provider-dialing. used for development and testing")
+	cmdRPCConsumer.Flags().String(lavasession.ClientCertPemFlag, "", "client certificate to present to providers that require mutual TLS (reloaded when the file changes)")
+	cmdRPCConsumer.Flags().String(lavasession.ClientKeyPemFlag, "", "the key of the --"+lavasession.ClientCertPemFlag+" client certificate")
 	cmdRPCConsumer.Flags().Bool(common.TestModeFlagName, false, "test mode causes rpcconsumer to send dummy data and print all of the metadata in it's listeners")
 	cmdRPCConsumer.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
 	cmdRPCConsumer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	cmdRPCConsumer.Flags().String(relayrecorder.RecordRelaysFlagName, "", "file to record the received relays, the providers they were sent to and the optimizer inputs in, for replaying with 'test replay-relays' (disabled when empty)")
+	cmdRPCConsumer.Flags().Duration(HealthCheckIntervalFlagName, DefaultHealthCheckInterval, "interval between the health checks sent to providers of endpoints configured with health-checks, providers are checked on pairing updates as well")
 	cmdRPCConsumer.Flags().Var(&strategyFlag, "strategy", fmt.Sprintf("the strategy to use to pick providers (%s)", strings.Join(strategyNames, "|")))
 	cmdRPCConsumer.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
+	cmdRPCConsumer.Flags().String(tracing.OtlpEndpointFlagName, "", "OTLP grpc endpoint to export relay traces to (such as a local collector on localhost:4317), tracing is disabled when empty")
+	cmdRPCConsumer.Flags().Bool(tracing.OtlpInsecureFlagName, false, "connect to the OTLP endpoint without TLS")
+	cmdRPCConsumer.Flags().Float64(tracing.TracingSampleRatioFlagName, 1, "ratio of relays to trace when exporting traces, a sampled remote parent can't force sampling: relays traced by the consumer are traced by the provider only if the provider's ratio is at least the consumer's")
 	cmdRPCConsumer.Flags().BoolVar(&DebugRelaysFlag, DebugRelaysFlagName, false, "adding debug information to relays")
 	cmdRPCConsumer.Flags().BoolVar(&lavasession.DebugProbes, DebugProbesFlagName, false, "adding information to probes")
+	common.AddRelayCompressionFlags(cmdRPCConsumer)
 	common.AddRollingLogConfig(cmdRPCConsumer)
 	return cmdRPCConsumer
 }
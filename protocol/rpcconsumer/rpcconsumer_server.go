@@ -3,8 +3,10 @@ package rpcconsumer
 import (
 	"context"
 	"errors"
+	"io"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -16,12 +18,16 @@ import (
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/rpcconsumer/relayrecorder"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	plantypes "github.com/lavanet/lava/x/plans/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -47,6 +53,8 @@ type RPCConsumerServer struct {
 	consumerAddress        sdk.AccAddress
 	consumerServices       map[string]struct{}
 	consumerConsistency    *ConsumerConsistency
+	relayRecorder          *relayrecorder.Recorder
+	healthChecks           []*healthCheck
 }
 
 type ConsumerTxSender interface {
@@ -67,6 +75,8 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	rpcConsumerLogs *metrics.RPCConsumerLogs,
 	consumerAddress sdk.AccAddress,
 	consumerConsistency *ConsumerConsistency,
+	relayRecorder *relayrecorder.Recorder, // optional
+	healthCheckInterval time.Duration,
 ) (err error) {
 	rpccs.consumerSessionManager = consumerSessionManager
 	rpccs.listenEndpoint = listenEndpoint
@@ -80,6 +90,7 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	rpccs.finalizationConsensus = finalizationConsensus
 	rpccs.consumerAddress = consumerAddress
 	rpccs.consumerConsistency = consumerConsistency
+	rpccs.relayRecorder = relayRecorder
 	consumerPolicy, err := rpccs.consumerTxSender.GetConsumerPolicy(ctx, consumerAddress.String(), listenEndpoint.ChainID)
 	if err != nil {
 		return err
@@ -107,6 +118,18 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	if err != nil {
 		return err
 	}
+	if listenEndpoint.HealthChecks.Enabled() {
+		rpccs.healthChecks, err = rpccs.buildHealthChecks(listenEndpoint.HealthChecks)
+		if err != nil {
+			return err
+		}
+		if len(rpccs.healthChecks) == 0 {
+			utils.LavaFormatWarning("health checks are enabled but the spec has no verifications to check", nil, utils.Attribute{Key: "endpoint", Value: listenEndpoint.Key()})
+		} else {
+			consumerSessionManager.SetProviderHealthChecker(rpccs)
+			go rpccs.runPeriodicHealthChecks(ctx, healthCheckInterval)
+		}
+	}
 	go chainListener.Serve(ctx)
 	// we trigger a latest block call to get some more information on our providers
 	go rpccs.sendInitialRelays(MaxRelayRetries)
@@ -198,19 +221,38 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	// compares the response with other consumer wallets if defined so
 	// asynchronously sends data reliability if necessary
 
+	relaySentTime := time.Now()
+	if relayRecord := rpccs.relayRecorder.NewRelayRecord(ctx, url, []byte(req), connectionType, metadata, dappID, consumerIp); relayRecord != nil {
+		ctx = relayrecorder.WithRelayRecord(ctx, relayRecord)
+		defer func() {
+			relayRecord.Finish(relayResult, time.Since(relaySentTime), errRet)
+			rpccs.relayRecorder.RecordRelay(rpccs.listenEndpoint.ChainID, rpccs.listenEndpoint.ApiInterface, relayRecord)
+		}()
+	}
+	ctx, span := tracing.StartSpan(ctx, "rpcconsumer.SendRelay",
+		attribute.String("chain_id", rpccs.listenEndpoint.ChainID),
+		attribute.String("api_interface", rpccs.listenEndpoint.ApiInterface),
+		attribute.String("dapp_id", dappID),
+	)
+	defer func() {
+		if relayResult != nil {
+			span.SetAttributes(attribute.String("provider", relayResult.ProviderAddress))
+		}
+		tracing.EndSpan(span, errRet)
+	}()
 	// remove lava directive headers
 	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders(metadata)
-	relaySentTime := time.Now()
 	chainMessage, err := rpccs.chainParser.ParseMsg(url, []byte(req), connectionType, metadata, rpccs.getLatestBlock())
 	if err != nil {
 		return nil, err
 	}
-	// temporarily disable subscriptions
+	// subscriptions are only supported for grpc server streaming at the moment
 	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
+	if isSubscription && rpccs.listenEndpoint.ApiInterface != spectypes.APIInterfaceGrpc {
 		return &common.RelayResult{ProviderAddress: ""}, utils.LavaFormatError("Subscriptions are not supported at the moment", nil)
 	}
 
+	span.SetAttributes(attribute.String("method", chainMessage.GetApi().Name))
 	rpccs.HandleDirectiveHeadersForMessage(chainMessage, directiveHeaders)
 	if _, ok := rpccs.consumerServices[chainlib.GetAddon(chainMessage)]; !ok {
 		utils.LavaFormatError("unsupported addon usage, consumer policy does not allow", nil,
@@ -287,12 +329,12 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	}
 
 	enabled, dataReliabilityThreshold := rpccs.chainParser.DataReliabilityParams()
-	if enabled {
+	if enabled && !isSubscription {
 		for _, relayResult := range relayResults {
 			// new context is needed for data reliability as some clients cancel the context they provide when the relay returns
 			// as data reliability happens in a go routine it will continue while the response returns.
 			guid, found := utils.GetUniqueIdentifier(ctx)
-			dataReliabilityContext := context.Background()
+			dataReliabilityContext := tracing.WithSpanFrom(context.Background(), ctx)
 			if found {
 				dataReliabilityContext = utils.WithUniqueIdentifier(dataReliabilityContext, guid)
 			}
@@ -300,6 +342,7 @@ func (rpccs *RPCConsumerServer) SendRelay(
 		}
 	}
 
+	span.SetAttributes(attribute.Int64("retries", int64(retries)))
 	// TODO: secure, go over relay results to find discrepancies and choose majority, or trigger a second wallet relay
 	if len(relayResults) == 0 {
 		rpccs.appendHeadersToRelayResult(ctx, errorRelayResult, retries)
@@ -347,11 +390,8 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	// in case connection totally fails, update unresponsive providers in ConsumerSessionManager
 
 	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
-		// temporarily disable subscriptions
-		// TODO: fix subscription and disable this case.
-		return &common.RelayResult{ProviderAddress: ""}, utils.LavaFormatError("Subscriptions are disabled currently", nil)
-	}
+	relayRecord := relayrecorder.GetRelayRecord(ctx)
+	round := relayRecord.NextRound()
 
 	privKey := rpccs.privKey
 	chainID := rpccs.listenEndpoint.ChainID
@@ -365,8 +405,11 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	}
 	// consumerEmergencyTracker always use latest virtual epoch
 	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
+	ctx, span := tracing.StartSpan(ctx, "rpcconsumer.sendRelayToProvider", attribute.Int("round", round), attribute.Int64("requested_block", reqBlock))
+	defer func() { tracing.EndSpan(span, errRet) }()
 	sessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), *unwantedProviders, reqBlock, chainlib.GetAddon(chainMessage), chainMessage.GetExtensions(), chainlib.GetStateful(chainMessage), virtualEpoch)
 	if err != nil {
+		relayRecord.AddAttempt(round, "", 0, nil, 0, false, err)
 		return &common.RelayResult{ProviderAddress: ""}, err
 	}
 
@@ -385,12 +428,19 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 		go func(providerPublicAddress string, sessionInfo *lavasession.SessionInfo) {
 			var localRelayResult *common.RelayResult
 			var errResponse error
-			goroutineCtx, goroutineCtxCancel := context.WithCancel(context.Background())
+			attemptStart := time.Now()
+			cached := false
+			goroutineCtx, goroutineCtxCancel := context.WithCancel(tracing.WithSpanFrom(context.Background(), ctx))
 			guid, found := utils.GetUniqueIdentifier(ctx)
 			if found {
 				goroutineCtx = utils.WithUniqueIdentifier(goroutineCtx, guid)
 			}
+			var attemptSpan trace.Span
+			goroutineCtx, attemptSpan = tracing.StartSpan(goroutineCtx, "rpcconsumer.relay", attribute.String("provider", providerPublicAddress), attribute.Int64("epoch", int64(sessionInfo.Epoch)))
 			defer func() {
+				attemptSpan.SetAttributes(attribute.Bool("cached", cached))
+				tracing.EndSpan(attemptSpan, errResponse)
+				relayRecord.AddAttempt(round, providerPublicAddress, sessionInfo.Epoch, localRelayResult, time.Since(attemptStart), cached, errResponse)
 				// Return response
 				responses <- &relayResponse{
 					relayResult: localRelayResult,
@@ -424,10 +474,9 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 			endpointClient := *singleConsumerSession.Endpoint.Client
 
 			if isSubscription {
-				localRelayResult, errResponse = rpccs.relaySubscriptionInner(goroutineCtx, endpointClient, singleConsumerSession, localRelayResult)
-				if errResponse != nil {
-					return
-				}
+				// the subscription outlives this goroutine, so it's bound to the caller's context
+				localRelayResult, errResponse = rpccs.relaySubscriptionInner(ctx, endpointClient, singleConsumerSession, localRelayResult, chainlib.IsGrpcServerStream(chainMessage), chainlib.GetComputeUnits(chainMessage), virtualEpoch)
+				return
 			}
 			requestedBlock, _ := chainMessage.RequestedBlock()
 			if requestedBlock != spectypes.NOT_APPLICABLE {
@@ -439,6 +488,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 					// Info was fetched from cache, so we don't need to change the state
 					// so we can return here, no need to update anything and calculate as this info was fetched from the cache
 					localRelayResult.Reply = reply
+					cached = true
 					lavaprotocol.UpdateRequestedBlock(localRelayResult.Request.RelayData, reply) // update relay request requestedBlock to the provided one in case it was arbitrary
 					errResponse = rpccs.consumerSessionManager.OnSessionUnUsed(singleConsumerSession)
 
@@ -509,7 +559,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 				if requestedBlock == spectypes.NOT_APPLICABLE {
 					return
 				}
-				new_ctx := context.Background()
+				new_ctx := tracing.WithSpanFrom(context.Background(), goroutineCtx)
 				new_ctx, cancel := context.WithTimeout(new_ctx, common.DataReliabilityTimeoutIncrease)
 				defer cancel()
 				err2 := rpccs.cache.SetEntry(new_ctx, localRelayResult.Request.RelayData, nil, chainID, localRelayResult.Reply, localRelayResult.Finalized, localRelayResult.Request.RelaySession.Provider, nil) // caching in the portal doesn't care about hashes
@@ -573,10 +623,24 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 		relaySentTime := time.Now()
 		connectCtx, connectCtxCancel := context.WithTimeout(ctx, relayTimeout)
 		metadataAdd := metadata.New(map[string]string{common.IP_FORWARDING_HEADER_NAME: consumerToken})
+		if len(common.RelayCompressions) > 0 {
+			metadataAdd.Set(common.RelayCompressionsMetadataKey, strings.Join(common.RelayCompressions, ","))
+		}
+		requestToSend := relayRequest
+		if compression := common.ShouldCompress(relayRequest.RelayData.Data, singleConsumerSession.Endpoint.RelayCompressions()); compression != "" {
+			compressedRequest, err := compressedRelayRequest(relayRequest, compression)
+			if err != nil {
+				utils.LavaFormatWarning("failed compressing relay data, sending it uncompressed", err, utils.Attribute{Key: "GUID", Value: ctx})
+			} else {
+				requestToSend = compressedRequest
+				metadataAdd.Set(common.RelayDataCompressionMetadataKey, compression)
+			}
+		}
+		tracing.InjectToMetadata(ctx, metadataAdd)
 		connectCtx = metadata.NewOutgoingContext(connectCtx, metadataAdd)
 		defer connectCtxCancel()
 		var trailer metadata.MD
-		reply, err = endpointClient.Relay(connectCtx, relayRequest, grpc.Trailer(&trailer))
+		reply, err = endpointClient.Relay(connectCtx, requestToSend, grpc.Trailer(&trailer))
 		statuses := trailer.Get(common.StatusCodeMetadataKey)
 		if len(statuses) > 0 {
 			codeNum, errStatus := strconv.Atoi(statuses[0])
@@ -611,6 +675,13 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 			}
 			return reply, 0, err, backoff
 		}
+		// the provider signed the uncompressed reply data, restore it before verifying
+		if compressions := trailer.Get(common.ReplyDataCompressionMetadataKey); len(compressions) > 0 {
+			reply.Data, err = common.DecompressData(compressions[0], reply.Data)
+			if err != nil {
+				return reply, 0, utils.LavaFormatWarning("failed decompressing relay reply", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "provider", Value: providerPublicAddress}), false
+			}
+		}
 		return reply, relayLatency, nil, false
 	}
 	reply, relayLatency, err, backoff := callRelay()
@@ -650,9 +721,21 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	return relayResult, relayLatency, nil, false
 }
 
-func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endpointClient pairingtypes.RelayerClient, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult) (relayResultRet *common.RelayResult, err error) {
+// compressedRelayRequest returns a copy of the relay request with compressed relay data, the relay request keeps the
+// uncompressed data the consumer signed
+func compressedRelayRequest(relayRequest *pairingtypes.RelayRequest, compression string) (*pairingtypes.RelayRequest, error) {
+	data, err := common.CompressData(compression, relayRequest.RelayData.Data)
+	if err != nil {
+		return nil, err
+	}
+	relayData := *relayRequest.RelayData
+	relayData.Data = data
+	return &pairingtypes.RelayRequest{RelaySession: relayRequest.RelaySession, RelayData: &relayData}, nil
+}
+
+func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endpointClient pairingtypes.RelayerClient, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult, meterMessages bool, messageCU, virtualEpoch uint64) (relayResultRet *common.RelayResult, err error) {
 	// relaySentTime := time.Now()
-	replyServer, err := endpointClient.RelaySubscribe(ctx, relayResult.Request)
+	replyServer, err := endpointClient.RelaySubscribe(tracing.InjectToOutgoingContext(ctx), relayResult.Request)
 	// relayLatency := time.Since(relaySentTime) // TODO: use subscription QoS
 	if err != nil {
 		errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, err)
@@ -664,9 +747,98 @@ func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endp
 	// TODO: need to check that if provider fails and returns error, this is reflected here and we run onSessionDone
 	// my thoughts are that this fails if the grpc fails not if the provider fails, and if the provider returns an error this is reflected by the Recv function on the chainListener calling us here
 	// and this is too late
-	relayResult.ReplyServer = &replyServer
-	err = rpccs.consumerSessionManager.OnSessionDoneIncreaseCUOnly(singleConsumerSession)
-	return relayResult, err
+	if !meterMessages {
+		// the subscribe relay pays for the whole subscription
+		relayResult.ReplyServer = &replyServer
+		err = rpccs.consumerSessionManager.OnSessionDoneIncreaseCUOnly(singleConsumerSession)
+		return relayResult, err
+	}
+	// the subscribe relay pays for the first reply, every message a grpc server stream sends after it is charged to the session.
+	// the session stays locked for the subscription, so unary relays don't interleave with the streamed cu,
+	// and it's released once the stream ends
+	meteredReplyServer := newMeteredSubscribeClient(replyServer,
+		func() error {
+			return rpccs.consumerSessionManager.OnSubscriptionMessage(singleConsumerSession, messageCU, virtualEpoch)
+		},
+		func() {
+			err := rpccs.consumerSessionManager.OnSessionDoneIncreaseCUOnly(singleConsumerSession)
+			if err != nil {
+				utils.LavaFormatError("failed releasing subscription session", err, utils.Attribute{Key: "GUID", Value: ctx})
+			}
+		},
+	)
+	relayResult.ReplyServer = &meteredReplyServer
+	return relayResult, nil
+}
+
+// meteredSubscribeClient calls onMessage for every message received on a subscription after its first reply,
+// and onDone once when the subscription ends
+type meteredSubscribeClient struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	onMessage func() error
+	onDone    func()
+	lock      sync.Mutex
+	replied   bool
+	done      bool
+}
+
+func newMeteredSubscribeClient(replyServer pairingtypes.Relayer_RelaySubscribeClient, onMessage func() error, onDone func()) pairingtypes.Relayer_RelaySubscribeClient {
+	msc := &meteredSubscribeClient{
+		Relayer_RelaySubscribeClient: replyServer,
+		onMessage:                    onMessage,
+		onDone:                       onDone,
+	}
+	go func() {
+		// the stream's context is done when the stream ends, even if it's not read anymore
+		<-replyServer.Context().Done()
+		msc.lock.Lock()
+		defer msc.lock.Unlock()
+		msc.finish()
+	}()
+	return msc
+}
+
+func (msc *meteredSubscribeClient) Recv() (*pairingtypes.RelayReply, error) {
+	reply := new(pairingtypes.RelayReply)
+	if err := msc.RecvMsg(reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (msc *meteredSubscribeClient) RecvMsg(m interface{}) error {
+	err := msc.Relayer_RelaySubscribeClient.RecvMsg(m)
+	msc.lock.Lock()
+	defer msc.lock.Unlock()
+	if msc.done {
+		// the session was already released, messages can't be charged to it anymore
+		if err == nil {
+			err = io.EOF
+		}
+		return err
+	}
+	if err != nil {
+		msc.finish()
+		return err
+	}
+	if msc.replied {
+		err = msc.onMessage()
+		if err != nil {
+			msc.finish()
+		}
+		return err
+	}
+	msc.replied = true
+	return nil
+}
+
+// finish must be called while holding the lock
+func (msc *meteredSubscribeClient) finish() {
+	if msc.done {
+		return
+	}
+	msc.done = true
+	msc.onDone()
 }
 
 func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context.Context, dappID string, consumerIp string, relayResult *common.RelayResult, chainMessage chainlib.ChainMessage, dataReliabilityThreshold uint32, unwantedProviders map[string]struct{}) error {
@@ -0,0 +1,152 @@
+package rpcconsumer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils/rand"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type mockSubscribeClient struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	ctx      context.Context
+	messages chan error
+}
+
+func (msc *mockSubscribeClient) Context() context.Context {
+	return msc.ctx
+}
+
+func (msc *mockSubscribeClient) RecvMsg(m interface{}) error {
+	select {
+	case err := <-msc.messages:
+		return err
+	case <-msc.ctx.Done():
+		return msc.ctx.Err()
+	}
+}
+
+func (msc *mockSubscribeClient) Recv() (*pairingtypes.RelayReply, error) {
+	reply := new(pairingtypes.RelayReply)
+	if err := msc.RecvMsg(reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func TestMeteredSubscribeClient(t *testing.T) {
+	newClient := func(ctx context.Context) (*mockSubscribeClient, pairingtypes.Relayer_RelaySubscribeClient, *int32, *int32) {
+		var charged, done int32
+		replyServer := &mockSubscribeClient{ctx: ctx, messages: make(chan error, 10)}
+		metered := newMeteredSubscribeClient(replyServer,
+			func() error {
+				atomic.AddInt32(&charged, 1)
+				return nil
+			},
+			func() { atomic.AddInt32(&done, 1) },
+		)
+		return replyServer, metered, &charged, &done
+	}
+
+	t.Run("messages after the first reply are charged", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		replyServer, metered, charged, done := newClient(ctx)
+		for i := 0; i < 3; i++ {
+			replyServer.messages <- nil
+		}
+		replyServer.messages <- io.EOF
+		for i := 0; i < 3; i++ {
+			_, err := metered.Recv()
+			require.NoError(t, err)
+		}
+		require.Equal(t, int32(2), atomic.LoadInt32(charged))
+		require.Equal(t, int32(0), atomic.LoadInt32(done))
+
+		// the session is released once when the stream ends
+		_, err := metered.Recv()
+		require.ErrorIs(t, err, io.EOF)
+		cancel()
+		require.Never(t, func() bool { return atomic.LoadInt32(done) != 1 }, 100*time.Millisecond, 10*time.Millisecond)
+	})
+
+	t.Run("session is released when the stream is not read anymore", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		replyServer, metered, charged, done := newClient(ctx)
+		replyServer.messages <- nil
+		_, err := metered.Recv()
+		require.NoError(t, err)
+		cancel()
+		require.Eventually(t, func() bool { return atomic.LoadInt32(done) == 1 }, time.Second, 10*time.Millisecond)
+
+		// messages received after the release aren't charged
+		replyServer.messages <- nil
+		_, err = metered.Recv()
+		require.Error(t, err)
+		require.Equal(t, int32(0), atomic.LoadInt32(charged))
+		require.Equal(t, int32(1), atomic.LoadInt32(done))
+	})
+}
+
+type mockRelayerClient struct {
+	pairingtypes.RelayerClient
+	replyServer pairingtypes.Relayer_RelaySubscribeClient
+}
+
+func (mrc *mockRelayerClient) RelaySubscribe(ctx context.Context, in *pairingtypes.RelayRequest, opts ...grpc.CallOption) (pairingtypes.Relayer_RelaySubscribeClient, error) {
+	return mrc.replyServer, nil
+}
+
+func TestRelaySubscriptionNotMetered(t *testing.T) {
+	rand.InitRandomSeed()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chainParser, _, _, closeServer, err := chainlib.CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, http.NotFound, "../../", nil)
+	require.NoError(t, err)
+	if closeServer != nil {
+		defer closeServer()
+	}
+	chainMessage, err := chainParser.ParseMsg("", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`), http.MethodPost, nil, 0)
+	require.NoError(t, err)
+	require.True(t, chainlib.IsSubscription(chainMessage))
+	require.False(t, chainlib.IsGrpcServerStream(chainMessage))
+
+	endpoint := &lavasession.Endpoint{}
+	sessionsWithProvider := &lavasession.ConsumerSessionsWithProvider{Sessions: map[int64]*lavasession.SingleConsumerSession{}}
+	singleConsumerSession, _, err := sessionsWithProvider.GetConsumerSessionInstanceFromEndpoint(endpoint, 0)
+	require.NoError(t, err)
+	singleConsumerSession.LatestRelayCu = chainlib.GetComputeUnits(chainMessage)
+
+	replyServer := &mockSubscribeClient{ctx: ctx, messages: make(chan error, 10)}
+	rpccs := &RPCConsumerServer{consumerSessionManager: &lavasession.ConsumerSessionManager{}}
+	relayResult, err := rpccs.relaySubscriptionInner(ctx, &mockRelayerClient{replyServer: replyServer}, singleConsumerSession,
+		&common.RelayResult{Request: &pairingtypes.RelayRequest{}}, chainlib.IsGrpcServerStream(chainMessage), chainlib.GetComputeUnits(chainMessage), 0)
+	require.NoError(t, err)
+
+	// the subscription is paid by the subscribe relay and the session is released right away
+	var expectedReplyServer pairingtypes.Relayer_RelaySubscribeClient = replyServer
+	require.Equal(t, expectedReplyServer, *relayResult.ReplyServer)
+	reusedSession, _, err := sessionsWithProvider.GetConsumerSessionInstanceFromEndpoint(endpoint, 0)
+	require.NoError(t, err)
+	require.Equal(t, singleConsumerSession.SessionId, reusedSession.SessionId)
+
+	// messages pushed on the subscription aren't charged
+	for i := 0; i < 3; i++ {
+		replyServer.messages <- nil
+		_, err = (*relayResult.ReplyServer).Recv()
+		require.NoError(t, err)
+	}
+	require.Equal(t, chainlib.GetComputeUnits(chainMessage), singleConsumerSession.CuSum)
+}
@@ -0,0 +1,214 @@
+package auditlog
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	AuditLogFlagName           = "audit-log"
+	AuditLogMaxSizeFlagName    = "audit-log-max-size"
+	AuditLogMaxBackupsFlagName = "audit-log-max-backups"
+	AuditLogMaxAgeFlagName     = "audit-log-max-age"
+
+	DefaultAuditLogMaxSize    = 100 // MB
+	DefaultAuditLogMaxBackups = 10
+	DefaultAuditLogMaxAge     = 30 // days
+
+	// relay records can hold large requests, allow lines well above the default scanner limit
+	maxRecordLineSize = 64 * 1024 * 1024
+)
+
+type Config struct {
+	Path       string // empty disables the audit log
+	MaxSize    int    // MB per file before rotating
+	MaxBackups int    // rotated files to keep
+	MaxAge     int    // days to keep rotated files
+}
+
+// RelayRecord is a served relay as written to the audit log, one json object per line. It holds
+// what the consumer signed, what was sent to the node and the hash of what the provider signed and
+// returned, so a disputed response can be checked and replayed against the node later
+type RelayRecord struct {
+	Time         time.Time `json:"time"`
+	GUID         string    `json:"guid,omitempty"`
+	ChainID      string    `json:"chain_id"`
+	ApiInterface string    `json:"api_interface"`
+	Provider     string    `json:"provider"`
+	Consumer     string    `json:"consumer"`
+	Api          string    `json:"api"`
+
+	// relay session
+	Epoch       int64  `json:"epoch"`
+	SessionId   uint64 `json:"session_id"`
+	RelayNum    uint64 `json:"relay_num"`
+	CuSum       uint64 `json:"cu_sum"`
+	ConsumerSig []byte `json:"consumer_sig,omitempty"`
+
+	// relay data
+	ConnectionType string                  `json:"connection_type,omitempty"`
+	ApiUrl         string                  `json:"api_url,omitempty"`
+	Data           []byte                  `json:"data,omitempty"`
+	Metadata       []pairingtypes.Metadata `json:"metadata,omitempty"`
+	Addon          string                  `json:"addon,omitempty"`
+	Extensions     []string                `json:"extensions,omitempty"`
+	SeenBlock      int64                   `json:"seen_block"`
+	RequestBlock   int64                   `json:"request_block"` // as requested (and signed) by the consumer
+	ServedBlock    int64                   `json:"served_block"`  // after the provider replaced relative blocks (e.g. latest)
+
+	// reply
+	Cached                bool                    `json:"cached"`
+	ReplyHash             string                  `json:"reply_hash"` // sha256 of the node reply data
+	ReplySig              []byte                  `json:"reply_sig,omitempty"`
+	ReplySigBlocks        []byte                  `json:"reply_sig_blocks,omitempty"`
+	LatestBlock           int64                   `json:"latest_block"`
+	FinalizedBlocksHashes string                  `json:"finalized_blocks_hashes,omitempty"`
+	ReplyMetadata         []pairingtypes.Metadata `json:"reply_metadata,omitempty"`
+}
+
+// NewRelayRecord builds the record of a relay, the request's block is the one served (the
+// consumer's requested block is given separately since serving may replace it)
+func NewRelayRecord(chainID, apiInterface, provider, consumer, api string, requestBlock int64, request *pairingtypes.RelayRequest, reply *pairingtypes.RelayReply, cached bool) *RelayRecord {
+	return &RelayRecord{
+		Time:                  time.Now().UTC(),
+		ChainID:               chainID,
+		ApiInterface:          apiInterface,
+		Provider:              provider,
+		Consumer:              consumer,
+		Api:                   api,
+		Epoch:                 request.RelaySession.Epoch,
+		SessionId:             request.RelaySession.SessionId,
+		RelayNum:              request.RelaySession.RelayNum,
+		CuSum:                 request.RelaySession.CuSum,
+		ConsumerSig:           request.RelaySession.Sig,
+		ConnectionType:        request.RelayData.ConnectionType,
+		ApiUrl:                request.RelayData.ApiUrl,
+		Data:                  request.RelayData.Data,
+		Metadata:              request.RelayData.Metadata,
+		Addon:                 request.RelayData.Addon,
+		Extensions:            request.RelayData.Extensions,
+		SeenBlock:             request.RelayData.SeenBlock,
+		RequestBlock:          requestBlock,
+		ServedBlock:           request.RelayData.RequestBlock,
+		Cached:                cached,
+		ReplyHash:             ReplyHash(reply.Data),
+		ReplySig:              reply.Sig,
+		ReplySigBlocks:        reply.SigBlocks,
+		LatestBlock:           reply.LatestBlock,
+		FinalizedBlocksHashes: string(reply.FinalizedBlocksHashes),
+		ReplyMetadata:         reply.Metadata,
+	}
+}
+
+// ReplyHash is the hash of the reply data recorded in the audit log
+func ReplyHash(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// AuditLogger appends relay records to a rotating file. A nil AuditLogger is disabled
+type AuditLogger struct {
+	lock    sync.Mutex
+	output  *lumberjack.Logger
+	encoder *json.Encoder
+}
+
+// NewAuditLogger returns an audit logger writing to the configured path, or nil if the path is empty
+func NewAuditLogger(config Config) *AuditLogger {
+	if config.Path == "" {
+		return nil
+	}
+	output := &lumberjack.Logger{
+		Filename:   config.Path,
+		MaxSize:    config.MaxSize,
+		MaxBackups: config.MaxBackups,
+		MaxAge:     config.MaxAge,
+		Compress:   true,
+	}
+	utils.LavaFormatInfo("Relay audit log enabled",
+		utils.Attribute{Key: "path", Value: config.Path},
+		utils.Attribute{Key: "maxSizeMB", Value: config.MaxSize},
+		utils.Attribute{Key: "maxBackups", Value: config.MaxBackups},
+		utils.Attribute{Key: "maxAgeDays", Value: config.MaxAge},
+	)
+	return &AuditLogger{output: output, encoder: json.NewEncoder(output)}
+}
+
+func (al *AuditLogger) Enabled() bool {
+	return al != nil
+}
+
+func (al *AuditLogger) LogRelay(record *RelayRecord) {
+	if al == nil {
+		return
+	}
+	al.lock.Lock()
+	defer al.lock.Unlock()
+	if err := al.encoder.Encode(record); err != nil {
+		utils.LavaFormatWarning("failed writing relay audit record", err,
+			utils.Attribute{Key: "consumer", Value: record.Consumer},
+			utils.Attribute{Key: "sessionId", Value: record.SessionId},
+			utils.Attribute{Key: "relayNum", Value: record.RelayNum},
+		)
+	}
+}
+
+func (al *AuditLogger) Close() error {
+	if al == nil {
+		return nil
+	}
+	al.lock.Lock()
+	defer al.lock.Unlock()
+	return al.output.Close()
+}
+
+// ReadRelayRecords reads the records of an audit log file (rotated files are gzip compressed)
+// that pass the filter, a nil filter passes all records
+func ReadRelayRecords(path string, filter func(*RelayRecord) bool) ([]*RelayRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordLineSize)
+	var records []*RelayRecord
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := &RelayRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, utils.LavaFormatError("invalid audit log record", err, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "line", Value: line})
+		}
+		if filter == nil || filter(record) {
+			records = append(records, record)
+		}
+	}
+	return records, scanner.Err()
+}
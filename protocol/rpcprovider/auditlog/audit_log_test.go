@@ -0,0 +1,160 @@
+package auditlog
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testRelay(consumer string, sessionId, relayNum uint64, data string, replyData string) (*pairingtypes.RelayRequest, *pairingtypes.RelayReply) {
+	request := &pairingtypes.RelayRequest{
+		RelaySession: &pairingtypes.RelaySession{
+			SpecId:    "ETH1",
+			SessionId: sessionId,
+			RelayNum:  relayNum,
+			CuSum:     10 * relayNum,
+			Epoch:     20,
+			Sig:       []byte("consumer-sig"),
+		},
+		RelayData: &pairingtypes.RelayPrivateData{
+			ConnectionType: http.MethodPost,
+			Data:           []byte(data),
+			RequestBlock:   100,
+			SeenBlock:      99,
+			ApiInterface:   spectypes.APIInterfaceJsonRPC,
+		},
+	}
+	reply := &pairingtypes.RelayReply{
+		Data:        []byte(replyData),
+		Sig:         []byte("provider-sig"),
+		LatestBlock: 101,
+	}
+	return request, reply
+}
+
+func TestAuditLogWriteRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditLogger := NewAuditLogger(Config{Path: path, MaxSize: 1, MaxBackups: 1, MaxAge: 1})
+	require.True(t, auditLogger.Enabled())
+
+	for i := uint64(1); i <= 3; i++ {
+		consumer := "consumer1"
+		if i == 3 {
+			consumer = "consumer2"
+		}
+		request, reply := testRelay(consumer, 7, i, fmt.Sprintf(`{"id":%d}`, i), fmt.Sprintf(`{"result":%d}`, i))
+		auditLogger.LogRelay(NewRelayRecord("ETH1", spectypes.APIInterfaceJsonRPC, "provider", consumer, "eth_blockNumber", spectypes.LATEST_BLOCK, request, reply, i == 2))
+	}
+	require.NoError(t, auditLogger.Close())
+
+	records, err := ReadRelayRecords(path, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	record := records[1]
+	require.Equal(t, "ETH1", record.ChainID)
+	require.Equal(t, "consumer1", record.Consumer)
+	require.Equal(t, "provider", record.Provider)
+	require.Equal(t, "eth_blockNumber", record.Api)
+	require.Equal(t, uint64(7), record.SessionId)
+	require.Equal(t, uint64(2), record.RelayNum)
+	require.Equal(t, uint64(20), record.CuSum)
+	require.Equal(t, int64(20), record.Epoch)
+	require.Equal(t, []byte("consumer-sig"), record.ConsumerSig)
+	require.Equal(t, []byte(`{"id":2}`), record.Data)
+	require.Equal(t, spectypes.LATEST_BLOCK, record.RequestBlock)
+	require.Equal(t, int64(100), record.ServedBlock)
+	require.Equal(t, int64(99), record.SeenBlock)
+	require.True(t, record.Cached)
+	require.Equal(t, ReplyHash([]byte(`{"result":2}`)), record.ReplyHash)
+	require.Equal(t, []byte("provider-sig"), record.ReplySig)
+	require.Equal(t, int64(101), record.LatestBlock)
+
+	records, err = ReadRelayRecords(path, func(record *RelayRecord) bool { return record.Consumer == "consumer2" })
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(3), records[0].RelayNum)
+
+	// rotated files are compressed
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	gzPath := path + ".gz"
+	gzFile, err := os.Create(gzPath)
+	require.NoError(t, err)
+	gzWriter := gzip.NewWriter(gzFile)
+	_, err = gzWriter.Write(raw)
+	require.NoError(t, err)
+	require.NoError(t, gzWriter.Close())
+	require.NoError(t, gzFile.Close())
+
+	records, err = ReadRelayRecords(gzPath, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+}
+
+func TestAuditLogDisabled(t *testing.T) {
+	auditLogger := NewAuditLogger(Config{})
+	require.Nil(t, auditLogger)
+	require.False(t, auditLogger.Enabled())
+	request, reply := testRelay("consumer", 1, 1, "{}", "{}")
+	auditLogger.LogRelay(NewRelayRecord("ETH1", spectypes.APIInterfaceJsonRPC, "provider", "consumer", "api", 0, request, reply, false))
+	require.NoError(t, auditLogger.Close())
+}
+
+func TestReplayRelay(t *testing.T) {
+	ctx := context.Background()
+
+	var lock sync.Mutex
+	var lastRequest string
+	balance := "0x10"
+	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		lock.Lock()
+		defer lock.Unlock()
+		lastRequest = string(body)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"%s"}`, balance)
+	})
+
+	chainParser, chainRouter, _, closeServer, err := chainlib.CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandler, "../../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	request, reply := testRelay("consumer", 1, 1, `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x0000000000000000000000000000000000000000","latest"]}`, "")
+	record := NewRelayRecord("ETH1", spectypes.APIInterfaceJsonRPC, "provider", "consumer", "eth_getBalance", spectypes.LATEST_BLOCK, request, reply, false)
+
+	// the recorded request reaches the node
+	result := ReplayRelay(ctx, chainParser, chainRouter, record)
+	require.NoError(t, result.Err)
+	lock.Lock()
+	require.Contains(t, lastRequest, "eth_getBalance")
+	lock.Unlock()
+
+	// the node replies the same
+	record.ReplyHash = result.ReplyHash
+	result = ReplayRelay(ctx, chainParser, chainRouter, record)
+	require.True(t, result.Match())
+
+	// the node replies differently
+	lock.Lock()
+	balance = "0x20"
+	lock.Unlock()
+	result = ReplayRelay(ctx, chainParser, chainRouter, record)
+	require.NoError(t, result.Err)
+	require.False(t, result.Match())
+}
@@ -0,0 +1,149 @@
+package auditlog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ReplayConsumerFlagName  = "consumer"
+	ReplaySessionIdFlagName = "session-id"
+	ReplayRelayNumFlagName  = "relay-num"
+)
+
+type ReplayResult struct {
+	Record    *RelayRecord
+	ReplyHash string
+	Err       error
+}
+
+func (rr *ReplayResult) Match() bool {
+	return rr.Err == nil && rr.ReplyHash == rr.Record.ReplyHash
+}
+
+// ReplayRelay sends a recorded relay to the node again, on the block it was served on, and
+// returns the hash of the node's reply
+func ReplayRelay(ctx context.Context, chainParser chainlib.ChainParser, chainRouter chainlib.ChainRouter, record *RelayRecord) *ReplayResult {
+	result := &ReplayResult{Record: record}
+	chainMessage, err := chainParser.ParseMsg(record.ApiUrl, record.Data, record.ConnectionType, record.Metadata, 0)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	// the provider pinned relative (latest) requests to the block it served, do the same
+	chainMessage.UpdateLatestBlockInMessage(record.ServedBlock, true)
+
+	reply, _, _, err := chainRouter.SendNodeMsg(ctx, nil, chainMessage, record.Extensions)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.ReplyHash = ReplyHash(reply.Data)
+	return result
+}
+
+func CreateAuditReplayCobraCommand() *cobra.Command {
+	cmdAuditReplay := &cobra.Command{
+		Use:   `audit-replay [audit-log-file] [spec-chain-id] [api-interface] [node-url]`,
+		Short: `replay relays recorded in a provider audit log against a node and compare the replies`,
+		Long: `reads the relays of the chain and api interface recorded in a provider audit log (see --audit-log of rpcprovider),
+sends each of them to the node again on the block it was served on and compares the hash of the node's reply with the recorded one.
+the spec is fetched from the lava node. rotated (gzip compressed) audit log files are supported`,
+		Example: `audit-replay /logs/audit.log ETH1 jsonrpc https://eth-node:8545 --consumer lava@1abc... --session-id 123 --node https://lava-rpc:26657`,
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auditLogPath, chainID, apiInterface, nodeUrl := args[0], args[1], args[2], args[3]
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := context.Background()
+
+			consumer, err := cmd.Flags().GetString(ReplayConsumerFlagName)
+			if err != nil {
+				return err
+			}
+			sessionId, err := cmd.Flags().GetUint64(ReplaySessionIdFlagName)
+			if err != nil {
+				return err
+			}
+			relayNum, err := cmd.Flags().GetUint64(ReplayRelayNumFlagName)
+			if err != nil {
+				return err
+			}
+			filterSessionId := cmd.Flags().Changed(ReplaySessionIdFlagName)
+			filterRelayNum := cmd.Flags().Changed(ReplayRelayNumFlagName)
+
+			records, err := ReadRelayRecords(auditLogPath, func(record *RelayRecord) bool {
+				return record.ChainID == chainID && record.ApiInterface == apiInterface &&
+					(consumer == "" || record.Consumer == consumer) &&
+					(!filterSessionId || record.SessionId == sessionId) &&
+					(!filterRelayNum || record.RelayNum == relayNum)
+			})
+			if err != nil {
+				return utils.LavaFormatError("failed reading audit log", err, utils.Attribute{Key: "path", Value: auditLogPath})
+			}
+			if len(records) == 0 {
+				utils.LavaFormatInfo("no matching relays in the audit log", utils.Attribute{Key: "path", Value: auditLogPath})
+				return nil
+			}
+
+			stateQuery := statetracker.NewConsumerStateQuery(ctx, clientCtx)
+			spec, err := stateQuery.GetSpec(ctx, chainID)
+			if err != nil {
+				return utils.LavaFormatError("failed fetching spec", err, utils.Attribute{Key: "chainID", Value: chainID})
+			}
+			chainParser, err := chainlib.NewChainParser(apiInterface)
+			if err != nil {
+				return err
+			}
+			chainParser.SetSpec(*spec)
+			endpoint := &lavasession.RPCProviderEndpoint{
+				ChainID:      chainID,
+				ApiInterface: apiInterface,
+				NodeUrls:     []common.NodeUrl{{Url: nodeUrl}},
+			}
+			chainRouter, err := chainlib.GetChainRouter(ctx, 1, endpoint, chainParser)
+			if err != nil {
+				return utils.LavaFormatError("failed creating chain proxy", err, utils.Attribute{Key: "nodeUrl", Value: nodeUrl})
+			}
+
+			mismatches := 0
+			for _, record := range records {
+				result := ReplayRelay(ctx, chainParser, chainRouter, record)
+				status := "match"
+				if !result.Match() {
+					mismatches++
+					status = "MISMATCH"
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s consumer %s session %d relay %d api %s block %d: recorded %s replayed %s",
+					status, record.Time.Format("2006-01-02T15:04:05Z"), record.Consumer, record.SessionId, record.RelayNum,
+					record.Api, record.ServedBlock, record.ReplyHash, result.ReplyHash)
+				if result.Err != nil {
+					fmt.Fprintf(cmd.OutOrStdout(), " (error: %s)", result.Err)
+				}
+				fmt.Fprintln(cmd.OutOrStdout())
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "replayed %d relays, %d mismatches\n", len(records), mismatches)
+			if mismatches > 0 {
+				return fmt.Errorf("%d of %d replayed relays do not match the audit log", mismatches, len(records))
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmdAuditReplay)
+	cmdAuditReplay.Flags().String(ReplayConsumerFlagName, "", "replay only the relays of this consumer address")
+	cmdAuditReplay.Flags().Uint64(ReplaySessionIdFlagName, 0, "replay only the relays of this session id")
+	cmdAuditReplay.Flags().Uint64(ReplayRelayNumFlagName, 0, "replay only the relays with this relay number")
+	return cmdAuditReplay
+}
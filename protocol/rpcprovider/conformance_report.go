@@ -0,0 +1,199 @@
+package rpcprovider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+)
+
+const (
+	ConformanceReportFormatFlag = "report-format"
+	ConformanceReportFileFlag   = "report-file"
+
+	ConformanceReportFormatJSON  = "json"
+	ConformanceReportFormatJUnit = "junit"
+)
+
+type ConformanceStatus string
+
+const (
+	ConformanceStatusPassed  ConformanceStatus = "passed"
+	ConformanceStatusFailed  ConformanceStatus = "failed"
+	ConformanceStatusWarning ConformanceStatus = "warning" // failed, but not critical
+	ConformanceStatusSkipped ConformanceStatus = "skipped"
+)
+
+// ConformanceCheck is the result of a single check of a provider endpoint
+type ConformanceCheck struct {
+	Chain        string            `json:"chain"`
+	ApiInterface string            `json:"api_interface,omitempty"`
+	Addon        string            `json:"addon,omitempty"`
+	Extension    string            `json:"extension,omitempty"`
+	Endpoint     string            `json:"endpoint,omitempty"`
+	Name         string            `json:"name"`
+	Status       ConformanceStatus `json:"status"`
+	Message      string            `json:"message,omitempty"`
+	Duration     time.Duration     `json:"duration_ns,omitempty"`
+}
+
+// ConformanceReport gathers the checks of an rpcprovider test run, so they can be
+// written in a machine readable format (and used to gate deployments)
+type ConformanceReport struct {
+	Provider      string             `json:"provider"`
+	TargetVersion string             `json:"target_version,omitempty"`
+	Time          time.Time          `json:"time"`
+	Passed        bool               `json:"passed"`
+	Checks        []ConformanceCheck `json:"checks"`
+}
+
+func NewConformanceReport(provider string) *ConformanceReport {
+	return &ConformanceReport{Provider: provider, Time: time.Now().UTC(), Passed: true, Checks: []ConformanceCheck{}}
+}
+
+func (cr *ConformanceReport) Add(check ConformanceCheck) {
+	cr.Checks = append(cr.Checks, check)
+	cr.Passed = cr.Failures() == 0
+}
+
+// AddResult adds a check that passed if err is nil, and failed otherwise
+func (cr *ConformanceReport) AddResult(check ConformanceCheck, err error) {
+	check.Status = ConformanceStatusPassed
+	if err != nil {
+		check.Status = ConformanceStatusFailed
+		check.Message = err.Error()
+	}
+	cr.Add(check)
+}
+
+func (cr *ConformanceReport) count(status ConformanceStatus) int {
+	count := 0
+	for _, check := range cr.Checks {
+		if check.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+func (cr *ConformanceReport) Failures() int {
+	return cr.count(ConformanceStatusFailed)
+}
+
+func (cr *ConformanceReport) Write(w io.Writer, format string) error {
+	switch format {
+	case ConformanceReportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(cr)
+	case ConformanceReportFormatJUnit:
+		return cr.writeJUnit(w)
+	default:
+		return fmt.Errorf("unsupported report format %q, expected %s or %s", format, ConformanceReportFormatJSON, ConformanceReportFormatJUnit)
+	}
+}
+
+// WriteFile writes the report to a file
+func (cr *ConformanceReport) WriteFile(path string, format string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return cr.Write(file, format)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+// writeJUnit writes the report as JUnit XML, with a test suite per chain
+func (cr *ConformanceReport) writeJUnit(w io.Writer) error {
+	suites := map[string]*junitTestSuite{}
+	for _, check := range cr.Checks {
+		suite, ok := suites[check.Chain]
+		if !ok {
+			suite = &junitTestSuite{Name: check.Chain, Timestamp: cr.Time.Format(time.RFC3339)}
+			suites[check.Chain] = suite
+		}
+		className := check.Chain
+		for _, part := range []string{check.ApiInterface, check.Addon, check.Extension} {
+			if part != "" {
+				className += "." + part
+			}
+		}
+		testCase := junitTestCase{
+			Name:      check.Name,
+			ClassName: className,
+			Time:      fmt.Sprintf("%.3f", check.Duration.Seconds()),
+		}
+		switch check.Status {
+		case ConformanceStatusFailed:
+			testCase.Failure = &junitMessage{Message: check.Message}
+			suite.Failures++
+		case ConformanceStatusSkipped:
+			testCase.Skipped = &junitMessage{Message: check.Message}
+			suite.Skipped++
+		case ConformanceStatusWarning:
+			testCase.SystemOut = "warning: " + check.Message
+		}
+		suite.Tests++
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	chains := make([]string, 0, len(suites))
+	for chain := range suites {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	root := junitTestSuites{Name: "rpcprovider conformance " + cr.Provider}
+	for _, chain := range chains {
+		suite := suites[chain]
+		root.Tests += suite.Tests
+		root.Failures += suite.Failures
+		root.Skipped += suite.Skipped
+		root.Suites = append(root.Suites, *suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
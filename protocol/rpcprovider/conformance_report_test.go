@@ -0,0 +1,194 @@
+package rpcprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/protocol/chainlib"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestConformanceReport(t *testing.T) {
+	report := NewConformanceReport("provider")
+	base := ConformanceCheck{Chain: "LAV1", ApiInterface: "rest", Endpoint: "127.0.0.1:2220"}
+
+	verifications := []*pairingtypes.Verification{
+		{Name: "chain-id", Passed: true, Critical: true},
+		{Name: "pruning", Addon: "archive", Passed: false, Critical: true, Error: "missing block"},
+		{Name: "earliest", Passed: false, Critical: false, Error: "lagging"},
+	}
+	failed := addVerificationChecks(report, base, verifications)
+	require.Equal(t, []string{"pruning"}, failed)
+	require.False(t, report.Passed)
+	require.Equal(t, 1, report.Failures())
+
+	// claimed addon failed its verification, claimed extension was not verified
+	archive := base
+	archive.Addon = "archive"
+	require.Error(t, addClaimedServiceCheck(report, archive, verifications))
+	trace := base
+	trace.Extension = "trace"
+	require.NoError(t, addClaimedServiceCheck(report, trace, verifications))
+	require.Equal(t, ConformanceStatusWarning, report.Checks[len(report.Checks)-1].Status)
+
+	// other chain, outdated provider
+	report.AddResult(ConformanceCheck{Chain: "ETH1", Name: "probe"}, nil)
+	addVerificationChecks(report, ConformanceCheck{Chain: "ETH1"}, nil)
+	require.Equal(t, ConformanceStatusSkipped, report.Checks[len(report.Checks)-1].Status)
+	require.Equal(t, 2, report.Failures())
+
+	buf := bytes.Buffer{}
+	require.NoError(t, report.Write(&buf, ConformanceReportFormatJSON))
+	var decoded ConformanceReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, report.Checks, decoded.Checks)
+	require.False(t, decoded.Passed)
+
+	buf.Reset()
+	require.NoError(t, report.Write(&buf, ConformanceReportFormatJUnit))
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+	require.Equal(t, len(report.Checks), suites.Tests)
+	require.Equal(t, 2, suites.Failures)
+	require.Equal(t, 1, suites.Skipped)
+	require.Len(t, suites.Suites, 2)
+	require.Equal(t, "ETH1", suites.Suites[0].Name)
+	require.Equal(t, "LAV1", suites.Suites[1].Name)
+
+	require.Error(t, report.Write(&buf, "yaml"))
+}
+
+type mockProtocolQueryServer struct {
+	protocoltypes.UnimplementedQueryServer
+}
+
+func (mockProtocolQueryServer) Params(ctx context.Context, req *protocoltypes.QueryParamsRequest) (*protocoltypes.QueryParamsResponse, error) {
+	return &protocoltypes.QueryParamsResponse{Params: protocoltypes.DefaultParams()}, nil
+}
+
+func TestConformanceReportToStdout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	protocoltypes.RegisterQueryServer(server, mockProtocolQueryServer{})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	clientCtx := client.Context{}.WithGRPCClient(conn)
+
+	// the report goes to stdout, the summary to stderr
+	stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+	err = runConformanceTest(context.Background(), clientCtx, tx.Factory{}, nil, "provider", ConformanceReportFormatJSON, "", &stdout, &stderr)
+	require.NoError(t, err)
+	var report ConformanceReport
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+	require.Equal(t, "provider", report.Provider)
+	require.True(t, report.Passed)
+	require.Contains(t, stderr.String(), "SUMMARY")
+
+	// without a report, the summary goes to stdout
+	stdout.Reset()
+	stderr.Reset()
+	err = runConformanceTest(context.Background(), clientCtx, tx.Factory{}, nil, "provider", "", "", &stdout, &stderr)
+	require.NoError(t, err)
+	require.Contains(t, stdout.String(), "SUMMARY")
+	require.Empty(t, stderr.String())
+}
+
+type mockVerificationsRunner struct {
+	lock    sync.Mutex
+	runs    int
+	block   chan struct{} // when set, runs wait for it to close
+	results []chainlib.VerificationResult
+}
+
+func (mvr *mockVerificationsRunner) RunVerifications(ctx context.Context) []chainlib.VerificationResult {
+	if mvr.block != nil {
+		<-mvr.block
+	}
+	mvr.lock.Lock()
+	defer mvr.lock.Unlock()
+	mvr.runs++
+	return mvr.results
+}
+
+func (mvr *mockVerificationsRunner) Runs() int {
+	mvr.lock.Lock()
+	defer mvr.lock.Unlock()
+	return mvr.runs
+}
+
+func TestProbeVerifications(t *testing.T) {
+	var nilVerifications *ProbeVerifications
+	require.Nil(t, nilVerifications.Get(context.Background(), "caller"))
+
+	runner := &mockVerificationsRunner{results: []chainlib.VerificationResult{
+		{VerificationContainer: chainlib.VerificationContainer{Name: "chain-id", Severity: spectypes.Verification_Fail}},
+		{
+			VerificationContainer: chainlib.VerificationContainer{
+				Name:            "pruning",
+				Severity:        spectypes.Verification_Warning,
+				VerificationKey: chainlib.VerificationKey{Addon: "archive"},
+			},
+			Err: fmt.Errorf("missing block"),
+		},
+	}}
+	probeVerifications := NewProbeVerifications(runner, ProbeVerificationsCacheTTL, ProbeVerificationsCallerInterval)
+	verifications := probeVerifications.Get(context.Background(), "caller")
+	expected := []*pairingtypes.Verification{
+		{Name: "chain-id", Passed: true, Critical: true},
+		{Name: "pruning", Addon: "archive", Passed: false, Critical: false, Error: "missing block"},
+	}
+	require.Equal(t, expected, verifications)
+
+	// cached
+	probeVerifications.Get(context.Background(), "other")
+	require.Equal(t, 1, runner.Runs())
+
+	// expired: each caller triggers a run once per interval, and gets the cached results otherwise
+	probeVerifications = NewProbeVerifications(runner, 0, ProbeVerificationsCallerInterval)
+	require.Equal(t, expected, probeVerifications.Get(context.Background(), "caller"))
+	require.Equal(t, expected, probeVerifications.Get(context.Background(), "caller"))
+	require.Equal(t, 2, runner.Runs())
+	probeVerifications.Get(context.Background(), "other")
+	require.Equal(t, 3, runner.Runs())
+
+	// probes arriving during a run wait for it instead of running again
+	runner.block = make(chan struct{})
+	probeVerifications = NewProbeVerifications(runner, ProbeVerificationsCacheTTL, ProbeVerificationsCallerInterval)
+	results := make(chan []*pairingtypes.Verification, 2)
+	go func() { results <- probeVerifications.Get(context.Background(), "caller") }()
+	require.Eventually(t, func() bool {
+		probeVerifications.lock.Lock()
+		defer probeVerifications.lock.Unlock()
+		return probeVerifications.running != nil
+	}, time.Second, time.Millisecond)
+	go func() { results <- probeVerifications.Get(context.Background(), "other") }()
+
+	// a probe that gives up waiting doesn't get results
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Nil(t, probeVerifications.Get(ctx, "third"))
+
+	close(runner.block)
+	require.Equal(t, expected, <-results)
+	require.Equal(t, expected, <-results)
+	require.Equal(t, 4, runner.Runs())
+}
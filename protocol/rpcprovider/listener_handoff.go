@@ -0,0 +1,207 @@
+package rpcprovider
+
+import (
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	// inheritedListenersEnv passes the listener sockets a provider hands off to the new process, as address=fd pairs
+	inheritedListenersEnv = "LAVA_PROVIDER_INHERITED_LISTENERS"
+	// handoffReleaseEnv passes the fd of a pipe the previous process closes once it released the reward storage
+	handoffReleaseEnv = "LAVA_PROVIDER_HANDOFF_RELEASE"
+	// handoffShardEnv passes the reward db shard id of the previous process
+	handoffShardEnv = "LAVA_PROVIDER_HANDOFF_SHARD"
+)
+
+var inheritedListeners struct {
+	once      sync.Once
+	lock      sync.Mutex
+	listeners map[string]net.Listener
+}
+
+// takeInheritedListener returns the listener a previous provider process handed off for this address, each listener is taken once
+func takeInheritedListener(address string) (net.Listener, bool) {
+	inheritedListeners.once.Do(func() {
+		inheritedListeners.listeners = parseInheritedListeners(os.Getenv(inheritedListenersEnv))
+		os.Unsetenv(inheritedListenersEnv)
+	})
+	inheritedListeners.lock.Lock()
+	defer inheritedListeners.lock.Unlock()
+	listener, ok := inheritedListeners.listeners[address]
+	if ok {
+		delete(inheritedListeners.listeners, address)
+	}
+	return listener, ok
+}
+
+func parseInheritedListeners(value string) map[string]net.Listener {
+	listeners := map[string]net.Listener{}
+	if value == "" {
+		return listeners
+	}
+	for _, pair := range strings.Split(value, ",") {
+		address, fdString, found := strings.Cut(pair, "=")
+		fd, err := strconv.ParseUint(fdString, 10, 64)
+		if !found || err != nil {
+			utils.LavaFormatError("invalid inherited listener, ignoring it", err, utils.Attribute{Key: "listener", Value: pair})
+			continue
+		}
+		file := os.NewFile(uintptr(fd), address)
+		listener, err := net.FileListener(file)
+		file.Close() // FileListener holds its own copy of the socket
+		if err != nil {
+			utils.LavaFormatError("failed using inherited listener, ignoring it", err, utils.Attribute{Key: "listener", Value: pair})
+			continue
+		}
+		utils.LavaFormatInfo("Provider took over inherited listener", utils.Attribute{Key: "address", Value: address})
+		listeners[address] = listener
+	}
+	return listeners
+}
+
+// handoffRelease is held by a process started by a listener handoff, the previous process owns the reward storage
+// (reward dbs, earnings ledger, shard lock and claim coordination) until it releases it
+type handoffRelease struct {
+	shardID uint
+	file    *os.File
+}
+
+// takeHandoffRelease returns the release of the previous process, nil when this process wasn't started by a handoff
+func takeHandoffRelease() *handoffRelease {
+	fdString, found := os.LookupEnv(handoffReleaseEnv)
+	if !found {
+		return nil
+	}
+	shardString := os.Getenv(handoffShardEnv)
+	os.Unsetenv(handoffReleaseEnv)
+	os.Unsetenv(handoffShardEnv)
+	fd, err := strconv.ParseUint(fdString, 10, 64)
+	if err != nil {
+		utils.LavaFormatError("invalid handoff release, ignoring it", err, utils.Attribute{Key: "release", Value: fdString})
+		return nil
+	}
+	shardID, err := strconv.ParseUint(shardString, 10, 64)
+	if err != nil {
+		utils.LavaFormatError("invalid handoff shard, ignoring the handoff release", err, utils.Attribute{Key: "shard", Value: shardString})
+		return nil
+	}
+	return &handoffRelease{shardID: uint(shardID), file: os.NewFile(uintptr(fd), "handoff-release")}
+}
+
+// wait blocks until the previous process released the reward storage, or exited
+func (hr *handoffRelease) wait() {
+	defer hr.file.Close()
+	utils.LavaFormatInfo("Waiting for the previous provider process to release the reward storage", utils.Attribute{Key: "shard", Value: hr.shardID})
+	// the previous process never writes, the pipe reaches EOF once it closes its end
+	io.Copy(io.Discard, hr.file)
+	utils.LavaFormatInfo("Previous provider process released the reward storage", utils.Attribute{Key: "shard", Value: hr.shardID})
+}
+
+// listenerHandoff holds copies of the listener sockets and starts a new process serving them, while this process stops
+// accepting and finishes the relays in flight. this process owns the reward storage until it calls release
+type listenerHandoff struct {
+	addresses     []string
+	files         []*os.File
+	shardID       uint
+	releaseReader *os.File
+	releaseWriter *os.File
+}
+
+func newListenerHandoff(listeners map[string]*ProviderListener, shardID uint) (*listenerHandoff, error) {
+	handoff := &listenerHandoff{shardID: shardID}
+	for address, providerListener := range listeners {
+		fileListener, ok := providerListener.listener.(interface{ File() (*os.File, error) })
+		if !ok {
+			handoff.close()
+			return nil, utils.LavaFormatError("listener can't be handed off", nil, utils.Attribute{Key: "address", Value: address})
+		}
+		file, err := fileListener.File()
+		if err != nil {
+			handoff.close()
+			return nil, utils.LavaFormatError("failed copying listener socket", err, utils.Attribute{Key: "address", Value: address})
+		}
+		handoff.addresses = append(handoff.addresses, address)
+		handoff.files = append(handoff.files, file)
+	}
+	var err error
+	handoff.releaseReader, handoff.releaseWriter, err = os.Pipe()
+	if err != nil {
+		handoff.close()
+		return nil, utils.LavaFormatError("failed creating the handoff release pipe", err)
+	}
+	return handoff, nil
+}
+
+// environ returns the environment of the new process, extra files are numbered from 3 after stdin, stdout and stderr,
+// the listener sockets come first and the release pipe after them
+func (lh *listenerHandoff) environ() []string {
+	inherited := make([]string, len(lh.addresses))
+	for idx, address := range lh.addresses {
+		inherited[idx] = address + "=" + strconv.Itoa(3+idx)
+	}
+	return append(os.Environ(),
+		inheritedListenersEnv+"="+strings.Join(inherited, ","),
+		handoffReleaseEnv+"="+strconv.Itoa(3+len(lh.files)),
+		handoffShardEnv+"="+strconv.FormatUint(uint64(lh.shardID), 10),
+	)
+}
+
+// startProcess starts the provider again with the same arguments, inheriting the listener sockets. the new process
+// serves them right away, and waits for release before taking over the reward storage
+func (lh *listenerHandoff) startProcess() error {
+	defer lh.closeInherited()
+	executable, err := os.Executable()
+	if err != nil {
+		return utils.LavaFormatError("failed finding the provider executable for restart", err)
+	}
+	cmd := exec.Command(executable, os.Args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = append(append([]*os.File{}, lh.files...), lh.releaseReader)
+	cmd.Env = lh.environ()
+	err = cmd.Start()
+	if err != nil {
+		return utils.LavaFormatError("failed starting the new provider process", err)
+	}
+	utils.LavaFormatInfo("Provider handed its listeners to a new process", utils.Attribute{Key: "pid", Value: cmd.Process.Pid}, utils.Attribute{Key: "listeners", Value: strings.Join(lh.addresses, ",")})
+	return cmd.Process.Release()
+}
+
+// release lets the new process take over the reward storage, it must be called once this process closed its reward
+// dbs and earnings ledger, and released its shard lock and claim coordination
+func (lh *listenerHandoff) release() {
+	if lh.releaseWriter != nil {
+		lh.releaseWriter.Close()
+		lh.releaseWriter = nil
+		utils.LavaFormatInfo("Provider released the reward storage to the new process", utils.Attribute{Key: "shard", Value: lh.shardID})
+	}
+}
+
+// closeInherited closes this process's copies of the files the new process inherits
+func (lh *listenerHandoff) closeInherited() {
+	for _, file := range lh.files {
+		file.Close()
+	}
+	lh.files = nil
+	if lh.releaseReader != nil {
+		lh.releaseReader.Close()
+		lh.releaseReader = nil
+	}
+}
+
+func (lh *listenerHandoff) close() {
+	lh.closeInherited()
+	if lh.releaseWriter != nil {
+		lh.releaseWriter.Close()
+		lh.releaseWriter = nil
+	}
+}
@@ -0,0 +1,92 @@
+//go:build !windows
+// +build !windows
+
+package rpcprovider
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenerHandoff(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	handoff, err := newListenerHandoff(map[string]*ProviderListener{address: {networkAddress: address, listener: listener}}, 2)
+	require.NoError(t, err)
+	defer handoff.close()
+	require.Len(t, handoff.files, 1)
+	environ := handoff.environ()
+	// the release pipe comes after the listener sockets
+	require.Equal(t, []string{inheritedListenersEnv + "=" + address + "=3", handoffReleaseEnv + "=4", handoffShardEnv + "=2"}, environ[len(environ)-3:])
+
+	// the original listener shuts down, the socket stays open in the copy handed off
+	require.NoError(t, listener.Close())
+	// the new process owns the sockets at the numbered fds, here it owns a duplicate of the copy
+	fd, err := syscall.Dup(int(handoff.files[0].Fd()))
+	require.NoError(t, err)
+	inherited := parseInheritedListeners(address + "=" + strconv.Itoa(fd) + ",invalid")
+	require.Len(t, inherited, 1)
+	inheritedListener := inherited[address]
+	require.NotNil(t, inheritedListener)
+	defer inheritedListener.Close()
+
+	accepted := make(chan error, 1)
+	go func() {
+		conn, err := inheritedListener.Accept()
+		if err == nil {
+			conn.Close()
+		}
+		accepted <- err
+	}()
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	conn.Close()
+	require.NoError(t, <-accepted)
+	require.True(t, strings.HasPrefix(inheritedListener.Addr().String(), "127.0.0.1:"))
+}
+
+func TestHandoffRelease(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	address := listener.Addr().String()
+	handoff, err := newListenerHandoff(map[string]*ProviderListener{address: {networkAddress: address, listener: listener}}, 2)
+	require.NoError(t, err)
+	defer handoff.close()
+
+	// the new process owns the read end of the pipe at the numbered fd, here it owns a duplicate of it
+	fd, err := syscall.Dup(int(handoff.releaseReader.Fd()))
+	require.NoError(t, err)
+	t.Setenv(handoffReleaseEnv, strconv.Itoa(fd))
+	t.Setenv(handoffShardEnv, "2")
+	release := takeHandoffRelease()
+	require.NotNil(t, release)
+	require.Equal(t, uint(2), release.shardID)
+	require.Nil(t, takeHandoffRelease())
+	// the parent's copies of the inherited files are closed once the new process started
+	handoff.closeInherited()
+
+	released := make(chan struct{})
+	go func() {
+		release.wait()
+		close(released)
+	}()
+	select {
+	case <-released:
+		t.Fatal("released before the previous process released the reward storage")
+	case <-time.After(50 * time.Millisecond):
+	}
+	handoff.release()
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		t.Fatal("not released after the previous process released the reward storage")
+	}
+}
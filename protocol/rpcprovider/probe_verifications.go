@@ -0,0 +1,108 @@
+package rpcprovider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	// ProbeVerificationsCacheTTL is the time the verifications results are reused for probes
+	ProbeVerificationsCacheTTL = time.Minute
+	// ProbeVerificationsCallerInterval is the minimal time between verifications runs triggered by the same caller
+	ProbeVerificationsCallerInterval = 10 * time.Minute
+	// probeVerificationsTimeout bounds a verifications run, it doesn't depend on the probe that triggered it
+	probeVerificationsTimeout = 30 * time.Second
+)
+
+// ProbeVerifications runs the spec verifications of an endpoint for probes that ask for them
+// (e.g. by the provider conformance test). Probes are not signed, so in order not to load the
+// node the results are cached, a single run is in progress at a time (probes arriving meanwhile
+// wait for it without holding the lock), and each caller can trigger a run once per interval,
+// later probes of that caller get the cached results even when they expired.
+type ProbeVerifications struct {
+	lock           sync.Mutex
+	runner         chainlib.VerificationsRunner
+	results        []*pairingtypes.Verification
+	lastRun        time.Time
+	running        chan struct{} // closed when the run in progress is over, nil when not running
+	ttl            time.Duration
+	callerInterval time.Duration
+	callers        map[string]time.Time // caller -> last time it triggered a run
+}
+
+func NewProbeVerifications(runner chainlib.VerificationsRunner, ttl time.Duration, callerInterval time.Duration) *ProbeVerifications {
+	return &ProbeVerifications{runner: runner, ttl: ttl, callerInterval: callerInterval, callers: map[string]time.Time{}}
+}
+
+// Get returns the (possibly cached) verifications results for a caller (identified by its ip)
+func (pv *ProbeVerifications) Get(ctx context.Context, caller string) []*pairingtypes.Verification {
+	if pv == nil || pv.runner == nil {
+		return nil
+	}
+	pv.lock.Lock()
+	if pv.results != nil && time.Since(pv.lastRun) < pv.ttl {
+		defer pv.lock.Unlock()
+		return pv.results
+	}
+	if running := pv.running; running != nil {
+		pv.lock.Unlock()
+		return pv.waitForRun(ctx, running)
+	}
+	now := time.Now()
+	if lastTriggered, ok := pv.callers[caller]; ok && now.Sub(lastTriggered) < pv.callerInterval {
+		defer pv.lock.Unlock()
+		return pv.results
+	}
+	for knownCaller, lastTriggered := range pv.callers {
+		if now.Sub(lastTriggered) >= pv.callerInterval {
+			delete(pv.callers, knownCaller)
+		}
+	}
+	pv.callers[caller] = now
+	running := make(chan struct{})
+	pv.running = running
+	pv.lock.Unlock()
+
+	go pv.run(running)
+	return pv.waitForRun(ctx, running)
+}
+
+func (pv *ProbeVerifications) waitForRun(ctx context.Context, running chan struct{}) []*pairingtypes.Verification {
+	select {
+	case <-running:
+	case <-ctx.Done():
+	}
+	pv.lock.Lock()
+	defer pv.lock.Unlock()
+	return pv.results
+}
+
+func (pv *ProbeVerifications) run(running chan struct{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), probeVerificationsTimeout)
+	defer cancel()
+	results := []*pairingtypes.Verification{}
+	for _, result := range pv.runner.RunVerifications(ctx) {
+		verification := &pairingtypes.Verification{
+			Name:      result.Name,
+			Addon:     result.Addon,
+			Extension: result.Extension,
+			Passed:    result.Err == nil,
+			Critical:  result.Severity == spectypes.Verification_Fail,
+		}
+		if result.Err != nil {
+			verification.Error = result.Err.Error()
+		}
+		results = append(results, verification)
+	}
+	pv.lock.Lock()
+	defer pv.lock.Unlock()
+	pv.results = results
+	pv.lastRun = time.Now()
+	pv.running = nil
+	close(running)
+}
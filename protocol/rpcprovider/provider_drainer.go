@@ -0,0 +1,231 @@
+package rpcprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	DrainTimeoutFlagName       = "drain-timeout"
+	AdminListenAddressFlagName = "admin-listen-address"
+
+	// DefaultDrainTimeout stays below the 30 seconds grace period orchestrators commonly give a stopping process
+	// (e.g. kubernetes terminationGracePeriodSeconds) before killing it, raise both together
+	DefaultDrainTimeout = 25 * time.Second
+)
+
+// DrainConfig holds how the provider drains before shutting down, an empty admin address disables the admin endpoint
+type DrainConfig struct {
+	Timeout            time.Duration
+	AdminListenAddress string
+}
+
+type shutdownMode int
+
+const (
+	// shutdownImmediate stops serving right away, relays in flight get the listener shutdown grace period
+	shutdownImmediate shutdownMode = iota
+	// shutdownDrain stops admitting relays and waits for the relays in flight before shutting down
+	shutdownDrain
+	// shutdownRestart starts a new provider process on the listener sockets first, then stops accepting and finishes
+	// the relays in flight without draining, so consumers keep choosing the provider
+	shutdownRestart
+)
+
+func (sm shutdownMode) String() string {
+	switch sm {
+	case shutdownDrain:
+		return "drain"
+	case shutdownRestart:
+		return "restart"
+	default:
+		return "immediate"
+	}
+}
+
+// ProviderDrainer stops the provider from admitting new relays once it is draining, and tracks the relays in flight
+// so the provider can wait for them to finish before shutting down
+type ProviderDrainer struct {
+	lock     sync.Mutex
+	draining bool
+	stopping bool // set by Drain and Handoff, the provider waits for the relays in flight
+	inFlight int
+	idle     chan struct{} // closed once stopping with no relays in flight
+}
+
+func NewProviderDrainer() *ProviderDrainer {
+	return &ProviderDrainer{idle: make(chan struct{})}
+}
+
+// Admit tracks a new relay, returns ProviderDrainingError if the provider is draining. done must be called once the relay is over
+func (pd *ProviderDrainer) Admit() (done func(), err error) {
+	if pd == nil {
+		return func() {}, nil
+	}
+	pd.lock.Lock()
+	defer pd.lock.Unlock()
+	if pd.draining {
+		// not logged, every relay of a draining provider is rejected until the consumers block it
+		return nil, sdkerrors.Wrapf(lavasession.ProviderDrainingError, "rejecting relay, in flight: %d", pd.inFlight)
+	}
+	pd.inFlight++
+	return pd.releaseOnce(), nil
+}
+
+// Track tracks work that belongs to a relay that was already admitted, such as saving its proof, so it is tracked while draining as well
+func (pd *ProviderDrainer) Track() (done func()) {
+	if pd == nil {
+		return func() {}
+	}
+	pd.lock.Lock()
+	defer pd.lock.Unlock()
+	pd.inFlight++
+	return pd.releaseOnce()
+}
+
+func (pd *ProviderDrainer) releaseOnce() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			pd.lock.Lock()
+			defer pd.lock.Unlock()
+			pd.inFlight--
+			if pd.stopping && pd.inFlight == 0 {
+				close(pd.idle)
+			}
+		})
+	}
+}
+
+// Drain stops admitting relays, probes answer ProviderDrainingError from now on so consumers stop choosing this provider
+func (pd *ProviderDrainer) Drain() {
+	if pd == nil {
+		return
+	}
+	pd.lock.Lock()
+	defer pd.lock.Unlock()
+	pd.draining = true
+	pd.stop()
+}
+
+// Handoff waits for the relays in flight like Drain but keeps admitting relays and answering probes as usual, the
+// process taking over the listeners serves the new connections and consumers mustn't block the provider
+func (pd *ProviderDrainer) Handoff() {
+	if pd == nil {
+		return
+	}
+	pd.lock.Lock()
+	defer pd.lock.Unlock()
+	pd.stop()
+}
+
+// stop must be called while holding the lock
+func (pd *ProviderDrainer) stop() {
+	if pd.stopping {
+		return
+	}
+	pd.stopping = true
+	if pd.inFlight == 0 {
+		close(pd.idle)
+	}
+}
+
+func (pd *ProviderDrainer) Draining() bool {
+	if pd == nil {
+		return false
+	}
+	pd.lock.Lock()
+	defer pd.lock.Unlock()
+	return pd.draining
+}
+
+func (pd *ProviderDrainer) InFlight() int {
+	if pd == nil {
+		return 0
+	}
+	pd.lock.Lock()
+	defer pd.lock.Unlock()
+	return pd.inFlight
+}
+
+// WaitForInFlight waits for the relays in flight to finish after Drain or Handoff, subscriptions are relays in flight until they end
+func (pd *ProviderDrainer) WaitForInFlight(ctx context.Context) error {
+	if pd == nil {
+		return nil
+	}
+	select {
+	case <-pd.idle:
+		return nil
+	case <-ctx.Done():
+		return utils.LavaFormatWarning("relays still in flight after drain timeout", ctx.Err(), utils.Attribute{Key: "inFlight", Value: pd.InFlight()})
+	}
+}
+
+// drainAndWait drains the provider and waits for the relays in flight until they finish or ctx is done
+func (pd *ProviderDrainer) drainAndWait(ctx context.Context) {
+	pd.Drain()
+	utils.LavaFormatInfo("Provider draining, waiting for relays in flight", utils.Attribute{Key: "inFlight", Value: pd.InFlight()})
+	if err := pd.WaitForInFlight(ctx); err == nil {
+		utils.LavaFormatInfo("Provider drained, no relays in flight")
+	}
+}
+
+type drainStatus struct {
+	Draining bool `json:"draining"`
+	InFlight int  `json:"in_flight"`
+}
+
+// adminHandler serves the drain status, and requests to drain (POST /drain) or restart (POST /restart) the provider
+func (pd *ProviderDrainer) adminHandler(shutdownRequests chan<- shutdownMode) http.Handler {
+	requestShutdown := func(mode shutdownMode) http.HandlerFunc {
+		return func(resp http.ResponseWriter, req *http.Request) {
+			if req.Method != http.MethodPost {
+				http.Error(resp, "use POST to "+mode.String()+" the provider", http.StatusMethodNotAllowed)
+				return
+			}
+			select {
+			case shutdownRequests <- mode:
+				utils.LavaFormatInfo("Provider shutdown requested by admin endpoint", utils.Attribute{Key: "mode", Value: mode.String()})
+				resp.WriteHeader(http.StatusAccepted)
+			default:
+				http.Error(resp, "provider is already shutting down", http.StatusConflict)
+			}
+		}
+	}
+	drainHandler := requestShutdown(shutdownDrain)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/drain", func(resp http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			resp.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(resp).Encode(drainStatus{Draining: pd.Draining(), InFlight: pd.InFlight()})
+			return
+		}
+		drainHandler(resp, req)
+	})
+	mux.HandleFunc("/restart", requestShutdown(shutdownRestart))
+	return mux
+}
+
+// serveAdmin serves the admin endpoint until ctx is done or stop is called, stop returns once the address is free
+func (pd *ProviderDrainer) serveAdmin(ctx context.Context, listenAddress string, shutdownRequests chan<- shutdownMode) (stop func()) {
+	server := &http.Server{Addr: listenAddress, Handler: pd.adminHandler(shutdownRequests), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	go func() {
+		utils.LavaFormatInfo("Provider admin endpoint listening", utils.Attribute{Key: "address", Value: listenAddress})
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			utils.LavaFormatError("provider admin endpoint failed", err, utils.Attribute{Key: "address", Value: listenAddress})
+		}
+	}()
+	return func() { server.Close() }
+}
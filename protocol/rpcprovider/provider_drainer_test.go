@@ -0,0 +1,117 @@
+package rpcprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderDrainer(t *testing.T) {
+	// a nil drainer admits everything and never drains
+	var disabled *ProviderDrainer
+	done, err := disabled.Admit()
+	require.NoError(t, err)
+	done()
+	require.False(t, disabled.Draining())
+	require.NoError(t, disabled.WaitForInFlight(context.Background()))
+
+	drainer := NewProviderDrainer()
+	relayDone, err := drainer.Admit()
+	require.NoError(t, err)
+	subscriptionDone, err := drainer.Admit()
+	require.NoError(t, err)
+	require.Equal(t, 2, drainer.InFlight())
+
+	drainer.Drain()
+	_, err = drainer.Admit()
+	require.True(t, lavasession.ProviderDrainingError.Is(err))
+	// work of an admitted relay is still tracked while draining
+	proofDone := drainer.Track()
+	relayDone()
+	relayDone() // releasing twice doesn't release another relay
+	require.Equal(t, 2, drainer.InFlight())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, drainer.WaitForInFlight(ctx))
+
+	proofDone()
+	subscriptionDone()
+	require.NoError(t, drainer.WaitForInFlight(context.Background()))
+	require.Zero(t, drainer.InFlight())
+}
+
+func TestProviderDrainerHandoff(t *testing.T) {
+	drainer := NewProviderDrainer()
+	relayDone, err := drainer.Admit()
+	require.NoError(t, err)
+
+	// a provider handing off to a new process waits for its relays without draining
+	drainer.Handoff()
+	require.False(t, drainer.Draining())
+	lateRelayDone, err := drainer.Admit()
+	require.NoError(t, err)
+	relayDone()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, drainer.WaitForInFlight(ctx))
+	lateRelayDone()
+	require.NoError(t, drainer.WaitForInFlight(context.Background()))
+
+	// draining after the handoff started rejects relays
+	drainer.Drain()
+	_, err = drainer.Admit()
+	require.True(t, lavasession.ProviderDrainingError.Is(err))
+}
+
+func TestDrainingProviderRejectsRelays(t *testing.T) {
+	drainer := NewProviderDrainer()
+	rpcps := &RPCProviderServer{drainer: drainer, rpcProviderEndpoint: &lavasession.RPCProviderEndpoint{ChainID: "LAV1", ApiInterface: "tendermintrpc"}}
+	drainer.Drain()
+
+	_, err := rpcps.Probe(context.Background(), &pairingtypes.ProbeRequest{Guid: 1})
+	require.True(t, lavasession.IsProviderDraining(err))
+	_, err = rpcps.Relay(context.Background(), &pairingtypes.RelayRequest{RelayData: &pairingtypes.RelayPrivateData{}, RelaySession: &pairingtypes.RelaySession{}})
+	require.True(t, lavasession.IsProviderDraining(err))
+	err = rpcps.RelaySubscribe(&pairingtypes.RelayRequest{RelayData: &pairingtypes.RelayPrivateData{}, RelaySession: &pairingtypes.RelaySession{}}, nil)
+	require.True(t, lavasession.IsProviderDraining(err))
+}
+
+func TestDrainAdminHandler(t *testing.T) {
+	drainer := NewProviderDrainer()
+	shutdownRequests := make(chan shutdownMode, 1)
+	server := httptest.NewServer(drainer.adminHandler(shutdownRequests))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/restart")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	resp, err = http.Post(server.URL+"/drain", "", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	// the provider is already shutting down until the request is handled
+	resp, err = http.Post(server.URL+"/restart", "", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	require.Equal(t, shutdownDrain, <-shutdownRequests)
+
+	drainer.Drain()
+	resp, err = http.Get(server.URL + "/drain")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	status := drainStatus{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+	require.Equal(t, drainStatus{Draining: true, InFlight: 0}, status)
+}
@@ -3,6 +3,7 @@ package rpcprovider
 import (
 	"context"
 	"errors"
+	"net"
 	"net/http"
 	"strings"
 	"sync"
@@ -24,6 +25,7 @@ type ProviderListener struct {
 	networkAddress string
 	relayServer    *relayServer
 	httpServer     http.Server
+	listener       net.Listener
 }
 
 func (pl *ProviderListener) Key() string {
@@ -44,9 +46,12 @@ func (pl *ProviderListener) RegisterReceiver(existingReceiver RelayReceiver, end
 	return nil
 }
 
+// Shutdown stops accepting connections and waits for the requests in flight until shutdownCtx is done, then closes
+// the connections left, the process still has to save the proofs of the relays served
 func (pl *ProviderListener) Shutdown(shutdownCtx context.Context) error {
 	if err := pl.httpServer.Shutdown(shutdownCtx); err != nil {
-		utils.LavaFormatFatal("Provider failed to shutdown", err)
+		utils.LavaFormatWarning("Provider listener didn't shut down gracefully, closing its connections", err, utils.Attribute{Key: "address", Value: pl.networkAddress})
+		return pl.httpServer.Close()
 	}
 	return nil
 }
@@ -55,7 +60,12 @@ func NewProviderListener(ctx context.Context, networkAddress lavasession.Network
 	pl := &ProviderListener{networkAddress: networkAddress.Address}
 
 	// GRPC
-	lis := chainlib.GetListenerWithRetryGrpc("tcp", networkAddress.Address)
+	// a provider restarting with a listener handoff serves the sockets of the previous process, so no connection is refused
+	lis, inherited := takeInheritedListener(networkAddress.Address)
+	if !inherited {
+		lis = chainlib.GetListenerWithRetryGrpc("tcp", networkAddress.Address)
+	}
+	pl.listener = lis
 	serverReceiveMaxMessageSize := grpc.MaxRecvMsgSize(1024 * 1024 * 32) // setting receive size to 32mb instead of 4mb default
 	grpcServer := grpc.NewServer(serverReceiveMaxMessageSize)
 
@@ -77,7 +87,7 @@ func NewProviderListener(ctx context.Context, networkAddress lavasession.Network
 		utils.LavaFormatWarning("Running with disabled TLS configuration", nil)
 		serveExecutor = func() error { return pl.httpServer.Serve(lis) }
 	} else {
-		pl.httpServer.TLSConfig = lavasession.GetTlsConfig(networkAddress)
+		pl.httpServer.TLSConfig = lavasession.GetTlsConfig(ctx, networkAddress)
 		serveExecutor = func() error { return pl.httpServer.ServeTLS(lis, "", "") }
 	}
 
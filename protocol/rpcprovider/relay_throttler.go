@@ -0,0 +1,274 @@
+package rpcprovider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	MaxConcurrentRelaysFlagName     = "max-concurrent-relays"
+	RelayQueueTimeoutFlagName       = "relay-queue-timeout"
+	ConsumerRelaysPerSecondFlagName = "consumer-relays-per-second"
+	ConsumerRelaysBurstFlagName     = "consumer-relays-burst"
+	ConsumerCuPerSecondFlagName     = "consumer-cu-per-second"
+	ConsumerCuBurstFlagName         = "consumer-cu-burst"
+
+	DefaultRelayQueueTimeout = 500 * time.Millisecond
+	// idle consumer buckets are removed after this long, a full bucket holds no state worth keeping
+	throttlerCleanupInterval = time.Minute
+)
+
+// RelayThrottlerConfig holds the admission control limits of a provider endpoint, a zero value disables the relevant limit
+type RelayThrottlerConfig struct {
+	MaxConcurrentRelays     uint
+	RelayQueueTimeout       time.Duration
+	ConsumerRelaysPerSecond float64
+	ConsumerRelaysBurst     uint
+	ConsumerCuPerSecond     float64
+	ConsumerCuBurst         uint64
+}
+
+func (rtc RelayThrottlerConfig) Enabled() bool {
+	return rtc.MaxConcurrentRelays > 0 || rtc.ConsumerRelaysPerSecond > 0 || rtc.ConsumerCuPerSecond > 0
+}
+
+type tokenBucket struct {
+	tokens     float64
+	capacity   float64
+	refillRate float64 // tokens per second
+	lastRefill time.Time
+}
+
+func newTokenBucket(refillRate float64, capacity float64, now time.Time) *tokenBucket {
+	if capacity < 1 {
+		// burst must allow at least one second worth of tokens, otherwise nothing is ever served
+		capacity = refillRate
+		if capacity < 1 {
+			capacity = 1
+		}
+	}
+	return &tokenBucket{tokens: capacity, capacity: capacity, refillRate: refillRate, lastRefill: now}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(tb.lastRefill).Seconds()
+	if elapsed > 0 {
+		tb.tokens += elapsed * tb.refillRate
+		if tb.tokens > tb.capacity {
+			tb.tokens = tb.capacity
+		}
+		tb.lastRefill = now
+	}
+}
+
+// canTake assumes refill was called, a request larger than the bucket is allowed only when the bucket is full
+func (tb *tokenBucket) canTake(amount float64) bool {
+	return tb.tokens >= amount || tb.tokens >= tb.capacity
+}
+
+func (tb *tokenBucket) take(amount float64) {
+	tb.tokens -= amount
+}
+
+func (tb *tokenBucket) isFull() bool {
+	return tb.tokens >= tb.capacity
+}
+
+type consumerLimits struct {
+	relays *tokenBucket // nil when relay rate limiting is disabled
+	cu     *tokenBucket // nil when cu rate limiting is disabled
+}
+
+// RelayThrottler limits the relays a provider endpoint serves concurrently and the rate each consumer can send.
+// relays waiting for a concurrency slot are queued per consumer and released round robin between consumers,
+// so a single consumer flooding the provider can't starve the others.
+// a nil *RelayThrottler is valid and never throttles.
+type RelayThrottler struct {
+	lock           sync.Mutex
+	config         RelayThrottlerConfig
+	inFlight       uint
+	limits         map[string]*consumerLimits
+	waiting        map[string][]chan struct{} // consumer -> FIFO of relays waiting for a slot
+	consumersOrder []string                   // consumers with waiting relays, in round robin order
+	lastCleanup    time.Time
+}
+
+func NewRelayThrottler(config RelayThrottlerConfig) *RelayThrottler {
+	if !config.Enabled() {
+		return nil
+	}
+	if config.RelayQueueTimeout <= 0 {
+		config.RelayQueueTimeout = DefaultRelayQueueTimeout
+	}
+	return &RelayThrottler{
+		config:      config,
+		limits:      map[string]*consumerLimits{},
+		waiting:     map[string][]chan struct{}{},
+		lastCleanup: time.Now(),
+	}
+}
+
+// CheckRateLimit consumes the consumer's relay and cu allowance, returns ProviderRelayThrottledError if it was exceeded
+func (rt *RelayThrottler) CheckRateLimit(ctx context.Context, consumer string, cu uint64) error {
+	if rt == nil {
+		return nil
+	}
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	now := time.Now()
+	rt.cleanupIdleConsumers(now)
+	limits := rt.getConsumerLimits(consumer, now)
+	if limits.relays != nil {
+		limits.relays.refill(now)
+		if !limits.relays.canTake(1) {
+			return utils.LavaFormatWarning("relay throttled, consumer exceeded relay rate limit", lavasession.ProviderRelayThrottledError, utils.LogAttr("GUID", ctx), utils.LogAttr("consumer", consumer), utils.LogAttr("limit", rt.config.ConsumerRelaysPerSecond))
+		}
+	}
+	if limits.cu != nil {
+		limits.cu.refill(now)
+		if !limits.cu.canTake(float64(cu)) {
+			return utils.LavaFormatWarning("relay throttled, consumer exceeded cu rate limit", lavasession.ProviderRelayThrottledError, utils.LogAttr("GUID", ctx), utils.LogAttr("consumer", consumer), utils.LogAttr("cu", cu), utils.LogAttr("limit", rt.config.ConsumerCuPerSecond))
+		}
+	}
+	// only consume once both limits allow the relay
+	if limits.relays != nil {
+		limits.relays.take(1)
+	}
+	if limits.cu != nil {
+		limits.cu.take(float64(cu))
+	}
+	return nil
+}
+
+// Acquire applies the consumer rate limits and waits for a concurrency slot, the returned release function must be called when the relay is done
+func (rt *RelayThrottler) Acquire(ctx context.Context, consumer string, cu uint64) (release func(), err error) {
+	if rt == nil {
+		return func() {}, nil
+	}
+	err = rt.CheckRateLimit(ctx, consumer, cu)
+	if err != nil {
+		return nil, err
+	}
+	if rt.config.MaxConcurrentRelays == 0 {
+		return func() {}, nil
+	}
+
+	rt.lock.Lock()
+	if rt.inFlight < rt.config.MaxConcurrentRelays && len(rt.consumersOrder) == 0 {
+		rt.inFlight++
+		rt.lock.Unlock()
+		return rt.release, nil
+	}
+	slot := make(chan struct{})
+	if len(rt.waiting[consumer]) == 0 {
+		rt.consumersOrder = append(rt.consumersOrder, consumer)
+	}
+	rt.waiting[consumer] = append(rt.waiting[consumer], slot)
+	rt.lock.Unlock()
+
+	timer := time.NewTimer(rt.config.RelayQueueTimeout)
+	defer timer.Stop()
+	select {
+	case <-slot:
+		return rt.release, nil
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	rt.lock.Lock()
+	removed := rt.removeWaiter(consumer, slot)
+	rt.lock.Unlock()
+	if !removed {
+		// the slot was handed to us while we were timing out, give it back
+		<-slot
+		rt.release()
+	}
+	return nil, utils.LavaFormatWarning("relay throttled, timed out waiting for a free slot", lavasession.ProviderRelayThrottledError, utils.LogAttr("GUID", ctx), utils.LogAttr("consumer", consumer), utils.LogAttr("maxConcurrentRelays", rt.config.MaxConcurrentRelays))
+}
+
+func (rt *RelayThrottler) release() {
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	if len(rt.consumersOrder) == 0 {
+		rt.inFlight--
+		return
+	}
+	// hand the slot over to the next consumer in line, inFlight stays the same
+	consumer := rt.consumersOrder[0]
+	queue := rt.waiting[consumer]
+	slot := queue[0]
+	rt.waiting[consumer] = queue[1:]
+	rt.consumersOrder = rt.consumersOrder[1:]
+	if len(rt.waiting[consumer]) > 0 {
+		rt.consumersOrder = append(rt.consumersOrder, consumer)
+	} else {
+		delete(rt.waiting, consumer)
+	}
+	close(slot)
+}
+
+// must be called while locked, returns false if the waiter was already handed a slot
+func (rt *RelayThrottler) removeWaiter(consumer string, slot chan struct{}) bool {
+	queue := rt.waiting[consumer]
+	for idx, waiter := range queue {
+		if waiter != slot {
+			continue
+		}
+		queue = append(queue[:idx], queue[idx+1:]...)
+		if len(queue) > 0 {
+			rt.waiting[consumer] = queue
+			return true
+		}
+		delete(rt.waiting, consumer)
+		for orderIdx, waitingConsumer := range rt.consumersOrder {
+			if waitingConsumer == consumer {
+				rt.consumersOrder = append(rt.consumersOrder[:orderIdx], rt.consumersOrder[orderIdx+1:]...)
+				break
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// must be called while locked
+func (rt *RelayThrottler) getConsumerLimits(consumer string, now time.Time) *consumerLimits {
+	limits, ok := rt.limits[consumer]
+	if ok {
+		return limits
+	}
+	limits = &consumerLimits{}
+	if rt.config.ConsumerRelaysPerSecond > 0 {
+		limits.relays = newTokenBucket(rt.config.ConsumerRelaysPerSecond, float64(rt.config.ConsumerRelaysBurst), now)
+	}
+	if rt.config.ConsumerCuPerSecond > 0 {
+		limits.cu = newTokenBucket(rt.config.ConsumerCuPerSecond, float64(rt.config.ConsumerCuBurst), now)
+	}
+	rt.limits[consumer] = limits
+	return limits
+}
+
+// must be called while locked
+func (rt *RelayThrottler) cleanupIdleConsumers(now time.Time) {
+	if now.Sub(rt.lastCleanup) < throttlerCleanupInterval {
+		return
+	}
+	rt.lastCleanup = now
+	for consumer, limits := range rt.limits {
+		idle := true
+		if limits.relays != nil {
+			limits.relays.refill(now)
+			idle = limits.relays.isFull()
+		}
+		if idle && limits.cu != nil {
+			limits.cu.refill(now)
+			idle = limits.cu.isFull()
+		}
+		if idle {
+			delete(rt.limits, consumer)
+		}
+	}
+}
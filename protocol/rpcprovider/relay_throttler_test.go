@@ -0,0 +1,126 @@
+package rpcprovider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayThrottlerDisabled(t *testing.T) {
+	throttler := NewRelayThrottler(RelayThrottlerConfig{})
+	require.Nil(t, throttler)
+	// a nil throttler never throttles
+	for i := 0; i < 100; i++ {
+		release, err := throttler.Acquire(context.Background(), "consumer", 1000)
+		require.NoError(t, err)
+		release()
+	}
+	require.NoError(t, throttler.CheckRateLimit(context.Background(), "consumer", 1000))
+}
+
+func TestRelayThrottlerConsumerRateLimits(t *testing.T) {
+	plays := []struct {
+		name       string
+		config     RelayThrottlerConfig
+		cu         uint64
+		allowed    int
+		otherAllow bool
+	}{
+		{
+			name:       "relays per second",
+			config:     RelayThrottlerConfig{ConsumerRelaysPerSecond: 0.001, ConsumerRelaysBurst: 3},
+			cu:         10,
+			allowed:    3,
+			otherAllow: true,
+		},
+		{
+			name:       "cu per second",
+			config:     RelayThrottlerConfig{ConsumerCuPerSecond: 0.001, ConsumerCuBurst: 100},
+			cu:         30,
+			allowed:    3,
+			otherAllow: true,
+		},
+		{
+			name:       "relay bigger than cu burst is allowed on a full bucket",
+			config:     RelayThrottlerConfig{ConsumerCuPerSecond: 0.001, ConsumerCuBurst: 10},
+			cu:         50,
+			allowed:    1,
+			otherAllow: true,
+		},
+	}
+	for _, play := range plays {
+		t.Run(play.name, func(t *testing.T) {
+			ctx := context.Background()
+			throttler := NewRelayThrottler(play.config)
+			for i := 0; i < play.allowed; i++ {
+				release, err := throttler.Acquire(ctx, "consumer", play.cu)
+				require.NoError(t, err, i)
+				release()
+			}
+			_, err := throttler.Acquire(ctx, "consumer", play.cu)
+			require.Error(t, err)
+			require.True(t, lavasession.ProviderRelayThrottledError.Is(err))
+			// limits are per consumer
+			_, err = throttler.Acquire(ctx, "other", play.cu)
+			require.Equal(t, play.otherAllow, err == nil)
+		})
+	}
+}
+
+func TestRelayThrottlerConcurrencyQueueTimeout(t *testing.T) {
+	ctx := context.Background()
+	throttler := NewRelayThrottler(RelayThrottlerConfig{MaxConcurrentRelays: 1, RelayQueueTimeout: 10 * time.Millisecond})
+	release, err := throttler.Acquire(ctx, "consumer", 1)
+	require.NoError(t, err)
+	_, err = throttler.Acquire(ctx, "other", 1)
+	require.True(t, lavasession.ProviderRelayThrottledError.Is(err))
+	release()
+	// the timed out waiter must not hold the slot
+	release, err = throttler.Acquire(ctx, "other", 1)
+	require.NoError(t, err)
+	release()
+	require.Zero(t, throttler.inFlight)
+}
+
+func TestRelayThrottlerFairQueuing(t *testing.T) {
+	ctx := context.Background()
+	throttler := NewRelayThrottler(RelayThrottlerConfig{MaxConcurrentRelays: 1, RelayQueueTimeout: time.Second})
+	release, err := throttler.Acquire(ctx, "busy", 1)
+	require.NoError(t, err)
+
+	served := make(chan string, 4)
+	enqueue := func(consumer string, queued int) {
+		go func() {
+			release, err := throttler.Acquire(ctx, consumer, 1)
+			if err != nil {
+				served <- "error"
+				return
+			}
+			served <- consumer
+			release()
+		}()
+		// wait until the waiter is queued so the order is deterministic
+		require.Eventually(t, func() bool {
+			throttler.lock.Lock()
+			defer throttler.lock.Unlock()
+			return len(throttler.waiting[consumer]) == queued
+		}, time.Second, time.Millisecond)
+	}
+	// the busy consumer queues three relays before the quiet consumer queues one
+	enqueue("busy", 1)
+	enqueue("busy", 2)
+	enqueue("busy", 3)
+	enqueue("quiet", 1)
+	release()
+
+	order := []string{}
+	for i := 0; i < 4; i++ {
+		order = append(order, <-served)
+	}
+	// round robin between consumers lets the quiet consumer in before the rest of the busy consumer's queue
+	require.Equal(t, []string{"busy", "quiet", "busy", "busy"}, order)
+	require.Zero(t, throttler.inFlight)
+}
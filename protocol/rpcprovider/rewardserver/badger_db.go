@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/dgraph-io/badger/v4"
+	"github.com/lavanet/lava/utils"
 )
 
 type BadgerDB struct {
@@ -197,7 +198,13 @@ func NewMemoryDB(specId string) DB {
 	}
 }
 
-func NewLocalDB(storagePath, providerAddr string, specId string, shard uint) DB {
+// NewLocalDB opens the reward db of a spec, the provider address and spec id are path elements of the db
+func NewLocalDB(storagePath, providerAddr string, specId string, shard uint) (DB, error) {
+	for _, element := range []string{providerAddr, specId} {
+		if element == "" || element == "." || element == ".." || strings.ContainsAny(element, `/\`) {
+			return nil, utils.LavaFormatWarning("invalid reward db path element", nil, utils.Attribute{Key: "element", Value: element})
+		}
+	}
 	shardString := strconv.FormatUint(uint64(shard), 10)
 	path := filepath.Join(storagePath, providerAddr, specId, shardString)
 	Options := badger.DefaultOptions(path)
@@ -205,7 +212,7 @@ func NewLocalDB(storagePath, providerAddr string, specId string, shard uint) DB
 	Options.Logger = nil
 	db, err := badger.Open(Options)
 	if err != nil {
-		panic(err)
+		return nil, utils.LavaFormatError("failed opening reward db", err, utils.Attribute{Key: "path", Value: path})
 	}
 
 	return &BadgerDB{
@@ -214,7 +221,7 @@ func NewLocalDB(storagePath, providerAddr string, specId string, shard uint) DB
 		shardString:  shardString,
 		rewards:      make(map[string]*entryWithTtl),
 		db:           db,
-	}
+	}, nil
 }
 
 type entryWithTtl struct {
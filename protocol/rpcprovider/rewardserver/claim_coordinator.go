@@ -0,0 +1,276 @@
+package rewardserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	ClaimLockFlagName                  = "claim-lock"
+	ClaimForwardListenAddressFlagName  = "claim-forward-listen-address"
+	ClaimForwardAddressFlagName        = "claim-forward-address"
+	ClaimForwardSecretFileFlagName     = "claim-forward-secret-file"
+	ClaimForwardPath                   = "/forward-proofs"
+	DefaultClaimElectionInterval       = 5 * time.Second
+	DefaultClaimForwardListenAddress   = "127.0.0.1:0"
+	MaxAutoAssignedShards              = 64
+	claimForwardTimeout                = 30 * time.Second
+	maxForwardedProofsRequestBodyBytes = 64 << 20
+	claimForwardAuthorizationScheme    = "Bearer "
+)
+
+type ClaimCoordinationConfig struct {
+	// LockURI is the claim lock shared by the replicas, a local file path or a uri of a registered lock backend
+	LockURI       string
+	ListenAddress string
+	// ForwardAddress is the address other replicas reach ListenAddress on, the bound listen address when empty
+	ForwardAddress string
+	// AutoShard assigns the replica a free shard id instead of a configured one
+	AutoShard bool
+	// ForwardSecret is shared by the replicas, the claim leader only accepts proofs forwarded with it
+	ForwardSecret string
+}
+
+// ReadClaimForwardSecret reads the forwarding secret shared by the replicas from a file
+func ReadClaimForwardSecret(path string) (string, error) {
+	if path == "" {
+		return "", utils.LavaFormatError("claim coordination requires a forwarding secret, set --"+ClaimForwardSecretFileFlagName, nil)
+	}
+	secret, err := os.ReadFile(path)
+	if err != nil {
+		return "", utils.LavaFormatError("failed reading the claim forwarding secret", err, utils.Attribute{Key: "path", Value: path})
+	}
+	trimmed := strings.TrimSpace(string(secret))
+	if trimmed == "" {
+		return "", utils.LavaFormatError("empty claim forwarding secret", nil, utils.Attribute{Key: "path", Value: path})
+	}
+	return trimmed, nil
+}
+
+// PairingVerifier verifies a consumer was paired with the provider, so forwarded proofs can't be made up by other consumers
+type PairingVerifier interface {
+	VerifyPairing(ctx context.Context, consumerAddress, providerAddress string, epoch uint64, chainID string) (valid bool, total int64, projectId string, err error)
+}
+
+func (ccc ClaimCoordinationConfig) Enabled() bool {
+	return ccc.LockURI != ""
+}
+
+// ClaimCoordinator coordinates the replicas of a provider address so that only one of them claims rewards.
+// the replica holding the claim lock is the leader, it sends the relay payment transactions and receives the proofs
+// of the other replicas, which forward their claimable proofs to it instead of claiming them
+type ClaimCoordinator struct {
+	lock             ClaimLock
+	providerAddress  string
+	shardID          uint
+	forwardAddress   string
+	electionInterval time.Duration
+	isLeader         atomic.Bool
+	httpClient       *http.Client
+	forwardSecret    string
+	pairingVerifier  PairingVerifier
+	running          sync.WaitGroup // the election loop and the forwarding server, until their context is done
+}
+
+// NewClaimCoordinator creates a coordinator for the provider address, forwardAddress is the address other replicas
+// reach this replica's forwarding handler on when it is the leader. forwardSecret authenticates the replicas to each
+// other, and pairingVerifier verifies the consumers of the proofs forwarded to the leader
+func NewClaimCoordinator(lock ClaimLock, providerAddress string, shardID uint, forwardAddress string, forwardSecret string, pairingVerifier PairingVerifier) *ClaimCoordinator {
+	return &ClaimCoordinator{
+		lock:             lock,
+		providerAddress:  providerAddress,
+		shardID:          shardID,
+		forwardAddress:   forwardAddress,
+		electionInterval: DefaultClaimElectionInterval,
+		httpClient:       &http.Client{Timeout: claimForwardTimeout},
+		forwardSecret:    forwardSecret,
+		pairingVerifier:  pairingVerifier,
+	}
+}
+
+// Wait returns once the coordinator stopped after the context it was started with is done, the claim lock is
+// released and the forwarding listener is closed, so another process can take over
+func (cc *ClaimCoordinator) Wait() {
+	if cc == nil {
+		return
+	}
+	cc.running.Wait()
+}
+
+// IsLeader returns true if this replica claims rewards, a replica without a coordinator always claims
+func (cc *ClaimCoordinator) IsLeader() bool {
+	if cc == nil {
+		return true
+	}
+	return cc.isLeader.Load()
+}
+
+// Start runs the leader election until ctx is done, the first election is done before returning
+func (cc *ClaimCoordinator) Start(ctx context.Context) {
+	cc.elect(ctx)
+	cc.running.Add(1)
+	go func() {
+		defer cc.running.Done()
+		ticker := time.NewTicker(cc.electionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				cc.isLeader.Store(false)
+				if err := cc.lock.Unlock(); err != nil {
+					utils.LavaFormatWarning("failed releasing the claim lock", err)
+				}
+				return
+			case <-ticker.C:
+				cc.elect(ctx)
+			}
+		}
+	}()
+}
+
+func (cc *ClaimCoordinator) elect(ctx context.Context) {
+	wasLeader := cc.isLeader.Load()
+	locked, err := cc.lock.TryLock(ctx)
+	if err != nil {
+		// leadership can't be confirmed, stop claiming so two replicas never claim together
+		utils.LavaFormatWarning("failed acquiring the claim lock", err)
+		locked = false
+	}
+	if locked && !wasLeader {
+		err = cc.lock.SetLeaderAddress(ctx, cc.forwardAddress)
+		if err != nil {
+			utils.LavaFormatError("failed publishing the claim leader address, releasing the claim lock", err)
+			cc.lock.Unlock()
+			return
+		}
+		utils.LavaFormatInfo("this replica is now the rewards claim leader", utils.Attribute{Key: "forward_address", Value: cc.forwardAddress})
+	} else if !locked && wasLeader {
+		utils.LavaFormatWarning("this replica is no longer the rewards claim leader", nil)
+	}
+	cc.isLeader.Store(locked)
+}
+
+// ForwardProofs sends the relay sessions to the claim leader
+func (cc *ClaimCoordinator) ForwardProofs(ctx context.Context, relaySessions []*pairingtypes.RelaySession) error {
+	leaderAddress, err := cc.lock.LeaderAddress(ctx)
+	if err != nil || leaderAddress == "" {
+		return utils.LavaFormatWarning("failed finding the claim leader address", err)
+	}
+	body, err := (&pairingtypes.MsgRelayPayment{Creator: cc.providerAddress, Relays: relaySessions}).Marshal()
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(leaderAddress, "://") {
+		leaderAddress = "http://" + leaderAddress
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(leaderAddress, "/")+ClaimForwardPath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/octet-stream")
+	request.Header.Set("Authorization", claimForwardAuthorizationScheme+cc.forwardSecret)
+	response, err := cc.httpClient.Do(request)
+	if err != nil {
+		return utils.LavaFormatWarning("failed forwarding proofs to the claim leader", err, utils.Attribute{Key: "leader", Value: leaderAddress})
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		reply, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return utils.LavaFormatWarning("claim leader rejected forwarded proofs", nil,
+			utils.Attribute{Key: "leader", Value: leaderAddress},
+			utils.Attribute{Key: "status", Value: response.StatusCode},
+			utils.Attribute{Key: "reply", Value: strings.TrimSpace(string(reply))},
+		)
+	}
+	return nil
+}
+
+// authorized checks the request carries the forwarding secret shared by the replicas
+func (cc *ClaimCoordinator) authorized(r *http.Request) bool {
+	authorization := r.Header.Get("Authorization")
+	if cc.forwardSecret == "" || !strings.HasPrefix(authorization, claimForwardAuthorizationScheme) {
+		return false
+	}
+	secret := strings.TrimPrefix(authorization, claimForwardAuthorizationScheme)
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(cc.forwardSecret)) == 1
+}
+
+// Handler receives the proofs forwarded by the other replicas, only while this replica is the leader
+func (cc *ClaimCoordinator) Handler(rws *RewardServer) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ClaimForwardPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !cc.authorized(r) {
+			utils.LavaFormatWarning("rejected unauthorized forwarded proofs", nil, utils.Attribute{Key: "remote", Value: r.RemoteAddr})
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if !cc.IsLeader() {
+			http.Error(w, "not the claim leader", http.StatusConflict)
+			return
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxForwardedProofsRequestBodyBytes))
+		if err != nil {
+			http.Error(w, "failed reading request", http.StatusBadRequest)
+			return
+		}
+		msg := pairingtypes.MsgRelayPayment{}
+		if err := msg.Unmarshal(body); err != nil {
+			http.Error(w, "invalid forwarded proofs", http.StatusBadRequest)
+			return
+		}
+		if err := rws.ReceiveForwardedProofs(r.Context(), msg.Relays, cc.providerAddress, cc.pairingVerifier); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		utils.LavaFormatDebug("received forwarded proofs", utils.Attribute{Key: "relay_sessions", Value: len(msg.Relays)})
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
+
+// ServeForwardedProofs serves the forwarding handler on listenAddress until ctx is done, it must be called before Start.
+// a replica without a forward address advertises the address it listens on, so replicas sharing a host can listen on port 0
+func (cc *ClaimCoordinator) ServeForwardedProofs(ctx context.Context, rws *RewardServer, listenAddress string) error {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return err
+	}
+	if cc.forwardAddress == "" {
+		cc.forwardAddress = listener.Addr().String()
+	}
+	server := &http.Server{
+		Handler:           cc.Handler(rws),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	cc.running.Add(1)
+	go func() {
+		defer cc.running.Done()
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	go func() {
+		if err := server.Serve(listener); err != http.ErrServerClosed {
+			utils.LavaFormatError("rewards claim forwarding server failed", err)
+		}
+	}()
+	utils.LavaFormatInfo("rewards claim forwarding listening", utils.Attribute{Key: "address", Value: listener.Addr().String()}, utils.Attribute{Key: "forward_address", Value: cc.forwardAddress})
+	return nil
+}
@@ -0,0 +1,189 @@
+package rewardserver
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils/rand"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileClaimLock(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "claim.lock")
+	first, err := NewClaimLock(path)
+	require.NoError(t, err)
+	second, err := NewClaimLock("file://" + path)
+	require.NoError(t, err)
+
+	locked, err := first.TryLock(ctx)
+	require.NoError(t, err)
+	require.True(t, locked)
+	locked, err = second.TryLock(ctx)
+	require.NoError(t, err)
+	require.False(t, locked)
+
+	require.NoError(t, first.SetLeaderAddress(ctx, "127.0.0.1:2222"))
+	address, err := second.LeaderAddress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:2222", address)
+
+	require.NoError(t, first.Unlock())
+	locked, err = second.TryLock(ctx)
+	require.NoError(t, err)
+	require.True(t, locked)
+
+	_, err = NewClaimLock("unknown://lock")
+	require.Error(t, err)
+}
+
+func TestAcquireShard(t *testing.T) {
+	storagePath := t.TempDir()
+	first, err := AcquireShard(storagePath, "provider", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), first.ShardID)
+	second, err := AcquireShard(storagePath, "provider", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint(1), second.ShardID)
+	_, err = AcquireShard(storagePath, "provider", 2)
+	require.Error(t, err)
+
+	// a different provider has its own shards
+	other, err := AcquireShard(storagePath, "other", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), other.ShardID)
+
+	require.NoError(t, first.Release())
+	third, err := AcquireShard(storagePath, "provider", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), third.ShardID)
+
+	// a handed over shard is locked once the previous holder released it
+	_, err = LockShard(storagePath, "provider", 1)
+	require.Error(t, err)
+	require.NoError(t, second.Release())
+	handedOver, err := LockShard(storagePath, "provider", 1)
+	require.NoError(t, err)
+	require.Equal(t, uint(1), handedOver.ShardID)
+}
+
+type pairingVerifierMock struct {
+	paired map[string]bool // consumer -> paired
+}
+
+func (pvm *pairingVerifierMock) VerifyPairing(ctx context.Context, consumerAddress, providerAddress string, epoch uint64, chainID string) (valid bool, total int64, projectId string, err error) {
+	return pvm.paired[consumerAddress], 1, "", nil
+}
+
+func TestClaimForwarding(t *testing.T) {
+	rand.InitRandomSeed()
+	sdkCtx := sdk.WrapSDKContext(sdk.NewContext(nil, tmproto.Header{}, false, nil))
+	privKey, acc := sigs.GenerateFloatingKey()
+	lockPath := filepath.Join(t.TempDir(), "claim.lock")
+	pairingVerifier := &pairingVerifierMock{paired: map[string]bool{acc.String(): true}}
+
+	setupReplica := func(t *testing.T) (*RewardServer, *rewardsTxSenderMock, *ClaimCoordinator) {
+		rewardDB, err := createInMemoryRewardDb([]string{"spec"})
+		require.NoError(t, err)
+		txSender := &rewardsTxSenderMock{blockDistance: 20}
+		rws := NewRewardServer(txSender, nil, rewardDB, "badger_test", 1, 10, nil)
+		lock, err := NewFileClaimLock(lockPath)
+		require.NoError(t, err)
+		claimCoordinator := NewClaimCoordinator(lock, "provider", 0, "", "secret", pairingVerifier)
+		rws.SetClaimCoordinator(claimCoordinator)
+		return rws, txSender, claimCoordinator
+	}
+	buildProof := func(t *testing.T, sessionId uint64, epoch uint64, specId string, privKey *btcec.PrivateKey) *pairingtypes.RelaySession {
+		proof := common.BuildRelayRequestWithSession(sdkCtx, "provider", []byte{}, sessionId, 10, specId, nil)
+		proof.Epoch = int64(epoch)
+		var err error
+		proof.Sig, err = sigs.Sign(privKey, *proof)
+		require.NoError(t, err)
+		return proof
+	}
+	sendProof := func(t *testing.T, rws *RewardServer, sessionId uint64, epoch uint64) {
+		proof := buildProof(t, sessionId, epoch, "spec", privKey)
+		_, updated := rws.SendNewProof(context.Background(), proof, epoch, acc.String(), "apiInterface")
+		require.True(t, updated)
+	}
+	sessionIds := func(relaySessions []*pairingtypes.RelaySession) []uint64 {
+		ids := []uint64{}
+		for _, relaySession := range relaySessions {
+			ids = append(ids, relaySession.SessionId)
+		}
+		return ids
+	}
+
+	leader, leaderTxSender, leaderCoordinator := setupReplica(t)
+	server := httptest.NewServer(leaderCoordinator.Handler(leader))
+	defer server.Close()
+	leaderCoordinator.forwardAddress = server.URL
+	leaderCtx, leaderCancel := context.WithCancel(context.Background())
+	defer leaderCancel()
+	leaderCoordinator.Start(leaderCtx)
+	require.True(t, leaderCoordinator.IsLeader())
+
+	// forwarded proofs are rejected without the shared secret, of specs the leader doesn't serve, and of unpaired consumers
+	forward := func(secret string, relaySessions ...*pairingtypes.RelaySession) int {
+		body, err := (&pairingtypes.MsgRelayPayment{Creator: "provider", Relays: relaySessions}).Marshal()
+		require.NoError(t, err)
+		request, err := http.NewRequest(http.MethodPost, server.URL+ClaimForwardPath, bytes.NewReader(body))
+		require.NoError(t, err)
+		if secret != "" {
+			request.Header.Set("Authorization", "Bearer "+secret)
+		}
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		response.Body.Close()
+		return response.StatusCode
+	}
+	unpairedKey, _ := sigs.GenerateFloatingKey()
+	require.Equal(t, http.StatusUnauthorized, forward("", buildProof(t, 10, 100, "spec", privKey)))
+	require.Equal(t, http.StatusUnauthorized, forward("wrong", buildProof(t, 10, 100, "spec", privKey)))
+	require.Equal(t, http.StatusBadRequest, forward("secret", buildProof(t, 10, 100, "../../spec", privKey)))
+	require.Equal(t, http.StatusBadRequest, forward("secret", buildProof(t, 10, 100, "spec", privKey), buildProof(t, 11, 100, "spec", unpairedKey)))
+	require.Empty(t, leader.rewards)
+
+	follower, followerTxSender, followerCoordinator := setupReplica(t)
+	followerCtx, followerCancel := context.WithCancel(context.Background())
+	defer followerCancel()
+	followerCoordinator.Start(followerCtx)
+	require.False(t, followerCoordinator.IsLeader())
+
+	// the follower forwards its claimable proofs instead of claiming them
+	sendProof(t, follower, 1, 100)
+	sendProof(t, follower, 2, 110)
+	follower.UpdateEpoch(120)
+	require.Empty(t, followerTxSender.sentPayments)
+	require.Len(t, follower.rewards, 1) // epoch 110 isn't claimable yet
+
+	// the leader claims them with its own
+	sendProof(t, leader, 3, 100)
+	leader.UpdateEpoch(120)
+	require.ElementsMatch(t, []uint64{1, 3}, sessionIds(leaderTxSender.sentPayments))
+
+	// proofs that fail forwarding are kept for the next epoch
+	server.Close()
+	follower.UpdateEpoch(130)
+	require.Empty(t, followerTxSender.sentPayments)
+	require.Len(t, follower.rewards, 1)
+
+	// once the leader is gone the follower takes over and claims them itself
+	leaderCancel()
+	require.Eventually(t, func() bool { return !leaderCoordinator.IsLeader() }, time.Second, 10*time.Millisecond)
+	followerCoordinator.elect(context.Background())
+	require.True(t, followerCoordinator.IsLeader())
+	follower.UpdateEpoch(130)
+	require.Equal(t, []uint64{2}, sessionIds(followerTxSender.sentPayments))
+}
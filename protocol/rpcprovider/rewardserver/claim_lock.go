@@ -0,0 +1,196 @@
+package rewardserver
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	FileClaimLockScheme   = "file"
+	claimLeaderFileSuffix = ".leader"
+	shardLocksDir         = "shards"
+)
+
+// ClaimLock elects the single replica that claims the rewards of a provider address, replicas that don't hold the lock
+// forward their proofs to the leader address published by the replica that does
+type ClaimLock interface {
+	// TryLock acquires the lock without blocking, it returns true if this replica holds it
+	TryLock(ctx context.Context) (bool, error)
+	Unlock() error
+	// SetLeaderAddress publishes the address the leader receives forwarded proofs on, only called while holding the lock
+	SetLeaderAddress(ctx context.Context, address string) error
+	LeaderAddress(ctx context.Context) (string, error)
+}
+
+// ClaimLockFactory creates a claim lock from a lock uri of its scheme
+type ClaimLockFactory func(lockURI *url.URL) (ClaimLock, error)
+
+var (
+	claimLockBackendsLock sync.RWMutex
+	claimLockBackends     = map[string]ClaimLockFactory{
+		FileClaimLockScheme: func(lockURI *url.URL) (ClaimLock, error) {
+			return NewFileClaimLock(filepath.Join(lockURI.Host, lockURI.Path))
+		},
+	}
+)
+
+// RegisterClaimLockBackend adds a claim lock backend for lock uris of the scheme, e.g. a lock over a shared key value store
+func RegisterClaimLockBackend(scheme string, factory ClaimLockFactory) {
+	claimLockBackendsLock.Lock()
+	defer claimLockBackendsLock.Unlock()
+	claimLockBackends[scheme] = factory
+}
+
+// NewClaimLock creates the claim lock of the uri scheme, a uri without a scheme is a local file path
+func NewClaimLock(lockURI string) (ClaimLock, error) {
+	if !strings.Contains(lockURI, "://") {
+		return NewFileClaimLock(lockURI)
+	}
+	parsed, err := url.Parse(lockURI)
+	if err != nil {
+		return nil, utils.LavaFormatError("invalid claim lock uri", err, utils.Attribute{Key: "uri", Value: lockURI})
+	}
+	claimLockBackendsLock.RLock()
+	factory, ok := claimLockBackends[parsed.Scheme]
+	claimLockBackendsLock.RUnlock()
+	if !ok {
+		return nil, utils.LavaFormatError("unsupported claim lock backend", nil, utils.Attribute{Key: "uri", Value: lockURI})
+	}
+	return factory(parsed)
+}
+
+// FileClaimLock is a claim lock over an exclusive lock of a local file, shared by replicas running on the same host
+// or over a shared file system. the lock is released when the replica exits
+type FileClaimLock struct {
+	path string
+	lock sync.Mutex
+	file *os.File
+}
+
+var _ ClaimLock = (*FileClaimLock)(nil)
+
+func NewFileClaimLock(path string) (*FileClaimLock, error) {
+	if path == "" {
+		return nil, utils.LavaFormatError("claim lock file path is empty", nil)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, utils.LavaFormatError("failed creating claim lock directory", err, utils.Attribute{Key: "path", Value: path})
+	}
+	return &FileClaimLock{path: path}, nil
+}
+
+func (fcl *FileClaimLock) TryLock(ctx context.Context) (bool, error) {
+	fcl.lock.Lock()
+	defer fcl.lock.Unlock()
+	if fcl.file != nil {
+		return true, nil
+	}
+	file, locked, err := tryLockFile(fcl.path)
+	if err != nil || !locked {
+		return false, err
+	}
+	fcl.file = file
+	return true, nil
+}
+
+func (fcl *FileClaimLock) Unlock() error {
+	fcl.lock.Lock()
+	defer fcl.lock.Unlock()
+	if fcl.file == nil {
+		return nil
+	}
+	err := unlockFile(fcl.file)
+	fcl.file = nil
+	return err
+}
+
+func (fcl *FileClaimLock) SetLeaderAddress(ctx context.Context, address string) error {
+	// write and rename so followers never read a partial address
+	leaderPath := fcl.path + claimLeaderFileSuffix
+	tempFile, err := os.CreateTemp(filepath.Dir(leaderPath), filepath.Base(leaderPath)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = tempFile.WriteString(address)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+	return os.Rename(tempFile.Name(), leaderPath)
+}
+
+func (fcl *FileClaimLock) LeaderAddress(ctx context.Context) (string, error) {
+	address, err := os.ReadFile(fcl.path + claimLeaderFileSuffix)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(address)), nil
+}
+
+// ShardLock holds a shard id of a provider address for the lifetime of the replica
+type ShardLock struct {
+	ShardID uint
+	file    *os.File
+}
+
+func (sl *ShardLock) Release() error {
+	if sl == nil || sl.file == nil {
+		return nil
+	}
+	err := unlockFile(sl.file)
+	sl.file = nil
+	return err
+}
+
+// AcquireShard locks the lowest shard id that no other replica of the provider address holds, so replicas sharing a
+// reward storage path don't need to be given their shard ids
+func AcquireShard(storagePath, providerAddr string, maxShards uint) (*ShardLock, error) {
+	dir, err := shardLocksPath(storagePath, providerAddr)
+	if err != nil {
+		return nil, err
+	}
+	for shardID := uint(0); shardID < maxShards; shardID++ {
+		file, locked, err := tryLockFile(filepath.Join(dir, strconv.FormatUint(uint64(shardID), 10)+".lock"))
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			return &ShardLock{ShardID: shardID, file: file}, nil
+		}
+	}
+	return nil, utils.LavaFormatError("all shards of the provider are held by other replicas", nil, utils.Attribute{Key: "provider", Value: providerAddr}, utils.Attribute{Key: "shards", Value: maxShards})
+}
+
+func shardLocksPath(storagePath, providerAddr string) (string, error) {
+	dir := filepath.Join(storagePath, providerAddr, shardLocksDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", utils.LavaFormatError("failed creating shard locks directory", err, utils.Attribute{Key: "path", Value: dir})
+	}
+	return dir, nil
+}
+
+// LockShard locks the given shard id of the provider address, such as the shard a previous process handed over
+func LockShard(storagePath, providerAddr string, shardID uint) (*ShardLock, error) {
+	dir, err := shardLocksPath(storagePath, providerAddr)
+	if err != nil {
+		return nil, err
+	}
+	file, locked, err := tryLockFile(filepath.Join(dir, strconv.FormatUint(uint64(shardID), 10)+".lock"))
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, utils.LavaFormatError("shard is held by another replica", nil, utils.Attribute{Key: "provider", Value: providerAddr}, utils.Attribute{Key: "shard", Value: shardID})
+	}
+	return &ShardLock{ShardID: shardID, file: file}, nil
+}
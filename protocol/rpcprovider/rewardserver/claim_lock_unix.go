@@ -0,0 +1,35 @@
+//go:build !windows
+// +build !windows
+
+package rewardserver
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// tryLockFile opens the file and takes an exclusive lock on it without blocking, the lock is held as long as the file is open
+func tryLockFile(path string) (file *os.File, locked bool, err error) {
+	file, err = os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, false, err
+	}
+	err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err != nil {
+		file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return file, true, nil
+}
+
+func unlockFile(file *os.File) error {
+	err := syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,18 @@
+//go:build windows
+// +build windows
+
+package rewardserver
+
+import (
+	"os"
+
+	"github.com/lavanet/lava/utils"
+)
+
+func tryLockFile(path string) (file *os.File, locked bool, err error) {
+	return nil, false, utils.LavaFormatError("file claim locks are not supported on windows, use a different claim lock backend", nil, utils.Attribute{Key: "path", Value: path})
+}
+
+func unlockFile(file *os.File) error {
+	return file.Close()
+}
@@ -0,0 +1,149 @@
+package rewardserver
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const ClaimMaxGasPerCUFlagName = "claim-max-gas-per-cu"
+
+// ClaimMaxGasPerCU holds claimable relay sessions, batching them across consumers and epochs, until claiming all
+// of them costs at most this much gas per claimed CU. 0 claims relay sessions as soon as they are claimable
+var ClaimMaxGasPerCU float64 = 0
+
+// ClaimFee is the gas and fee of a relay payment transaction
+type ClaimFee struct {
+	GasWanted uint64
+	GasUsed   uint64
+	Fee       sdk.Coin
+}
+
+type claimBatch struct {
+	relaySessions []*pairingtypes.RelaySession
+	expected      ClaimFee
+	estimated     bool
+}
+
+func totalCU(relaySessions []*pairingtypes.RelaySession) (cu uint64) {
+	for _, relaySession := range relaySessions {
+		cu += relaySession.CuSum
+	}
+	return cu
+}
+
+func (rws *RewardServer) claimArgs(relaySessions []*pairingtypes.RelaySession) (description string, latestBlocks []*pairingtypes.LatestBlockReport) {
+	specs := map[string]struct{}{}
+	for _, relay := range relaySessions {
+		specs[relay.SpecId] = struct{}{}
+	}
+	return strconv.FormatUint(rws.serverID, 10), rws.latestBlockReports(specs)
+}
+
+func (rws *RewardServer) estimateClaim(ctx context.Context, relaySessions []*pairingtypes.RelaySession) (ClaimFee, error) {
+	description, latestBlocks := rws.claimArgs(relaySessions)
+	return rws.rewardsTxSender.EstimateRelayPayment(ctx, relaySessions, description, latestBlocks)
+}
+
+// isClaimEfficient checks if claiming the relay sessions in a single batch costs at most ClaimMaxGasPerCU gas per
+// claimed CU. a claim that can't be estimated is sent, the same as before estimating
+func (rws *RewardServer) isClaimEfficient(ctx context.Context, relaySessions []*pairingtypes.RelaySession) bool {
+	if ClaimMaxGasPerCU <= 0 {
+		return true
+	}
+	cu := totalCU(relaySessions)
+	if cu == 0 {
+		return true
+	}
+	estimate, err := rws.estimateClaim(ctx, relaySessions)
+	if err != nil {
+		return true
+	}
+	gasPerCU := float64(estimate.GasWanted) / float64(cu)
+	utils.LavaFormatDebug("estimated rewards claim efficiency",
+		utils.Attribute{Key: "relay_sessions", Value: len(relaySessions)},
+		utils.Attribute{Key: "cu", Value: cu},
+		utils.Attribute{Key: "gas", Value: estimate.GasWanted},
+		utils.Attribute{Key: "gas_per_cu", Value: gasPerCU},
+		utils.Attribute{Key: "max_gas_per_cu", Value: ClaimMaxGasPerCU},
+	)
+	return gasPerCU <= ClaimMaxGasPerCU
+}
+
+// splitClaim splits the relay sessions to batches that fit the block gas limit, halving oversized batches
+func (rws *RewardServer) splitClaim(ctx context.Context, relaySessions []*pairingtypes.RelaySession, maxGas uint64) []claimBatch {
+	estimate, err := rws.estimateClaim(ctx, relaySessions)
+	if err != nil {
+		// can't estimate, the tx will report the error
+		return []claimBatch{{relaySessions: relaySessions}}
+	}
+	if maxGas == 0 || estimate.GasWanted <= maxGas || len(relaySessions) == 1 {
+		return []claimBatch{{relaySessions: relaySessions, expected: estimate, estimated: true}}
+	}
+	half := len(relaySessions) / 2
+	return append(rws.splitClaim(ctx, relaySessions[:half], maxGas), rws.splitClaim(ctx, relaySessions[half:], maxGas)...)
+}
+
+// sendClaims sends the relay sessions in as many relay payment transactions as needed to fit the block gas limit,
+// a failed transaction doesn't stop sending the rest
+func (rws *RewardServer) sendClaims(ctx context.Context, relaySessions []*pairingtypes.RelaySession) error {
+	// keep a consumer's epoch sessions together when splitting
+	sort.SliceStable(relaySessions, func(i, j int) bool {
+		if relaySessions[i].Epoch != relaySessions[j].Epoch {
+			return relaySessions[i].Epoch < relaySessions[j].Epoch
+		}
+		if relaySessions[i].SpecId != relaySessions[j].SpecId {
+			return relaySessions[i].SpecId < relaySessions[j].SpecId
+		}
+		return relaySessions[i].SessionId < relaySessions[j].SessionId
+	})
+	maxGas, err := rws.rewardsTxSender.MaxClaimGas(ctx)
+	if err != nil {
+		utils.LavaFormatWarning("failed fetching block gas limit, sending rewards claim in a single transaction", err)
+		maxGas = 0
+	}
+	batches := rws.splitClaim(ctx, relaySessions, maxGas)
+	if len(batches) > 1 {
+		utils.LavaFormatInfo("rewards claim exceeds the block gas limit, splitting", utils.Attribute{Key: "batches", Value: len(batches)}, utils.Attribute{Key: "max_gas", Value: maxGas})
+	}
+
+	var errRet error
+	for _, batch := range batches {
+		description, latestBlocks := rws.claimArgs(batch.relaySessions)
+		actual, err := rws.rewardsTxSender.TxRelayPayment(ctx, batch.relaySessions, description, latestBlocks)
+		if err != nil {
+			rws.updatePaymentRequestAttempt(batch.relaySessions, false)
+			errRet = err
+			continue
+		}
+		rws.updatePaymentRequestAttempt(batch.relaySessions, true)
+		rws.getEarningsLedger().RecordClaims(batch.relaySessions)
+		rws.reportClaimFee(batch, actual)
+	}
+	return errRet
+}
+
+func (rws *RewardServer) reportClaimFee(batch claimBatch, actual ClaimFee) {
+	attributes := []utils.Attribute{
+		{Key: "relay_sessions", Value: len(batch.relaySessions)},
+		{Key: "cu", Value: totalCU(batch.relaySessions)},
+		{Key: "actual_fee", Value: actual.Fee.String()},
+		{Key: "gas_wanted", Value: actual.GasWanted},
+		{Key: "gas_used", Value: actual.GasUsed},
+	}
+	if batch.estimated {
+		attributes = append(attributes,
+			utils.Attribute{Key: "expected_fee", Value: batch.expected.Fee.String()},
+			utils.Attribute{Key: "expected_gas", Value: batch.expected.GasWanted},
+		)
+	}
+	utils.LavaFormatInfo("rewards claim fee", attributes...)
+	if !batch.estimated || batch.expected.Fee.Amount.IsNil() || actual.Fee.Amount.IsNil() {
+		return
+	}
+	rws.providerMetrics.AddClaimFee(float64(batch.expected.Fee.Amount.Int64()), float64(actual.Fee.Amount.Int64()), batch.expected.GasWanted, actual.GasWanted, actual.GasUsed)
+}
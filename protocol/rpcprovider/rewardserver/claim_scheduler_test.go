@@ -0,0 +1,122 @@
+package rewardserver
+
+import (
+	"context"
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils/rand"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimScheduling(t *testing.T) {
+	rand.InitRandomSeed()
+	ctx := sdk.WrapSDKContext(sdk.NewContext(nil, tmproto.Header{}, false, nil))
+	privKey, acc := sigs.GenerateFloatingKey()
+
+	setupRewardServer := func(t *testing.T, txSender *rewardsTxSenderMock) (rws *RewardServer, claims *[][]*pairingtypes.RelaySession) {
+		rewardDB, err := createInMemoryRewardDb([]string{"spec"})
+		require.NoError(t, err)
+		claims = &[][]*pairingtypes.RelaySession{}
+		txSender.txRelayPaymentCallback = func(_ context.Context, payments []*pairingtypes.RelaySession, _ string, _ []*pairingtypes.LatestBlockReport) error {
+			*claims = append(*claims, payments)
+			txSender.sentPayments = append(txSender.sentPayments, payments...)
+			return nil
+		}
+		return NewRewardServer(txSender, nil, rewardDB, "badger_test", 1, 10, nil), claims
+	}
+	sendProof := func(t *testing.T, rws *RewardServer, sessionId uint64, epoch uint64) {
+		proof := common.BuildRelayRequestWithSession(ctx, "provider", []byte{}, sessionId, 10, "spec", nil)
+		proof.Epoch = int64(epoch)
+		var err error
+		proof.Sig, err = sigs.Sign(privKey, *proof)
+		require.NoError(t, err)
+		_, updated := rws.SendNewProof(context.Background(), proof, epoch, acc.String(), "apiInterface")
+		require.True(t, updated)
+	}
+	setMaxGasPerCU := func(t *testing.T, maxGasPerCU float64) {
+		prev := ClaimMaxGasPerCU
+		ClaimMaxGasPerCU = maxGasPerCU
+		t.Cleanup(func() { ClaimMaxGasPerCU = prev })
+	}
+
+	t.Run("batch until efficient", func(t *testing.T) {
+		// a claim costs 1000 gas and 100 per relay session of 10 CU, 3 sessions or more cost at most 50 gas per CU
+		setMaxGasPerCU(t, 50)
+		txSender := &rewardsTxSenderMock{blockDistance: 20, txGas: 1000, sessionGas: 100}
+		rws, claims := setupRewardServer(t, txSender)
+
+		sendProof(t, rws, 1, 100)
+		rws.UpdateEpoch(120)
+		require.Empty(t, *claims)
+
+		sendProof(t, rws, 2, 110)
+		sendProof(t, rws, 3, 110)
+		rws.UpdateEpoch(130)
+		require.Len(t, *claims, 1)
+		require.Len(t, (*claims)[0], 3)
+		require.Empty(t, rws.rewards)
+	})
+
+	t.Run("never held past the recommended epochs", func(t *testing.T) {
+		setMaxGasPerCU(t, 50)
+		txSender := &rewardsTxSenderMock{blockDistance: 20, txGas: 1000, sessionGas: 100}
+		rws, claims := setupRewardServer(t, txSender)
+
+		sendProof(t, rws, 1, 100)
+		// claimable from epoch 120, held for 20 more blocks
+		rws.UpdateEpoch(120)
+		rws.UpdateEpoch(130)
+		require.Empty(t, *claims)
+		rws.UpdateEpoch(140)
+		require.Len(t, *claims, 1)
+		require.Equal(t, uint64(1), (*claims)[0][0].SessionId)
+	})
+
+	t.Run("held proofs are claimed before the earliest saved epoch", func(t *testing.T) {
+		setMaxGasPerCU(t, 50)
+		txSender := &rewardsTxSenderMock{blockDistance: 20, txGas: 1000, sessionGas: 100, earliestBlockInMemory: 90}
+		rws, claims := setupRewardServer(t, txSender)
+
+		sendProof(t, rws, 1, 100)
+		rws.UpdateEpoch(120)
+		require.Len(t, *claims, 1)
+	})
+
+	t.Run("claims right away without a threshold", func(t *testing.T) {
+		setMaxGasPerCU(t, 0)
+		txSender := &rewardsTxSenderMock{blockDistance: 20, txGas: 1000, sessionGas: 100}
+		rws, claims := setupRewardServer(t, txSender)
+
+		sendProof(t, rws, 1, 100)
+		rws.UpdateEpoch(120)
+		require.Len(t, *claims, 1)
+		require.Equal(t, 1, txSender.estimations) // only estimated for the fee report
+	})
+
+	t.Run("split to fit the block gas limit", func(t *testing.T) {
+		setMaxGasPerCU(t, 0)
+		txSender := &rewardsTxSenderMock{txGas: 1000, sessionGas: 100, maxClaimGas: 1200}
+		rws, claims := setupRewardServer(t, txSender)
+
+		for sessionId := uint64(1); sessionId <= 5; sessionId++ {
+			sendProof(t, rws, sessionId, 100)
+		}
+		rws.UpdateEpoch(100)
+		require.Len(t, txSender.sentPayments, 5)
+		sizes := []int{}
+		sessionIds := []uint64{}
+		for _, claim := range *claims {
+			sizes = append(sizes, len(claim))
+			for _, relaySession := range claim {
+				sessionIds = append(sessionIds, relaySession.SessionId)
+			}
+		}
+		require.Equal(t, []int{2, 1, 2}, sizes)
+		require.Equal(t, []uint64{1, 2, 3, 4, 5}, sessionIds)
+	})
+}
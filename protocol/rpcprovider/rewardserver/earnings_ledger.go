@@ -0,0 +1,392 @@
+package rewardserver
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/utils/slices"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	EarningsLedgerDir        = "earnings"
+	earningsLedgerFileSuffix = ".jsonl"
+
+	LedgerRecordClaim   = "claim"
+	LedgerRecordPayment = "payment"
+
+	EarningsReportFormatJSON = "json"
+	EarningsReportFormatCSV  = "csv"
+)
+
+// LedgerRecord is a line in the earnings ledger: a claimed relay session, or a payment
+// (relay_payment event) that matched a claim of this provider
+type LedgerRecord struct {
+	Type      string    `json:"type"`
+	Time      time.Time `json:"time"`
+	ChainID   string    `json:"chain_id"`
+	Epoch     uint64    `json:"epoch"`
+	Consumer  string    `json:"consumer"`
+	SessionId uint64    `json:"session_id"`
+	CU        uint64    `json:"cu"`
+	RelayNum  uint64    `json:"relay_num,omitempty"`
+	Amount    string    `json:"amount,omitempty"` // payments only
+	Block     int64     `json:"block,omitempty"`  // payments only
+}
+
+// EarningsLedger is a persistent (append only) ledger of the provider's claims and payments.
+// Unlike the reward DB, claimed proofs are kept, so income can be reconciled against serviced CU.
+// It is a plain file so it can be read (e.g. by "rewards report") while the provider runs.
+type EarningsLedger struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+func EarningsLedgerPath(storagePath, providerAddr string, shard uint) string {
+	return filepath.Join(storagePath, providerAddr, EarningsLedgerDir, strconv.FormatUint(uint64(shard), 10)+earningsLedgerFileSuffix)
+}
+
+// EarningsLedgerFiles returns the ledger files of all the shards of a provider
+func EarningsLedgerFiles(storagePath, providerAddr string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(storagePath, providerAddr, EarningsLedgerDir, "*"+earningsLedgerFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func NewEarningsLedger(path string) (*EarningsLedger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &EarningsLedger{file: file}, nil
+}
+
+// RecordClaims adds the relay sessions that were sent in a successful payment request
+func (el *EarningsLedger) RecordClaims(relays []*pairingtypes.RelaySession) {
+	if el == nil {
+		return
+	}
+	now := time.Now().UTC()
+	records := make([]LedgerRecord, 0, len(relays))
+	for _, relay := range relays {
+		consumerAddr, err := sigs.ExtractSignerAddress(relay)
+		if err != nil {
+			utils.LavaFormatWarning("earnings ledger failed extracting consumer address", err, utils.Attribute{Key: "sessionId", Value: relay.SessionId})
+			continue
+		}
+		records = append(records, LedgerRecord{
+			Type:      LedgerRecordClaim,
+			Time:      now,
+			ChainID:   relay.SpecId,
+			Epoch:     uint64(relay.Epoch),
+			Consumer:  consumerAddr.String(),
+			SessionId: relay.SessionId,
+			CU:        relay.CuSum,
+			RelayNum:  relay.RelayNum,
+		})
+	}
+	el.append(records...)
+}
+
+// RecordPayment adds a payment of a claim of this provider
+func (el *EarningsLedger) RecordPayment(payment *PaymentRequest) {
+	if el == nil {
+		return
+	}
+	el.append(LedgerRecord{
+		Type:      LedgerRecordPayment,
+		Time:      time.Now().UTC(),
+		ChainID:   payment.ChainID,
+		Epoch:     payment.PaymentEpoch,
+		Consumer:  payment.Client.String(),
+		SessionId: payment.UniqueIdentifier,
+		CU:        payment.CU,
+		Amount:    payment.Amount.String(),
+		Block:     payment.BlockHeightDeadline,
+	})
+}
+
+func (el *EarningsLedger) append(records ...LedgerRecord) {
+	if len(records) == 0 {
+		return
+	}
+	buf := []byte{}
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			utils.LavaFormatError("earnings ledger failed encoding record", err, utils.Attribute{Key: "record", Value: record})
+			continue
+		}
+		buf = append(append(buf, line...), '\n')
+	}
+
+	el.lock.Lock()
+	defer el.lock.Unlock()
+	// a single write per batch, so readers see whole lines
+	if _, err := el.file.Write(buf); err != nil {
+		utils.LavaFormatError("earnings ledger failed writing records", err, utils.Attribute{Key: "records", Value: len(records)})
+	}
+}
+
+func (el *EarningsLedger) Close() error {
+	if el == nil {
+		return nil
+	}
+	el.lock.Lock()
+	defer el.lock.Unlock()
+	return el.file.Close()
+}
+
+// ReadLedgerRecords reads the records of ledger files. A partially written last line is ignored.
+func ReadLedgerRecords(paths ...string) ([]LedgerRecord, error) {
+	records := []LedgerRecord{}
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for line := 1; scanner.Scan(); line++ {
+			if len(scanner.Bytes()) == 0 {
+				continue
+			}
+			var record LedgerRecord
+			if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+				utils.LavaFormatWarning("skipping invalid earnings ledger line", err, utils.Attribute{Key: "file", Value: path}, utils.Attribute{Key: "line", Value: line})
+				continue
+			}
+			records = append(records, record)
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+// LedgerEntry is a claimed relay session and its payment (if paid)
+type LedgerEntry struct {
+	ChainID      string    `json:"chain_id"`
+	Epoch        uint64    `json:"epoch"`
+	Consumer     string    `json:"consumer"`
+	SessionId    uint64    `json:"session_id"`
+	ClaimedCU    uint64    `json:"claimed_cu"`
+	ClaimedAt    time.Time `json:"claimed_at"`
+	Paid         bool      `json:"paid"`
+	PaidCU       uint64    `json:"paid_cu"`
+	PaidUlava    math.Int  `json:"paid_ulava"`
+	PaidAt       time.Time `json:"paid_at"`
+	PaymentBlock int64     `json:"payment_block,omitempty"`
+}
+
+// LedgerTotals are the totals of the ledger entries of a consumer, chain and epoch
+// (or of all entries, with empty keys)
+type LedgerTotals struct {
+	ChainID        string   `json:"chain_id,omitempty"`
+	Epoch          uint64   `json:"epoch,omitempty"`
+	Consumer       string   `json:"consumer,omitempty"`
+	Sessions       uint64   `json:"sessions"`
+	UnpaidSessions uint64   `json:"unpaid_sessions"`
+	ClaimedCU      uint64   `json:"claimed_cu"`
+	PaidCU         uint64   `json:"paid_cu"`
+	PaidUlava      math.Int `json:"paid_ulava"`
+}
+
+func (lt *LedgerTotals) add(entry LedgerEntry) {
+	lt.Sessions++
+	lt.ClaimedCU += entry.ClaimedCU
+	if !entry.Paid {
+		lt.UnpaidSessions++
+		return
+	}
+	lt.PaidCU += entry.PaidCU
+	lt.PaidUlava = lt.PaidUlava.Add(entry.PaidUlava)
+}
+
+// EarningsReportFilter selects ledger entries, empty fields select all
+type EarningsReportFilter struct {
+	ChainIDs  []string
+	Consumer  string
+	FromEpoch uint64
+	ToEpoch   uint64
+}
+
+func (erf EarningsReportFilter) match(entry LedgerEntry) bool {
+	if len(erf.ChainIDs) > 0 && !slices.Contains(erf.ChainIDs, entry.ChainID) {
+		return false
+	}
+	if erf.Consumer != "" && erf.Consumer != entry.Consumer {
+		return false
+	}
+	if entry.Epoch < erf.FromEpoch || (erf.ToEpoch != 0 && entry.Epoch > erf.ToEpoch) {
+		return false
+	}
+	return true
+}
+
+type EarningsReport struct {
+	Entries []LedgerEntry  `json:"entries"`
+	Totals  []LedgerTotals `json:"totals"` // per consumer, chain and epoch
+	Total   LedgerTotals   `json:"total"`
+}
+
+type ledgerEntryKey struct {
+	chainID   string
+	epoch     uint64
+	consumer  string
+	sessionId uint64
+}
+
+// NewEarningsReport matches the claims and payments of the ledger records, per session
+func NewEarningsReport(records []LedgerRecord, filter EarningsReportFilter) *EarningsReport {
+	entries := map[ledgerEntryKey]*LedgerEntry{}
+	getEntry := func(record LedgerRecord) *LedgerEntry {
+		key := ledgerEntryKey{chainID: record.ChainID, epoch: record.Epoch, consumer: record.Consumer, sessionId: record.SessionId}
+		entry, ok := entries[key]
+		if !ok {
+			entry = &LedgerEntry{ChainID: record.ChainID, Epoch: record.Epoch, Consumer: record.Consumer, SessionId: record.SessionId, PaidUlava: math.ZeroInt()}
+			entries[key] = entry
+		}
+		return entry
+	}
+
+	for _, record := range records {
+		entry := getEntry(record)
+		switch record.Type {
+		case LedgerRecordClaim:
+			// a session can be claimed again (retries), the claim holds its total cu
+			if record.CU >= entry.ClaimedCU {
+				entry.ClaimedCU = record.CU
+				entry.ClaimedAt = record.Time
+			}
+		case LedgerRecordPayment:
+			if entry.Paid {
+				continue
+			}
+			entry.Paid = true
+			entry.PaidCU = record.CU
+			entry.PaidAt = record.Time
+			entry.PaymentBlock = record.Block
+			if amount, err := ulavaAmount(record.Amount); err == nil {
+				entry.PaidUlava = amount
+			} else {
+				utils.LavaFormatWarning("invalid payment amount in earnings ledger", err, utils.Attribute{Key: "amount", Value: record.Amount})
+			}
+		}
+	}
+
+	report := &EarningsReport{Entries: []LedgerEntry{}, Totals: []LedgerTotals{}, Total: LedgerTotals{PaidUlava: math.ZeroInt()}}
+	for _, entry := range entries {
+		if filter.match(*entry) {
+			report.Entries = append(report.Entries, *entry)
+		}
+	}
+	sort.Slice(report.Entries, func(i, j int) bool {
+		a, b := report.Entries[i], report.Entries[j]
+		if a.ChainID != b.ChainID {
+			return a.ChainID < b.ChainID
+		}
+		if a.Epoch != b.Epoch {
+			return a.Epoch < b.Epoch
+		}
+		if a.Consumer != b.Consumer {
+			return a.Consumer < b.Consumer
+		}
+		return a.SessionId < b.SessionId
+	})
+
+	// entries are sorted, so each totals group is contiguous
+	for _, entry := range report.Entries {
+		last := len(report.Totals) - 1
+		if last < 0 || report.Totals[last].ChainID != entry.ChainID || report.Totals[last].Epoch != entry.Epoch || report.Totals[last].Consumer != entry.Consumer {
+			report.Totals = append(report.Totals, LedgerTotals{ChainID: entry.ChainID, Epoch: entry.Epoch, Consumer: entry.Consumer, PaidUlava: math.ZeroInt()})
+			last++
+		}
+		report.Totals[last].add(entry)
+		report.Total.add(entry)
+	}
+	return report
+}
+
+// ulavaAmount returns the ulava amount of a coin string (e.g. "100ulava")
+func ulavaAmount(coin string) (math.Int, error) {
+	if coin == "" {
+		return math.ZeroInt(), nil
+	}
+	parsed, err := sdk.ParseCoinNormalized(coin)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if parsed.Denom != commontypes.TokenDenom {
+		return math.Int{}, fmt.Errorf("unexpected denom %s", parsed.Denom)
+	}
+	return parsed.Amount, nil
+}
+
+// Write writes the report as json, or as csv rows of the sessions (or of the totals)
+func (er *EarningsReport) Write(w io.Writer, format string, totalsOnly bool) error {
+	switch format {
+	case EarningsReportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if totalsOnly {
+			return encoder.Encode(struct {
+				Totals []LedgerTotals `json:"totals"`
+				Total  LedgerTotals   `json:"total"`
+			}{Totals: er.Totals, Total: er.Total})
+		}
+		return encoder.Encode(er)
+	case EarningsReportFormatCSV:
+		writer := csv.NewWriter(w)
+		if totalsOnly {
+			writer.Write([]string{"chain_id", "epoch", "consumer", "sessions", "unpaid_sessions", "claimed_cu", "paid_cu", "paid_ulava"})
+			for _, totals := range er.Totals {
+				writer.Write([]string{
+					totals.ChainID, strconv.FormatUint(totals.Epoch, 10), totals.Consumer,
+					strconv.FormatUint(totals.Sessions, 10), strconv.FormatUint(totals.UnpaidSessions, 10),
+					strconv.FormatUint(totals.ClaimedCU, 10), strconv.FormatUint(totals.PaidCU, 10), totals.PaidUlava.String(),
+				})
+			}
+		} else {
+			writer.Write([]string{"chain_id", "epoch", "consumer", "session_id", "claimed_cu", "claimed_at", "paid", "paid_cu", "paid_ulava", "paid_at", "payment_block"})
+			for _, entry := range er.Entries {
+				paidAt := ""
+				if entry.Paid {
+					paidAt = entry.PaidAt.Format(time.RFC3339)
+				}
+				writer.Write([]string{
+					entry.ChainID, strconv.FormatUint(entry.Epoch, 10), entry.Consumer, strconv.FormatUint(entry.SessionId, 10),
+					strconv.FormatUint(entry.ClaimedCU, 10), entry.ClaimedAt.Format(time.RFC3339), strconv.FormatBool(entry.Paid),
+					strconv.FormatUint(entry.PaidCU, 10), entry.PaidUlava.String(), paidAt, strconv.FormatInt(entry.PaymentBlock, 10),
+				})
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return fmt.Errorf("unsupported report format %q, expected %s or %s", format, EarningsReportFormatJSON, EarningsReportFormatCSV)
+	}
+}
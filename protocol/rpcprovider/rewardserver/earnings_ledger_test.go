@@ -0,0 +1,137 @@
+package rewardserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils/rand"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEarningsLedger(t *testing.T) {
+	rand.InitRandomSeed()
+	storagePath := t.TempDir()
+	ledgerPath := EarningsLedgerPath(storagePath, "provider", 0)
+	ledger, err := NewEarningsLedger(ledgerPath)
+	require.NoError(t, err)
+
+	rewardDB, err := createInMemoryRewardDb([]string{"spec"})
+	require.NoError(t, err)
+	stubRewardsTxSender := rewardsTxSenderMock{}
+	rws := NewRewardServer(&stubRewardsTxSender, nil, rewardDB, "badger_test", 1, 10, nil)
+	rws.SetEarningsLedger(ledger)
+
+	privKey, acc := sigs.GenerateFloatingKey()
+	ctx := sdk.WrapSDKContext(sdk.NewContext(nil, tmproto.Header{}, false, nil))
+	epoch := uint64(1)
+	for _, sessionId := range []uint64{1, 2, 3} {
+		proof := common.BuildRelayRequestWithSession(ctx, "provider", []byte{}, sessionId, sessionId*10, "spec", nil)
+		proof.Epoch = int64(epoch)
+		proof.Sig, err = sigs.Sign(privKey, *proof)
+		require.NoError(t, err)
+		_, _ = rws.SendNewProof(context.Background(), proof, epoch, acc.String(), "apiInterface")
+	}
+	rws.UpdateEpoch(epoch)
+	require.Len(t, stubRewardsTxSender.sentPayments, 3)
+
+	// sessions 1 and 2 are paid, a payment of another reward server is not recorded
+	for _, sessionId := range []uint64{1, 2} {
+		rws.PaymentHandler(&PaymentRequest{
+			CU:                  sessionId * 10,
+			BlockHeightDeadline: 100,
+			PaymentEpoch:        epoch,
+			Amount:              sdk.NewCoin("ulava", math.NewIntFromUint64(sessionId*5)),
+			Client:              acc,
+			UniqueIdentifier:    sessionId,
+			Description:         rws.Description(),
+			ChainID:             "spec",
+		})
+	}
+	rws.PaymentHandler(&PaymentRequest{Client: acc, UniqueIdentifier: 3, ChainID: "spec", Description: strconv.FormatUint(rws.serverID+1, 10)})
+	require.NoError(t, rws.CloseAllDataBases())
+
+	// a partially written line is ignored
+	file, err := os.OpenFile(ledgerPath, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"type":"claim","chain_id":"sp`)
+	require.NoError(t, err)
+	file.Close()
+
+	files, err := EarningsLedgerFiles(storagePath, "provider")
+	require.NoError(t, err)
+	require.Equal(t, []string{ledgerPath}, files)
+	records, err := ReadLedgerRecords(files...)
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+
+	report := NewEarningsReport(records, EarningsReportFilter{})
+	require.Len(t, report.Entries, 3)
+	require.True(t, report.Entries[0].Paid)
+	require.Equal(t, uint64(10), report.Entries[0].ClaimedCU)
+	require.Equal(t, math.NewInt(5), report.Entries[0].PaidUlava)
+	require.False(t, report.Entries[2].Paid)
+	require.Equal(t, []LedgerTotals{{
+		ChainID: "spec", Epoch: epoch, Consumer: acc.String(),
+		Sessions: 3, UnpaidSessions: 1, ClaimedCU: 60, PaidCU: 30, PaidUlava: math.NewInt(15),
+	}}, report.Totals)
+	require.Equal(t, uint64(60), report.Total.ClaimedCU)
+
+	// filters
+	require.Empty(t, NewEarningsReport(records, EarningsReportFilter{ChainIDs: []string{"other"}}).Entries)
+	require.Empty(t, NewEarningsReport(records, EarningsReportFilter{FromEpoch: epoch + 1}).Entries)
+	require.Len(t, NewEarningsReport(records, EarningsReportFilter{Consumer: acc.String(), ToEpoch: epoch}).Entries, 3)
+
+	buf := bytes.Buffer{}
+	require.NoError(t, report.Write(&buf, EarningsReportFormatCSV, false))
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4) // header and sessions
+	require.Equal(t, "session_id", rows[0][3])
+
+	buf.Reset()
+	require.NoError(t, report.Write(&buf, EarningsReportFormatCSV, true))
+	rows, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, []string{"spec", "1", acc.String(), "3", "1", "60", "30", "15"}, rows[1])
+
+	buf.Reset()
+	require.NoError(t, report.Write(&buf, EarningsReportFormatJSON, false))
+	var decoded EarningsReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, report.Totals, decoded.Totals)
+
+	require.Error(t, report.Write(&buf, "xml", false))
+}
+
+func TestRewardsReportCommand(t *testing.T) {
+	storagePath := t.TempDir()
+	cmd := CreateRewardsReportCobraCommand()
+	cmd.SetArgs([]string{"provider", "--" + RewardServerStorageFlagName, storagePath})
+	require.Error(t, cmd.Execute()) // no ledger
+
+	ledger, err := NewEarningsLedger(EarningsLedgerPath(storagePath, "provider", 1))
+	require.NoError(t, err)
+	ledger.RecordPayment(&PaymentRequest{CU: 10, Amount: sdk.NewCoin("ulava", math.NewInt(3)), ChainID: "spec", UniqueIdentifier: 7})
+	require.NoError(t, ledger.Close())
+
+	output := filepath.Join(storagePath, "report.json")
+	cmd = CreateRewardsReportCobraCommand()
+	cmd.SetArgs([]string{"provider", "--" + RewardServerStorageFlagName, storagePath, "--format", "json", "--totals", "--output", output})
+	require.NoError(t, cmd.Execute())
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	var decoded EarningsReport
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, math.NewInt(3), decoded.Total.PaidUlava)
+}
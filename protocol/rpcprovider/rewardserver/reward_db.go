@@ -239,6 +239,8 @@ func (rs *RewardDB) AddDB(db DB) error {
 }
 
 func (rs *RewardDB) DBExists(specId string) bool {
+	rs.lock.RLock()
+	defer rs.lock.RUnlock()
 	_, found := rs.dbs[specId]
 	return found
 }
@@ -89,10 +89,14 @@ type RewardServer struct {
 	rewardsSnapshotThresholdCh     chan struct{}
 	failedRewardsPaymentRequests   map[uint64]*RelaySessionsToRetryAttempts // key is SessionId
 	chainTrackerSpecsInf           ChainTrackerSpecsInf
+	earningsLedger                 *EarningsLedger
+	claimCoordinator               *ClaimCoordinator
 }
 
 type RewardsTxSender interface {
-	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) error
+	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (ClaimFee, error)
+	EstimateRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (ClaimFee, error)
+	MaxClaimGas(ctx context.Context) (uint64, error)
 	GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx context.Context) (uint64, error)
 	EarliestBlockInMemory(ctx context.Context) (uint64, error)
 }
@@ -164,19 +168,15 @@ func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) err
 		return utils.LavaFormatError("sendRewardsClaim failed to get earliest block in memory", err)
 	}
 
+	if claimCoordinator := rws.getClaimCoordinator(); !claimCoordinator.IsLeader() {
+		return rws.forwardRewardsClaim(ctx, claimCoordinator, epoch, earliestSavedEpoch)
+	}
+
 	failedRewardRequestsToRetry := rws.gatherFailedRequestPaymentsToRetry(earliestSavedEpoch)
 	if len(failedRewardRequestsToRetry) > 0 {
-		specs := map[string]struct{}{}
-		for _, relay := range failedRewardRequestsToRetry {
-			specs[relay.SpecId] = struct{}{}
-		}
-
-		err = rws.rewardsTxSender.TxRelayPayment(ctx, failedRewardRequestsToRetry, strconv.FormatUint(rws.serverID, 10), rws.latestBlockReports(specs))
+		err = rws.sendClaims(ctx, failedRewardRequestsToRetry)
 		if err != nil {
-			rws.updatePaymentRequestAttempt(failedRewardRequestsToRetry, false)
 			utils.LavaFormatError("failed sending previously failed payment requests", err)
-		} else {
-			rws.updatePaymentRequestAttempt(failedRewardRequestsToRetry, true)
 		}
 	}
 
@@ -185,7 +185,6 @@ func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) err
 		return err
 	}
 
-	specs := map[string]struct{}{}
 	for _, relay := range rewardsToClaim {
 		consumerAddr, err := sigs.ExtractSignerAddress(relay)
 		if err != nil {
@@ -204,15 +203,12 @@ func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) err
 		}
 		rws.addExpectedPayment(expectedPay)
 		rws.updateCUServiced(relay.CuSum)
-		specs[relay.SpecId] = struct{}{}
 	}
 	if len(rewardsToClaim) > 0 {
-		err = rws.rewardsTxSender.TxRelayPayment(ctx, rewardsToClaim, strconv.FormatUint(rws.serverID, 10), rws.latestBlockReports(specs))
+		err = rws.sendClaims(ctx, rewardsToClaim)
 		if err != nil {
-			rws.updatePaymentRequestAttempt(rewardsToClaim, false)
 			return utils.LavaFormatError("failed sending rewards claim", err)
 		}
-		rws.updatePaymentRequestAttempt(rewardsToClaim, true)
 
 		utils.LavaFormatDebug("Sent rewards claim", utils.Attribute{Key: "number_of_relay_sessions_sent", Value: len(rewardsToClaim)})
 	} else {
@@ -288,17 +284,40 @@ func (rws *RewardServer) RemoveExpectedPayment(paidCUToFInd uint64, expectedClie
 	return false
 }
 
+// gatherRewardsForClaim returns the relay sessions to claim. relay sessions are claimable once their epoch is no longer
+// valid for use, they are held to be claimed together with later ones while claiming them isn't gas efficient,
+// for up to RecommendedEpochNumToCollectPayment epochs
 func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch uint64, earliestSavedEpoch uint64) (rewardsForClaim []*pairingtypes.RelaySession, errRet error) {
+	claimableEpochs, claimables, mustClaim, err := rws.collectClaimableRewards(ctx, currentEpoch, earliestSavedEpoch)
+	if err != nil || len(claimables) == 0 {
+		return nil, err
+	}
+	if !mustClaim && !rws.isClaimEfficient(ctx, claimables) {
+		utils.LavaFormatDebug("holding rewards claim until it is gas efficient", utils.Attribute{Key: "relay_sessions", Value: len(claimables)})
+		return nil, nil
+	}
+	return rws.takeRewardsForClaim(claimableEpochs), nil
+}
+
+// collectClaimableRewards returns the relay sessions of the epochs that are no longer valid for use without removing them,
+// mustClaim is set when some of them can't be held anymore
+func (rws *RewardServer) collectClaimableRewards(ctx context.Context, currentEpoch uint64, earliestSavedEpoch uint64) (claimableEpochs []uint64, claimables []*pairingtypes.RelaySession, mustClaim bool, errRet error) {
 	blockDistanceForEpochValidity, err := rws.rewardsTxSender.GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx)
 	if err != nil {
-		return nil, utils.LavaFormatError("gatherRewardsForClaim failed to GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment", err)
+		return nil, nil, false, utils.LavaFormatError("gatherRewardsForClaim failed to GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment", err)
 	}
 
 	if blockDistanceForEpochValidity > currentEpoch {
-		return nil, utils.LavaFormatWarning("gatherRewardsForClaim current epoch is too low to claim rewards", nil, utils.Attribute{Key: "current epoch", Value: currentEpoch})
+		return nil, nil, false, utils.LavaFormatWarning("gatherRewardsForClaim current epoch is too low to claim rewards", nil, utils.Attribute{Key: "current epoch", Value: currentEpoch})
 	}
 
 	activeEpochThreshold := currentEpoch - blockDistanceForEpochValidity
+	// relay sessions from this epoch and older can't be held anymore
+	claimDeadlineEpoch := earliestSavedEpoch + blockDistanceForEpochValidity
+	if activeEpochThreshold >= blockDistanceForEpochValidity && activeEpochThreshold-blockDistanceForEpochValidity > claimDeadlineEpoch {
+		claimDeadlineEpoch = activeEpochThreshold - blockDistanceForEpochValidity
+	}
+
 	rws.lock.Lock()
 	defer rws.lock.Unlock()
 	for epoch, epochRewards := range rws.rewards {
@@ -317,6 +336,30 @@ func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch
 			continue
 		}
 
+		if epoch <= claimDeadlineEpoch {
+			mustClaim = true
+		}
+		claimableEpochs = append(claimableEpochs, epoch)
+		for _, rewards := range epochRewards.consumerRewards {
+			proofs, err := rewards.PrepareRewardsForClaim()
+			if err != nil {
+				continue
+			}
+			claimables = append(claimables, proofs...)
+		}
+	}
+	return claimableEpochs, claimables, mustClaim, nil
+}
+
+// takeRewardsForClaim removes the relay sessions of the epochs from memory and returns them
+func (rws *RewardServer) takeRewardsForClaim(claimableEpochs []uint64) (rewardsForClaim []*pairingtypes.RelaySession) {
+	rws.lock.Lock()
+	defer rws.lock.Unlock()
+	for _, epoch := range claimableEpochs {
+		epochRewards, ok := rws.rewards[epoch]
+		if !ok {
+			continue
+		}
 		for consumerAddr, rewards := range epochRewards.consumerRewards {
 			claimables, err := rewards.PrepareRewardsForClaim()
 			if err != nil {
@@ -330,7 +373,82 @@ func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch
 			delete(rws.rewards, epoch)
 		}
 	}
-	return rewardsForClaim, errRet
+	return rewardsForClaim
+}
+
+// forwardRewardsClaim sends the claimable relay sessions of a replica that isn't the claim leader to the leader, they
+// are batched there with the leader's own. relay sessions that fail forwarding are kept to be forwarded next epoch
+func (rws *RewardServer) forwardRewardsClaim(ctx context.Context, claimCoordinator *ClaimCoordinator, epoch uint64, earliestSavedEpoch uint64) error {
+	failedRewardRequestsToRetry := rws.gatherFailedRequestPaymentsToRetry(earliestSavedEpoch)
+	claimableEpochs, _, _, err := rws.collectClaimableRewards(ctx, epoch, earliestSavedEpoch)
+	if err != nil {
+		return err
+	}
+	rewardsToForward := rws.takeRewardsForClaim(claimableEpochs)
+	relaySessions := append(append([]*pairingtypes.RelaySession{}, failedRewardRequestsToRetry...), rewardsToForward...)
+	if len(relaySessions) == 0 {
+		utils.LavaFormatDebug("no rewards to forward")
+		return nil
+	}
+
+	err = claimCoordinator.ForwardProofs(ctx, relaySessions)
+	if err != nil {
+		for _, relay := range rewardsToForward {
+			consumerAddr, err := sigs.ExtractSignerAddress(relay)
+			if err != nil {
+				continue
+			}
+			rws.saveProofInMemory(ctx, getKeyForConsumerRewards(relay.SpecId, consumerAddr.String()), relay, uint64(relay.Epoch), consumerAddr.String())
+		}
+		return utils.LavaFormatError("failed forwarding rewards claim to the claim leader", err)
+	}
+
+	// the leader owns the forwarded relay sessions now, restoring them here would claim them twice
+	rws.updatePaymentRequestAttempt(failedRewardRequestsToRetry, true)
+	rws.lock.Lock()
+	for _, relay := range relaySessions {
+		rws.deleteRelaySessionFromRewardDB(relay)
+	}
+	rws.lock.Unlock()
+	utils.LavaFormatDebug("Forwarded rewards claim to the claim leader", utils.Attribute{Key: "number_of_relay_sessions_sent", Value: len(relaySessions)})
+	return nil
+}
+
+// ReceiveForwardedProofs saves the relay sessions forwarded by a replica that isn't the claim leader, to be claimed
+// with this replica's relay sessions. the sessions are only saved if they are all of served specs, and signed by
+// consumers paired with the provider
+func (rws *RewardServer) ReceiveForwardedProofs(ctx context.Context, relaySessions []*pairingtypes.RelaySession, providerAddress string, pairingVerifier PairingVerifier) error {
+	if pairingVerifier == nil {
+		return utils.LavaFormatError("can't verify forwarded proofs without a pairing verifier", nil)
+	}
+	// all the relay sessions are verified before storing any of them
+	consumers := make([]string, len(relaySessions))
+	for idx, relay := range relaySessions {
+		if relay.Provider != providerAddress {
+			return utils.LavaFormatWarning("forwarded relay session of a different provider", nil, utils.Attribute{Key: "provider", Value: relay.Provider}, utils.Attribute{Key: "expected", Value: providerAddress})
+		}
+		// the replicas serve the same specs, a reward db is added for each served spec on startup
+		if !rws.rewardDB.DBExists(relay.SpecId) {
+			return utils.LavaFormatWarning("forwarded relay session of a spec this provider doesn't serve", nil, utils.Attribute{Key: "specId", Value: relay.SpecId})
+		}
+		consumerAddr, err := sigs.ExtractSignerAddress(relay)
+		if err != nil {
+			return utils.LavaFormatWarning("invalid consumer address extraction from forwarded relay", err, utils.Attribute{Key: "sessionId", Value: relay.SessionId})
+		}
+		valid, _, _, err := pairingVerifier.VerifyPairing(ctx, consumerAddr.String(), providerAddress, uint64(relay.Epoch), relay.SpecId)
+		if err != nil || !valid {
+			return utils.LavaFormatWarning("forwarded relay session of a consumer not paired with the provider", err,
+				utils.Attribute{Key: "consumer", Value: consumerAddr.String()},
+				utils.Attribute{Key: "epoch", Value: relay.Epoch},
+				utils.Attribute{Key: "specId", Value: relay.SpecId},
+			)
+		}
+		consumers[idx] = consumerAddr.String()
+	}
+	for idx, relay := range relaySessions {
+		rws.SendNewProof(ctx, relay, uint64(relay.Epoch), consumers[idx], "")
+	}
+	return nil
 }
 
 func (rws *RewardServer) SubscribeStarted(consumer string, epoch uint64, subscribeID string) {
@@ -349,7 +467,7 @@ func (rws *RewardServer) updateCUPaid(cu uint64) {
 	atomic.AddUint64(&rws.totalCUPaid, cu)
 }
 
-func (rws *RewardServer) AddDataBase(specId string, providerPublicAddress string, shardID uint) {
+func (rws *RewardServer) AddDataBase(specId string, providerPublicAddress string, shardID uint) error {
 	// the db itself doesn't need locks. as it self manages locks inside.
 	// but opening a db can race. (NewLocalDB) so we lock this method.
 	// Also, we construct the in-memory rewards from the DB, so that needs a lock as well
@@ -357,15 +475,59 @@ func (rws *RewardServer) AddDataBase(specId string, providerPublicAddress string
 	defer rws.lock.Unlock()
 	found := rws.rewardDB.DBExists(specId)
 	if !found {
-		rws.rewardDB.AddDB(NewLocalDB(rws.rewardStoragePath, providerPublicAddress, specId, shardID))
+		db, err := NewLocalDB(rws.rewardStoragePath, providerPublicAddress, specId, shardID)
+		if err != nil {
+			return err
+		}
+		err = rws.rewardDB.AddDB(db)
+		if err != nil {
+			return err
+		}
 		rws.restoreRewardsFromDB(specId)
 	}
+	return nil
+}
+
+// SaveRewardsSnapshot saves the proofs held in memory to the reward DB right away, so proofs gathered since the last
+// snapshot survive a shutdown
+func (rws *RewardServer) SaveRewardsSnapshot() {
+	rws.resetSnapshotTimerAndSaveRewardsSnapshotToDB()
 }
 
 func (rws *RewardServer) CloseAllDataBases() error {
+	if err := rws.getEarningsLedger().Close(); err != nil {
+		utils.LavaFormatWarning("failed closing earnings ledger", err)
+	}
 	return rws.rewardDB.Close()
 }
 
+// SetEarningsLedger sets the ledger that records claimed relay sessions and their payments
+func (rws *RewardServer) SetEarningsLedger(earningsLedger *EarningsLedger) {
+	rws.lock.Lock()
+	defer rws.lock.Unlock()
+	rws.earningsLedger = earningsLedger
+}
+
+func (rws *RewardServer) getEarningsLedger() *EarningsLedger {
+	rws.lock.RLock()
+	defer rws.lock.RUnlock()
+	return rws.earningsLedger
+}
+
+// SetClaimCoordinator makes the reward server claim only while its replica is the claim leader, and forward its proofs
+// to the leader otherwise
+func (rws *RewardServer) SetClaimCoordinator(claimCoordinator *ClaimCoordinator) {
+	rws.lock.Lock()
+	defer rws.lock.Unlock()
+	rws.claimCoordinator = claimCoordinator
+}
+
+func (rws *RewardServer) getClaimCoordinator() *ClaimCoordinator {
+	rws.lock.RLock()
+	defer rws.lock.RUnlock()
+	return rws.claimCoordinator
+}
+
 func (rws *RewardServer) Description() string {
 	return strconv.FormatUint(rws.serverID, 10)
 }
@@ -379,6 +541,7 @@ func (rws *RewardServer) PaymentHandler(payment *PaymentRequest) {
 	if serverID == rws.serverID {
 		rws.updateCUPaid(payment.CU)
 		go rws.providerMetrics.AddPayment(payment.ChainID, payment.CU)
+		rws.getEarningsLedger().RecordPayment(payment)
 		removedPayment := rws.RemoveExpectedPayment(payment.CU, payment.Client, payment.BlockHeightDeadline, payment.UniqueIdentifier, payment.ChainID)
 		if !removedPayment {
 			utils.LavaFormatWarning("tried removing payment that wasn't expected", nil, utils.Attribute{Key: "payment", Value: payment})
@@ -436,6 +599,10 @@ func (rws *RewardServer) resetSnapshotTimerAndSaveRewardsSnapshotToDB() {
 	for epoch, epochRewards := range rws.rewards {
 		for consumerRewardKey, consumerRewards := range epochRewards.consumerRewards {
 			for sessionId, proof := range consumerRewards.proofs {
+				if !rws.rewardDB.DBExists(proof.SpecId) {
+					// the db of the spec isn't open yet, such as while a previous process still owns it after a restart
+					continue
+				}
 				rewardEntity := &RewardEntity{
 					Epoch:        epoch,
 					ConsumerAddr: consumerRewards.consumer,
@@ -502,9 +669,20 @@ func (rws *RewardServer) restoreRewardsFromDB(specId string) (err error) {
 		}
 
 		// ConsumerRewardsKey is made from a combination of specId + apiInterface + consumerAddress.
-		// So if it's a different DB, it's a different specId, which also means different consumerRewards
+		// proofs of the spec can already be in memory when the db opens after relays were served, such as after a
+		// restart, so the proof with the higher cu of each session is kept
 		for consumerRewardsKey, consumerRewardsFromDb := range epochRewardsFromDb.consumerRewards {
-			epochRewards.consumerRewards[consumerRewardsKey] = consumerRewardsFromDb
+			consumerRewards, ok := epochRewards.consumerRewards[consumerRewardsKey]
+			if !ok {
+				epochRewards.consumerRewards[consumerRewardsKey] = consumerRewardsFromDb
+				continue
+			}
+			for sessionId, proofFromDb := range consumerRewardsFromDb.proofs {
+				proof, ok := consumerRewards.proofs[sessionId]
+				if !ok || proof.CuSum < proofFromDb.CuSum {
+					consumerRewards.proofs[sessionId] = proofFromDb
+				}
+			}
 		}
 	}
 
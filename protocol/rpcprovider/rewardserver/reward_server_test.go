@@ -466,6 +466,35 @@ func TestRestoreRewardsFromDB(t *testing.T) {
 	require.Equal(t, 2, len(stubRewardsTxSender.sentPayments))
 }
 
+func TestRestoreRewardsFromDBMergesProofsInMemory(t *testing.T) {
+	rand.InitRandomSeed()
+	const providerAddr = "providerAddr"
+	const spec = "spec1"
+
+	rewardDB, err := createInMemoryRewardDb([]string{spec})
+	require.NoError(t, err)
+	rws := NewRewardServer(&rewardsTxSenderMock{}, nil, rewardDB, "badger_test", 1000, 1000, nil)
+
+	epoch := uint64(1)
+	ctx := sdk.WrapSDKContext(sdk.NewContext(nil, tmproto.Header{}, false, nil))
+	for _, sessionId := range []uint64{1, 2} {
+		rws.SendNewProof(context.TODO(), common.BuildRelayRequestWithSession(ctx, providerAddr, []byte{}, sessionId, uint64(10), spec, nil), epoch, "consumerAddress", "apiInterface")
+	}
+	// proofs of a spec without an open db don't fail the snapshot
+	rws.SendNewProof(context.TODO(), common.BuildRelayRequestWithSession(ctx, providerAddr, []byte{}, 1, uint64(10), "spec2", nil), epoch, "consumerAddress", "apiInterface")
+	rws.SaveRewardsSnapshot()
+
+	// the process taking over serves relays before it opens the db
+	rws = NewRewardServer(&rewardsTxSenderMock{}, nil, rewardDB, "badger_test", 1000, 1000, nil)
+	rws.SendNewProof(context.TODO(), common.BuildRelayRequestWithSession(ctx, providerAddr, []byte{}, 1, uint64(20), spec, nil), epoch, "consumerAddress", "apiInterface")
+	require.NoError(t, rws.restoreRewardsFromDB(spec))
+
+	proofs := rws.rewards[epoch].consumerRewards[getKeyForConsumerRewards(spec, "consumerAddress")].proofs
+	require.Len(t, proofs, 2)
+	require.Equal(t, uint64(20), proofs[1].CuSum)
+	require.Equal(t, uint64(10), proofs[2].CuSum)
+}
+
 func TestFailedPaymentRequestAttemptsHappyFlow(t *testing.T) {
 	rand.InitRandomSeed()
 	const providerAddr = "providerAddr"
@@ -579,10 +608,12 @@ func BenchmarkSendNewProofLocal(b *testing.B) {
 	os.RemoveAll("badger_test")
 
 	ctx := sdk.WrapSDKContext(sdk.NewContext(nil, tmproto.Header{}, false, nil))
-	db1 := NewLocalDB("badger_test", "provider", "spec", 0)
-	db2 := NewLocalDB("badger_test", "provider", "spec2", 0)
+	db1, err := NewLocalDB("badger_test", "provider", "spec", 0)
+	require.NoError(b, err)
+	db2, err := NewLocalDB("badger_test", "provider", "spec2", 0)
+	require.NoError(b, err)
 	rewardStore := NewRewardDB()
-	err := rewardStore.AddDB(db1)
+	err = rewardStore.AddDB(db1)
 	require.NoError(b, err)
 
 	err = rewardStore.AddDB(db2)
@@ -622,6 +653,11 @@ type rewardsTxSenderMock struct {
 	earliestBlockInMemory  uint64
 	sentPayments           []*pairingtypes.RelaySession
 	txRelayPaymentCallback func(context.Context, []*pairingtypes.RelaySession, string, []*pairingtypes.LatestBlockReport) error
+	blockDistance          uint64
+	txGas                  uint64
+	sessionGas             uint64
+	maxClaimGas            uint64
+	estimations            int
 }
 
 func (rts *rewardsTxSenderMock) defaultTxRelayPaymentCallback(_ context.Context, payments []*pairingtypes.RelaySession, _ string, _ []*pairingtypes.LatestBlockReport) error {
@@ -632,16 +668,33 @@ func (rts *rewardsTxSenderMock) defaultTxRelayPaymentCallback(_ context.Context,
 
 func (rts *rewardsTxSenderMock) TxRelayPayment(ctx context.Context, payments []*pairingtypes.RelaySession,
 	description string, latestBlocks []*pairingtypes.LatestBlockReport,
-) error {
+) (ClaimFee, error) {
 	if rts.txRelayPaymentCallback != nil {
-		return rts.txRelayPaymentCallback(ctx, payments, description, latestBlocks)
+		return rts.claimFee(payments), rts.txRelayPaymentCallback(ctx, payments, description, latestBlocks)
 	}
 
-	return rts.defaultTxRelayPaymentCallback(ctx, payments, description, latestBlocks)
+	return rts.claimFee(payments), rts.defaultTxRelayPaymentCallback(ctx, payments, description, latestBlocks)
+}
+
+func (rts *rewardsTxSenderMock) EstimateRelayPayment(_ context.Context, payments []*pairingtypes.RelaySession,
+	_ string, _ []*pairingtypes.LatestBlockReport,
+) (ClaimFee, error) {
+	rts.estimations++
+	return rts.claimFee(payments), nil
+}
+
+// claimFee is a fixed tx overhead and a fixed gas per relay session
+func (rts *rewardsTxSenderMock) claimFee(payments []*pairingtypes.RelaySession) ClaimFee {
+	gas := rts.txGas + rts.sessionGas*uint64(len(payments))
+	return ClaimFee{GasWanted: gas, GasUsed: gas / 3, Fee: sdk.NewCoin("ulava", sdk.NewIntFromUint64(gas))}
+}
+
+func (rts *rewardsTxSenderMock) MaxClaimGas(_ context.Context) (uint64, error) {
+	return rts.maxClaimGas, nil
 }
 
 func (rts *rewardsTxSenderMock) GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(_ context.Context) (uint64, error) {
-	return 0, nil
+	return rts.blockDistance, nil
 }
 
 func (rts *rewardsTxSenderMock) EarliestBlockInMemory(_ context.Context) (uint64, error) {
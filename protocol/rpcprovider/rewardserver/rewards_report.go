@@ -0,0 +1,109 @@
+package rewardserver
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	earningsReportFormatFlag = "format"
+	earningsReportOutputFlag = "output"
+	earningsReportTotalsFlag = "totals"
+	earningsReportChainsFlag = "chain-ids"
+	earningsReportConsumer   = "consumer"
+	earningsReportFromEpoch  = "from-epoch"
+	earningsReportToEpoch    = "to-epoch"
+)
+
+func CreateRewardsCobraCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "Provider rewards tools",
+	}
+	cmd.AddCommand(CreateRewardsReportCobraCommand())
+	return cmd
+}
+
+func CreateRewardsReportCobraCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report [provider-address]",
+		Short: "Export the provider earnings ledger (claimed relay sessions and their payments)",
+		Long: `Reads the earnings ledger that the provider's reward server keeps in its storage path, matches every
+claimed relay session with its payment, and exports them per consumer, chain and epoch with totals in ulava,
+to reconcile on-chain income against serviced CU. Sessions without a payment are reported as unpaid.`,
+		Example: `lavap rewards report lava@1abc... --format csv --totals --output earnings.csv
+lavap rewards report lava@1abc... --reward-server-storage .storage/rewardserver --chain-ids ETH1 --from-epoch 1000`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			storagePath, err := flags.GetString(RewardServerStorageFlagName)
+			if err != nil {
+				return err
+			}
+			format, err := flags.GetString(earningsReportFormatFlag)
+			if err != nil {
+				return err
+			}
+			output, err := flags.GetString(earningsReportOutputFlag)
+			if err != nil {
+				return err
+			}
+			totalsOnly, err := flags.GetBool(earningsReportTotalsFlag)
+			if err != nil {
+				return err
+			}
+			filter := EarningsReportFilter{}
+			chainIDs, err := flags.GetString(earningsReportChainsFlag)
+			if err != nil {
+				return err
+			}
+			if chainIDs != "" {
+				filter.ChainIDs = strings.Split(chainIDs, ",")
+			}
+			if filter.Consumer, err = flags.GetString(earningsReportConsumer); err != nil {
+				return err
+			}
+			if filter.FromEpoch, err = flags.GetUint64(earningsReportFromEpoch); err != nil {
+				return err
+			}
+			if filter.ToEpoch, err = flags.GetUint64(earningsReportToEpoch); err != nil {
+				return err
+			}
+
+			files, err := EarningsLedgerFiles(storagePath, args[0])
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("no earnings ledger found for provider %s in %s", args[0], storagePath)
+			}
+			records, err := ReadLedgerRecords(files...)
+			if err != nil {
+				return err
+			}
+			report := NewEarningsReport(records, filter)
+
+			if output == "" {
+				return report.Write(cmd.OutOrStdout(), format, totalsOnly)
+			}
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			return report.Write(file, format, totalsOnly)
+		},
+	}
+	cmd.Flags().String(RewardServerStorageFlagName, DefaultRewardServerStorage, "the path of the reward server data (as set for the provider)")
+	cmd.Flags().String(earningsReportFormatFlag, EarningsReportFormatCSV, "report format: csv or json")
+	cmd.Flags().String(earningsReportOutputFlag, "", "output file (default: stdout)")
+	cmd.Flags().Bool(earningsReportTotalsFlag, false, "report only the totals per consumer, chain and epoch")
+	cmd.Flags().String(earningsReportChainsFlag, "", "comma separated chain IDs to report (default: all)")
+	cmd.Flags().String(earningsReportConsumer, "", "consumer address to report (default: all)")
+	cmd.Flags().Uint64(earningsReportFromEpoch, 0, "first epoch to report")
+	cmd.Flags().Uint64(earningsReportToEpoch, 0, "last epoch to report (default: latest)")
+	return cmd
+}
@@ -24,9 +24,11 @@ import (
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/rpcprovider/auditlog"
 	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
 	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
 	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/protocol/upgrade"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
@@ -58,7 +60,9 @@ type ProviderStateTrackerInf interface {
 	RegisterReliabilityManagerForVoteUpdates(ctx context.Context, voteUpdatable statetracker.VoteUpdatable, endpointP *lavasession.RPCProviderEndpoint)
 	RegisterForEpochUpdates(ctx context.Context, epochUpdatable statetracker.EpochUpdatable)
 	RegisterForDowntimeParamsUpdates(ctx context.Context, downtimeParamsUpdatable statetracker.DowntimeParamsUpdatable) error
-	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) error
+	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (rewardserver.ClaimFee, error)
+	EstimateRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (rewardserver.ClaimFee, error)
+	MaxClaimGas(ctx context.Context) (uint64, error)
 	SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error
 	SendVoteCommitment(voteID string, vote *reliabilitymanager.VoteData) error
 	LatestBlock() int64
@@ -89,16 +93,46 @@ type RPCProvider struct {
 	cache                  *performance.Cache
 	shardID                uint // shardID is a flag that allows setting up multiple provider databases of the same chain
 	chainTrackers          *ChainTrackers
+	relayThrottlerConfig   RelayThrottlerConfig
+	auditLogger            *auditlog.AuditLogger
+	drainer                *ProviderDrainer
+	rewardStorageReady     chan struct{} // closed once the provider owns the reward storage and can open reward dbs
+	shardLock              *rewardserver.ShardLock
+	claimCoordinator       *rewardserver.ClaimCoordinator
 }
 
-func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, cache *performance.Cache, parallelConnections uint, metricsListenAddress string, rewardStoragePath string, rewardTTL time.Duration, shardID uint, rewardsSnapshotThreshold uint, rewardsSnapshotTimeoutSec uint) (err error) {
+func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, cache *performance.Cache, parallelConnections uint, metricsListenAddress string, rewardStoragePath string, rewardTTL time.Duration, shardID uint, rewardsSnapshotThreshold uint, rewardsSnapshotTimeoutSec uint, relayThrottlerConfig RelayThrottlerConfig, claimCoordinationConfig rewardserver.ClaimCoordinationConfig, auditLogger *auditlog.AuditLogger, drainConfig DrainConfig) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
+	drainSignalChan := make(chan os.Signal, 1)
+	restartSignalChan := make(chan os.Signal, 1)
+	notifyShutdownSignals(drainSignalChan, restartSignalChan)
+	var handoff *listenerHandoff
 	defer func() {
 		signal.Stop(signalChan)
+		signal.Stop(drainSignalChan)
+		signal.Stop(restartSignalChan)
 		cancel()
+		if handoff != nil {
+			handoff.close()
+		}
 	}()
+	// a process started by a listener handoff serves the listeners right away, and takes over the reward storage and
+	// the admin endpoint once the previous process finished its relays and released them
+	release := takeHandoffRelease()
+	rpcp.rewardStorageReady = make(chan struct{})
+	rpcp.drainer = NewProviderDrainer()
+	shutdownRequests := make(chan shutdownMode, 1)
+	stopAdmin := func() {}
+	startAdmin := func() {
+		if drainConfig.AdminListenAddress != "" {
+			stopAdmin = rpcp.drainer.serveAdmin(ctx, drainConfig.AdminListenAddress, shutdownRequests)
+		}
+	}
+	if release == nil {
+		startAdmin()
+	}
 	rpcp.chainTrackers = &ChainTrackers{}
 	rpcp.parallelConnections = parallelConnections
 	rpcp.cache = cache
@@ -106,6 +140,11 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 	rpcp.providerMetricsManager.SetVersion(upgrade.GetCurrentVersion().ProviderVersion)
 	rpcp.rpcProviderListeners = make(map[string]*ProviderListener)
 	rpcp.shardID = shardID
+	if release != nil {
+		rpcp.shardID = release.shardID
+	}
+	rpcp.relayThrottlerConfig = relayThrottlerConfig
+	rpcp.auditLogger = auditLogger
 	// single state tracker
 	lavaChainFetcher := chainlib.NewLavaChainFetcher(ctx, clientCtx)
 	providerStateTracker, err := statetracker.NewProviderStateTracker(ctx, txFactory, clientCtx, lavaChainFetcher, rpcp.providerMetricsManager)
@@ -125,8 +164,6 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 	// single reward server
 	rewardDB := rewardserver.NewRewardDBWithTTL(rewardTTL)
 	rpcp.rewardServer = rewardserver.NewRewardServer(providerStateTracker, rpcp.providerMetricsManager, rewardDB, rewardStoragePath, rewardsSnapshotThreshold, rewardsSnapshotTimeoutSec, rpcp.chainTrackers)
-	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, rpcp.rewardServer)
-	rpcp.providerStateTracker.RegisterPaymentUpdatableForPayments(ctx, rpcp.rewardServer)
 	keyName, err := sigs.GetKeyName(clientCtx)
 	if err != nil {
 		utils.LavaFormatFatal("failed getting key name from clientCtx", err)
@@ -149,6 +186,16 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 		utils.LavaFormatFatal("failed unmarshaling public address", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "pubkey", Value: pubKey.Address()})
 	}
 	utils.LavaFormatInfo("RPCProvider pubkey: " + rpcp.addr.String())
+	if release == nil {
+		rpcp.setupRewardStorage(ctx, nil, rewardStoragePath, claimCoordinationConfig)
+		close(rpcp.rewardStorageReady)
+	} else {
+		go func() {
+			rpcp.setupRewardStorage(ctx, release, rewardStoragePath, claimCoordinationConfig)
+			startAdmin()
+			close(rpcp.rewardStorageReady)
+		}()
+	}
 	utils.LavaFormatInfo("RPCProvider setting up endpoints", utils.Attribute{Key: "count", Value: strconv.Itoa(len(rpcProviderEndpoints))})
 	blockMemorySize, err := rpcp.providerStateTracker.GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx) // get the number of blocks to keep in PSM.
 	if err != nil {
@@ -181,28 +228,185 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 		utils.LavaFormatInfo("[+] all endpoints up and running")
 	}
 	// tearing down
+	mode := shutdownImmediate
 	select {
 	case <-ctx.Done():
 		utils.LavaFormatInfo("Provider Server ctx.Done")
 	case <-signalChan:
 		utils.LavaFormatInfo("Provider Server signalChan")
+	case <-drainSignalChan:
+		mode = shutdownDrain
+	case <-restartSignalChan:
+		mode = shutdownRestart
+	case mode = <-shutdownRequests:
 	}
+	utils.LavaFormatInfo("Provider shutting down", utils.Attribute{Key: "mode", Value: mode.String()})
 
-	for _, listener := range rpcp.rpcProviderListeners {
-		shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), 10*time.Second)
-		listener.Shutdown(shutdownCtx)
-		defer shutdownRelease()
+	if mode == shutdownRestart {
+		// the new process serves the listener sockets together with this one until this one stops accepting
+		handoff = rpcp.startListenerHandoff()
+		if handoff == nil {
+			mode = shutdownDrain
+		}
+	}
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), drainConfig.Timeout)
+	defer drainCancel()
+	if mode != shutdownImmediate {
+		go func() {
+			// an interrupt while draining stops waiting for the relays in flight
+			select {
+			case <-signalChan:
+				utils.LavaFormatInfo("Provider drain interrupted")
+				drainCancel()
+			case <-drainCtx.Done():
+			}
+		}()
+	}
+	shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), 10*time.Second)
+	defer shutdownRelease()
+	switch mode {
+	case shutdownDrain:
+		rpcp.drainer.drainAndWait(drainCtx)
+	case shutdownRestart:
+		// not draining, consumers would block the provider for the epoch while the new process serves them.
+		// the listeners stop accepting and the relays on the open connections finish within the drain timeout
+		rpcp.drainer.Handoff()
+		shutdownCtx = drainCtx
+	}
+	rpcp.shutdownListeners(shutdownCtx)
+	if mode == shutdownRestart {
+		// relays served before the listeners shut down still save their proofs
+		if err := rpcp.drainer.WaitForInFlight(drainCtx); err == nil {
+			utils.LavaFormatInfo("Provider finished the relays in flight")
+		}
+	}
+	select {
+	case <-rpcp.rewardStorageReady:
+	default:
+		// the proofs can only be saved, and the reward storage handed over, once this process owns it
+		utils.LavaFormatInfo("Provider waiting for the previous process to release the reward storage before shutting down")
+		<-rpcp.rewardStorageReady
 	}
 
-	// close all reward dbs
+	// save the proofs gathered since the last snapshot and close all reward dbs
+	rpcp.rewardServer.SaveRewardsSnapshot()
 	err = rpcp.rewardServer.CloseAllDataBases()
 	if err != nil {
 		utils.LavaFormatError("failed to close reward db", err)
 	}
+	if handoff != nil {
+		// everything the new process takes over is released before handing it over
+		cancel()
+		rpcp.claimCoordinator.Wait()
+		stopAdmin()
+	}
+	rpcp.shardLock.Release()
+	if handoff != nil {
+		handoff.release()
+	}
+	return nil
+}
+
+// setupRewardStorage takes over the reward storage, the shard lock, the claim coordination and the earnings ledger,
+// the reward dbs of the endpoints open once rewardStorageReady is closed after it. a process started by a listener
+// handoff waits for the previous process to release them first
+func (rpcp *RPCProvider) setupRewardStorage(ctx context.Context, release *handoffRelease, rewardStoragePath string, claimCoordinationConfig rewardserver.ClaimCoordinationConfig) {
+	if release != nil {
+		release.wait()
+	}
+	if claimCoordinationConfig.Enabled() {
+		if claimCoordinationConfig.AutoShard {
+			var shardLock *rewardserver.ShardLock
+			var err error
+			if release != nil {
+				// the shard id of the previous process is already set
+				shardLock, err = rewardserver.LockShard(rewardStoragePath, rpcp.addr.String(), release.shardID)
+			} else {
+				shardLock, err = rewardserver.AcquireShard(rewardStoragePath, rpcp.addr.String(), rewardserver.MaxAutoAssignedShards)
+				if err == nil {
+					rpcp.shardID = shardLock.ShardID
+				}
+			}
+			if err != nil {
+				utils.LavaFormatFatal("failed acquiring a reward db shard", err)
+			}
+			rpcp.shardLock = shardLock
+			utils.LavaFormatInfo("RPCProvider acquired reward db shard", utils.Attribute{Key: "shard", Value: rpcp.shardID})
+		}
+		claimLock, err := rewardserver.NewClaimLock(claimCoordinationConfig.LockURI)
+		if err != nil {
+			utils.LavaFormatFatal("failed creating the rewards claim lock", err)
+		}
+		claimCoordinator := rewardserver.NewClaimCoordinator(claimLock, rpcp.addr.String(), rpcp.shardID, claimCoordinationConfig.ForwardAddress, claimCoordinationConfig.ForwardSecret, rpcp.providerStateTracker)
+		rpcp.rewardServer.SetClaimCoordinator(claimCoordinator)
+		err = claimCoordinator.ServeForwardedProofs(ctx, rpcp.rewardServer, claimCoordinationConfig.ListenAddress)
+		if err != nil {
+			utils.LavaFormatFatal("failed serving rewards claim forwarding", err, utils.Attribute{Key: "address", Value: claimCoordinationConfig.ListenAddress})
+		}
+		claimCoordinator.Start(ctx)
+		rpcp.claimCoordinator = claimCoordinator
+	}
+	earningsLedger, err := rewardserver.NewEarningsLedger(rewardserver.EarningsLedgerPath(rewardStoragePath, rpcp.addr.String(), rpcp.shardID))
+	if err != nil {
+		utils.LavaFormatError("failed opening earnings ledger, claims and payments will not be recorded", err)
+	} else {
+		rpcp.rewardServer.SetEarningsLedger(earningsLedger)
+	}
+	// rewards are claimed once the claim coordination is set up, the previous process claims until it released it
+	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, rpcp.rewardServer)
+	rpcp.providerStateTracker.RegisterPaymentUpdatableForPayments(ctx, rpcp.rewardServer)
+}
 
+// addRewardDataBase opens the reward db of the chain once the provider owns the reward storage
+func (rpcp *RPCProvider) addRewardDataBase(chainID string) error {
+	select {
+	case <-rpcp.rewardStorageReady:
+		return rpcp.rewardServer.AddDataBase(chainID, rpcp.addr.String(), rpcp.shardID)
+	default:
+	}
+	// the proofs of the relays served until then are kept in memory and merged with the db when it opens
+	go func() {
+		<-rpcp.rewardStorageReady
+		if err := rpcp.rewardServer.AddDataBase(chainID, rpcp.addr.String(), rpcp.shardID); err != nil {
+			utils.LavaFormatError("failed adding reward db", err, utils.Attribute{Key: "chainID", Value: chainID})
+		}
+	}()
 	return nil
 }
 
+// startListenerHandoff starts a new provider process serving copies of the listener sockets, nil if it can't
+func (rpcp *RPCProvider) startListenerHandoff() *listenerHandoff {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	handoff, err := newListenerHandoff(rpcp.rpcProviderListeners, rpcp.shardID)
+	if err != nil {
+		utils.LavaFormatError("can't hand off the listeners, draining without a restart", err)
+		return nil
+	}
+	err = handoff.startProcess()
+	if err != nil {
+		utils.LavaFormatError("can't start the new provider process, draining without a restart", err)
+		handoff.close()
+		return nil
+	}
+	return handoff
+}
+
+// shutdownListeners stops all listeners from accepting together, and waits for their requests in flight until shutdownCtx is done
+func (rpcp *RPCProvider) shutdownListeners(shutdownCtx context.Context) {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	var wg sync.WaitGroup
+	for _, listener := range rpcp.rpcProviderListeners {
+		wg.Add(1)
+		go func(listener *ProviderListener) {
+			defer wg.Done()
+			listener.Shutdown(shutdownCtx)
+		}(listener)
+	}
+	wg.Wait()
+}
+
 func getActiveEndpoints(rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, disabledEndpointsList []*lavasession.RPCProviderEndpoint) []*lavasession.RPCProviderEndpoint {
 	activeEndpoints := map[*lavasession.RPCProviderEndpoint]struct{}{}
 	for _, endpoint := range rpcProviderEndpoints {
@@ -294,11 +498,11 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	}
 
 	// in order to utilize shared resources between chains we need go routines with the same chain to wait for one another here
+	var chainFetcher chainlib.ChainFetcherIf
 	chainCommonSetup := func() error {
 		rpcp.chainMutexes[chainID].Lock()
 		defer rpcp.chainMutexes[chainID].Unlock()
 
-		var chainFetcher chainlib.ChainFetcherIf
 		if enabled, _ := chainParser.DataReliabilityParams(); enabled {
 			chainFetcher = chainlib.NewChainFetcher(ctx, chainRouter, chainParser, rpcProviderEndpoint, rpcp.cache)
 		} else {
@@ -362,10 +566,17 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	rpcp.providerStateTracker.RegisterReliabilityManagerForVoteUpdates(ctx, reliabilityManager, rpcProviderEndpoint)
 
 	// add a database for this chainID if does not exist.
-	rpcp.rewardServer.AddDataBase(rpcProviderEndpoint.ChainID, rpcp.addr.String(), rpcp.shardID)
+	err = rpcp.addRewardDataBase(rpcProviderEndpoint.ChainID)
+	if err != nil {
+		return utils.LavaFormatError("failed adding reward db", err, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID})
+	}
+
+	// report the spec verifications to probes asking for them (e.g. conformance tests)
+	verificationsRunner, _ := chainFetcher.(chainlib.VerificationsRunner)
+	probeVerifications := NewProbeVerifications(verificationsRunner, ProbeVerificationsCacheTTL, ProbeVerificationsCallerInterval)
 
 	rpcProviderServer := &RPCProviderServer{}
-	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics)
+	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics, NewRelayThrottler(rpcp.relayThrottlerConfig), probeVerifications, rpcp.auditLogger, rpcp.drainer)
 	// set up grpc listener
 	var listener *ProviderListener
 	func() {
@@ -547,6 +758,7 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 
 			utils.LavaFormatInfo("lavap Binary Version: " + upgrade.GetCurrentVersion().ProviderVersion)
 			rand.InitRandomSeed()
+			common.RelayCompressions = common.ParseCompressions(common.RelayCompressions)
 			var cache *performance.Cache = nil
 			cacheAddr := viper.GetString(performance.CacheFlagName)
 			if cacheAddr != "" {
@@ -566,7 +778,7 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 			}
 			stickinessHeaderName := viper.GetString(StickinessHeaderName)
 			if stickinessHeaderName != "" {
-				RPCProviderStickinessHeaderName = stickinessHeaderName
+				chainlib.RPCProviderStickinessHeaderName = stickinessHeaderName
 			}
 			prometheusListenAddr := viper.GetString(metrics.MetricsListenFlagName)
 			rewardStoragePath := viper.GetString(rewardserver.RewardServerStorageFlagName)
@@ -574,10 +786,46 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 			shardID := viper.GetUint(ShardIDFlagName)
 			rewardsSnapshotThreshold := viper.GetUint(rewardserver.RewardsSnapshotThresholdFlagName)
 			rewardsSnapshotTimeoutSec := viper.GetUint(rewardserver.RewardsSnapshotTimeoutSecFlagName)
+			relayThrottlerConfig := RelayThrottlerConfig{
+				MaxConcurrentRelays:     viper.GetUint(MaxConcurrentRelaysFlagName),
+				RelayQueueTimeout:       viper.GetDuration(RelayQueueTimeoutFlagName),
+				ConsumerRelaysPerSecond: viper.GetFloat64(ConsumerRelaysPerSecondFlagName),
+				ConsumerRelaysBurst:     viper.GetUint(ConsumerRelaysBurstFlagName),
+				ConsumerCuPerSecond:     viper.GetFloat64(ConsumerCuPerSecondFlagName),
+				ConsumerCuBurst:         viper.GetUint64(ConsumerCuBurstFlagName),
+			}
+			claimCoordinationConfig := rewardserver.ClaimCoordinationConfig{
+				LockURI:        viper.GetString(rewardserver.ClaimLockFlagName),
+				ListenAddress:  viper.GetString(rewardserver.ClaimForwardListenAddressFlagName),
+				ForwardAddress: viper.GetString(rewardserver.ClaimForwardAddressFlagName),
+				AutoShard:      !cmd.Flags().Changed(ShardIDFlagName),
+			}
+			if claimCoordinationConfig.Enabled() {
+				claimCoordinationConfig.ForwardSecret, err = rewardserver.ReadClaimForwardSecret(viper.GetString(rewardserver.ClaimForwardSecretFileFlagName))
+				if err != nil {
+					return err
+				}
+			}
+			auditLogger := auditlog.NewAuditLogger(auditlog.Config{
+				Path:       viper.GetString(auditlog.AuditLogFlagName),
+				MaxSize:    viper.GetInt(auditlog.AuditLogMaxSizeFlagName),
+				MaxBackups: viper.GetInt(auditlog.AuditLogMaxBackupsFlagName),
+				MaxAge:     viper.GetInt(auditlog.AuditLogMaxAgeFlagName),
+			})
+			defer auditLogger.Close()
+			drainConfig := DrainConfig{
+				Timeout:            viper.GetDuration(DrainTimeoutFlagName),
+				AdminListenAddress: viper.GetString(AdminListenAddressFlagName),
+			}
+			shutdownTracing, err := tracing.InitTracing(ctx, "rpcprovider", viper.GetString(tracing.OtlpEndpointFlagName), viper.GetBool(tracing.OtlpInsecureFlagName), viper.GetFloat64(tracing.TracingSampleRatioFlagName))
+			if err != nil {
+				return err
+			}
+			defer shutdownTracing(context.Background())
 			rpcProvider := RPCProvider{}
 			err = rpcProvider.Start(
 				ctx, txFactory, clientCtx, rpcProviderEndpoints, cache, numberOfNodeParallelConnections, prometheusListenAddr,
-				rewardStoragePath, rewardTTL, shardID, rewardsSnapshotThreshold, rewardsSnapshotTimeoutSec)
+				rewardStoragePath, rewardTTL, shardID, rewardsSnapshotThreshold, rewardsSnapshotTimeoutSec, relayThrottlerConfig, claimCoordinationConfig, auditLogger, drainConfig)
 			return err
 		},
 	}
@@ -593,15 +841,36 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.Flags().Uint(chainproxy.ParallelConnectionsFlag, chainproxy.NumberOfParallelConnections, "parallel connections")
 	cmdRPCProvider.Flags().String(flags.FlagLogLevel, "debug", "log level")
 	cmdRPCProvider.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
+	cmdRPCProvider.Flags().String(tracing.OtlpEndpointFlagName, "", "OTLP grpc endpoint to export relay traces to (such as a local collector on localhost:4317), tracing is disabled when empty")
+	cmdRPCProvider.Flags().Bool(tracing.OtlpInsecureFlagName, false, "connect to the OTLP endpoint without TLS")
+	cmdRPCProvider.Flags().Float64(tracing.TracingSampleRatioFlagName, 1, "ratio of relays to trace when exporting traces, a sampled remote parent can't force sampling: relays traced by the consumer are traced by the provider only if the provider's ratio is at least the consumer's")
 	cmdRPCProvider.Flags().String(rewardserver.RewardServerStorageFlagName, rewardserver.DefaultRewardServerStorage, "the path to store reward server data")
 	cmdRPCProvider.Flags().Duration(rewardserver.RewardTTLFlagName, rewardserver.DefaultRewardTTL, "reward time to live")
 	cmdRPCProvider.Flags().Uint(ShardIDFlagName, DefaultShardID, "shard id")
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotThresholdFlagName, rewardserver.DefaultRewardsSnapshotThreshold, "the number of rewards to wait until making snapshot of the rewards memory")
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotTimeoutSecFlagName, rewardserver.DefaultRewardsSnapshotTimeoutSec, "the seconds to wait until making snapshot of the rewards memory")
-	cmdRPCProvider.Flags().String(StickinessHeaderName, RPCProviderStickinessHeaderName, "the name of the header to be attacked to requests for stickiness by consumer, used for consistency")
+	cmdRPCProvider.Flags().Float64Var(&rewardserver.ClaimMaxGasPerCU, rewardserver.ClaimMaxGasPerCUFlagName, rewardserver.ClaimMaxGasPerCU, "hold claimable relay sessions and batch them across consumers and epochs until claiming them costs at most this much gas per claimed CU, sessions are never held for more than the recommended epochs to collect payment (0 claims right away)")
+	cmdRPCProvider.Flags().String(rewardserver.ClaimLockFlagName, "", "coordinate the replicas of the provider address so only one of them claims rewards, the others forward their proofs to it. a lock file path shared by the replicas, or a uri of a registered lock backend (empty disables coordination). without --"+ShardIDFlagName+" each replica is assigned a free shard")
+	cmdRPCProvider.Flags().String(rewardserver.ClaimForwardListenAddressFlagName, rewardserver.DefaultClaimForwardListenAddress, "the address the claim leader receives forwarded proofs on, replicas on different hosts need an address reachable by the others")
+	cmdRPCProvider.Flags().String(rewardserver.ClaimForwardAddressFlagName, "", "the address the other replicas reach --"+rewardserver.ClaimForwardListenAddressFlagName+" on (default: the listen address)")
+	cmdRPCProvider.Flags().String(rewardserver.ClaimForwardSecretFileFlagName, "", "a file holding the secret shared by the replicas to authenticate forwarded proofs, required with --"+rewardserver.ClaimLockFlagName)
+	cmdRPCProvider.Flags().String(StickinessHeaderName, chainlib.RPCProviderStickinessHeaderName, "the name of the header to be attacked to requests for stickiness by consumer, used for consistency")
 	cmdRPCProvider.Flags().Uint64Var(&chaintracker.PollingMultiplier, chaintracker.PollingMultiplierFlagName, 1, "when set, forces the chain tracker to poll more often, improving the sync at the cost of more queries")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationInterval, SpecValidationIntervalFlagName, SpecValidationInterval, "determines the interval of which to run validation on the spec for all connected chains")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationIntervalDisabledChains, SpecValidationIntervalDisabledChainsFlagName, SpecValidationIntervalDisabledChains, "determines the interval of which to run validation on the spec for all disabled chains, determines recovery time")
+	cmdRPCProvider.Flags().Uint(MaxConcurrentRelaysFlagName, 0, "maximum number of relays served concurrently per endpoint, excess relays are queued fairly between consumers (0 means unlimited)")
+	cmdRPCProvider.Flags().Duration(RelayQueueTimeoutFlagName, DefaultRelayQueueTimeout, "how long a queued relay waits for a free slot before it is throttled")
+	cmdRPCProvider.Flags().Float64(ConsumerRelaysPerSecondFlagName, 0, "maximum relays per second a single consumer can send per endpoint (0 means unlimited)")
+	cmdRPCProvider.Flags().Uint(ConsumerRelaysBurstFlagName, 0, "relays a consumer can burst above its per second limit (0 means one second worth)")
+	cmdRPCProvider.Flags().Float64(ConsumerCuPerSecondFlagName, 0, "maximum compute units per second a single consumer can use per endpoint (0 means unlimited)")
+	cmdRPCProvider.Flags().Uint64(ConsumerCuBurstFlagName, 0, "compute units a consumer can burst above its per second limit (0 means one second worth)")
+	cmdRPCProvider.Flags().String(auditlog.AuditLogFlagName, "", "record every served relay (request, reply hash and signature, blocks and consumer) to this file, for disputes and replaying with audit-replay (empty disables)")
+	cmdRPCProvider.Flags().Int(auditlog.AuditLogMaxSizeFlagName, auditlog.DefaultAuditLogMaxSize, "audit log max size in MB before it is rotated")
+	cmdRPCProvider.Flags().Int(auditlog.AuditLogMaxBackupsFlagName, auditlog.DefaultAuditLogMaxBackups, "rotated audit log files to keep")
+	cmdRPCProvider.Flags().Int(auditlog.AuditLogMaxAgeFlagName, auditlog.DefaultAuditLogMaxAge, "days to keep rotated audit log files")
+	cmdRPCProvider.Flags().Duration(DrainTimeoutFlagName, DefaultDrainTimeout, "how long the provider waits for relays and subscriptions in flight before shutting down, when draining (SIGTERM or an admin drain request) or restarting with a listener handoff (SIGUSR2 or an admin restart request), keep it below the grace period of the orchestrator stopping the provider")
+	cmdRPCProvider.Flags().String(AdminListenAddressFlagName, "", "address of the admin endpoint to drain (POST /drain) or restart with a listener handoff (POST /restart) the provider, such as localhost:7780 (empty disables)")
+	common.AddRelayCompressionFlags(cmdRPCProvider)
 	common.AddRollingLogConfig(cmdRPCProvider)
 	return cmdRPCProvider
 }
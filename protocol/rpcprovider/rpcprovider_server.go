@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -22,12 +23,15 @@ import (
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
 	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/protocol/rpcprovider/auditlog"
+	"github.com/lavanet/lava/protocol/tracing"
 	"github.com/lavanet/lava/protocol/upgrade"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/utils/slices"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	"go.opentelemetry.io/otel/attribute"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -38,8 +42,6 @@ const (
 	debugLatency     = false
 )
 
-var RPCProviderStickinessHeaderName = "X-Node-Sticky"
-
 type RPCProviderServer struct {
 	cache                     *performance.Cache
 	chainRouter               chainlib.ChainRouter
@@ -54,6 +56,10 @@ type RPCProviderServer struct {
 	lavaChainID               string
 	allowedMissingCUThreshold float64
 	metrics                   *metrics.ProviderMetrics
+	relayThrottler            *RelayThrottler
+	probeVerifications        *ProbeVerifications
+	auditLogger               *auditlog.AuditLogger
+	drainer                   *ProviderDrainer
 }
 
 type ReliabilityManagerInf interface {
@@ -88,6 +94,10 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	lavaChainID string,
 	allowedMissingCUThreshold float64,
 	providerMetrics *metrics.ProviderMetrics,
+	relayThrottler *RelayThrottler,
+	probeVerifications *ProbeVerifications,
+	auditLogger *auditlog.AuditLogger,
+	drainer *ProviderDrainer,
 ) {
 	rpcps.cache = cache
 	rpcps.chainRouter = chainRouter
@@ -102,13 +112,36 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	rpcps.lavaChainID = lavaChainID
 	rpcps.allowedMissingCUThreshold = allowedMissingCUThreshold
 	rpcps.metrics = providerMetrics
+	rpcps.relayThrottler = relayThrottler
+	rpcps.probeVerifications = probeVerifications
+	rpcps.auditLogger = auditLogger
+	rpcps.drainer = drainer
 }
 
 // function used to handle relay requests from a consumer, it is called by a provider_listener by calling RegisterReceiver
-func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (relayReply *pairingtypes.RelayReply, errRet error) {
 	if request.RelayData == nil || request.RelaySession == nil {
 		return nil, utils.LavaFormatWarning("invalid relay request, internal fields are nil", nil)
 	}
+	// a draining provider finishes the relays in flight but doesn't admit new ones
+	relayDone, err := rpcps.drainer.Admit()
+	if err != nil {
+		return nil, rpcps.handleRelayErrorStatus(err)
+	}
+	defer relayDone()
+	// continue the trace of the consumer that sent the relay
+	ctx, span := tracing.StartSpan(tracing.ExtractFromIncomingContext(ctx), "rpcprovider.Relay",
+		attribute.String("chain_id", rpcps.rpcProviderEndpoint.ChainID),
+		attribute.String("api_interface", rpcps.rpcProviderEndpoint.ApiInterface),
+		attribute.Int64("session_id", int64(request.RelaySession.SessionId)),
+		attribute.Int64("relay_num", int64(request.RelaySession.RelayNum)),
+	)
+	defer func() { tracing.EndSpan(span, errRet) }()
+	// the consumer signed the uncompressed relay data, restore it before anything verifies or uses it
+	err = rpcps.decompressRelayData(ctx, request)
+	if err != nil {
+		return nil, err
+	}
 	ctx = utils.AppendUniqueIdentifier(ctx, lavaprotocol.GetSalt(request.RelayData))
 	startTime := time.Now()
 	// This is for the SDK, since the timeout is not automatically added to the request like in Go
@@ -139,9 +172,22 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 	if err != nil {
 		return nil, rpcps.handleRelayErrorStatus(err)
 	}
+	span.SetAttributes(attribute.String("method", chainMessage.GetApi().Name), attribute.String("consumer", consumerAddress.String()))
+
+	// admission control, a throttled relay is a failed relay so the consumer can retry it on another provider
+	releaseThrottler, err := rpcps.relayThrottler.Acquire(ctx, consumerAddress.String(), chainMessage.GetApi().ComputeUnits)
+	if err != nil {
+		relayFailureError := rpcps.providerSessionManager.OnSessionFailure(relaySession, request.RelaySession.RelayNum)
+		if relayFailureError != nil {
+			utils.LavaFormatError("OnSessionFailure failed on throttled relay", relayFailureError, utils.Attribute{Key: "GUID", Value: ctx})
+		}
+		go rpcps.metrics.AddError()
+		return nil, rpcps.handleRelayErrorStatus(err)
+	}
 
 	// Try sending relay
 	reply, err := rpcps.TryRelay(ctx, request, consumerAddress, chainMessage)
+	releaseThrottler()
 
 	if err != nil || common.ContextOutOfTime(ctx) {
 		// failed to send relay. we need to adjust session state. cuSum and relayNumber.
@@ -174,7 +220,7 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 			// Therefore the signature changes, so we need the original copy to extract the address from it.
 			// we want this code to run in parallel so it doesn't stop the flow
 
-			go rpcps.SendProof(ctx, pairingEpoch, request, consumerAddress, chainMessage.GetApiCollection().CollectionData.ApiInterface)
+			go rpcps.sendTrackedProof(ctx, pairingEpoch, request, consumerAddress, chainMessage.GetApiCollection().CollectionData.ApiInterface, rpcps.drainer.Track())
 			utils.LavaFormatDebug("Provider Finished Relay Successfully",
 				utils.Attribute{Key: "request.SessionId", Value: request.RelaySession.SessionId},
 				utils.Attribute{Key: "request.relayNumber", Value: request.RelaySession.RelayNum},
@@ -185,6 +231,9 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 			)
 		}
 	}
+	if err == nil {
+		rpcps.compressReply(ctx, reply)
+	}
 	utils.LavaFormatDebug("Provider returned a relay response",
 		utils.Attribute{Key: "GUID", Value: ctx},
 		utils.Attribute{Key: "request.SessionId", Value: request.RelaySession.SessionId},
@@ -277,6 +326,12 @@ func (rpcps *RPCProviderServer) RelaySubscribe(request *pairingtypes.RelayReques
 	if request.RelayData == nil || request.RelaySession == nil {
 		return utils.LavaFormatError("invalid relay subscribe request, internal fields are nil", nil)
 	}
+	// a subscription is in flight until it ends, so draining waits for open subscriptions as well
+	relayDone, err := rpcps.drainer.Admit()
+	if err != nil {
+		return rpcps.handleRelayErrorStatus(err)
+	}
+	defer relayDone()
 	ctx := utils.AppendUniqueIdentifier(context.Background(), lavaprotocol.GetSalt(request.RelayData))
 	utils.LavaFormatDebug("Provider got relay subscribe request",
 		utils.Attribute{Key: "request.SessionId", Value: request.RelaySession.SessionId},
@@ -288,12 +343,21 @@ func (rpcps *RPCProviderServer) RelaySubscribe(request *pairingtypes.RelayReques
 	if err != nil {
 		return rpcps.handleRelayErrorStatus(err)
 	}
+	// subscriptions are long lived so they don't hold a concurrency slot, only the consumer rate limits apply
+	err = rpcps.relayThrottler.CheckRateLimit(ctx, consumerAddress.String(), chainMessage.GetApi().ComputeUnits)
+	if err != nil {
+		relayFailureError := rpcps.providerSessionManager.OnSessionFailure(relaySession, request.RelaySession.RelayNum)
+		if relayFailureError != nil {
+			utils.LavaFormatError("OnSessionFailure failed on throttled subscription", relayFailureError, utils.Attribute{Key: "GUID", Value: ctx})
+		}
+		return rpcps.handleRelayErrorStatus(err)
+	}
 	subscribed, err := rpcps.TryRelaySubscribe(ctx, uint64(request.RelaySession.Epoch), srv, chainMessage, consumerAddress, relaySession, request.RelaySession.RelayNum) // this function does not return until subscription ends
 	if subscribed {
 		// meaning we created a subscription and used it for at least a message
 		pairingEpoch := relaySession.PairingEpoch
 		// no need to perform on session done as we did it in try relay subscribe
-		go rpcps.SendProof(ctx, pairingEpoch, request, consumerAddress, chainMessage.GetApiCollection().CollectionData.ApiInterface)
+		go rpcps.sendTrackedProof(ctx, pairingEpoch, request, consumerAddress, chainMessage.GetApiCollection().CollectionData.ApiInterface, rpcps.drainer.Track())
 		utils.LavaFormatDebug("Provider Finished Relay Successfully",
 			utils.Attribute{Key: "request.SessionId", Value: request.RelaySession.SessionId},
 			utils.Attribute{Key: "request.relayNumber", Value: request.RelaySession.RelayNum},
@@ -322,6 +386,12 @@ func (rpcps *RPCProviderServer) SendProof(ctx context.Context, epoch uint64, req
 	return nil
 }
 
+// sendTrackedProof sends the proof of a relay in the background, the drainer waits for it so the proof is saved before shutting down
+func (rpcps *RPCProviderServer) sendTrackedProof(ctx context.Context, epoch uint64, request *pairingtypes.RelayRequest, consumerAddress sdk.AccAddress, apiInterface string, done func()) {
+	defer done()
+	rpcps.SendProof(ctx, epoch, request, consumerAddress, apiInterface)
+}
+
 func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBlockHeight uint64, srv pairingtypes.Relayer_RelaySubscribeServer, chainMessage chainlib.ChainMessage, consumerAddress sdk.AccAddress, relaySession *lavasession.SingleProviderSession, relayNumber uint64) (subscribed bool, errRet error) {
 	var reply *pairingtypes.RelayReply
 	var clientSub *rpcclient.ClientSubscription
@@ -357,6 +427,10 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 		return false, err
 	}
 	rpcps.rewardServer.SubscribeStarted(consumerAddress.String(), requestBlockHeight, subscriptionID)
+	// the subscribe relay pays for the first reply, every message a grpc server stream sends after it is charged to the session
+	meterMessages := chainlib.IsGrpcServerStream(chainMessage)
+	messageCU := chainMessage.GetApi().ComputeUnits
+	virtualEpoch := rpcps.stateTracker.GetVirtualEpoch(requestBlockHeight)
 	processSubscribeMessages := func() (subscribed bool, errRet error) {
 		err = srv.Send(reply) // this reply contains the RPC ID
 		if err != nil {
@@ -367,15 +441,27 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 
 		for {
 			select {
-			case <-clientSub.Err():
-				utils.LavaFormatError("client sub", err, utils.Attribute{Key: "GUID", Value: ctx})
+			case err := <-clientSub.Err():
+				if err != nil {
+					utils.LavaFormatError("client sub", err, utils.Attribute{Key: "GUID", Value: ctx})
+				}
 				// delete this connection from the subs map
 
 				return subscribed, err
 			case subscribeReply := <-subscribeRepliesChan:
-				data, err := json.Marshal(subscribeReply)
-				if err != nil {
-					return subscribed, utils.LavaFormatError("client sub unmarshal", err, utils.Attribute{Key: "GUID", Value: ctx})
+				// grpc streams forward the node's raw messages
+				data, ok := subscribeReply.([]byte)
+				if !ok {
+					data, err = json.Marshal(subscribeReply)
+					if err != nil {
+						return subscribed, utils.LavaFormatError("client sub unmarshal", err, utils.Attribute{Key: "GUID", Value: ctx})
+					}
+				}
+				if meterMessages {
+					err = relaySession.AddSubscriptionMessageCU(ctx, messageCU, virtualEpoch)
+					if err != nil {
+						return subscribed, utils.LavaFormatWarning("subscription message exceeds the consumer's compute units", err, utils.Attribute{Key: "GUID", Value: ctx})
+					}
 				}
 
 				err = srv.Send(
@@ -576,11 +662,17 @@ func (rpcps *RPCProviderServer) handleRelayErrorStatus(err error) error {
 		err = status.Error(codes.Code(lavasession.SessionOutOfSyncError.ABCICode()), err.Error())
 	} else if lavasession.EpochMismatchError.Is(err) {
 		err = status.Error(codes.Code(lavasession.EpochMismatchError.ABCICode()), err.Error())
+	} else if lavasession.ProviderRelayThrottledError.Is(err) {
+		err = status.Error(codes.Code(lavasession.ProviderRelayThrottledError.ABCICode()), err.Error())
+	} else if lavasession.ProviderDrainingError.Is(err) {
+		err = status.Error(codes.Code(lavasession.ProviderDrainingError.ABCICode()), err.Error())
 	}
 	return err
 }
 
 func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingtypes.RelayRequest, consumerAddr sdk.AccAddress, chainMsg chainlib.ChainMessage) (*pairingtypes.RelayReply, error) {
+	// the consumer's requested block, before data reliability replaces relative blocks
+	requestBlock := request.RelayData.RequestBlock
 	errV := rpcps.ValidateRequest(chainMsg, request, ctx)
 	if errV != nil {
 		return nil, errV
@@ -662,6 +754,7 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 			utils.LavaFormatWarning("cache not connected", err, utils.Attribute{Key: "GUID", Value: ctx})
 		}
 	}
+	cached := err == nil && reply != nil
 	if err != nil || reply == nil {
 		// we need to send relay, cache miss or invalid
 		sendTime := time.Now()
@@ -669,7 +762,7 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 			utils.LavaFormatDebug("sending relay to node", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "specID", Value: rpcps.rpcProviderEndpoint.ChainID})
 		}
 		// add stickiness header
-		chainMsg.AppendHeader([]pairingtypes.Metadata{{Name: RPCProviderStickinessHeaderName, Value: common.GetUniqueToken(consumerAddr.String(), common.GetTokenFromGrpcContext(ctx))}})
+		chainMsg.AppendHeader([]pairingtypes.Metadata{{Name: chainlib.RPCProviderStickinessHeaderName, Value: common.GetUniqueToken(consumerAddr.String(), common.GetTokenFromGrpcContext(ctx))}})
 		if debugConsistency {
 			utils.LavaFormatDebug("adding stickiness header", utils.LogAttr("tokenFromContext", common.GetTokenFromGrpcContext(ctx)), utils.LogAttr("unique_token", common.GetUniqueToken(consumerAddr.String(), common.GetIpFromGrpcContext(ctx))))
 		}
@@ -731,6 +824,14 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 	if err != nil {
 		return nil, err
 	}
+	if rpcps.auditLogger.Enabled() {
+		record := auditlog.NewRelayRecord(rpcps.rpcProviderEndpoint.ChainID, rpcps.rpcProviderEndpoint.ApiInterface, rpcps.providerAddress.String(),
+			consumerAddr.String(), chainMsg.GetApi().Name, requestBlock, request, reply, cached)
+		if guid, found := utils.GetUniqueIdentifier(ctx); found {
+			record.GUID = strconv.FormatUint(guid, 10)
+		}
+		rpcps.auditLogger.LogRelay(record)
+	}
 	reply.Metadata = append(reply.Metadata, ignoredMetadata...) // appended here only after signing
 	// return reply to user
 	return reply, nil
@@ -912,6 +1013,10 @@ func (rpcps *RPCProviderServer) processUnsubscribe(ctx context.Context, apiName
 }
 
 func (rpcps *RPCProviderServer) Probe(ctx context.Context, probeReq *pairingtypes.ProbeRequest) (*pairingtypes.ProbeReply, error) {
+	if rpcps.drainer.Draining() {
+		// consumers block a draining provider for the epoch, so their optimizers stop choosing it
+		return nil, rpcps.handleRelayErrorStatus(sdkerrors.Wrapf(lavasession.ProviderDrainingError, "probe of %s", rpcps.rpcProviderEndpoint.Key()))
+	}
 	latestB, _ := rpcps.reliabilityManager.GetLatestBlockNum()
 	probeReply := &pairingtypes.ProbeReply{
 		Guid:                  probeReq.GetGuid(),
@@ -920,11 +1025,69 @@ func (rpcps *RPCProviderServer) Probe(ctx context.Context, probeReq *pairingtype
 		LavaEpoch:             rpcps.providerSessionManager.GetCurrentEpochAtomic(),
 		LavaLatestBlock:       uint64(rpcps.stateTracker.LatestBlock()),
 	}
+	if probeReq.GetWithVerification() {
+		probeReply.Verifications = rpcps.probeVerifications.Get(ctx, probeCaller(ctx))
+	}
 	trailer := metadata.Pairs(common.VersionMetadataKey, upgrade.GetCurrentVersion().ProviderVersion)
+	if len(common.RelayCompressions) > 0 {
+		trailer.Set(common.RelayCompressionsMetadataKey, strings.Join(common.RelayCompressions, ","))
+	}
 	grpc.SetTrailer(ctx, trailer) // we ignore this error here since this code can be triggered not from grpc
 	return probeReply, nil
 }
 
+// probeCaller identifies the caller of a probe by its ip, so it can't get around the rate limits by using other ports
+func probeCaller(ctx context.Context) string {
+	caller := common.GetIpFromGrpcContext(ctx)
+	if host, _, err := net.SplitHostPort(caller); err == nil {
+		return host
+	}
+	return caller
+}
+
+func (rpcps *RPCProviderServer) decompressRelayData(ctx context.Context, request *pairingtypes.RelayRequest) error {
+	incomingMetaData, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return nil
+	}
+	compressions := incomingMetaData.Get(common.RelayDataCompressionMetadataKey)
+	if len(compressions) == 0 {
+		return nil
+	}
+	data, err := common.DecompressData(compressions[0], request.RelayData.Data)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid relay request, failed decompressing relay data", err, utils.Attribute{Key: "compression", Value: compressions[0]})
+	}
+	request.RelayData.Data = data
+	return nil
+}
+
+// compressReply compresses the signed reply data when the consumer accepts a compression we support, the consumer
+// decompresses it before verifying the signature
+func (rpcps *RPCProviderServer) compressReply(ctx context.Context, reply *pairingtypes.RelayReply) {
+	if reply == nil {
+		return
+	}
+	incomingMetaData, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return
+	}
+	compression := common.ShouldCompress(reply.Data, common.ParseCompressions(incomingMetaData.Get(common.RelayCompressionsMetadataKey)))
+	if compression == "" {
+		return
+	}
+	data, err := common.CompressData(compression, reply.Data)
+	if err != nil {
+		utils.LavaFormatWarning("failed compressing relay reply, sending it uncompressed", err, utils.Attribute{Key: "GUID", Value: ctx})
+		return
+	}
+	if len(data) >= len(reply.Data) {
+		return
+	}
+	reply.Data = data
+	grpc.SetTrailer(ctx, metadata.Pairs(common.ReplyDataCompressionMetadataKey, compression)) // we ignore this error here since this code can be triggered not from grpc
+}
+
 func (rpcps *RPCProviderServer) tryGetTimeoutFromRequest(ctx context.Context) (time.Duration, bool, error) {
 	incomingMetaData, found := metadata.FromIncomingContext(ctx)
 	if !found {
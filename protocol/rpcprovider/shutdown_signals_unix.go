@@ -0,0 +1,16 @@
+//go:build !windows
+// +build !windows
+
+package rpcprovider
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyShutdownSignals relays SIGTERM as a request to drain, and SIGUSR2 as a request to restart with the same listeners
+func notifyShutdownSignals(drainSignals chan<- os.Signal, restartSignals chan<- os.Signal) {
+	signal.Notify(drainSignals, syscall.SIGTERM)
+	signal.Notify(restartSignals, syscall.SIGUSR2)
+}
@@ -0,0 +1,15 @@
+//go:build windows
+// +build windows
+
+package rpcprovider
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyShutdownSignals relays SIGTERM as a request to drain, listeners can't be handed off to a new process on windows
+func notifyShutdownSignals(drainSignals chan<- os.Signal, restartSignals chan<- os.Signal) {
+	signal.Notify(drainSignals, syscall.SIGTERM)
+}
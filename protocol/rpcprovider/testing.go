@@ -3,7 +3,9 @@ package rpcprovider
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
@@ -22,6 +24,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	"github.com/lavanet/lava/utils/sigs"
+	lavaslices "github.com/lavanet/lava/utils/slices"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	pairingcli "github.com/lavanet/lava/x/pairing/client/cli"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -101,7 +104,149 @@ func validateCORSHeaders(resp *http.Response) error {
 	return nil
 }
 
-func startTesting(ctx context.Context, clientCtx client.Context, txFactory tx.Factory, providerEntries []epochstoragetypes.StakeEntry) error {
+// conformanceMaxProvidersToCompare is the number of other providers probed to compare latest blocks to
+const conformanceMaxProvidersToCompare = 5
+
+var errNoProvidersToCompare = errors.New("no other providers to compare latest block to")
+
+// addVerificationChecks adds a check per spec verification reported by the provider,
+// and returns the names of the verifications that failed
+func addVerificationChecks(report *ConformanceReport, base ConformanceCheck, verifications []*pairingtypes.Verification) (failed []string) {
+	if len(verifications) == 0 {
+		check := base
+		check.Name = "verifications"
+		check.Status = ConformanceStatusSkipped
+		check.Message = "provider did not report verifications (outdated provider, or no verifications in spec)"
+		report.Add(check)
+		return nil
+	}
+	for _, verification := range verifications {
+		check := base
+		check.Name = "verification " + verification.Name
+		check.Addon = verification.Addon
+		check.Extension = verification.Extension
+		check.Message = verification.Error
+		switch {
+		case verification.Passed:
+			check.Status = ConformanceStatusPassed
+		case verification.Critical:
+			check.Status = ConformanceStatusFailed
+			failed = append(failed, verification.Name)
+		default:
+			check.Status = ConformanceStatusWarning
+		}
+		report.Add(check)
+	}
+	return failed
+}
+
+// addClaimedServiceCheck checks that an addon/extension the provider claims is served,
+// i.e. its verifications passed on the provider's node
+func addClaimedServiceCheck(report *ConformanceReport, check ConformanceCheck, verifications []*pairingtypes.Verification) error {
+	check.Name = "claimed service"
+	if len(verifications) == 0 {
+		check.Status = ConformanceStatusSkipped
+		check.Message = "provider did not report verifications"
+		report.Add(check)
+		return nil
+	}
+	matched := false
+	for _, verification := range verifications {
+		if verification.Addon != check.Addon || verification.Extension != check.Extension {
+			continue
+		}
+		matched = true
+		if !verification.Passed && verification.Critical {
+			err := fmt.Errorf("claimed service failed verification %s: %s", verification.Name, verification.Error)
+			report.AddResult(check, err)
+			return err
+		}
+	}
+	if !matched {
+		check.Status = ConformanceStatusWarning
+		check.Message = "claimed service was not verified, no matching verifications were reported"
+		report.Add(check)
+		return nil
+	}
+	report.AddResult(check, nil)
+	return nil
+}
+
+// probeLatestBlock probes a provider endpoint and returns the latest block it reports
+func probeLatestBlock(ctx context.Context, ipPort string, chainID string, apiInterface string) (int64, error) {
+	cswp := lavasession.ConsumerSessionsWithProvider{}
+	relayerClientPt, conn, err := cswp.ConnectRawClientWithTimeout(ctx, ipPort)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	relayerClient := *relayerClientPt
+	probeResp, err := relayerClient.Probe(ctx, &pairingtypes.ProbeRequest{
+		Guid:         uint64(rand.Int63()),
+		SpecId:       chainID,
+		ApiInterface: apiInterface,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return probeResp.GetLatestBlock(), nil
+}
+
+// checkLatestBlock compares the latest block of the provider to the median of (some of)
+// the other providers staked on the chain, allowing the spec's block lag
+func checkLatestBlock(ctx context.Context, specQuerier spectypes.QueryClient, pairingQuerier pairingtypes.QueryClient, providerEntry epochstoragetypes.StakeEntry, apiInterface string, latestBlock int64) error {
+	specResp, err := specQuerier.Spec(ctx, &spectypes.QueryGetSpecRequest{ChainID: providerEntry.Chain})
+	if err != nil {
+		return utils.LavaFormatError("failed querying spec", err, utils.Attribute{Key: "chainID", Value: providerEntry.Chain})
+	}
+	providersResp, err := pairingQuerier.Providers(ctx, &pairingtypes.QueryProvidersRequest{ChainID: providerEntry.Chain})
+	if err != nil {
+		return utils.LavaFormatError("failed querying providers", err, utils.Attribute{Key: "chainID", Value: providerEntry.Chain})
+	}
+
+	otherBlocks := []int64{}
+	stakeEntries := providersResp.StakeEntry
+	if len(stakeEntries) > 0 {
+		offset := rand.Intn(len(stakeEntries))
+		for i := range stakeEntries {
+			if len(otherBlocks) >= conformanceMaxProvidersToCompare {
+				break
+			}
+			other := stakeEntries[(offset+i)%len(stakeEntries)]
+			if other.Address == providerEntry.Address {
+				continue
+			}
+			endpoints := other.GetEndpointsSupportingService(apiInterface, "", "")
+			if len(endpoints) == 0 {
+				continue
+			}
+			otherBlock, err := probeLatestBlock(ctx, endpoints[0].IPPORT, providerEntry.Chain, apiInterface)
+			if err != nil {
+				utils.LavaFormatDebug("failed probing provider to compare latest block", utils.Attribute{Key: "provider", Value: other.Address}, utils.Attribute{Key: "error", Value: err})
+				continue
+			}
+			otherBlocks = append(otherBlocks, otherBlock)
+		}
+	}
+	if len(otherBlocks) == 0 {
+		return errNoProvidersToCompare
+	}
+
+	medianBlock := lavaslices.Median(otherBlocks)
+	allowedLag := specResp.Spec.AllowedBlockLagForQosSync
+	if latestBlock < medianBlock-allowedLag {
+		return utils.LavaFormatError("provider latest block is behind other providers", nil,
+			utils.Attribute{Key: "chainID", Value: providerEntry.Chain},
+			utils.Attribute{Key: "apiInterface", Value: apiInterface},
+			utils.Attribute{Key: "latestBlock", Value: latestBlock},
+			utils.Attribute{Key: "providersMedianBlock", Value: medianBlock},
+			utils.Attribute{Key: "allowedLag", Value: allowedLag},
+		)
+	}
+	return nil
+}
+
+func startTesting(ctx context.Context, clientCtx client.Context, txFactory tx.Factory, providerEntries []epochstoragetypes.StakeEntry, report *ConformanceReport, summaryOut io.Writer) error {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
@@ -122,11 +267,16 @@ func startTesting(ctx context.Context, clientCtx client.Context, txFactory tx.Fa
 		utils.LavaFormatFatal("failed fetching protocol version from node", err)
 	}
 	targetVersion := lvutil.ParseToSemanticVersion(lavaVersion.ProviderTarget)
+	report.TargetVersion = lavaVersion.ProviderTarget
+	specQuerier := spectypes.NewQueryClient(clientCtx)
+	pairingQuerier := pairingtypes.NewQueryClient(clientCtx)
 	for _, providerEntry := range providerEntries {
 		utils.LavaFormatInfo("checking provider entry", utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "endpoints", Value: providerEntry.Endpoints})
 
+		// latest blocks reported by the provider's endpoints, per api interface
+		latestBlocks := map[string]int64{}
 		for _, endpoint := range providerEntry.Endpoints {
-			checkOneProvider := func(apiInterface string, addon string) (time.Duration, string, int64, error) {
+			checkOneProvider := func(apiInterface string, addon string, extension string) (time.Duration, string, *pairingtypes.ProbeReply, error) {
 				cswp := lavasession.ConsumerSessionsWithProvider{}
 				if portValid := validatePortNumber(endpoint.IPPORT); portValid != "" && !slices.Contains(portValidation, portValid) {
 					portValidation = append(portValidation, portValid)
@@ -139,10 +289,10 @@ func startTesting(ctx context.Context, clientCtx client.Context, txFactory tx.Fa
 						_, _, err := cswp.ConnectRawClientWithTimeout(ctx, endpoint.IPPORT)
 						lavasession.AllowInsecureConnectionToProviders = false
 						if err == nil {
-							return 0, "", 0, utils.LavaFormatError("provider endpoint is insecure when it should be secure", err, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
+							return 0, "", nil, utils.LavaFormatError("provider endpoint is insecure when it should be secure", err, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
 						}
 					}
-					return 0, "", 0, utils.LavaFormatError("failed connecting to provider endpoint", err, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
+					return 0, "", nil, utils.LavaFormatError("failed connecting to provider endpoint", err, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
 				}
 				defer conn.Close()
 				relayerClient := *relayerClientPt
@@ -152,54 +302,110 @@ func startTesting(ctx context.Context, clientCtx client.Context, txFactory tx.Fa
 					Guid:         guid,
 					SpecId:       providerEntry.Chain,
 					ApiInterface: apiInterface,
+					// ask the provider to run the spec verifications on its node
+					WithVerification: true,
 				}
 				var trailer metadata.MD
 				probeResp, err := relayerClient.Probe(ctx, probeReq, grpc.Trailer(&trailer))
 				if err != nil {
-					return 0, "", 0, utils.LavaFormatError("failed probing provider endpoint", err, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
+					return 0, "", nil, utils.LavaFormatError("failed probing provider endpoint", err, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
 				}
 				versions := strings.Join(trailer.Get(common.VersionMetadataKey), ",")
 				relayLatency := time.Since(relaySentTime)
 				if guid != probeResp.GetGuid() {
-					return 0, versions, 0, utils.LavaFormatError("probe returned invalid value", err, utils.Attribute{Key: "returnedGuid", Value: probeResp.GetGuid()}, utils.Attribute{Key: "guid", Value: guid}, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
+					return 0, versions, nil, utils.LavaFormatError("probe returned invalid value", err, utils.Attribute{Key: "returnedGuid", Value: probeResp.GetGuid()}, utils.Attribute{Key: "guid", Value: guid}, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
 				}
 
 				// CORS check
 				if err := PerformCORSCheck(endpoint); err != nil {
-					return 0, versions, 0, err
+					return 0, versions, nil, err
 				}
 
 				relayRequest := &pairingtypes.RelayRequest{
 					RelaySession: &pairingtypes.RelaySession{SpecId: providerEntry.Chain},
 					RelayData:    &pairingtypes.RelayPrivateData{ApiInterface: apiInterface, Addon: addon},
 				}
+				if extension != "" {
+					relayRequest.RelayData.Extensions = []string{extension}
+				}
 				_, err = relayerClient.Relay(ctx, relayRequest)
 				if err == nil {
-					return 0, "", 0, utils.LavaFormatError("relay Without signature did not error, unexpected", nil, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
+					return 0, "", nil, utils.LavaFormatError("relay Without signature did not error, unexpected", nil, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
 				}
 				code := status.Code(err)
 				if code != codes.Code(lavasession.EpochMismatchError.ABCICode()) {
-					return 0, versions, 0, utils.LavaFormatError("relay returned unexpected error", err, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
+					return 0, versions, nil, utils.LavaFormatError("relay returned unexpected error", err, utils.Attribute{Key: "apiInterface", Value: apiInterface}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT})
 				}
-				return relayLatency, versions, probeResp.GetLatestBlock(), nil
+				return relayLatency, versions, probeResp, nil
 			}
 			endpointServices := endpoint.GetSupportedServices()
 			if len(endpointServices) == 0 {
 				utils.LavaFormatWarning("endpoint has no supported services", nil, utils.Attribute{Key: "endpoint", Value: endpoint})
 			}
+			// verifications are run per api interface, report them once
+			verifiedApiInterfaces := map[string][]*pairingtypes.Verification{}
 			for _, endpointService := range endpointServices {
-				probeLatency, version, latestBlockFromProbe, err := checkOneProvider(endpointService.ApiInterface, endpointService.Addon)
+				check := ConformanceCheck{
+					Chain:        providerEntry.Chain,
+					ApiInterface: endpointService.ApiInterface,
+					Addon:        endpointService.Addon,
+					Extension:    endpointService.Extension,
+					Endpoint:     endpoint.IPPORT,
+				}
+				probeLatency, version, probeResp, err := checkOneProvider(endpointService.ApiInterface, endpointService.Addon, endpointService.Extension)
+				check.Name = "probe"
+				check.Duration = probeLatency
+				report.AddResult(check, err)
 				if err != nil {
 					badChains = append(badChains, providerEntry.Chain+" "+endpointService.String())
 					continue
 				}
+				check.Duration = 0
 				parsedVer := lvutil.ParseToSemanticVersion(strings.TrimPrefix(version, "v"))
 				if lvutil.IsVersionLessThan(parsedVer, targetVersion) || lvutil.IsVersionGreaterThan(parsedVer, targetVersion) {
+					check.Name = "version"
+					report.AddResult(check, fmt.Errorf("version %s should be: %s", version, lavaVersion.ProviderTarget))
 					badChains = append(badChains, providerEntry.Chain+" "+endpointService.String()+" Version:"+version+" should be: "+lavaVersion.ProviderTarget)
 					continue
 				}
+				check.Name = "version"
+				report.AddResult(check, nil)
+
+				verifications, verified := verifiedApiInterfaces[endpointService.ApiInterface]
+				if !verified {
+					verifications = probeResp.GetVerifications()
+					verifiedApiInterfaces[endpointService.ApiInterface] = verifications
+					for _, failed := range addVerificationChecks(report, check, verifications) {
+						badChains = append(badChains, providerEntry.Chain+" "+endpointService.ApiInterface+" verification: "+failed)
+					}
+				}
+				if endpointService.Addon != "" || endpointService.Extension != "" {
+					if err := addClaimedServiceCheck(report, check, verifications); err != nil {
+						badChains = append(badChains, providerEntry.Chain+" "+endpointService.String()+" "+err.Error())
+						continue
+					}
+				}
+
+				if probeResp.GetLatestBlock() > latestBlocks[endpointService.ApiInterface] {
+					latestBlocks[endpointService.ApiInterface] = probeResp.GetLatestBlock()
+				}
 				utils.LavaFormatInfo("successfully verified provider endpoint", utils.LogAttr("version", version), utils.Attribute{Key: "enspointService", Value: endpointService}, utils.Attribute{Key: "chainID", Value: providerEntry.Chain}, utils.Attribute{Key: "network address", Value: endpoint.IPPORT}, utils.Attribute{Key: "probe latency", Value: probeLatency})
-				goodChains = append(goodChains, providerEntry.Chain+"-"+endpointService.String()+" version: "+version+" latest block: 0x"+strconv.FormatInt(latestBlockFromProbe, 16))
+				goodChains = append(goodChains, providerEntry.Chain+"-"+endpointService.String()+" version: "+version+" latest block: 0x"+strconv.FormatInt(probeResp.GetLatestBlock(), 16))
+			}
+		}
+
+		for apiInterface, latestBlock := range latestBlocks {
+			check := ConformanceCheck{Chain: providerEntry.Chain, ApiInterface: apiInterface, Name: "latest block"}
+			err := checkLatestBlock(ctx, specQuerier, pairingQuerier, providerEntry, apiInterface, latestBlock)
+			if err == errNoProvidersToCompare {
+				check.Status = ConformanceStatusSkipped
+				check.Message = err.Error()
+				report.Add(check)
+				continue
+			}
+			report.AddResult(check, err)
+			if err != nil {
+				badChains = append(badChains, providerEntry.Chain+" "+apiInterface+" "+err.Error())
 			}
 		}
 	}
@@ -215,7 +421,34 @@ func startTesting(ctx context.Context, clientCtx client.Context, txFactory tx.Fa
 			"Misconfigured URLs:",
 		}, portValidation...)
 	}
-	fmt.Printf("📄----------------------------------------✨SUMMARY✨----------------------------------------📄\n\n🔵 Tests Passed:\n🔹%s\n\n🔵 Tests Failed:\n🔹%s\n\n🔵 Provider Port Validation:\n🔹%s\n\n", strings.Join(goodChains, "\n🔹"), strings.Join(badChains, "\n🔹"), strings.Join(portValidation, "\n🔹"))
+	fmt.Fprintf(summaryOut, "📄----------------------------------------✨SUMMARY✨----------------------------------------📄\n\n🔵 Tests Passed:\n🔹%s\n\n🔵 Tests Failed:\n🔹%s\n\n🔵 Provider Port Validation:\n🔹%s\n\n", strings.Join(goodChains, "\n🔹"), strings.Join(badChains, "\n🔹"), strings.Join(portValidation, "\n🔹"))
+	return nil
+}
+
+// runConformanceTest tests the provider entries and writes the report (if a format is
+// given). When the report goes to stdout, the summary goes to stderr so that stdout
+// can be parsed
+func runConformanceTest(ctx context.Context, clientCtx client.Context, txFactory tx.Factory, providerEntries []epochstoragetypes.StakeEntry, address, reportFormat, reportFile string, stdout, stderr io.Writer) error {
+	summaryOut := stdout
+	if reportFormat != "" && reportFile == "" {
+		summaryOut = stderr
+	}
+	report := NewConformanceReport(address)
+	err := startTesting(ctx, clientCtx, txFactory, providerEntries, report, summaryOut)
+	if err != nil || reportFormat == "" {
+		return err
+	}
+	if reportFile == "" {
+		err = report.Write(stdout, reportFormat)
+	} else {
+		err = report.WriteFile(reportFile, reportFormat)
+	}
+	if err != nil {
+		return err
+	}
+	if !report.Passed {
+		return fmt.Errorf("conformance test failed: %d of %d checks failed", report.Failures(), len(report.Checks))
+	}
 	return nil
 }
 
@@ -227,10 +460,13 @@ func CreateTestRPCProviderCobraCommand() *cobra.Command {
 need to provider either provider_address or --from wallet_name
 optional flag: --endpoints in order to validate provider process before submitting a stake command
 endpoints is a space separated list of endpoint,
-each endpoint is: listen-ip:listen-port(the url),[optional: the api interfaces and addon to check],spec-id(the spec identifier to test)`,
+each endpoint is: listen-ip:listen-port(the url),[optional: the api interfaces and addon to check],spec-id(the spec identifier to test)
+the test runs the spec verifications on the provider's nodes, checks claimed addons and extensions and compares
+the latest block to other providers in the pairing. use --report-format to output a report (e.g. to gate deployments)`,
 		Example: `rpcprovider lava@myprovideraddress
 rpcprovider --from providerWallet
-rpcprovider --from providerWallet --endpoints "provider-public-grpc:port,jsonrpc,ETH1 provider-public-grpc:port,rest,LAV1"`,
+rpcprovider --from providerWallet --endpoints "provider-public-grpc:port,jsonrpc,ETH1 provider-public-grpc:port,rest,LAV1"
+rpcprovider --from providerWallet --report-format junit --report-file report.xml`,
 		Args: cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -359,7 +595,18 @@ rpcprovider --from providerWallet --endpoints "provider-public-grpc:port,jsonrpc
 				utils.LavaFormatError("no active chains for provider", nil, utils.Attribute{Key: "address", Value: address})
 			}
 			utils.LavaFormatDebug("checking chain entries", utils.Attribute{Key: "stakedProviderChains", Value: stakedProviderChains})
-			return startTesting(ctx, clientCtx, txFactory, stakedProviderChains)
+			reportFormat, err := cmd.Flags().GetString(ConformanceReportFormatFlag)
+			if err != nil {
+				return err
+			}
+			reportFile, err := cmd.Flags().GetString(ConformanceReportFileFlag)
+			if err != nil {
+				return err
+			}
+			if reportFormat == "" && reportFile != "" {
+				reportFormat = ConformanceReportFormatJSON
+			}
+			return runConformanceTest(ctx, clientCtx, txFactory, stakedProviderChains, address, reportFormat, reportFile, os.Stdout, os.Stderr)
 		},
 	}
 
@@ -367,5 +614,7 @@ rpcprovider --from providerWallet --endpoints "provider-public-grpc:port,jsonrpc
 	flags.AddTxFlagsToCmd(cmdTestRPCProvider)
 	cmdTestRPCProvider.Flags().Bool(lavasession.AllowInsecureConnectionToProvidersFlag, false, "allow insecure provider-dialing. used for development and testing")
 	cmdTestRPCProvider.Flags().String(common.EndpointsConfigName, "", "endpoints to check, overwrites reading it from the blockchain")
+	cmdTestRPCProvider.Flags().String(ConformanceReportFormatFlag, "", "write a machine readable report of all checks (json|junit), the command fails if any check failed")
+	cmdTestRPCProvider.Flags().String(ConformanceReportFileFlag, "", "file to write the report to, stdout if empty (the summary is then printed to stderr)")
 	return cmdTestRPCProvider
 }
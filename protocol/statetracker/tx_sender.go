@@ -17,6 +17,7 @@ import (
 	commontypes "github.com/lavanet/lava/common/types"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
+	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
 	"github.com/lavanet/lava/utils"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -76,16 +77,19 @@ func (ts *TxSender) checkProfitability(simResult *typestx.SimulateResponse, gasU
 }
 
 func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, checkProfitability bool) error {
-	txfactory := ts.txFactory.WithGasPrices(defaultGasPrice)
-	txfactory = txfactory.WithGasAdjustment(defaultGasAdjustment)
+	_, _, err := ts.simulateAndBroadCastTx(msg)
+	return err
+}
 
+// simulateAndBroadCastTx returns the committed tx result and the factory it was sent with
+func (ts *TxSender) simulateAndBroadCastTx(msg sdk.Msg) (common.TxResultData, tx.Factory, error) {
 	if err := msg.ValidateBasic(); err != nil {
-		return err
+		return common.TxResultData{}, ts.txFactory, err
 	}
 	clientCtx := ts.clientCtx
-	txfactory, err := ts.prepareFactory(txfactory)
+	txfactory, err := ts.prepareFactory(ts.defaultFactory())
 	if err != nil {
-		return err
+		return common.TxResultData{}, txfactory, err
 	}
 
 	success := false
@@ -97,7 +101,7 @@ func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, ch
 		utils.LavaFormatDebug("Attempting to send relay payment transaction", utils.LogAttr("index", idx))
 		txfactory, gasUsed, err = ts.simulateTxWithRetry(clientCtx, txfactory, msg)
 		if err != nil {
-			return utils.LavaFormatError("Failed Simulating transaction", err)
+			return latestResult, txfactory, utils.LavaFormatError("Failed Simulating transaction", err)
 		}
 		// incase we got an error the tx result is basically the error
 		latestResult, err = ts.SendTxAndVerifyCommit(txfactory, msg)
@@ -108,7 +112,7 @@ func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, ch
 		} else if strings.Contains(transactionResult, "account sequence") {
 			txfactory, err = ts.getNewFactoryFromASequenceNumberError(transactionResult, txfactory, clientCtx)
 			if err != nil {
-				return utils.LavaFormatError("Failed getting a new factory", err)
+				return latestResult, txfactory, utils.LavaFormatError("Failed getting a new factory", err)
 			}
 			// we got a new factory with an adjusted sequence number we should be good to try again
 		} else if strings.Contains(transactionResult, "out of gas") {
@@ -116,16 +120,29 @@ func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, ch
 		} else if strings.Contains(transactionResult, "insufficient fees; got:") { //
 			err := parseInsufficientFeesError(transactionResult, gasUsed)
 			if err == nil {
-				return utils.LavaFormatError("Failed sending transaction", nil, utils.Attribute{Key: "result", Value: latestResult})
+				return latestResult, txfactory, utils.LavaFormatError("Failed sending transaction", nil, utils.Attribute{Key: "result", Value: latestResult})
 			}
 		}
 		utils.LavaFormatDebug("Failed sending transaction, will retry", utils.LogAttr("Index", idx), utils.LogAttr("reason:", err), utils.LogAttr("rawLog", transactionResult))
 	}
 	if !success {
-		return utils.LavaFormatError("Failed sending transaction with all retries and giving up", nil, utils.Attribute{Key: "result", Value: latestResult}, utils.Attribute{Key: "Number Of Retries executed", Value: idx}, utils.Attribute{Key: "Parsed Sequence", Value: sequenceNumberParsed})
+		return latestResult, txfactory, utils.LavaFormatError("Failed sending transaction with all retries and giving up", nil, utils.Attribute{Key: "result", Value: latestResult}, utils.Attribute{Key: "Number Of Retries executed", Value: idx}, utils.Attribute{Key: "Parsed Sequence", Value: sequenceNumberParsed})
 	}
 	utils.LavaFormatInfo("Succeeded sending transaction", utils.Attribute{Key: "hash", Value: hex.EncodeToString(latestResult.Txhash)})
-	return nil
+	return latestResult, txfactory, nil
+}
+
+func (ts *TxSender) defaultFactory() tx.Factory {
+	return ts.txFactory.WithGasPrices(defaultGasPrice).WithGasAdjustment(defaultGasAdjustment)
+}
+
+// txFee is the fee paid for a tx with the given gas limit, calculated the same way the tx factory sets the fee
+func txFee(txfactory tx.Factory, gasLimit uint64) sdk.Coin {
+	gasPrices := txfactory.GasPrices()
+	if len(gasPrices) == 0 {
+		return sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(gasPrices[0].Denom, gasPrices[0].Amount.MulInt64(int64(gasLimit)).Ceil().RoundInt())
 }
 
 func (ts *TxSender) getSequenceNumberFromErrorOrClient(clientCtx client.Context, errString string) (uint64, error) {
@@ -230,9 +247,11 @@ func (ts *TxSender) waitForTxCommit(resultData common.TxResultData) (common.TxRe
 	select {
 	case txRes := <-txResultChan:
 		resultData = common.TxResultData{
-			RawLog: txRes.TxResult.Log,
-			Txhash: resultData.Txhash,
-			Code:   int(txRes.TxResult.Code),
+			RawLog:    txRes.TxResult.Log,
+			Txhash:    resultData.Txhash,
+			Code:      int(txRes.TxResult.Code),
+			GasWanted: txRes.TxResult.GasWanted,
+			GasUsed:   txRes.TxResult.GasUsed,
 		}
 		break
 	case <-time.After(5 * time.Minute):
@@ -308,14 +327,51 @@ func NewProviderTxSender(ctx context.Context, clientCtx client.Context, txFactor
 	return ts, nil
 }
 
-func (pts *ProviderTxSender) TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) error {
+func (pts *ProviderTxSender) TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (rewardserver.ClaimFee, error) {
 	msg := pairingtypes.NewMsgRelayPayment(pts.clientCtx.FromAddress.String(), relayRequests, description, latestBlocks)
 	utils.LavaFormatDebug("Sending reward TX", utils.LogAttr("Number_of_relay_sessions_for_payment", len(relayRequests)))
-	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, true)
+	result, txfactory, err := pts.simulateAndBroadCastTx(msg)
 	if err != nil {
-		return utils.LavaFormatError("relay_payment - sending Tx Failed", err)
+		return rewardserver.ClaimFee{}, utils.LavaFormatError("relay_payment - sending Tx Failed", err)
 	}
-	return nil
+	return rewardserver.ClaimFee{
+		GasWanted: uint64(result.GasWanted),
+		GasUsed:   uint64(result.GasUsed),
+		Fee:       txFee(txfactory, uint64(result.GasWanted)),
+	}, nil
+}
+
+// EstimateRelayPayment simulates a relay payment, the estimated gas includes the gas adjustment the tx is sent with
+func (pts *ProviderTxSender) EstimateRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (rewardserver.ClaimFee, error) {
+	msg := pairingtypes.NewMsgRelayPayment(pts.clientCtx.FromAddress.String(), relayRequests, description, latestBlocks)
+	if err := msg.ValidateBasic(); err != nil {
+		return rewardserver.ClaimFee{}, err
+	}
+	txfactory, err := pts.prepareFactory(pts.defaultFactory())
+	if err != nil {
+		return rewardserver.ClaimFee{}, err
+	}
+	txfactory, gasWanted, err := pts.simulateTxWithRetry(pts.clientCtx, txfactory, msg)
+	if err != nil {
+		return rewardserver.ClaimFee{}, utils.LavaFormatWarning("relay_payment - simulating Tx Failed", err)
+	}
+	return rewardserver.ClaimFee{GasWanted: gasWanted, Fee: txFee(txfactory, gasWanted)}, nil
+}
+
+// MaxClaimGas returns the block gas limit, 0 if there is no limit
+func (pts *ProviderTxSender) MaxClaimGas(ctx context.Context) (uint64, error) {
+	brp, err := tryIntoTendermintRPC(pts.clientCtx.Client)
+	if err != nil {
+		return 0, err
+	}
+	consensusParams, err := brp.ConsensusParams(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if consensusParams.ConsensusParams.Block.MaxGas <= 0 {
+		return 0, nil
+	}
+	return uint64(consensusParams.ConsensusParams.Block.MaxGas), nil
 }
 
 func (pts *ProviderTxSender) SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error {
@@ -0,0 +1,144 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/lavanet/lava/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	OtlpEndpointFlagName       = "otlp-endpoint"
+	OtlpInsecureFlagName       = "otlp-insecure"
+	TracingSampleRatioFlagName = "tracing-sample-ratio"
+
+	tracerName = "github.com/lavanet/lava/protocol"
+)
+
+// InitTracing exports the spans of the process to an OTLP grpc endpoint (such as a local collector on localhost:4317),
+// when the endpoint is empty tracing stays disabled and spans are no-ops. the returned function flushes and stops the exporter
+func InitTracing(ctx context.Context, serviceName string, endpoint string, insecure bool, sampleRatio float64) (shutdown func(context.Context) error, err error) {
+	// trace context is propagated even when this process doesn't export, so traces of other processes aren't broken
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	utils.SetLogContextHook(logContextHook)
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed creating otlp trace exporter", err, utils.Attribute{Key: "endpoint", Value: endpoint})
+	}
+	serviceResource, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, utils.LavaFormatError("failed creating tracing resource", err)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(serviceResource),
+		sdktrace.WithSampler(newSampler(sampleRatio)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	utils.LavaFormatInfo("Exporting traces", utils.Attribute{Key: "endpoint", Value: endpoint}, utils.Attribute{Key: "service", Value: serviceName}, utils.Attribute{Key: "sampleRatio", Value: sampleRatio})
+	return tracerProvider.Shutdown, nil
+}
+
+// newSampler samples the ratio of the traces, a remote parent can't force sampling. the ratio sampler decides by the
+// trace id, so a relay traced by a consumer is traced by a provider with the same or a higher ratio as well
+func newSampler(sampleRatio float64) sdktrace.Sampler {
+	ratioSampler := sdktrace.TraceIDRatioBased(sampleRatio)
+	return sdktrace.ParentBased(ratioSampler, sdktrace.WithRemoteParentSampled(ratioSampler))
+}
+
+// logContextHook adds the trace id to the log lines of a traced request, so logs of the consumer and the provider can be
+// joined with the trace, and records warnings and errors as events of the request's span
+func logContextHook(ctx context.Context, severity uint, output string) []utils.Attribute {
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		return nil
+	}
+	if span.IsRecording() && severity >= utils.LAVA_LOG_WARN {
+		span.AddEvent(output)
+	}
+	return []utils.Attribute{{Key: "trace_id", Value: span.SpanContext().TraceID().String()}}
+}
+
+// StartSpan starts a span as a child of the span in ctx, the returned context carries the new span
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends the span, marking it as failed when err isn't nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// WithSpanFrom returns ctx carrying the span of parent, for work detached from the context of the request it belongs to
+func WithSpanFrom(ctx context.Context, parent context.Context) context.Context {
+	return trace.ContextWithSpan(ctx, trace.SpanFromContext(parent))
+}
+
+// metadataCarrier carries the trace context in grpc metadata
+type metadataCarrier metadata.MD
+
+func (mc metadataCarrier) Get(key string) string {
+	values := metadata.MD(mc).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (mc metadataCarrier) Set(key string, value string) {
+	metadata.MD(mc).Set(key, value)
+}
+
+func (mc metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for key := range mc {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// InjectToMetadata adds the trace context of ctx to relay metadata sent to another process
+func InjectToMetadata(ctx context.Context, md metadata.MD) {
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+}
+
+// InjectToOutgoingContext adds the trace context of ctx to the metadata of the grpc calls made with it
+func InjectToOutgoingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	InjectToMetadata(ctx, md)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// ExtractFromIncomingContext returns ctx carrying the trace context the caller added to the relay metadata
+func ExtractFromIncomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
@@ -0,0 +1,175 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+	lock     sync.Mutex
+	requests []*collectortrace.ExportTraceServiceRequest
+}
+
+func (mc *mockCollector) Export(ctx context.Context, request *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	mc.requests = append(mc.requests, request)
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func withSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+	return recorder
+}
+
+func TestTracePropagation(t *testing.T) {
+	recorder := withSpanRecorder(t)
+	_, err := InitTracing(context.Background(), "test", "", false, 1)
+	require.NoError(t, err)
+
+	// consumer side
+	consumerCtx, consumerSpan := StartSpan(context.Background(), "consumer")
+	md := metadata.MD{}
+	InjectToMetadata(consumerCtx, md)
+	require.NotEmpty(t, md.Get("traceparent"))
+
+	// provider side
+	providerCtx, providerSpan := StartSpan(ExtractFromIncomingContext(metadata.NewIncomingContext(context.Background(), md)), "provider")
+	EndSpan(providerSpan, fmt.Errorf("node error"))
+	EndSpan(consumerSpan, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "provider", spans[0].Name())
+	require.Equal(t, consumerSpan.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+	require.Equal(t, consumerSpan.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Equal(t, codes.Unset, spans[1].Status().Code)
+
+	// detached work keeps the span of the request
+	detachedCtx := WithSpanFrom(context.Background(), providerCtx)
+	require.Equal(t, providerSpan.SpanContext(), trace.SpanContextFromContext(detachedCtx))
+
+	// outgoing grpc calls carry the trace context
+	outgoingMd, ok := metadata.FromOutgoingContext(InjectToOutgoingContext(metadata.AppendToOutgoingContext(providerCtx, "key", "value")))
+	require.True(t, ok)
+	require.Equal(t, []string{"value"}, outgoingMd.Get("key"))
+	require.NotEmpty(t, outgoingMd.Get("traceparent"))
+
+	// without metadata there's nothing to continue
+	_, orphanSpan := StartSpan(ExtractFromIncomingContext(context.Background()), "orphan")
+	require.NotEqual(t, consumerSpan.SpanContext().TraceID(), orphanSpan.SpanContext().TraceID())
+}
+
+func TestRemoteParentSampling(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder), sdktrace.WithSampler(newSampler(0))))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	// a caller asking for sampling doesn't override the ratio
+	md := metadata.MD{}
+	md.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	remoteCtx := ExtractFromIncomingContext(metadata.NewIncomingContext(context.Background(), md))
+	require.True(t, trace.SpanContextFromContext(remoteCtx).IsSampled())
+	localCtx, span := StartSpan(remoteCtx, "provider")
+	require.False(t, span.IsRecording())
+	// children of a local span follow it
+	_, child := StartSpan(localCtx, "child")
+	require.False(t, child.IsRecording())
+	EndSpan(child, nil)
+	EndSpan(span, nil)
+	require.Empty(t, recorder.Ended())
+}
+
+func TestLogContextHook(t *testing.T) {
+	recorder := withSpanRecorder(t)
+	_, err := InitTracing(context.Background(), "test", "", false, 1)
+	require.NoError(t, err)
+	t.Cleanup(func() { utils.SetLogContextHook(nil) })
+
+	ctx, span := StartSpan(context.Background(), "relay")
+	utils.LavaFormatDebug("debug line", utils.Attribute{Key: "GUID", Value: ctx})
+	utils.LavaFormatWarning("warning line", nil, utils.Attribute{Key: "GUID", Value: ctx})
+	EndSpan(span, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	// only warnings and errors are recorded on the span
+	require.Len(t, spans[0].Events(), 1)
+	require.Contains(t, spans[0].Events()[0].Name, "warning line")
+	require.Equal(t, []utils.Attribute{{Key: "trace_id", Value: span.SpanContext().TraceID().String()}}, logContextHook(ctx, utils.LAVA_LOG_INFO, ""))
+	require.Empty(t, logContextHook(context.Background(), utils.LAVA_LOG_INFO, ""))
+}
+
+func TestTracingDisabled(t *testing.T) {
+	shutdown, err := InitTracing(context.Background(), "test", "", false, 1)
+	require.NoError(t, err)
+	ctx, span := StartSpan(context.Background(), "noop")
+	require.False(t, span.IsRecording())
+	md := metadata.MD{}
+	InjectToMetadata(ctx, md)
+	require.Empty(t, md.Get("traceparent"))
+	EndSpan(span, fmt.Errorf("error"))
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestExportToCollector(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	collector := &mockCollector{}
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, collector)
+	go server.Serve(listener)
+	defer server.Stop()
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	ctx := context.Background()
+	shutdown, err := InitTracing(ctx, "rpcconsumer", listener.Addr().String(), true, 1)
+	require.NoError(t, err)
+	spanCtx, span := StartSpan(ctx, "rpcconsumer.SendRelay")
+	_, child := StartSpan(spanCtx, "rpcconsumer.relay")
+	EndSpan(child, nil)
+	EndSpan(span, nil)
+	// shutting down flushes the batched spans
+	require.NoError(t, shutdown(ctx))
+
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	names := []string{}
+	serviceName := ""
+	for _, request := range collector.requests {
+		for _, resourceSpans := range request.ResourceSpans {
+			for _, attr := range resourceSpans.Resource.Attributes {
+				if attr.Key == "service.name" {
+					serviceName = attr.Value.GetStringValue()
+				}
+			}
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				for _, span := range scopeSpans.Spans {
+					names = append(names, span.Name)
+				}
+			}
+		}
+	}
+	require.Equal(t, "rpcconsumer", serviceName)
+	require.ElementsMatch(t, []string{"rpcconsumer.SendRelay", "rpcconsumer.relay"}, names)
+}
@@ -444,6 +444,24 @@ func (ts *Tester) TxDualstakingClaimRewards(
 	return ts.Servers.DualstakingServer.ClaimRewards(ts.GoCtx, msg)
 }
 
+// TxDualstakingAutoCompound: implement 'tx dualstaking auto-compound'
+func (ts *Tester) TxDualstakingAutoCompound(
+	creator string,
+	provider string,
+	chainID string,
+	cadence dualstakingtypes.AutoCompound_Cadence,
+) (*dualstakingtypes.MsgAutoCompoundResponse, error) {
+	validator, _ := ts.GetAccount(VALIDATOR, 0)
+	msg := &dualstakingtypes.MsgAutoCompound{
+		Creator:   creator,
+		Validator: sdk.ValAddress(validator.Addr).String(),
+		Provider:  provider,
+		ChainID:   chainID,
+		Cadence:   cadence,
+	}
+	return ts.Servers.DualstakingServer.AutoCompound(ts.GoCtx, msg)
+}
+
 // TxSubscriptionBuy: implement 'tx subscription buy'
 func (ts *Tester) TxSubscriptionBuy(creator, consumer, plan string, months int, autoRenewal bool) (*subscriptiontypes.MsgBuyResponse, error) {
 	msg := &subscriptiontypes.MsgBuy{
@@ -486,6 +504,26 @@ func (ts *Tester) TxSubscriptionAutoRenewal(creator string, enable bool) error {
 	return err
 }
 
+// TxSubscriptionTransferOffer: implement 'tx subscription transfer-offer'
+func (ts *Tester) TxSubscriptionTransferOffer(creator, newConsumer string) error {
+	msg := &subscriptiontypes.MsgTransferOffer{
+		Creator:     creator,
+		NewConsumer: newConsumer,
+	}
+	_, err := ts.Servers.SubscriptionServer.TransferOffer(ts.GoCtx, msg)
+	return err
+}
+
+// TxSubscriptionTransferAccept: implement 'tx subscription transfer-accept'
+func (ts *Tester) TxSubscriptionTransferAccept(creator, consumer string) error {
+	msg := &subscriptiontypes.MsgTransferAccept{
+		Creator:  creator,
+		Consumer: consumer,
+	}
+	_, err := ts.Servers.SubscriptionServer.TransferAccept(ts.GoCtx, msg)
+	return err
+}
+
 // TxProjectAddKeys: implement 'tx project add-keys'
 func (ts *Tester) TxProjectAddKeys(projectID, creator string, projectKeys ...projectstypes.ProjectKey) error {
 	msg := projectstypes.MsgAddKeys{
@@ -806,6 +844,24 @@ func (ts *Tester) QueryDualstakingDelegatorRewards(delegator string, provider st
 	return ts.Keepers.Dualstaking.DelegatorRewards(ts.GoCtx, msg)
 }
 
+// QueryDualstakingProjectedStake implements 'q dualstaking projected-stake'
+func (ts *Tester) QueryDualstakingProjectedStake(provider string, chainID string) (*dualstakingtypes.QueryProjectedStakeResponse, error) {
+	msg := &dualstakingtypes.QueryProjectedStakeRequest{
+		Provider: provider,
+		ChainId:  chainID,
+	}
+	return ts.Keepers.Dualstaking.ProjectedStake(ts.GoCtx, msg)
+}
+
+// QueryPairingPendingDelegateChanges implements 'q pairing pending-delegate-changes'
+func (ts *Tester) QueryPairingPendingDelegateChanges(delegator string, provider string) (*pairingtypes.QueryPendingDelegateChangesResponse, error) {
+	msg := &pairingtypes.QueryPendingDelegateChangesRequest{
+		Delegator: delegator,
+		Provider:  provider,
+	}
+	return ts.Keepers.Pairing.PendingDelegateChanges(ts.GoCtx, msg)
+}
+
 // QueryFixationAllIndices implements 'q fixationstore all-indices'
 func (ts *Tester) QueryFixationAllIndices(storeKey string, prefix string) (*fixationstoretypes.QueryAllIndicesResponse, error) {
 	msg := &fixationstoretypes.QueryAllIndicesRequest{
@@ -0,0 +1,169 @@
+// Package jsonpath implements a small subset of JSONPath, used by specs to locate values
+// (e.g. the requested block) inside request parameters and replies.
+//
+// Supported syntax:
+//
+//	$          the root (optional)
+//	.name      a field of an object
+//	["name"]   a field of an object, for names with special characters
+//	[n]        an element of an array, negative indices count from the end
+//	[*], .*    all the elements of an array, or all the values of an object (ordered by key)
+package jsonpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type segmentKind int
+
+const (
+	fieldSegment segmentKind = iota
+	indexSegment
+	wildcardSegment
+)
+
+type segment struct {
+	kind  segmentKind
+	name  string
+	index int
+}
+
+type Path struct {
+	expr     string
+	segments []segment
+}
+
+func (p *Path) String() string {
+	return p.expr
+}
+
+// Compile parses a path expression
+func Compile(expr string) (*Path, error) {
+	path := &Path{expr: expr}
+	if strings.TrimSpace(expr) == "" {
+		return nil, fmt.Errorf("empty path expression")
+	}
+
+	i := 0
+	if expr[0] == '$' {
+		i++
+	} else if expr[0] != '.' && expr[0] != '[' {
+		// allow omitting the root: "a.b" is "$.a.b"
+		expr = "." + expr
+	}
+
+	for i < len(expr) {
+		switch expr[i] {
+		case '.':
+			i++
+			if i < len(expr) && expr[i] == '*' {
+				path.segments = append(path.segments, segment{kind: wildcardSegment})
+				i++
+				continue
+			}
+			end := i
+			for end < len(expr) && expr[end] != '.' && expr[end] != '[' {
+				end++
+			}
+			if end == i {
+				return nil, fmt.Errorf("invalid path %q: empty field name at %d", path.expr, i)
+			}
+			if strings.ContainsAny(expr[i:end], "]*\"'") {
+				return nil, fmt.Errorf("invalid path %q: invalid field name %q, use [\"name\"] for special characters", path.expr, expr[i:end])
+			}
+			path.segments = append(path.segments, segment{kind: fieldSegment, name: expr[i:end]})
+			i = end
+		case '[':
+			seg, next, err := parseBracket(expr, i)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %w", path.expr, err)
+			}
+			path.segments = append(path.segments, seg)
+			i = next
+		default:
+			return nil, fmt.Errorf("invalid path %q: unexpected character %q at %d", path.expr, expr[i], i)
+		}
+	}
+	return path, nil
+}
+
+// parseBracket parses a bracket segment starting at expr[start] == '[', and returns the
+// segment and the index following it
+func parseBracket(expr string, start int) (segment, int, error) {
+	i := start + 1
+	if i < len(expr) && (expr[i] == '"' || expr[i] == '\'') {
+		quote := expr[i]
+		end := strings.IndexByte(expr[i+1:], quote)
+		if end < 0 {
+			return segment{}, 0, fmt.Errorf("unterminated quoted field name at %d", i)
+		}
+		name := expr[i+1 : i+1+end]
+		i += end + 2
+		if i >= len(expr) || expr[i] != ']' {
+			return segment{}, 0, fmt.Errorf("expected ']' at %d", i)
+		}
+		return segment{kind: fieldSegment, name: name}, i + 1, nil
+	}
+
+	end := strings.IndexByte(expr[i:], ']')
+	if end < 0 {
+		return segment{}, 0, fmt.Errorf("unterminated '[' at %d", start)
+	}
+	content := strings.TrimSpace(expr[i : i+end])
+	next := i + end + 1
+	if content == "*" {
+		return segment{kind: wildcardSegment}, next, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return segment{}, 0, fmt.Errorf("invalid array index %q at %d", content, i)
+	}
+	return segment{kind: indexSegment, index: index}, next, nil
+}
+
+// Find returns all the values in data matching the path
+func (p *Path) Find(data interface{}) []interface{} {
+	current := []interface{}{data}
+	for _, seg := range p.segments {
+		next := []interface{}{}
+		for _, value := range current {
+			switch seg.kind {
+			case fieldSegment:
+				if object, ok := value.(map[string]interface{}); ok {
+					if field, ok := object[seg.name]; ok {
+						next = append(next, field)
+					}
+				}
+			case indexSegment:
+				if array, ok := value.([]interface{}); ok {
+					index := seg.index
+					if index < 0 {
+						index += len(array)
+					}
+					if index >= 0 && index < len(array) {
+						next = append(next, array[index])
+					}
+				}
+			case wildcardSegment:
+				switch typed := value.(type) {
+				case []interface{}:
+					next = append(next, typed...)
+				case map[string]interface{}:
+					keys := make([]string, 0, len(typed))
+					for key := range typed {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					for _, key := range keys {
+						next = append(next, typed[key])
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return current
+}
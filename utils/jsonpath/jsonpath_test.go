@@ -0,0 +1,52 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	valid := []string{"$", "$.a", "a.b", "$[0]", "[0].a", "$.a[-1]", "$.a[*].b", "$.*", `$["a.b"]`, "$['a']"}
+	for _, expr := range valid {
+		_, err := Compile(expr)
+		require.NoError(t, err, expr)
+	}
+	invalid := []string{"", "$.", "$..a", "$[", "$[a]", `$["a]`, `$["a"`, "$a", "$.a]"}
+	for _, expr := range invalid {
+		_, err := Compile(expr)
+		require.Error(t, err, expr)
+	}
+}
+
+func TestFind(t *testing.T) {
+	var data interface{}
+	err := json.Unmarshal([]byte(`[
+		{"block_id": {"block_number": 12}},
+		{"commitment": "finalized", "minContextSlot": null},
+		[{"n": 1}, {"n": 2}, {"m": 3}],
+		{"a.b": "dotted", "x": {"y": 1}, "z": {"y": 2}}
+	]`), &data)
+	require.NoError(t, err)
+
+	tests := []struct {
+		expr     string
+		expected []interface{}
+	}{
+		{"$[0].block_id.block_number", []interface{}{float64(12)}},
+		{"[1].commitment", []interface{}{"finalized"}},
+		{"$[1].minContextSlot", []interface{}{nil}},
+		{"$[1].missing", []interface{}{}},
+		{"$[2][*].n", []interface{}{float64(1), float64(2)}},
+		{"$[-1]['a.b']", []interface{}{"dotted"}},
+		{"$[3].*.y", []interface{}{float64(1), float64(2)}},
+		{"$[10]", []interface{}{}},
+		{"$[0][0]", []interface{}{}},
+	}
+	for _, test := range tests {
+		path, err := Compile(test.expr)
+		require.NoError(t, err, test.expr)
+		require.Equal(t, test.expected, path.Find(data), test.expr)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -38,8 +39,22 @@ var (
 	ExtendedLogLevel = "development"
 	rollingLogLogger = zerolog.New(os.Stderr).Level(zerolog.Disabled) // this is the singleton rolling logger.
 	globalLogLevel   = zerolog.DebugLevel
+	logContextHook   atomic.Pointer[LogContextHook]
 )
 
+// LogContextHook is called for every log line that has a context attribute, with the formatted log line,
+// the returned attributes are added to the log line
+type LogContextHook func(ctx context.Context, severity uint, output string) []Attribute
+
+// SetLogContextHook sets the hook called for log lines of requests, nil removes it
+func SetLogContextHook(hook LogContextHook) {
+	if hook == nil {
+		logContextHook.Store(nil)
+		return
+	}
+	logContextHook.Store(&hook)
+}
+
 type Attribute struct {
 	Key   string
 	Value interface{}
@@ -249,10 +264,14 @@ func LavaFormatLog(description string, err error, attributes []Attribute, severi
 		rollingLoggerEvent = rollingLoggerEvent.Err(err)
 		output = fmt.Sprintf("%s ErrMsg: %s", output, err.Error())
 	}
+	var ctx context.Context
 	if len(attributes) > 0 {
 		for idx, attr := range attributes {
 			key := attr.Key
 			val := attr.Value
+			if attrCtx, ok := val.(context.Context); ok && ctx == nil {
+				ctx = attrCtx
+			}
 			st_val := StrValueForLog(val, key, idx, attributes)
 			logEvent = logEvent.Str(key, st_val)
 			rollingLoggerEvent = rollingLoggerEvent.Str(key, st_val)
@@ -261,6 +280,13 @@ func LavaFormatLog(description string, err error, attributes []Attribute, severi
 		attributesStr := "{" + strings.Join(attrStrings, ",") + "}"
 		output = fmt.Sprintf("%s %+v", output, attributesStr)
 	}
+	if hook := logContextHook.Load(); hook != nil && ctx != nil {
+		for _, attr := range (*hook)(ctx, severity, output) {
+			st_val := StrValue(attr.Value)
+			logEvent = logEvent.Str(attr.Key, st_val)
+			rollingLoggerEvent = rollingLoggerEvent.Str(attr.Key, st_val)
+		}
+	}
 	logEvent.Msg(description)
 	rollingLoggerEvent.Msg(description)
 	// here we return the same type of the original error message, this handles nil case as well
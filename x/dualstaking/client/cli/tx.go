@@ -31,6 +31,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRedelegate())
 	cmd.AddCommand(CmdUnbond())
 	cmd.AddCommand(CmdClaimRewards())
+	cmd.AddCommand(CmdAutoCompound())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-compound [validator] provider chain-id cadence",
+		Short: "periodically restake the rewards of a delegation into it",
+		Long: `Configure the auto-compounding of the rewards of a delegation (to a provider on a chain).
+The rewards are restaked (up to the provider's delegation limit) into the delegation
+on epoch start, at the chosen cadence: "epoch" (every epoch), "month" (once a month),
+or "disabled" (stop auto-compounding).`,
+		Example: `lavad tx dualstaking auto-compound <provider> <chain-id> epoch --from <delegator>
+lavad tx dualstaking auto-compound <validator> <provider> <chain-id> month --from <delegator>`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			index := 0
+			argvalidator := ""
+			if len(args) == 4 {
+				argvalidator = args[index]
+				index++
+			}
+
+			argProvider := args[index]
+			index++
+			argChainID := args[index]
+			index++
+			argCadence, err := types.ParseAutoCompoundCadence(args[index])
+			if err != nil {
+				return err
+			}
+
+			// the validator is not needed to disable auto-compounding
+			if argvalidator == "" && argCadence != types.AutoCompound_DISABLED {
+				argvalidator = GetValidator(clientCtx)
+			}
+
+			msg := types.NewMsgAutoCompound(
+				clientCtx.GetFromAddress().String(),
+				argvalidator,
+				argProvider,
+				argChainID,
+				argCadence,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,6 +18,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.DelegatorRewardList {
 		k.SetDelegatorReward(ctx, elem)
 	}
+
+	// Set all the AutoCompound
+	for _, elem := range genState.AutoCompoundList {
+		k.SetAutoCompound(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -28,6 +33,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.DelegationsFS = k.ExportDelegations(ctx)
 	genesis.DelegatorsFS = k.ExportDelegators(ctx)
 	genesis.DelegatorRewardList = k.GetAllDelegatorReward(ctx)
+	genesis.AutoCompoundList = k.GetAllAutoCompound(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -0,0 +1,351 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	subscriptionstypes "github.com/lavanet/lava/x/subscription/types"
+)
+
+// Auto-compounding lets a delegator opt-in to have the rewards of a delegation
+// restaked into the same delegation (same provider and chain) periodically,
+// instead of claiming them (MsgClaimRewards) and delegating them (MsgDelegate)
+// manually. The configuration is kept per delegation (keyed like DelegatorReward)
+// and is processed in rounds that start on epoch start: a config is due every epoch
+// (EPOCH cadence) or once a month since the last compounding (MONTH cadence).
+// A round processes at most MaxAutoCompoundsPerBlock configs per block, resuming
+// from a cursor kept in the store, so the work per block stays bounded. A round
+// that outlasts the epoch is completed before the next one starts.
+//
+// Restaking respects the provider's DelegateLimit: delegations beyond the limit
+// do not count towards the effective stake, so only the part of the reward that
+// fits below the limit is restaked. The remainder stays in the DelegatorReward
+// map and can be claimed (or restaked later, when room becomes available).
+
+// SetAutoCompound set a specific AutoCompound in the store from its index
+func (k Keeper) SetAutoCompound(ctx sdk.Context, autoCompound types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+	index := types.DelegationKey(autoCompound.Provider, autoCompound.Delegator, autoCompound.ChainID)
+	b := k.cdc.MustMarshal(&autoCompound)
+	store.Set(types.AutoCompoundKey(
+		index,
+	), b)
+}
+
+// GetAutoCompound returns a AutoCompound from its index
+func (k Keeper) GetAutoCompound(
+	ctx sdk.Context,
+	index string,
+) (val types.AutoCompound, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+
+	b := store.Get(types.AutoCompoundKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAutoCompound removes a AutoCompound from the store
+func (k Keeper) RemoveAutoCompound(
+	ctx sdk.Context,
+	index string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+	store.Delete(types.AutoCompoundKey(
+		index,
+	))
+}
+
+// GetAllAutoCompound returns all AutoCompound
+func (k Keeper) GetAllAutoCompound(ctx sdk.Context) (list []types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetProviderAutoCompound returns all AutoCompound of a provider's delegations
+func (k Keeper) GetProviderAutoCompound(ctx sdk.Context, provider string) (list []types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.AutoCompoundProviderPrefix(provider))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ConfigureAutoCompound sets (or disables) the auto-compounding of a delegation's rewards
+func (k Keeper) ConfigureAutoCompound(ctx sdk.Context, delegator, validator, provider, chainID string, cadence types.AutoCompound_Cadence) error {
+	index := types.DelegationKey(provider, delegator, chainID)
+
+	if cadence == types.AutoCompound_DISABLED {
+		if _, found := k.GetAutoCompound(ctx, index); !found {
+			return utils.LavaFormatWarning("auto-compound not enabled for delegation", types.ErrAutoCompoundNotFound,
+				utils.Attribute{Key: "delegator", Value: delegator},
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "chainID", Value: chainID},
+			)
+		}
+		k.RemoveAutoCompound(ctx, index)
+		return nil
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	if _, found := k.GetDelegation(ctx, delegator, provider, chainID, nextEpoch); !found {
+		return utils.LavaFormatWarning("cannot auto-compound a non-existing delegation", types.ErrAutoCompoundNotFound,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return utils.LavaFormatWarning("cannot auto-compound with a non-existing validator", types.ErrAutoCompoundNotFound,
+			utils.Attribute{Key: "validator", Value: validator},
+		)
+	}
+
+	autoCompound := types.AutoCompound{
+		Delegator:    delegator,
+		Provider:     provider,
+		ChainID:      chainID,
+		Validator:    validator,
+		Cadence:      cadence,
+		LastCompound: ctx.BlockTime().UTC().Unix(),
+	}
+	k.SetAutoCompound(ctx, autoCompound)
+
+	return nil
+}
+
+// isAutoCompoundDue checks whether an auto-compound config should be processed now
+func isAutoCompoundDue(ctx sdk.Context, autoCompound types.AutoCompound) bool {
+	switch autoCompound.Cadence {
+	case types.AutoCompound_EPOCH:
+		return true
+	case types.AutoCompound_MONTH:
+		next := time.Unix(autoCompound.LastCompound, 0).UTC().AddDate(0, 1, 0)
+		return !ctx.BlockTime().UTC().Before(next)
+	default:
+		return false
+	}
+}
+
+// restakeRoom returns the amount that can be added to a delegation while still
+// counting towards the provider's effective stake. The provider's own stake is
+// not limited.
+func restakeRoom(stakeEntry epochstoragetypes.StakeEntry, delegator string, reward math.Int) math.Int {
+	if delegator == stakeEntry.Address {
+		return reward
+	}
+	room := stakeEntry.DelegateLimit.Amount.Sub(stakeEntry.DelegateTotal.Amount)
+	if room.IsNegative() {
+		return math.ZeroInt()
+	}
+	return math.MinInt(room, reward)
+}
+
+// autoCompoundRoundStart is the cursor of a new round, it sorts before all the AutoCompound keys
+var autoCompoundRoundStart = []byte{0}
+
+// StartAutoCompoundRound starts a new auto-compound round, unless the previous round is still in progress
+func (k Keeper) StartAutoCompoundRound(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.KeyPrefix(types.AutoCompoundCursorKey)) {
+		return
+	}
+	store.Set(types.KeyPrefix(types.AutoCompoundCursorKey), autoCompoundRoundStart)
+}
+
+// getAutoCompoundBatch returns up to limit AutoCompound starting at the cursor, and the key to resume from (nil when done)
+func (k Keeper) getAutoCompoundBatch(ctx sdk.Context, cursor []byte, limit int) (list []types.AutoCompound, next []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+	iterator := store.Iterator(cursor, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if len(list) == limit {
+			return list, iterator.Key()
+		}
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list, nil
+}
+
+// AutoCompoundRewards restakes the delegator rewards of the next (up to limit) due auto-compound
+// configs of the round in progress. It returns true when no round is in progress anymore.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context, limit int) (done bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.KeyPrefix(types.AutoCompoundCursorKey))
+	if cursor == nil {
+		return true
+	}
+
+	// collect the batch first, the configs are updated (or removed) while processing it
+	batch, next := k.getAutoCompoundBatch(ctx, cursor, limit)
+	if next == nil {
+		store.Delete(types.KeyPrefix(types.AutoCompoundCursorKey))
+	} else {
+		store.Set(types.KeyPrefix(types.AutoCompoundCursorKey), next)
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+	for _, autoCompound := range batch {
+		index := types.DelegationKey(autoCompound.Provider, autoCompound.Delegator, autoCompound.ChainID)
+
+		// the delegation was removed (fully unbonded): drop the config
+		if _, found := k.GetDelegation(ctx, autoCompound.Delegator, autoCompound.Provider, autoCompound.ChainID, nextEpoch); !found {
+			k.RemoveAutoCompound(ctx, index)
+			continue
+		}
+
+		if !isAutoCompoundDue(ctx, autoCompound) {
+			continue
+		}
+
+		restaked, err := k.restakeDelegatorReward(ctx, autoCompound)
+		if err != nil {
+			utils.LavaFormatWarning("failed to auto-compound delegator reward", err,
+				utils.Attribute{Key: "delegator", Value: autoCompound.Delegator},
+				utils.Attribute{Key: "provider", Value: autoCompound.Provider},
+				utils.Attribute{Key: "chainID", Value: autoCompound.ChainID},
+			)
+			continue
+		}
+
+		autoCompound.LastCompound = ctx.BlockTime().UTC().Unix()
+		k.SetAutoCompound(ctx, autoCompound)
+
+		if restaked.IsZero() {
+			continue
+		}
+
+		details := map[string]string{
+			"delegator": autoCompound.Delegator,
+			"provider":  autoCompound.Provider,
+			"chainID":   autoCompound.ChainID,
+			"amount":    restaked.String(),
+		}
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.RestakeRewardsEventName, details, "Auto-Compound Delegator Rewards")
+	}
+
+	return next == nil
+}
+
+// restakeDelegatorReward moves the delegator's reward (up to the provider's delegation
+// limit) into its delegation. It is atomic: on failure no state is changed.
+func (k Keeper) restakeDelegatorReward(ctx sdk.Context, autoCompound types.AutoCompound) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	restaked := sdk.NewCoin(bondDenom, math.ZeroInt())
+
+	index := types.DelegationKey(autoCompound.Provider, autoCompound.Delegator, autoCompound.ChainID)
+	delegatorReward, found := k.GetDelegatorReward(ctx, index)
+	if !found || delegatorReward.Amount.IsZero() {
+		return restaked, nil
+	}
+
+	providerAddr, err := sdk.AccAddressFromBech32(autoCompound.Provider)
+	if err != nil {
+		return restaked, err
+	}
+
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, autoCompound.ChainID, providerAddr)
+	if !found {
+		return restaked, epochstoragetypes.ErrProviderNotStaked
+	}
+
+	amount := restakeRoom(stakeEntry, autoCompound.Delegator, delegatorReward.Amount.Amount)
+	if !amount.IsPositive() {
+		return restaked, nil
+	}
+	restaked = sdk.NewCoin(bondDenom, amount)
+
+	delegatorAcc, err := sdk.AccAddressFromBech32(autoCompound.Delegator)
+	if err != nil {
+		return restaked, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	// not minting new coins because they're minted when the provider
+	// asked for payment (and the delegator reward map was updated)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, subscriptionstypes.ModuleName, delegatorAcc, sdk.NewCoins(restaked))
+	if err != nil {
+		return restaked, err
+	}
+
+	err = k.DelegateFull(cacheCtx, autoCompound.Delegator, autoCompound.Validator, autoCompound.Provider, autoCompound.ChainID, restaked)
+	if err != nil {
+		return restaked, err
+	}
+
+	delegatorReward.Amount = delegatorReward.Amount.Sub(restaked)
+	if delegatorReward.Amount.IsZero() {
+		k.RemoveDelegatorReward(cacheCtx, index)
+	} else {
+		k.SetDelegatorReward(cacheCtx, delegatorReward)
+	}
+
+	writeCache()
+
+	return restaked, nil
+}
+
+// ProjectedRestake returns the rewards that would be restaked into a provider's
+// stake (for a chain) by auto-compounding, if all configs were due now
+func (k Keeper) ProjectedRestake(ctx sdk.Context, stakeEntry epochstoragetypes.StakeEntry) (selfRestake math.Int, delegationsRestake math.Int) {
+	selfRestake, delegationsRestake = math.ZeroInt(), math.ZeroInt()
+
+	for _, autoCompound := range k.GetProviderAutoCompound(ctx, stakeEntry.Address) {
+		if autoCompound.ChainID != stakeEntry.Chain {
+			continue
+		}
+
+		index := types.DelegationKey(autoCompound.Provider, autoCompound.Delegator, autoCompound.ChainID)
+		delegatorReward, found := k.GetDelegatorReward(ctx, index)
+		if !found {
+			continue
+		}
+
+		if autoCompound.Delegator == stakeEntry.Address {
+			selfRestake = selfRestake.Add(delegatorReward.Amount.Amount)
+		} else {
+			delegationsRestake = delegationsRestake.Add(delegatorReward.Amount.Amount)
+		}
+	}
+
+	return selfRestake, delegationsRestake
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAutoCompound checks that delegator rewards are restaked into the delegation
+// (up to the provider's delegation limit) and that the projected stake is as expected
+func TestAutoCompound(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	clientAcc, client := ts.GetAccount(common.CONSUMER, 0)
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	chainID := ts.spec.Name
+
+	// delegation limit leaves room for half of the reward
+	stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, chainID, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, chainID, stakeEntry, index)
+
+	amount := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake/2))
+	_, err := ts.TxDualstakingDelegate(client, provider, chainID, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// no delegation - cannot auto-compound
+	_, err = ts.TxDualstakingAutoCompound(provider, client, chainID, types.AutoCompound_EPOCH)
+	require.Error(t, err)
+	// not enabled - cannot disable
+	_, err = ts.TxDualstakingAutoCompound(client, provider, chainID, types.AutoCompound_DISABLED)
+	require.Error(t, err)
+
+	_, err = ts.TxDualstakingAutoCompound(client, provider, chainID, types.AutoCompound_EPOCH)
+	require.NoError(t, err)
+
+	// fabricate a delegator reward (and fund the subscription module that pays it)
+	reward := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake))
+	ts.Keepers.Dualstaking.SetDelegatorReward(ts.Ctx, types.DelegatorReward{
+		Delegator: client,
+		Provider:  provider,
+		ChainId:   chainID,
+		Amount:    reward,
+	})
+	moduleAcc := sdk.AccAddress([]byte(subscriptiontypes.ModuleName))
+	ts.Keepers.BankKeeper.AddToBalance(moduleAcc, sdk.NewCoins(reward))
+
+	// effective stake: stake + delegations; only half the reward fits under the limit
+	res, err := ts.QueryDualstakingProjectedStake(provider, chainID)
+	require.NoError(t, err)
+	require.Equal(t, testStake+testStake/2, res.EffectiveStake.Amount.Int64())
+	require.Equal(t, testStake/2, res.PendingRestake.Amount.Int64())
+	require.Equal(t, 2*testStake, res.ProjectedEffectiveStake.Amount.Int64())
+
+	balance := ts.GetBalance(clientAcc.Addr)
+
+	ts.AdvanceEpoch() // auto-compound
+	ts.AdvanceEpoch() // apply delegations
+
+	delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client, provider, chainID, ts.EpochStart())
+	require.True(t, found)
+	require.Equal(t, testStake, delegation.Amount.Amount.Int64())
+
+	// the leftover reward remains claimable, and the balance is unchanged
+	delegatorReward, found := ts.Keepers.Dualstaking.GetDelegatorReward(ts.Ctx, types.DelegationKey(provider, client, chainID))
+	require.True(t, found)
+	require.Equal(t, testStake/2, delegatorReward.Amount.Amount.Int64())
+	require.Equal(t, balance, ts.GetBalance(clientAcc.Addr))
+
+	// delegation limit reached: nothing more to restake
+	res, err = ts.QueryDualstakingProjectedStake(provider, chainID)
+	require.NoError(t, err)
+	require.Equal(t, 2*testStake, res.EffectiveStake.Amount.Int64())
+	require.True(t, res.PendingRestake.IsZero())
+
+	// disable auto-compound
+	_, err = ts.TxDualstakingAutoCompound(client, provider, chainID, types.AutoCompound_DISABLED)
+	require.NoError(t, err)
+	_, found = ts.Keepers.Dualstaking.GetAutoCompound(ts.Ctx, types.DelegationKey(provider, client, chainID))
+	require.False(t, found)
+
+	ts.verifyDelegatorsBalance()
+}
+
+// TestAutoCompoundBatches checks that an auto-compound round is processed in
+// bounded batches, resuming where the previous batch stopped
+func TestAutoCompoundBatches(t *testing.T) {
+	ts := newTester(t)
+
+	// 3 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(3, 1, 0, 0)
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	chainID := ts.spec.Name
+
+	stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, chainID, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(10*testStake))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, chainID, stakeEntry, index)
+
+	amount := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake))
+	clients := []string{}
+	for i := 0; i < 3; i++ {
+		_, client := ts.GetAccount(common.CONSUMER, i)
+		_, err := ts.TxDualstakingDelegate(client, provider, chainID, amount)
+		require.NoError(t, err)
+		clients = append(clients, client)
+	}
+	ts.AdvanceEpoch()
+
+	moduleAcc := sdk.AccAddress([]byte(subscriptiontypes.ModuleName))
+	reward := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake/10))
+	for _, client := range clients {
+		_, err := ts.TxDualstakingAutoCompound(client, provider, chainID, types.AutoCompound_EPOCH)
+		require.NoError(t, err)
+		ts.Keepers.Dualstaking.SetDelegatorReward(ts.Ctx, types.DelegatorReward{
+			Delegator: client,
+			Provider:  provider,
+			ChainId:   chainID,
+			Amount:    reward,
+		})
+		ts.Keepers.BankKeeper.AddToBalance(moduleAcc, sdk.NewCoins(reward))
+	}
+
+	// the projected restake only looks at the provider's configs
+	require.Len(t, ts.Keepers.Dualstaking.GetProviderAutoCompound(ts.Ctx, provider), 3)
+	require.Len(t, ts.Keepers.Dualstaking.GetProviderAutoCompound(ts.Ctx, clients[0]), 0)
+
+	// no round in progress: nothing to do
+	require.True(t, ts.Keepers.Dualstaking.AutoCompoundRewards(ts.Ctx, 1))
+	require.Len(t, ts.Keepers.Dualstaking.GetAllDelegatorReward(ts.Ctx), 3)
+
+	// one config per batch
+	ts.Keepers.Dualstaking.StartAutoCompoundRound(ts.Ctx)
+	require.False(t, ts.Keepers.Dualstaking.AutoCompoundRewards(ts.Ctx, 1))
+	require.Len(t, ts.Keepers.Dualstaking.GetAllDelegatorReward(ts.Ctx), 2)
+
+	// a round in progress is not restarted
+	ts.Keepers.Dualstaking.StartAutoCompoundRound(ts.Ctx)
+	require.False(t, ts.Keepers.Dualstaking.AutoCompoundRewards(ts.Ctx, 1))
+	require.Len(t, ts.Keepers.Dualstaking.GetAllDelegatorReward(ts.Ctx), 1)
+
+	require.True(t, ts.Keepers.Dualstaking.AutoCompoundRewards(ts.Ctx, 1))
+	require.Len(t, ts.Keepers.Dualstaking.GetAllDelegatorReward(ts.Ctx), 0)
+	require.True(t, ts.Keepers.Dualstaking.AutoCompoundRewards(ts.Ctx, 1))
+
+	ts.AdvanceEpoch() // apply delegations
+
+	for _, client := range clients {
+		delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client, provider, chainID, ts.EpochStart())
+		require.True(t, found)
+		require.Equal(t, testStake+testStake/10, delegation.Amount.Amount.Int64())
+	}
+
+	ts.verifyDelegatorsBalance()
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProjectedStake(goCtx context.Context, req *types.QueryProjectedStakeRequest) (res *types.QueryProjectedStakeResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddr, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, req.ChainId, providerAddr)
+	if !found {
+		return nil, epochstoragetypes.ErrProviderNotStaked
+	}
+
+	// effectiveStake = stake + min(delegateTotal, delegateLimit)
+	effectiveStake := func(stake, delegateTotal math.Int) math.Int {
+		return stake.Add(math.MinInt(delegateTotal, stakeEntry.DelegateLimit.Amount))
+	}
+
+	selfRestake, delegationsRestake := k.ProjectedRestake(ctx, stakeEntry)
+
+	current := effectiveStake(stakeEntry.Stake.Amount, stakeEntry.DelegateTotal.Amount)
+	projected := effectiveStake(
+		stakeEntry.Stake.Amount.Add(selfRestake),
+		stakeEntry.DelegateTotal.Amount.Add(delegationsRestake),
+	)
+
+	denom := k.stakingKeeper.BondDenom(ctx)
+	return &types.QueryProjectedStakeResponse{
+		EffectiveStake:          sdk.NewCoin(denom, current),
+		PendingRestake:          sdk.NewCoin(denom, projected.Sub(current)),
+		ProjectedEffectiveStake: sdk.NewCoin(denom, projected),
+	}, nil
+}
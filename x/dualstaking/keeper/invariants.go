@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// RegisterInvariants registers the dualstaking module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "provider-delegations", ProviderDelegationsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegations-backed", DelegationsBackedInvariant(k))
+}
+
+type delegationsSum struct {
+	self   math.Int
+	others math.Int
+}
+
+// ProviderDelegationsInvariant checks that the (epochstorage) stake entry of every
+// staked provider matches its delegations: the Stake is the self delegation and the
+// DelegateTotal is the sum of the delegations of all the other delegators
+func ProviderDelegationsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+		sums := map[string]*delegationsSum{}
+		for _, ind := range k.delegationFS.GetAllEntryIndices(ctx) {
+			var d types.Delegation
+			if found := k.delegationFS.FindEntry(ctx, ind, nextEpoch, &d); !found {
+				continue
+			}
+			key := d.Provider + " " + d.ChainID
+			sum, ok := sums[key]
+			if !ok {
+				sum = &delegationsSum{self: math.ZeroInt(), others: math.ZeroInt()}
+				sums[key] = sum
+			}
+			if d.Delegator == d.Provider {
+				sum.self = sum.self.Add(d.Amount.Amount)
+			} else {
+				sum.others = sum.others.Add(d.Amount.Amount)
+			}
+		}
+
+		var msg string
+		var count int
+		for _, chainID := range k.specKeeper.GetAllChainIDs(ctx) {
+			stakeStorage, found := k.epochstorageKeeper.GetStakeStorageCurrent(ctx, chainID)
+			if !found {
+				continue
+			}
+			for _, entry := range stakeStorage.StakeEntries {
+				sum, ok := sums[entry.Address+" "+chainID]
+				if !ok {
+					sum = &delegationsSum{self: math.ZeroInt(), others: math.ZeroInt()}
+				}
+				stake, delegateTotal := coinAmount(entry.Stake), coinAmount(entry.DelegateTotal)
+				if !stake.Equal(sum.self) || !delegateTotal.Equal(sum.others) {
+					count++
+					msg += fmt.Sprintf("\tprovider %s chain %s: stake %s self delegation %s, delegate total %s delegations %s\n",
+						entry.Address, chainID, stake, sum.self, delegateTotal, sum.others)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "provider-delegations", fmt.Sprintf(
+			"found %d stake entries that do not match their delegations\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// DelegationsBackedInvariant checks that the tokens held by the staking pools cover
+// all the delegations to providers (which include the providers' self delegations).
+// The provider delegations mirror the validator delegations, so their tokens are
+// held by the staking module (and not by the dualstaking pools)
+func DelegationsBackedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+		bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+		delegated := math.ZeroInt()
+		for _, ind := range k.delegationFS.GetAllEntryIndices(ctx) {
+			var d types.Delegation
+			if found := k.delegationFS.FindEntry(ctx, ind, nextEpoch, &d); found {
+				delegated = delegated.Add(d.Amount.Amount)
+			}
+		}
+
+		bonded := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(stakingtypes.BondedPoolName), bondDenom)
+		notBonded := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), bondDenom)
+		pools := bonded.Amount.Add(notBonded.Amount)
+
+		return sdk.FormatInvariant(types.ModuleName, "delegations-backed", fmt.Sprintf(
+			"\tsum of delegations: %s%s\n\tstaking pools balance: %s%s\n", delegated, bondDenom, pools, bondDenom,
+		)), delegated.GT(pools)
+	}
+}
+
+func coinAmount(coin sdk.Coin) math.Int {
+	if coin.IsNil() {
+		return math.ZeroInt()
+	}
+	return coin.Amount
+}
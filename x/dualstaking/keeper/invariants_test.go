@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderDelegationsInvariant(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 2 providers staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	provider2Acct, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Name, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider2Addr, ts.spec.Name, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	invariant := keeper.ProviderDelegationsInvariant(ts.Keepers.Dualstaking)
+	msg, broken := invariant(ts.Ctx)
+	require.False(t, broken, msg)
+
+	// corrupt the delegate total of one provider
+	stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider2Acct.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateTotal = stakeEntry.DelegateTotal.Add(amount)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Name, stakeEntry, index)
+
+	msg, broken = invariant(ts.Ctx)
+	require.True(t, broken, msg)
+	require.Contains(t, msg, "found 1 stake entries")
+}
+
+func TestDelegationsBackedInvariant(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Name, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	msg, broken := keeper.DelegationsBackedInvariant(ts.Keepers.Dualstaking)(ts.Ctx)
+	require.False(t, broken, msg)
+}
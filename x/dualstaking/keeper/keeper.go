@@ -110,3 +110,12 @@ func (k Keeper) ChangeDelegationTimestampForTesting(ctx sdk.Context, index strin
 	k.delegationFS.ModifyEntry(ctx, index, entryBlock, &d)
 	return nil
 }
+
+// BeginBlock starts an auto-compound round on epoch start, and restakes the due
+// auto-compounded delegator rewards of the round in bounded batches per block
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	if k.epochstorageKeeper.IsEpochStart(ctx) {
+		k.StartAutoCompoundRound(ctx)
+	}
+	k.AutoCompoundRewards(ctx, types.MaxAutoCompoundsPerBlock)
+}
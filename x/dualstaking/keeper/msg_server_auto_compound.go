@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func (k msgServer) AutoCompound(goCtx context.Context, msg *types.MsgAutoCompound) (*types.MsgAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.ConfigureAutoCompound(
+		ctx,
+		msg.Creator,
+		msg.Validator,
+		msg.Provider,
+		msg.ChainID,
+		msg.Cadence,
+	)
+	if err == nil {
+		logger := k.Keeper.Logger(ctx)
+		details := map[string]string{
+			"delegator": msg.Creator,
+			"provider":  msg.Provider,
+			"chainID":   msg.ChainID,
+			"validator": msg.Validator,
+			"cadence":   msg.Cadence.String(),
+		}
+		utils.LogLavaEvent(ctx, logger, types.AutoCompoundEventName, details, "Configure Delegation Auto-Compound")
+	}
+
+	return &types.MsgAutoCompoundResponse{}, err
+}
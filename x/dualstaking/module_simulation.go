@@ -39,6 +39,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgClaimRewards int = 100
 
+	opWeightMsgAutoCompound = "op_weight_msg_auto_compound"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAutoCompound int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -111,6 +115,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		dualstakingsimulation.SimulateMsgClaimRewards(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgAutoCompound int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAutoCompound, &weightMsgAutoCompound, nil,
+		func(_ *rand.Rand) {
+			weightMsgAutoCompound = defaultWeightMsgAutoCompound
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAutoCompound,
+		dualstakingsimulation.SimulateMsgAutoCompound(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -0,0 +1,578 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/dualstaking/auto_compound.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AutoCompound_Cadence int32
+
+const (
+	AutoCompound_DISABLED AutoCompound_Cadence = 0
+	AutoCompound_EPOCH    AutoCompound_Cadence = 1
+	AutoCompound_MONTH    AutoCompound_Cadence = 2
+)
+
+var AutoCompound_Cadence_name = map[int32]string{
+	0: "DISABLED",
+	1: "EPOCH",
+	2: "MONTH",
+}
+
+var AutoCompound_Cadence_value = map[string]int32{
+	"DISABLED": 0,
+	"EPOCH":    1,
+	"MONTH":    2,
+}
+
+func (x AutoCompound_Cadence) String() string {
+	return proto.EnumName(AutoCompound_Cadence_name, int32(x))
+}
+
+func (AutoCompound_Cadence) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e9b4eb6038bbfcd0, []int{0, 0}
+}
+
+type AutoCompound struct {
+	Delegator    string               `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Provider     string               `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID      string               `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Validator    string               `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	Cadence      AutoCompound_Cadence `protobuf:"varint,5,opt,name=cadence,proto3,enum=lavanet.lava.dualstaking.AutoCompound_Cadence" json:"cadence,omitempty"`
+	LastCompound int64                `protobuf:"varint,6,opt,name=last_compound,json=lastCompound,proto3" json:"last_compound,omitempty"`
+}
+
+func (m *AutoCompound) Reset()         { *m = AutoCompound{} }
+func (m *AutoCompound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompound) ProtoMessage()    {}
+func (*AutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9b4eb6038bbfcd0, []int{0}
+}
+func (m *AutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompound.Merge(m, src)
+}
+func (m *AutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompound proto.InternalMessageInfo
+
+func (m *AutoCompound) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetCadence() AutoCompound_Cadence {
+	if m != nil {
+		return m.Cadence
+	}
+	return AutoCompound_DISABLED
+}
+
+func (m *AutoCompound) GetLastCompound() int64 {
+	if m != nil {
+		return m.LastCompound
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("lavanet.lava.dualstaking.AutoCompound_Cadence", AutoCompound_Cadence_name, AutoCompound_Cadence_value)
+	proto.RegisterType((*AutoCompound)(nil), "lavanet.lava.dualstaking.AutoCompound")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/dualstaking/auto_compound.proto", fileDescriptor_e9b4eb6038bbfcd0)
+}
+
+var fileDescriptor_e9b4eb6038bbfcd0 = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x33, 0xad, 0xfd, 0x1b, 0xaa, 0x94, 0x59, 0x0d, 0x22, 0x43, 0xa9, 0x9b, 0x82, 0x3a,
+	0x01, 0x7d, 0x82, 0xfe, 0x04, 0x52, 0x50, 0x2b, 0xd1, 0x95, 0x1b, 0x99, 0x66, 0x86, 0x34, 0x98,
+	0x66, 0x42, 0x32, 0x09, 0xfa, 0x16, 0x3e, 0x81, 0xcf, 0xe3, 0xb2, 0x4b, 0x97, 0x92, 0xbc, 0x88,
+	0x24, 0x4d, 0x62, 0xbb, 0x70, 0x75, 0xe7, 0x9e, 0x7b, 0xbf, 0xc3, 0x1d, 0x0e, 0xbc, 0xf4, 0x58,
+	0xc2, 0x7c, 0xa1, 0xf4, 0xbc, 0xea, 0x3c, 0x66, 0x5e, 0xa4, 0xd8, 0xab, 0xeb, 0x3b, 0x3a, 0x8b,
+	0x95, 0x7c, 0xb1, 0xe5, 0x26, 0x90, 0xb1, 0xcf, 0x69, 0x10, 0x4a, 0x25, 0x11, 0x2e, 0xb7, 0x69,
+	0x5e, 0xe9, 0xde, 0xf6, 0xe8, 0xb3, 0x01, 0xfb, 0x93, 0x58, 0xc9, 0x59, 0x09, 0xa0, 0x33, 0xd8,
+	0xe3, 0xc2, 0x13, 0x0e, 0x53, 0x32, 0xc4, 0x60, 0x08, 0xc6, 0x3d, 0xeb, 0x4f, 0x40, 0xa7, 0xb0,
+	0x1b, 0x84, 0x32, 0x71, 0xb9, 0x08, 0x71, 0xa3, 0x18, 0xd6, 0x3d, 0xc2, 0xb0, 0x63, 0xaf, 0x99,
+	0xeb, 0x2f, 0xe6, 0xb8, 0x59, 0x8c, 0xaa, 0x36, 0xf7, 0x4c, 0x98, 0xe7, 0xf2, 0xc2, 0xf3, 0x68,
+	0xe7, 0x59, 0x0b, 0xc8, 0x84, 0x1d, 0x9b, 0x71, 0xe1, 0xdb, 0x02, 0xb7, 0x86, 0x60, 0x7c, 0x72,
+	0x4d, 0xe9, 0x7f, 0xe7, 0xd2, 0xfd, 0x53, 0xe9, 0x6c, 0x47, 0x59, 0x15, 0x8e, 0xce, 0xe1, 0xb1,
+	0xc7, 0x22, 0x55, 0xff, 0x1e, 0xb7, 0x87, 0x60, 0xdc, 0xb4, 0xfa, 0xb9, 0x58, 0x51, 0xa3, 0x2b,
+	0xd8, 0x29, 0x41, 0xd4, 0x87, 0xdd, 0xf9, 0xe2, 0x71, 0x32, 0xbd, 0x35, 0xe6, 0x03, 0x0d, 0xf5,
+	0x60, 0xcb, 0x78, 0x58, 0xce, 0xcc, 0x01, 0xc8, 0x9f, 0x77, 0xcb, 0xfb, 0x27, 0x73, 0xd0, 0x98,
+	0x1a, 0x5f, 0x29, 0x01, 0xdb, 0x94, 0x80, 0x9f, 0x94, 0x80, 0x8f, 0x8c, 0x68, 0xdb, 0x8c, 0x68,
+	0xdf, 0x19, 0xd1, 0x9e, 0x2f, 0x1c, 0x57, 0xad, 0xe3, 0x15, 0xb5, 0xe5, 0x46, 0x3f, 0x48, 0xe3,
+	0xed, 0x20, 0x0f, 0xf5, 0x1e, 0x88, 0x68, 0xd5, 0x2e, 0x82, 0xb8, 0xf9, 0x1d, 0x00, 0x71, 0x31,
+	0x79, 0x7f, 0xb8, 0x01, 0x00, 0x00,
+}
+
+func (m *AutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCompound != 0 {
+		i = encodeVarintAutoCompound(dAtA, i, uint64(m.LastCompound))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Cadence != 0 {
+		i = encodeVarintAutoCompound(dAtA, i, uint64(m.Cadence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAutoCompound(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAutoCompound(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	if m.Cadence != 0 {
+		n += 1 + sovAutoCompound(uint64(m.Cadence))
+	}
+	if m.LastCompound != 0 {
+		n += 1 + sovAutoCompound(uint64(m.LastCompound))
+	}
+	return n
+}
+
+func sovAutoCompound(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAutoCompound(x uint64) (n int) {
+	return sovAutoCompound(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoCompound
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cadence", wireType)
+			}
+			m.Cadence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cadence |= AutoCompound_Cadence(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompound", wireType)
+			}
+			m.LastCompound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCompound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoCompound(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAutoCompound(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAutoCompound
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAutoCompound
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAutoCompound
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAutoCompound
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAutoCompound        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAutoCompound          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAutoCompound = fmt.Errorf("proto: unexpected end of group")
+)
@@ -13,4 +13,5 @@ var (
 	ErrBadDelegationAmount       = sdkerrors.Register(ModuleName, 1003, "invalid delegation amount")
 	ErrUnbondingInProgress       = sdkerrors.Register(ModuleName, 1004, "unbonding already exists (same block)")
 	ErrCalculatingProviderReward = sdkerrors.Register(ModuleName, 1005, "provider reward calculation failed")
+	ErrAutoCompoundNotFound      = sdkerrors.Register(ModuleName, 1006, "auto-compound target not found")
 )
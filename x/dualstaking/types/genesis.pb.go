@@ -32,6 +32,7 @@ type GenesisState struct {
 	DelegatorsFS        types.GenesisState  `protobuf:"bytes,3,opt,name=delegatorsFS,proto3" json:"delegatorsFS"`
 	UnbondingsTS        types1.GenesisState `protobuf:"bytes,4,opt,name=unbondingsTS,proto3" json:"unbondingsTS"`
 	DelegatorRewardList []DelegatorReward   `protobuf:"bytes,5,rep,name=delegator_reward_list,json=delegatorRewardList,proto3" json:"delegator_reward_list"`
+	AutoCompoundList    []AutoCompound      `protobuf:"bytes,6,rep,name=auto_compound_list,json=autoCompoundList,proto3" json:"auto_compound_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +103,13 @@ func (m *GenesisState) GetDelegatorRewardList() []DelegatorReward {
 	return nil
 }
 
+func (m *GenesisState) GetAutoCompoundList() []AutoCompound {
+	if m != nil {
+		return m.AutoCompoundList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.dualstaking.GenesisState")
 }
@@ -111,30 +119,32 @@ func init() {
 }
 
 var fileDescriptor_d5bca863c53f218f = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x4e, 0x2a, 0x31,
-	0x14, 0x87, 0x67, 0x2e, 0x5c, 0x16, 0x85, 0xbb, 0x99, 0xab, 0xc9, 0x84, 0xc5, 0x48, 0x34, 0x18,
-	0x88, 0x49, 0x27, 0xc1, 0xbd, 0x0b, 0xe2, 0x9f, 0x8d, 0x89, 0x06, 0x58, 0xb9, 0x21, 0x85, 0xa9,
-	0xb5, 0x71, 0x68, 0x49, 0x7b, 0x50, 0x7c, 0x0b, 0x9f, 0xc4, 0xe7, 0x60, 0xc9, 0xd2, 0x95, 0x31,
-	0xf0, 0x22, 0x66, 0x3a, 0x15, 0xa9, 0x71, 0x36, 0xae, 0xda, 0x99, 0x7c, 0xe7, 0x6b, 0x7f, 0xa7,
-	0x07, 0x1d, 0xa6, 0xe4, 0x81, 0x08, 0x0a, 0x71, 0xb6, 0xc6, 0xc9, 0x8c, 0xa4, 0x1a, 0xc8, 0x3d,
-	0x17, 0x2c, 0x66, 0x54, 0x50, 0xcd, 0x35, 0x9e, 0x2a, 0x09, 0x32, 0x08, 0x2d, 0x87, 0xb3, 0x15,
-	0x6f, 0x71, 0xf5, 0x1d, 0x26, 0x99, 0x34, 0x50, 0x9c, 0xed, 0x72, 0xbe, 0xde, 0x2c, 0xf4, 0x4e,
-	0x89, 0x22, 0x13, 0xab, 0xad, 0xb7, 0x1d, 0xec, 0x96, 0xcf, 0x09, 0x70, 0x29, 0x34, 0x48, 0x45,
-	0x37, 0x5f, 0x16, 0x3d, 0x70, 0x50, 0xe0, 0x13, 0xaa, 0x72, 0xce, 0x6c, 0x2d, 0x14, 0x17, 0x1e,
-	0x9b, 0xd0, 0x94, 0x32, 0x02, 0x52, 0x0d, 0x15, 0x7d, 0x24, 0x2a, 0xc9, 0x0b, 0xf6, 0x5f, 0x4a,
-	0xa8, 0x76, 0x91, 0x27, 0xed, 0x03, 0x01, 0x1a, 0x9c, 0xa0, 0x4a, 0x7e, 0xc3, 0xd0, 0x6f, 0xf8,
-	0xad, 0x6a, 0xa7, 0x81, 0x8b, 0x92, 0xe3, 0x6b, 0xc3, 0x75, 0xcb, 0x8b, 0xb7, 0x3d, 0xaf, 0x67,
-	0xab, 0x82, 0x01, 0xfa, 0x67, 0x8f, 0xca, 0x82, 0x9c, 0xf7, 0xc3, 0x3f, 0x46, 0xd3, 0x72, 0x35,
-	0x4e, 0x52, 0xbc, 0x7d, 0x01, 0xab, 0x73, 0x25, 0x41, 0x0f, 0xd5, 0x36, 0x01, 0x32, 0x69, 0xe9,
-	0x57, 0x52, 0xc7, 0x11, 0x5c, 0xa1, 0xda, 0x4c, 0x8c, 0xa4, 0x48, 0xb8, 0x60, 0x7a, 0xd0, 0x0f,
-	0xcb, 0xc6, 0xd9, 0x74, 0x9d, 0x5f, 0x7d, 0xfe, 0x51, 0xb8, 0x2d, 0x08, 0xc6, 0x68, 0xf7, 0x7b,
-	0x97, 0x87, 0x29, 0xd7, 0x10, 0xfe, 0x6d, 0x94, 0x5a, 0xd5, 0x4e, 0xbb, 0xb8, 0x93, 0xa7, 0x9f,
-	0x65, 0x3d, 0x53, 0x65, 0xed, 0xff, 0x13, 0xf7, 0xf7, 0x25, 0xd7, 0xd0, 0x3d, 0x5b, 0xac, 0x22,
-	0x7f, 0xb9, 0x8a, 0xfc, 0xf7, 0x55, 0xe4, 0x3f, 0xaf, 0x23, 0x6f, 0xb9, 0x8e, 0xbc, 0xd7, 0x75,
-	0xe4, 0xdd, 0x1c, 0x31, 0x0e, 0x77, 0xb3, 0x11, 0x1e, 0xcb, 0x89, 0x3b, 0x06, 0x73, 0x67, 0x10,
-	0xe0, 0x69, 0x4a, 0xf5, 0xa8, 0x62, 0x9e, 0xff, 0xf8, 0x23, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x1d,
-	0x05, 0xe7, 0x00, 0x03, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x4f, 0xc2, 0x30,
+	0x18, 0x87, 0x37, 0x41, 0x0e, 0x05, 0x13, 0x53, 0x35, 0x59, 0x38, 0x4c, 0xa2, 0x81, 0x40, 0x34,
+	0x5b, 0x82, 0x77, 0x13, 0xf1, 0xdf, 0xc5, 0x44, 0x03, 0x9c, 0xb8, 0x90, 0xc2, 0xea, 0x6c, 0xdc,
+	0xda, 0x65, 0xed, 0x14, 0xbf, 0x82, 0x27, 0x3f, 0x16, 0x47, 0x8e, 0x9e, 0x8c, 0x81, 0x2f, 0x62,
+	0xd6, 0x55, 0x5c, 0x8d, 0xbb, 0x78, 0x5a, 0xd7, 0x3c, 0xbf, 0xe7, 0x6d, 0xdf, 0xbe, 0xa0, 0x15,
+	0xa0, 0x27, 0x44, 0xb1, 0x70, 0xd3, 0xaf, 0xeb, 0x25, 0x28, 0xe0, 0x02, 0x3d, 0x12, 0xea, 0xbb,
+	0x3e, 0xa6, 0x98, 0x13, 0xee, 0x44, 0x31, 0x13, 0x0c, 0x5a, 0x8a, 0x73, 0xd2, 0xaf, 0x93, 0xe3,
+	0xea, 0xbb, 0x3e, 0xf3, 0x99, 0x84, 0xdc, 0x74, 0x95, 0xf1, 0xf5, 0x66, 0xa1, 0x37, 0x42, 0x31,
+	0x0a, 0x95, 0xb6, 0xde, 0xd1, 0xb0, 0x7b, 0x32, 0x43, 0x82, 0x30, 0xca, 0x05, 0x8b, 0xf1, 0xfa,
+	0x4f, 0xa1, 0x87, 0x1a, 0x2a, 0x48, 0x88, 0xe3, 0x8c, 0x93, 0x4b, 0x05, 0xb9, 0x85, 0x65, 0x3d,
+	0x1c, 0x60, 0x1f, 0x09, 0x16, 0x8f, 0x63, 0xfc, 0x8c, 0x62, 0x4f, 0x05, 0x8e, 0x0b, 0x03, 0x28,
+	0x11, 0x6c, 0x3c, 0x65, 0x61, 0xc4, 0x12, 0xaa, 0xe8, 0x83, 0xd7, 0x32, 0xa8, 0x5d, 0x67, 0x7d,
+	0x19, 0x08, 0x24, 0x30, 0x3c, 0x05, 0x95, 0xec, 0x3e, 0x96, 0xd9, 0x30, 0xdb, 0xd5, 0x6e, 0xc3,
+	0x29, 0xea, 0x93, 0x73, 0x27, 0xb9, 0x5e, 0x79, 0xfe, 0xb1, 0x6f, 0xf4, 0x55, 0x0a, 0x0e, 0xc1,
+	0x96, 0x3a, 0x58, 0x7a, 0xed, 0xab, 0x81, 0xb5, 0x21, 0x35, 0x6d, 0x5d, 0xa3, 0xf5, 0xc5, 0xc9,
+	0x1f, 0x40, 0xe9, 0x74, 0x09, 0xec, 0x83, 0xda, 0xfa, 0xba, 0xa9, 0xb4, 0xf4, 0x2f, 0xa9, 0xe6,
+	0x80, 0xb7, 0xa0, 0x96, 0xd0, 0x09, 0xa3, 0x1e, 0xa1, 0x3e, 0x1f, 0x0e, 0xac, 0xb2, 0x74, 0x36,
+	0x75, 0xe7, 0xcf, 0xab, 0xfc, 0x29, 0xcc, 0x0b, 0xe0, 0x14, 0xec, 0xfd, 0x7e, 0x93, 0x71, 0x40,
+	0xb8, 0xb0, 0x36, 0x1b, 0xa5, 0x76, 0xb5, 0xdb, 0x29, 0xee, 0xe4, 0xc5, 0x77, 0xac, 0x2f, 0x53,
+	0xca, 0xbe, 0xe3, 0xe9, 0xdb, 0x37, 0x84, 0x0b, 0x38, 0x02, 0x50, 0x7b, 0xc7, 0xac, 0x42, 0x45,
+	0x56, 0x68, 0x15, 0x57, 0x38, 0x4b, 0x04, 0x3b, 0x57, 0x11, 0xa5, 0xdf, 0x46, 0xb9, 0xbd, 0xd4,
+	0xdd, 0xbb, 0x9c, 0x2f, 0x6d, 0x73, 0xb1, 0xb4, 0xcd, 0xcf, 0xa5, 0x6d, 0xbe, 0xad, 0x6c, 0x63,
+	0xb1, 0xb2, 0x8d, 0xf7, 0x95, 0x6d, 0x8c, 0x8e, 0x7c, 0x22, 0x1e, 0x92, 0x89, 0x33, 0x65, 0xa1,
+	0x3e, 0x90, 0x33, 0x6d, 0xc2, 0xc4, 0x4b, 0x84, 0xf9, 0xa4, 0x22, 0x47, 0xeb, 0xe4, 0x6b, 0x00,
+	0x8d, 0x9a, 0xcd, 0x8e, 0x8a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -157,6 +167,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundList) > 0 {
+		for iNdEx := len(m.AutoCompoundList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DelegatorRewardList) > 0 {
 		for iNdEx := len(m.DelegatorRewardList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -245,6 +269,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundList) > 0 {
+		for _, e := range m.AutoCompoundList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -449,6 +479,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundList = append(m.AutoCompoundList, AutoCompound{})
+			if err := m.AutoCompoundList[len(m.AutoCompoundList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -55,6 +55,24 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated autoCompound",
+			genState: &types.GenesisState{
+				AutoCompoundList: []types.AutoCompound{
+					{
+						Provider:  "p0",
+						Delegator: "d0",
+						ChainID:   "c0",
+					},
+					{
+						Provider:  "p0",
+						Delegator: "d0",
+						ChainID:   "c0",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
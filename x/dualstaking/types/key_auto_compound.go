@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// AutoCompoundKeyPrefix is the prefix to retrieve all AutoCompound
+	AutoCompoundKeyPrefix = "AutoCompound/value/"
+
+	// AutoCompoundCursorKey holds the key of the next AutoCompound to process while an auto-compound round is in progress
+	AutoCompoundCursorKey = "AutoCompound/cursor/"
+
+	// MaxAutoCompoundsPerBlock bounds the number of AutoCompound processed in a single block
+	MaxAutoCompoundsPerBlock = 100
+)
+
+// AutoCompoundKey returns the store key to retrieve an AutoCompound from the index fields
+func AutoCompoundKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AutoCompoundProviderPrefix returns the prefix of all the AutoCompound of a provider (keys start with the provider, see DelegationKey)
+func AutoCompoundProviderPrefix(provider string) []byte {
+	return []byte(provider + " ")
+}
+
+// ParseAutoCompoundCadence parses an auto-compound cadence name (case insensitive)
+func ParseAutoCompoundCadence(cadence string) (AutoCompound_Cadence, error) {
+	value, ok := AutoCompound_Cadence_value[strings.ToUpper(cadence)]
+	if !ok {
+		return AutoCompound_DISABLED, fmt.Errorf("invalid auto-compound cadence: %s", cadence)
+	}
+	return AutoCompound_Cadence(value), nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAutoCompound = "auto_compound"
+
+var _ sdk.Msg = &MsgAutoCompound{}
+
+func NewMsgAutoCompound(delegator string, validator string, provider string, chainID string, cadence AutoCompound_Cadence) *MsgAutoCompound {
+	return &MsgAutoCompound{
+		Creator:   delegator,
+		Validator: validator,
+		Provider:  provider,
+		ChainID:   chainID,
+		Cadence:   cadence,
+	}
+}
+
+func (msg *MsgAutoCompound) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAutoCompound) Type() string {
+	return TypeMsgAutoCompound
+}
+
+func (msg *MsgAutoCompound) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	// rewards are only given for delegations to actual providers
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	if _, ok := AutoCompound_Cadence_name[int32(msg.Cadence)]; !ok {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidRequest, "invalid auto-compound cadence (%d)", msg.Cadence)
+	}
+
+	if msg.Cadence != AutoCompound_DISABLED {
+		_, err = sdk.ValAddressFromBech32(msg.Validator)
+		if err != nil {
+			return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAutoCompound_ValidateBasic(t *testing.T) {
+	validator := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	tests := []struct {
+		name string
+		msg  MsgAutoCompound
+		err  error
+	}{
+		{
+			name: "invalid delegator",
+			msg: MsgAutoCompound{
+				Creator:   "invalid_address",
+				Validator: validator,
+				Provider:  sample.AccAddress(),
+				Cadence:   AutoCompound_EPOCH,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid provider",
+			msg: MsgAutoCompound{
+				Creator:   sample.AccAddress(),
+				Validator: validator,
+				Provider:  EMPTY_PROVIDER,
+				Cadence:   AutoCompound_EPOCH,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid validator",
+			msg: MsgAutoCompound{
+				Creator:   sample.AccAddress(),
+				Validator: "invalid_address",
+				Provider:  sample.AccAddress(),
+				Cadence:   AutoCompound_MONTH,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid cadence",
+			msg: MsgAutoCompound{
+				Creator:   sample.AccAddress(),
+				Validator: validator,
+				Provider:  sample.AccAddress(),
+				Cadence:   AutoCompound_Cadence(10),
+			},
+			err: legacyerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgAutoCompound{
+				Creator:   sample.AccAddress(),
+				Validator: validator,
+				Provider:  sample.AccAddress(),
+				Cadence:   AutoCompound_EPOCH,
+			},
+		}, {
+			name: "valid disable without validator",
+			msg: MsgAutoCompound{
+				Creator:  sample.AccAddress(),
+				Provider: sample.AccAddress(),
+				Cadence:  AutoCompound_DISABLED,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
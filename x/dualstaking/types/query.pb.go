@@ -470,6 +470,118 @@ func (m *DelegatorRewardInfo) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+type QueryProjectedStakeRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainId  string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryProjectedStakeRequest) Reset()         { *m = QueryProjectedStakeRequest{} }
+func (m *QueryProjectedStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedStakeRequest) ProtoMessage()    {}
+func (*QueryProjectedStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{9}
+}
+func (m *QueryProjectedStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedStakeRequest.Merge(m, src)
+}
+func (m *QueryProjectedStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedStakeRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedStakeRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryProjectedStakeRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryProjectedStakeResponse struct {
+	EffectiveStake          types.Coin `protobuf:"bytes,1,opt,name=effective_stake,json=effectiveStake,proto3" json:"effective_stake"`
+	PendingRestake          types.Coin `protobuf:"bytes,2,opt,name=pending_restake,json=pendingRestake,proto3" json:"pending_restake"`
+	ProjectedEffectiveStake types.Coin `protobuf:"bytes,3,opt,name=projected_effective_stake,json=projectedEffectiveStake,proto3" json:"projected_effective_stake"`
+}
+
+func (m *QueryProjectedStakeResponse) Reset()         { *m = QueryProjectedStakeResponse{} }
+func (m *QueryProjectedStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedStakeResponse) ProtoMessage()    {}
+func (*QueryProjectedStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{10}
+}
+func (m *QueryProjectedStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedStakeResponse.Merge(m, src)
+}
+func (m *QueryProjectedStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedStakeResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedStakeResponse) GetEffectiveStake() types.Coin {
+	if m != nil {
+		return m.EffectiveStake
+	}
+	return types.Coin{}
+}
+
+func (m *QueryProjectedStakeResponse) GetPendingRestake() types.Coin {
+	if m != nil {
+		return m.PendingRestake
+	}
+	return types.Coin{}
+}
+
+func (m *QueryProjectedStakeResponse) GetProjectedEffectiveStake() types.Coin {
+	if m != nil {
+		return m.ProjectedEffectiveStake
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.dualstaking.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.dualstaking.QueryParamsResponse")
@@ -480,6 +592,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorRewardsRequest)(nil), "lavanet.lava.dualstaking.QueryDelegatorRewardsRequest")
 	proto.RegisterType((*QueryDelegatorRewardsResponse)(nil), "lavanet.lava.dualstaking.QueryDelegatorRewardsResponse")
 	proto.RegisterType((*DelegatorRewardInfo)(nil), "lavanet.lava.dualstaking.DelegatorRewardInfo")
+	proto.RegisterType((*QueryProjectedStakeRequest)(nil), "lavanet.lava.dualstaking.QueryProjectedStakeRequest")
+	proto.RegisterType((*QueryProjectedStakeResponse)(nil), "lavanet.lava.dualstaking.QueryProjectedStakeResponse")
 }
 
 func init() {
@@ -487,49 +601,57 @@ func init() {
 }
 
 var fileDescriptor_8393eed0cfbc46b2 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x4f, 0x13, 0x4f,
-	0x18, 0xc6, 0x3b, 0xe5, 0xff, 0x2f, 0x30, 0xf5, 0x60, 0x06, 0x0e, 0xa5, 0xc1, 0xa5, 0x6e, 0x30,
-	0x36, 0x2a, 0x3b, 0xa1, 0x26, 0x02, 0x9a, 0x18, 0x45, 0x3c, 0x90, 0x48, 0xc4, 0x26, 0x5c, 0xbc,
-	0x34, 0xd3, 0xee, 0xb8, 0x4c, 0x6c, 0x67, 0x96, 0x9d, 0x29, 0x48, 0x08, 0x17, 0x13, 0xcf, 0x9a,
-	0xf0, 0xa5, 0x48, 0xf4, 0x40, 0xe2, 0xc5, 0x78, 0x30, 0x06, 0xfc, 0x20, 0x66, 0x67, 0x67, 0xeb,
-	0x2e, 0x65, 0xd9, 0x62, 0xe2, 0x69, 0xbb, 0xef, 0x3c, 0x33, 0xcf, 0xfb, 0x9b, 0x37, 0xcf, 0x16,
-	0xce, 0x77, 0xc9, 0x2e, 0xe1, 0x54, 0xe1, 0xf0, 0x89, 0xdd, 0x3e, 0xe9, 0x4a, 0x45, 0xde, 0x32,
-	0xee, 0xe1, 0x9d, 0x3e, 0x0d, 0xf6, 0x1d, 0x3f, 0x10, 0x4a, 0xa0, 0x8a, 0x51, 0x39, 0xe1, 0xd3,
-	0x49, 0xa8, 0xaa, 0xd3, 0x9e, 0xf0, 0x84, 0x16, 0xe1, 0xf0, 0x57, 0xa4, 0xaf, 0xce, 0x7a, 0x42,
-	0x78, 0x5d, 0x8a, 0x89, 0xcf, 0x30, 0xe1, 0x5c, 0x28, 0xa2, 0x98, 0xe0, 0xd2, 0xac, 0xde, 0xe9,
-	0x08, 0xd9, 0x13, 0x12, 0xb7, 0x89, 0xa4, 0x91, 0x0d, 0xde, 0x5d, 0x6c, 0x53, 0x45, 0x16, 0xb1,
-	0x4f, 0x3c, 0xc6, 0xb5, 0xd8, 0x68, 0x6f, 0x65, 0xf6, 0xe7, 0x93, 0x80, 0xf4, 0xe2, 0x23, 0x6f,
-	0x67, 0xca, 0x5c, 0xda, 0xa5, 0x1e, 0x51, 0xd4, 0x08, 0xad, 0xa4, 0x77, 0xec, 0xda, 0x11, 0xcc,
-	0xf8, 0xd9, 0xd3, 0x10, 0xbd, 0x0a, 0x3b, 0xda, 0xd4, 0xa7, 0x37, 0xe9, 0x4e, 0x9f, 0x4a, 0x65,
-	0x6f, 0xc1, 0xa9, 0x54, 0x55, 0xfa, 0x82, 0x4b, 0x8a, 0x1e, 0xc3, 0x52, 0xd4, 0x45, 0x05, 0xd4,
-	0x40, 0xbd, 0xdc, 0xa8, 0x39, 0x59, 0xf7, 0xe4, 0x44, 0x3b, 0x57, 0xff, 0x3b, 0xfe, 0x31, 0x57,
-	0x68, 0x9a, 0x5d, 0x36, 0x81, 0x96, 0x3e, 0x76, 0x2d, 0xea, 0x51, 0x04, 0x9b, 0x81, 0xd8, 0x65,
-	0x2e, 0x0d, 0x62, 0x63, 0x34, 0x0b, 0x27, 0xdd, 0x78, 0x51, 0x9b, 0x4c, 0x36, 0xff, 0x14, 0xd0,
-	0x4d, 0x78, 0x6d, 0x8f, 0xa9, 0xed, 0x96, 0x4f, 0xb9, 0xcb, 0xb8, 0x57, 0x29, 0xd6, 0x40, 0x7d,
-	0xa2, 0x59, 0x0e, 0x6b, 0x9b, 0x51, 0xc9, 0x16, 0x70, 0x2e, 0xd3, 0xc2, 0x50, 0xbc, 0x80, 0x65,
-	0x73, 0x64, 0x38, 0xa3, 0x0a, 0xa8, 0x8d, 0xd5, 0xcb, 0x8d, 0xf9, 0x6c, 0x94, 0xb5, 0x81, 0xd8,
-	0xe0, 0x24, 0xb7, 0xdb, 0x2d, 0xc3, 0x14, 0xfb, 0x0c, 0x8c, 0x07, 0x4c, 0x55, 0x38, 0xe1, 0x9b,
-	0x45, 0x83, 0x34, 0x78, 0xbf, 0x0a, 0xd1, 0x45, 0x06, 0xff, 0x84, 0x48, 0xc2, 0xd9, 0xf4, 0x15,
-	0x36, 0xe9, 0x1e, 0x09, 0xdc, 0x11, 0x67, 0x94, 0xa4, 0x2d, 0x9e, 0xa3, 0x9d, 0x81, 0x13, 0x9d,
-	0x6d, 0xc2, 0x78, 0x8b, 0xb9, 0x95, 0x31, 0xbd, 0x36, 0xae, 0xdf, 0xd7, 0x5d, 0x9b, 0xc3, 0x1b,
-	0x19, 0xa6, 0x86, 0x71, 0x03, 0x8e, 0x07, 0x51, 0xc9, 0xf0, 0x2d, 0xe4, 0xf2, 0xc5, 0x87, 0xac,
-	0xf3, 0x37, 0xc2, 0x80, 0xc6, 0x67, 0xd8, 0x1f, 0x00, 0x9c, 0xba, 0x40, 0x76, 0xe9, 0xb0, 0x92,
-	0xed, 0x17, 0x53, 0xed, 0xa3, 0x25, 0x58, 0x22, 0x3d, 0xd1, 0xe7, 0x4a, 0x73, 0x95, 0x1b, 0x33,
-	0x4e, 0x94, 0x3b, 0x27, 0xcc, 0x9d, 0x63, 0x72, 0xe7, 0x3c, 0x13, 0x2c, 0xbe, 0x71, 0x23, 0x6f,
-	0x1c, 0x95, 0xe0, 0xff, 0x1a, 0x1c, 0x7d, 0x04, 0xb0, 0x14, 0xa5, 0x06, 0xdd, 0xcb, 0x46, 0x1b,
-	0x0e, 0x6b, 0x75, 0x61, 0x44, 0x75, 0x74, 0x91, 0x76, 0xfd, 0xfd, 0xd7, 0x5f, 0x47, 0x45, 0x1b,
-	0xd5, 0x70, 0xce, 0xa7, 0x06, 0x7d, 0x01, 0x10, 0x0d, 0xe7, 0x08, 0x2d, 0xe7, 0xf8, 0x65, 0xa6,
-	0xbb, 0xba, 0xf2, 0x17, 0x3b, 0x4d, 0xd7, 0x4f, 0x75, 0xd7, 0x8f, 0xd0, 0x0a, 0xce, 0xfb, 0xf2,
-	0x89, 0xa0, 0x15, 0x4f, 0x4c, 0xe2, 0x83, 0x41, 0xf1, 0x10, 0x7d, 0x06, 0x10, 0x0d, 0x87, 0x28,
-	0x17, 0x27, 0x33, 0xd8, 0xb9, 0x38, 0xd9, 0x89, 0xb5, 0x9f, 0x68, 0x9c, 0x87, 0x68, 0xf9, 0x92,
-	0x21, 0x98, 0xdd, 0xad, 0x01, 0x82, 0xc4, 0x07, 0x71, 0xf1, 0x10, 0x7d, 0x07, 0xf0, 0xfa, 0xf9,
-	0xb0, 0xa0, 0x07, 0xa3, 0x5e, 0x70, 0x3a, 0xd2, 0xd5, 0xa5, 0x2b, 0xef, 0x33, 0x1c, 0x5b, 0x9a,
-	0xe3, 0x25, 0xda, 0x18, 0x65, 0x2c, 0x26, 0x7b, 0xc9, 0xa1, 0x24, 0x88, 0xf0, 0x41, 0x1c, 0xae,
-	0xc3, 0xd5, 0xe7, 0xc7, 0xa7, 0x16, 0x38, 0x39, 0xb5, 0xc0, 0xcf, 0x53, 0x0b, 0x7c, 0x3a, 0xb3,
-	0x0a, 0x27, 0x67, 0x56, 0xe1, 0xdb, 0x99, 0x55, 0x78, 0x7d, 0xd7, 0x63, 0x6a, 0xbb, 0xdf, 0x76,
-	0x3a, 0xa2, 0x97, 0xb6, 0x7c, 0x97, 0x32, 0x55, 0xfb, 0x3e, 0x95, 0xed, 0x92, 0xfe, 0x8f, 0xbb,
-	0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x17, 0xcc, 0x53, 0x88, 0xf5, 0x07, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdf, 0x4f, 0xd4, 0x4a,
+	0x14, 0xde, 0x2e, 0xf7, 0x2e, 0x30, 0x7b, 0xc3, 0x35, 0x03, 0x89, 0x4b, 0xc5, 0xb2, 0x36, 0x18,
+	0x37, 0x2a, 0x9d, 0xb0, 0xfe, 0x00, 0x34, 0x51, 0x41, 0x88, 0x92, 0x48, 0xc4, 0x25, 0xbc, 0xe8,
+	0x43, 0x33, 0xbb, 0x1d, 0x4a, 0x65, 0xb7, 0x53, 0xda, 0xd9, 0x45, 0x42, 0x78, 0x31, 0xf1, 0x55,
+	0x4d, 0xfc, 0x9f, 0x0c, 0x89, 0x3e, 0x90, 0xf8, 0x62, 0x8c, 0x31, 0x06, 0xfc, 0x43, 0x4c, 0xa7,
+	0xd3, 0xba, 0x65, 0xb7, 0x6c, 0x21, 0xf1, 0xa9, 0xf4, 0xcc, 0x39, 0xe7, 0xfb, 0xbe, 0x73, 0xa6,
+	0x1f, 0x0b, 0x26, 0xea, 0xb8, 0x85, 0x6d, 0xc2, 0x90, 0xff, 0x44, 0x46, 0x13, 0xd7, 0x3d, 0x86,
+	0x37, 0x2d, 0xdb, 0x44, 0x5b, 0x4d, 0xe2, 0xee, 0x68, 0x8e, 0x4b, 0x19, 0x85, 0x05, 0x91, 0xa5,
+	0xf9, 0x4f, 0xad, 0x2d, 0x4b, 0x1e, 0x31, 0xa9, 0x49, 0x79, 0x12, 0xf2, 0xff, 0x0a, 0xf2, 0xe5,
+	0x31, 0x93, 0x52, 0xb3, 0x4e, 0x10, 0x76, 0x2c, 0x84, 0x6d, 0x9b, 0x32, 0xcc, 0x2c, 0x6a, 0x7b,
+	0xe2, 0xf4, 0x6a, 0x8d, 0x7a, 0x0d, 0xea, 0xa1, 0x2a, 0xf6, 0x48, 0x00, 0x83, 0x5a, 0x53, 0x55,
+	0xc2, 0xf0, 0x14, 0x72, 0xb0, 0x69, 0xd9, 0x3c, 0x59, 0xe4, 0x5e, 0x4e, 0xe4, 0xe7, 0x60, 0x17,
+	0x37, 0xc2, 0x96, 0x57, 0x12, 0xd3, 0x0c, 0x52, 0x27, 0x26, 0x66, 0x44, 0x24, 0x2a, 0xed, 0xd8,
+	0x21, 0x6a, 0x8d, 0x5a, 0x02, 0x4f, 0x1d, 0x01, 0xf0, 0x99, 0xcf, 0x68, 0x85, 0x77, 0xaf, 0x90,
+	0xad, 0x26, 0xf1, 0x98, 0xba, 0x06, 0x86, 0x63, 0x51, 0xcf, 0xa1, 0xb6, 0x47, 0xe0, 0x3d, 0x90,
+	0x0b, 0x58, 0x14, 0xa4, 0xa2, 0x54, 0xca, 0x97, 0x8b, 0x5a, 0xd2, 0x9c, 0xb4, 0xa0, 0x72, 0xfe,
+	0x9f, 0xfd, 0x1f, 0xe3, 0x99, 0x8a, 0xa8, 0x52, 0x31, 0x50, 0x78, 0xdb, 0x85, 0x80, 0x23, 0x75,
+	0x57, 0x5c, 0xda, 0xb2, 0x0c, 0xe2, 0x86, 0xc0, 0x70, 0x0c, 0x0c, 0x1a, 0xe1, 0x21, 0x07, 0x19,
+	0xac, 0xfc, 0x09, 0xc0, 0x4b, 0xe0, 0xbf, 0x6d, 0x8b, 0x6d, 0xe8, 0x0e, 0xb1, 0x0d, 0xcb, 0x36,
+	0x0b, 0xd9, 0xa2, 0x54, 0x1a, 0xa8, 0xe4, 0xfd, 0xd8, 0x4a, 0x10, 0x52, 0x29, 0x18, 0x4f, 0x84,
+	0x10, 0x2a, 0x9e, 0x80, 0xbc, 0x68, 0xe9, 0xef, 0xa8, 0x20, 0x15, 0xfb, 0x4a, 0xf9, 0xf2, 0x44,
+	0xb2, 0x94, 0x85, 0x28, 0x59, 0xc8, 0x69, 0x2f, 0x57, 0x75, 0xa1, 0x29, 0xc4, 0x89, 0x80, 0x23,
+	0x4d, 0x32, 0x18, 0x70, 0xc4, 0xa1, 0x90, 0x14, 0xbd, 0x9f, 0x46, 0x51, 0x37, 0x80, 0xbf, 0xa2,
+	0xc8, 0x03, 0x63, 0xf1, 0x11, 0x56, 0xc8, 0x36, 0x76, 0x8d, 0x94, 0x3b, 0x6a, 0x57, 0x9b, 0x3d,
+	0xa6, 0x76, 0x14, 0x0c, 0xd4, 0x36, 0xb0, 0x65, 0xeb, 0x96, 0x51, 0xe8, 0xe3, 0x67, 0xfd, 0xfc,
+	0x7d, 0xc9, 0x50, 0x6d, 0x70, 0x31, 0x01, 0x54, 0x68, 0x5c, 0x06, 0xfd, 0x6e, 0x10, 0x12, 0xfa,
+	0x26, 0x7b, 0xea, 0x0b, 0x9b, 0x2c, 0xd9, 0xeb, 0x54, 0x08, 0x0d, 0x7b, 0xa8, 0x6f, 0x24, 0x30,
+	0xdc, 0x25, 0xed, 0xc4, 0x65, 0xb5, 0xd3, 0xcf, 0xc6, 0xe8, 0xc3, 0x69, 0x90, 0xc3, 0x0d, 0xda,
+	0xb4, 0x19, 0xd7, 0x95, 0x2f, 0x8f, 0x6a, 0xc1, 0x77, 0xa7, 0xf9, 0xdf, 0x9d, 0x26, 0xbe, 0x3b,
+	0xed, 0x21, 0xb5, 0xc2, 0x89, 0x8b, 0x74, 0x75, 0x15, 0xc8, 0xe1, 0x76, 0x5f, 0x92, 0x1a, 0x23,
+	0xc6, 0x2a, 0xc3, 0x9b, 0x24, 0xcd, 0xd5, 0x49, 0x66, 0xa3, 0xbe, 0xcd, 0x82, 0x0b, 0x5d, 0xbb,
+	0x8a, 0x59, 0x3e, 0x06, 0xff, 0x93, 0xf5, 0x75, 0x52, 0x63, 0x56, 0x8b, 0xe8, 0xfe, 0xd0, 0x48,
+	0x41, 0x4a, 0x47, 0x7b, 0x28, 0xaa, 0xe3, 0x1d, 0xfd, 0x4e, 0xe2, 0xea, 0xea, 0x2e, 0x09, 0x3a,
+	0x65, 0x53, 0x76, 0x12, 0x75, 0x95, 0xa0, 0x0c, 0xbe, 0x00, 0xa3, 0x4e, 0xc8, 0x56, 0x3f, 0xce,
+	0x2e, 0xe5, 0x50, 0xcf, 0x47, 0x1d, 0x16, 0x63, 0x34, 0xcb, 0xdf, 0xfb, 0xc1, 0xbf, 0x7c, 0x20,
+	0xf0, 0x9d, 0x04, 0x72, 0x81, 0x37, 0xc1, 0xeb, 0xc9, 0x17, 0xa8, 0xd3, 0x12, 0xe5, 0xc9, 0x94,
+	0xd9, 0xc1, 0x88, 0xd5, 0xd2, 0xeb, 0x2f, 0xbf, 0x3e, 0x64, 0x55, 0x58, 0x44, 0x3d, 0x0c, 0x1d,
+	0x7e, 0x96, 0x00, 0xec, 0x74, 0x2b, 0x38, 0xd3, 0x03, 0x2f, 0xd1, 0x43, 0xe5, 0xd9, 0x33, 0x54,
+	0x0a, 0xd6, 0x73, 0x9c, 0xf5, 0x5d, 0x38, 0x8b, 0x7a, 0xfd, 0x7f, 0xa1, 0xae, 0x1e, 0xde, 0x44,
+	0x0f, 0xed, 0x46, 0xc1, 0x3d, 0xf8, 0x49, 0x02, 0xb0, 0xd3, 0xaa, 0x7a, 0xca, 0x49, 0xb4, 0x4f,
+	0x79, 0xf6, 0x0c, 0x95, 0x42, 0xce, 0x03, 0x2e, 0xe7, 0x0e, 0x9c, 0x39, 0x61, 0x09, 0xa2, 0x5a,
+	0x8f, 0x24, 0x78, 0x68, 0x37, 0x0c, 0xee, 0xc1, 0x6f, 0x12, 0x38, 0x77, 0xdc, 0x92, 0xe0, 0xed,
+	0xb4, 0x03, 0x8e, 0x1b, 0xa7, 0x3c, 0x7d, 0xea, 0x3a, 0xa1, 0x63, 0x8d, 0xeb, 0x78, 0x0a, 0x97,
+	0xd3, 0xac, 0x45, 0x38, 0x5c, 0xfb, 0x52, 0xda, 0x14, 0xa1, 0xdd, 0xd0, 0x34, 0xf6, 0xe0, 0x47,
+	0x09, 0x0c, 0xc5, 0x1d, 0x02, 0xde, 0xec, 0x3d, 0xec, 0x4e, 0x9b, 0x92, 0x6f, 0x9d, 0xb2, 0x4a,
+	0xc8, 0x7a, 0xc4, 0x65, 0xcd, 0xc1, 0xfb, 0x27, 0xae, 0x47, 0x58, 0x82, 0x1f, 0x21, 0xdd, 0x85,
+	0xcc, 0x2f, 0xee, 0x1f, 0x2a, 0xd2, 0xc1, 0xa1, 0x22, 0xfd, 0x3c, 0x54, 0xa4, 0xf7, 0x47, 0x4a,
+	0xe6, 0xe0, 0x48, 0xc9, 0x7c, 0x3d, 0x52, 0x32, 0xcf, 0xaf, 0x99, 0x16, 0xdb, 0x68, 0x56, 0xb5,
+	0x1a, 0x6d, 0xc4, 0x41, 0x5e, 0xc5, 0x60, 0xd8, 0x8e, 0x43, 0xbc, 0x6a, 0x8e, 0xff, 0x24, 0xba,
+	0xf1, 0x7b, 0x00, 0x84, 0x80, 0x26, 0xf2, 0x24, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -552,6 +674,8 @@ type QueryClient interface {
 	ProviderDelegators(ctx context.Context, in *QueryProviderDelegatorsRequest, opts ...grpc.CallOption) (*QueryProviderDelegatorsResponse, error)
 	// Queries a the unclaimed rewards of a delegator.
 	DelegatorRewards(ctx context.Context, in *QueryDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsResponse, error)
+	// Queries the projected effective stake of a provider after restaking the auto-compounded rewards.
+	ProjectedStake(ctx context.Context, in *QueryProjectedStakeRequest, opts ...grpc.CallOption) (*QueryProjectedStakeResponse, error)
 }
 
 type queryClient struct {
@@ -598,6 +722,15 @@ func (c *queryClient) DelegatorRewards(ctx context.Context, in *QueryDelegatorRe
 	return out, nil
 }
 
+func (c *queryClient) ProjectedStake(ctx context.Context, in *QueryProjectedStakeRequest, opts ...grpc.CallOption) (*QueryProjectedStakeResponse, error) {
+	out := new(QueryProjectedStakeResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/ProjectedStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -608,6 +741,8 @@ type QueryServer interface {
 	ProviderDelegators(context.Context, *QueryProviderDelegatorsRequest) (*QueryProviderDelegatorsResponse, error)
 	// Queries a the unclaimed rewards of a delegator.
 	DelegatorRewards(context.Context, *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error)
+	// Queries the projected effective stake of a provider after restaking the auto-compounded rewards.
+	ProjectedStake(context.Context, *QueryProjectedStakeRequest) (*QueryProjectedStakeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -626,6 +761,9 @@ func (*UnimplementedQueryServer) ProviderDelegators(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) DelegatorRewards(ctx context.Context, req *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewards not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedStake(ctx context.Context, req *QueryProjectedStakeRequest) (*QueryProjectedStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedStake not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -703,6 +841,24 @@ func _Query_DelegatorRewards_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/ProjectedStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedStake(ctx, req.(*QueryProjectedStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.dualstaking.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -723,6 +879,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorRewards",
 			Handler:    _Query_DelegatorRewards_Handler,
 		},
+		{
+			MethodName: "ProjectedStake",
+			Handler:    _Query_ProjectedStake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/dualstaking/query.proto",
@@ -1066,6 +1226,96 @@ func (m *DelegatorRewardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectedStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProjectedEffectiveStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PendingRestake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EffectiveStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1214,6 +1464,38 @@ func (m *DelegatorRewardInfo) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectedStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EffectiveStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PendingRestake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedEffectiveStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2102,6 +2384,269 @@ func (m *DelegatorRewardInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectedStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRestake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingRestake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedEffectiveStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedEffectiveStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
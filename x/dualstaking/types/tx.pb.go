@@ -461,6 +461,118 @@ func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
 
+type MsgAutoCompound struct {
+	Creator   string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Validator string               `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Provider  string               `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID   string               `protobuf:"bytes,4,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Cadence   AutoCompound_Cadence `protobuf:"varint,5,opt,name=cadence,proto3,enum=lavanet.lava.dualstaking.AutoCompound_Cadence" json:"cadence,omitempty"`
+}
+
+func (m *MsgAutoCompound) Reset()         { *m = MsgAutoCompound{} }
+func (m *MsgAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgAutoCompound) ProtoMessage()    {}
+func (*MsgAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c4c178d368211c, []int{8}
+}
+func (m *MsgAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAutoCompound.Merge(m, src)
+}
+func (m *MsgAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAutoCompound proto.InternalMessageInfo
+
+func (m *MsgAutoCompound) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAutoCompound) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgAutoCompound) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MsgAutoCompound) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *MsgAutoCompound) GetCadence() AutoCompound_Cadence {
+	if m != nil {
+		return m.Cadence
+	}
+	return AutoCompound_DISABLED
+}
+
+type MsgAutoCompoundResponse struct {
+}
+
+func (m *MsgAutoCompoundResponse) Reset()         { *m = MsgAutoCompoundResponse{} }
+func (m *MsgAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c4c178d368211c, []int{9}
+}
+func (m *MsgAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDelegate)(nil), "lavanet.lava.dualstaking.MsgDelegate")
 	proto.RegisterType((*MsgDelegateResponse)(nil), "lavanet.lava.dualstaking.MsgDelegateResponse")
@@ -470,44 +582,51 @@ func init() {
 	proto.RegisterType((*MsgUnbondResponse)(nil), "lavanet.lava.dualstaking.MsgUnbondResponse")
 	proto.RegisterType((*MsgClaimRewards)(nil), "lavanet.lava.dualstaking.MsgClaimRewards")
 	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "lavanet.lava.dualstaking.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgAutoCompound)(nil), "lavanet.lava.dualstaking.MsgAutoCompound")
+	proto.RegisterType((*MsgAutoCompoundResponse)(nil), "lavanet.lava.dualstaking.MsgAutoCompoundResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/dualstaking/tx.proto", fileDescriptor_29c4c178d368211c) }
 
 var fileDescriptor_29c4c178d368211c = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x12, 0x42, 0x33, 0x69, 0x85, 0x70, 0xa9, 0xea, 0x5a, 0xe0, 0xb6, 0xa9, 0x10,
-	0x45, 0x15, 0x6b, 0xa5, 0x1c, 0xb8, 0x37, 0x45, 0x88, 0x83, 0x25, 0x64, 0x89, 0x4b, 0x2f, 0x65,
-	0x1d, 0x6f, 0xb7, 0x16, 0xb6, 0x27, 0xf2, 0x6e, 0x42, 0x79, 0x0b, 0xae, 0xbc, 0x06, 0xe2, 0x21,
-	0x7a, 0xec, 0x91, 0x13, 0x42, 0xc9, 0x8d, 0xa7, 0x40, 0xfe, 0xda, 0x38, 0x41, 0x98, 0x70, 0xec,
-	0x69, 0x77, 0x67, 0x7f, 0xf3, 0xf1, 0x9f, 0xfd, 0x80, 0xfd, 0x90, 0x4e, 0x68, 0xcc, 0xa4, 0x9d,
-	0x8e, 0xb6, 0x3f, 0xa6, 0xa1, 0x90, 0xf4, 0x43, 0x10, 0x73, 0x5b, 0x5e, 0x91, 0x51, 0x82, 0x12,
-	0x75, 0xa3, 0x40, 0x48, 0x3a, 0x92, 0x0a, 0x62, 0x5a, 0x43, 0x14, 0x11, 0x0a, 0xdb, 0xa3, 0x82,
-	0xd9, 0x93, 0xbe, 0xc7, 0x24, 0xed, 0xdb, 0x43, 0x0c, 0xe2, 0xdc, 0xd3, 0x7c, 0xc8, 0x91, 0x63,
-	0x36, 0xb5, 0xd3, 0x59, 0x6e, 0xed, 0x7d, 0xd3, 0xa0, 0xeb, 0x08, 0x7e, 0xca, 0x42, 0xc6, 0xa9,
-	0x64, 0xba, 0x01, 0xf7, 0x86, 0x09, 0xa3, 0x12, 0x13, 0x43, 0xdb, 0xd3, 0x0e, 0x3b, 0x6e, 0xb9,
-	0xd4, 0x1f, 0x41, 0x67, 0x42, 0xc3, 0xc0, 0xcf, 0xf6, 0xee, 0x66, 0x7b, 0x73, 0x83, 0x6e, 0xc2,
-	0xda, 0x28, 0xc1, 0x49, 0xe0, 0xb3, 0xc4, 0xb8, 0x93, 0x6d, 0xaa, 0x75, 0x16, 0xf3, 0x92, 0x06,
-	0xf1, 0x9b, 0x53, 0xa3, 0x59, 0xc4, 0xcc, 0x97, 0xfa, 0x4b, 0x68, 0xd3, 0x08, 0xc7, 0xb1, 0x34,
-	0x5a, 0x7b, 0xda, 0x61, 0xf7, 0x78, 0x87, 0xe4, 0x22, 0x48, 0x2a, 0x82, 0x14, 0x22, 0xc8, 0x00,
-	0x83, 0xf8, 0xa4, 0x75, 0xfd, 0x63, 0xb7, 0xe1, 0x16, 0x78, 0x6f, 0x0b, 0x36, 0x2b, 0x55, 0xbb,
-	0x4c, 0x8c, 0x30, 0x16, 0xac, 0xf7, 0x4b, 0x83, 0x0d, 0x47, 0x70, 0x97, 0xf9, 0xff, 0xd6, 0x73,
-	0x00, 0x1b, 0x17, 0x09, 0x46, 0xe7, 0x4b, 0x65, 0xaf, 0xa7, 0xc6, 0xb7, 0x65, 0xe9, 0xbb, 0xd0,
-	0x95, 0x38, 0x47, 0xf2, 0xf2, 0x41, 0xa2, 0x02, 0xf6, 0x21, 0x73, 0x38, 0x2f, 0x05, 0xb6, 0x32,
-	0xa2, 0x9b, 0xda, 0x06, 0x85, 0xc8, 0xc7, 0x00, 0x12, 0x15, 0x50, 0x74, 0x4e, 0xe2, 0xe0, 0x8f,
-	0x1e, 0xb4, 0xff, 0xaf, 0x07, 0xdb, 0xb0, 0xb5, 0xa0, 0x55, 0x75, 0xe1, 0xab, 0x06, 0x1d, 0x47,
-	0xf0, 0x77, 0xb1, 0x87, 0xb1, 0x7f, 0x5b, 0x4e, 0x74, 0x13, 0x1e, 0xa8, 0x9a, 0x95, 0x92, 0xd7,
-	0x70, 0xdf, 0x11, 0x7c, 0x10, 0xd2, 0x20, 0x72, 0xd9, 0x47, 0x9a, 0xf8, 0xa2, 0x46, 0x4e, 0x4d,
-	0xc1, 0xbd, 0x1d, 0xd8, 0x5e, 0x0a, 0x54, 0xe6, 0x38, 0xfe, 0xd2, 0x84, 0xa6, 0x23, 0xb8, 0xfe,
-	0x1e, 0xd6, 0xd4, 0x2b, 0x78, 0x42, 0xfe, 0xf6, 0xcc, 0x48, 0xe5, 0xda, 0x99, 0xcf, 0x57, 0xc2,
-	0xca, 0x4c, 0xfa, 0x05, 0x40, 0xe5, 0x66, 0x3e, 0xad, 0x75, 0x9e, 0x83, 0xa6, 0xbd, 0x22, 0xa8,
-	0xf2, 0x9c, 0x41, 0xbb, 0x38, 0xfb, 0x83, 0x5a, 0xd7, 0x1c, 0x32, 0x8f, 0x56, 0x80, 0x54, 0xec,
-	0x10, 0xd6, 0x17, 0x8e, 0xe3, 0x59, 0xad, 0x73, 0x15, 0x35, 0xfb, 0x2b, 0xa3, 0x65, 0xb6, 0x93,
-	0x57, 0xd7, 0x53, 0x4b, 0xbb, 0x99, 0x5a, 0xda, 0xcf, 0xa9, 0xa5, 0x7d, 0x9e, 0x59, 0x8d, 0x9b,
-	0x99, 0xd5, 0xf8, 0x3e, 0xb3, 0x1a, 0x67, 0x47, 0x3c, 0x90, 0x97, 0x63, 0x8f, 0x0c, 0x31, 0xb2,
-	0x17, 0x7e, 0xcd, 0xab, 0xc5, 0x7f, 0xf3, 0xd3, 0x88, 0x09, 0xaf, 0x9d, 0xfd, 0x75, 0x2f, 0x7e,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xba, 0x8a, 0xf3, 0x60, 0x05, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb5, 0x74, 0xeb, 0xeb, 0x06, 0x22, 0x63, 0x5a, 0x16, 0x41, 0xb6, 0x75, 0x42,
+	0x0c, 0x0d, 0x1c, 0xb5, 0x1c, 0x38, 0xb3, 0x0c, 0x01, 0x87, 0x4a, 0x28, 0x12, 0x97, 0x5d, 0x8a,
+	0x9b, 0x78, 0x59, 0x44, 0x12, 0x57, 0xb1, 0x53, 0xc6, 0x27, 0xe0, 0xca, 0x67, 0x41, 0x7c, 0x88,
+	0x71, 0xdb, 0x91, 0x13, 0x42, 0xed, 0x8d, 0x4f, 0x81, 0x92, 0x38, 0x69, 0x32, 0xd4, 0x10, 0x8e,
+	0x3b, 0x25, 0xb6, 0x7f, 0xcf, 0xef, 0xfd, 0xff, 0xf1, 0x8b, 0x61, 0xdf, 0xc3, 0x53, 0x1c, 0x10,
+	0xae, 0xc7, 0x4f, 0xdd, 0x8e, 0xb0, 0xc7, 0x38, 0xfe, 0xe0, 0x06, 0x8e, 0xce, 0x2f, 0xd0, 0x24,
+	0xa4, 0x9c, 0xca, 0x8a, 0x40, 0x50, 0xfc, 0x44, 0x05, 0x44, 0xd5, 0x2c, 0xca, 0x7c, 0xca, 0xf4,
+	0x31, 0x66, 0x44, 0x9f, 0xf6, 0xc7, 0x84, 0xe3, 0xbe, 0x6e, 0x51, 0x37, 0x48, 0x23, 0xd5, 0x7b,
+	0x0e, 0x75, 0x68, 0xf2, 0xaa, 0xc7, 0x6f, 0x62, 0xf6, 0xc9, 0xd2, 0x94, 0x38, 0xe2, 0x74, 0x64,
+	0x51, 0x7f, 0x42, 0xa3, 0xc0, 0x4e, 0xe9, 0xde, 0x37, 0x09, 0xba, 0x43, 0xe6, 0x9c, 0x10, 0x8f,
+	0x38, 0x98, 0x13, 0x59, 0x81, 0x55, 0x2b, 0x24, 0x98, 0xd3, 0x50, 0x91, 0xf6, 0xa4, 0xc3, 0x8e,
+	0x99, 0x0d, 0xe5, 0xfb, 0xd0, 0x99, 0x62, 0xcf, 0xb5, 0x93, 0xb5, 0x5b, 0xc9, 0xda, 0x62, 0x42,
+	0x56, 0x61, 0x6d, 0x12, 0xd2, 0xa9, 0x6b, 0x93, 0x50, 0x59, 0x49, 0x16, 0xf3, 0x71, 0xb2, 0xe7,
+	0x39, 0x76, 0x83, 0x37, 0x27, 0x4a, 0x53, 0xec, 0x99, 0x0e, 0xe5, 0xe7, 0xd0, 0xc6, 0x3e, 0x8d,
+	0x02, 0xae, 0xb4, 0xf6, 0xa4, 0xc3, 0xee, 0x60, 0x07, 0xa5, 0x92, 0x51, 0x2c, 0x19, 0x09, 0xc9,
+	0xc8, 0xa0, 0x6e, 0x70, 0xdc, 0xba, 0xfc, 0xb9, 0xdb, 0x30, 0x05, 0xde, 0xdb, 0x82, 0xcd, 0x42,
+	0xd5, 0x26, 0x61, 0x13, 0x1a, 0x30, 0xd2, 0xfb, 0x2d, 0xc1, 0xc6, 0x90, 0x39, 0x26, 0xb1, 0xff,
+	0xad, 0xe7, 0x00, 0x36, 0xce, 0x42, 0xea, 0x8f, 0xae, 0x95, 0xbd, 0x1e, 0x4f, 0xbe, 0xcd, 0x4a,
+	0xdf, 0x85, 0x2e, 0xa7, 0x0b, 0x24, 0x2d, 0x1f, 0x38, 0xcd, 0x81, 0x7d, 0x48, 0x02, 0x46, 0x99,
+	0xc0, 0x56, 0x42, 0x74, 0xe3, 0x39, 0x43, 0x88, 0x7c, 0x00, 0x10, 0xfb, 0x2e, 0x00, 0xe1, 0x1c,
+	0xa7, 0xc6, 0x5f, 0x1e, 0xb4, 0xff, 0xcf, 0x83, 0x6d, 0xd8, 0x2a, 0x69, 0xcd, 0x5d, 0xf8, 0x2a,
+	0x41, 0x67, 0xc8, 0x9c, 0x77, 0xc1, 0x98, 0x06, 0xf6, 0x4d, 0xf9, 0xa2, 0x9b, 0x70, 0x37, 0xaf,
+	0x39, 0x57, 0xf2, 0x0a, 0xee, 0x0c, 0x99, 0x63, 0x78, 0xd8, 0xf5, 0x4d, 0xf2, 0x11, 0x87, 0x36,
+	0xab, 0x90, 0x53, 0x51, 0x70, 0x6f, 0x07, 0xb6, 0xaf, 0x6d, 0x94, 0xe7, 0xf8, 0x2e, 0x25, 0x49,
+	0x5e, 0x44, 0x9c, 0x1a, 0xa2, 0x37, 0xea, 0x7a, 0xb6, 0x52, 0xe5, 0x59, 0x73, 0xb9, 0x67, 0xad,
+	0xb2, 0x67, 0xaf, 0x61, 0xd5, 0xc2, 0x36, 0x09, 0x2c, 0x92, 0x7c, 0x85, 0xdb, 0x03, 0x84, 0x96,
+	0xfd, 0x13, 0x50, 0xb1, 0x4c, 0x64, 0xa4, 0x51, 0x66, 0x16, 0x2e, 0x64, 0x16, 0x99, 0x4c, 0xe6,
+	0xe0, 0x73, 0x0b, 0x9a, 0x43, 0xe6, 0xc8, 0xef, 0x61, 0x2d, 0x6f, 0xf6, 0x87, 0xcb, 0xf3, 0x14,
+	0xba, 0x4b, 0x7d, 0x5a, 0x0b, 0xcb, 0x32, 0xc9, 0x67, 0x00, 0x85, 0x06, 0x7c, 0x54, 0x19, 0xbc,
+	0x00, 0x55, 0xbd, 0x26, 0x98, 0xe7, 0x39, 0x85, 0xb6, 0x38, 0xe2, 0x07, 0x95, 0xa1, 0x29, 0xa4,
+	0x1e, 0xd5, 0x80, 0xf2, 0xbd, 0x3d, 0x58, 0x2f, 0x9d, 0xba, 0xc7, 0x95, 0xc1, 0x45, 0x54, 0xed,
+	0xd7, 0x46, 0x8b, 0xd9, 0x4a, 0xc7, 0xaf, 0x3a, 0x5b, 0x11, 0x55, 0xfb, 0xb5, 0xd1, 0x2c, 0xdb,
+	0xf1, 0xcb, 0xcb, 0x99, 0x26, 0x5d, 0xcd, 0x34, 0xe9, 0xd7, 0x4c, 0x93, 0xbe, 0xcc, 0xb5, 0xc6,
+	0xd5, 0x5c, 0x6b, 0xfc, 0x98, 0x6b, 0x8d, 0xd3, 0x23, 0xc7, 0xe5, 0xe7, 0xd1, 0x18, 0x59, 0xd4,
+	0xd7, 0x4b, 0xb7, 0xc8, 0x45, 0xf9, 0xea, 0xfa, 0x34, 0x21, 0x6c, 0xdc, 0x4e, 0x2e, 0x90, 0x67,
+	0x7f, 0x06, 0x00, 0x3f, 0xb7, 0x47, 0xd0, 0xe3, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -526,6 +645,7 @@ type MsgClient interface {
 	Redelegate(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
 	Unbond(ctx context.Context, in *MsgUnbond, opts ...grpc.CallOption) (*MsgUnbondResponse, error)
 	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	AutoCompound(ctx context.Context, in *MsgAutoCompound, opts ...grpc.CallOption) (*MsgAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -572,12 +692,22 @@ func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts
 	return out, nil
 }
 
+func (c *msgClient) AutoCompound(ctx context.Context, in *MsgAutoCompound, opts ...grpc.CallOption) (*MsgAutoCompoundResponse, error) {
+	out := new(MsgAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Msg/AutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
 	Redelegate(context.Context, *MsgRedelegate) (*MsgRedelegateResponse, error)
 	Unbond(context.Context, *MsgUnbond) (*MsgUnbondResponse, error)
 	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	AutoCompound(context.Context, *MsgAutoCompound) (*MsgAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -596,6 +726,9 @@ func (*UnimplementedMsgServer) Unbond(ctx context.Context, req *MsgUnbond) (*Msg
 func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
 }
+func (*UnimplementedMsgServer) AutoCompound(ctx context.Context, req *MsgAutoCompound) (*MsgAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -673,6 +806,24 @@ func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Msg/AutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AutoCompound(ctx, req.(*MsgAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.dualstaking.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -693,6 +844,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimRewards",
 			Handler:    _Msg_ClaimRewards_Handler,
 		},
+		{
+			MethodName: "AutoCompound",
+			Handler:    _Msg_AutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/dualstaking/tx.proto",
@@ -1017,6 +1172,85 @@ func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cadence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cadence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1166,6 +1400,43 @@ func (m *MsgClaimRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Cadence != 0 {
+		n += 1 + sovTx(uint64(m.Cadence))
+	}
+	return n
+}
+
+func (m *MsgAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2151,6 +2422,253 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cadence", wireType)
+			}
+			m.Cadence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cadence |= AutoCompound_Cadence(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
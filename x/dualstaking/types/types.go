@@ -6,6 +6,8 @@ const (
 	RedelegateEventName        = "redelegate_between_providers"
 	RefundedEventName          = "refund_to_delegator"
 	ClaimRewardsEventName      = "delegator_claim_rewards"
+	AutoCompoundEventName      = "delegator_auto_compound"
+	RestakeRewardsEventName    = "delegator_restake_rewards"
 	ContributorRewardEventName = "contributor_rewards"
 	ValidatorSlashEventName    = "validator_slash"
 )
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/fixationstore/types"
+)
+
+// RegisterInvariants registers the fixationstore module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.MODULE_NAME, "refcounts", RefcountsInvariant(k))
+}
+
+// RefcountsInvariant checks that the entry versions of all the fixation stores
+// hold consistent reference counts
+func RefcountsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		for _, store := range k.fixationsStores {
+			for _, problem := range store.CheckRefcounts(ctx) {
+				problems = append(problems, store.GetStoreKey().Name()+"/"+problem)
+			}
+		}
+
+		return sdk.FormatInvariant(types.MODULE_NAME, "refcounts", fmt.Sprintf(
+			"found %d inconsistent entry versions\n%s", len(problems), strings.Join(problems, "\n"),
+		)), len(problems) > 0
+	}
+}
@@ -61,6 +61,11 @@ func (a AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 func (a AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
+// RegisterInvariants registers the fixationstore module invariants.
+func (a AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, a.k)
+}
+
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
@@ -38,6 +38,7 @@ import (
 //    - GetAllEntryIndices(): get all the entries indices (without versions)
 //    - GetAllEntryVersions(index): get all the versions of an entry (for testing)
 //    - GetEntryVersionsRange(index, block, delta): get range of entry versions (**)
+//    - CheckRefcounts(): verify the refcount state invariants (for crisis invariants)
 // Note:
 //    - methods marked with (*) expect an exact existing method, or otherwise will panic
 //    - methods marked with (**) will match an entry with the nearest-no-later block version
@@ -1039,6 +1040,65 @@ func (fs *FixationStore) GetAllEntryVersions(ctx sdk.Context, index string) (blo
 	return fs.getEntryVersionsFilter(ctx, index, 0, filter)
 }
 
+// CheckRefcounts verifies the refcount state invariants (see above) of all the
+// entry versions in the store, and returns a description of each violation.
+// References taken with GetEntry() are not tracked by the store, so it can only
+// verify that the latest and future entry versions hold their extra reference;
+// the owners of the references should verify those against their own state.
+func (fs *FixationStore) CheckRefcounts(ctx sdk.Context) (problems []string) {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	for _, index := range fs.AllEntryIndicesFilter(ctx, "", nil) {
+		safeIndex, err := SanitizeIndex(index)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: invalid index %q", fs.prefix, index))
+			continue
+		}
+		live := fs.isEntryIndexLive(ctx, safeIndex)
+
+		store := fs.getEntryStore(ctx, safeIndex)
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+		latest := 0
+		for ; iterator.Valid(); iterator.Next() {
+			var entry Entry
+			fs.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+			// a future entry version holds the extra reference until it becomes the
+			// latest (which happens in the begin-block of its block)
+			held := entry.IsLatest || (entry.Block >= ctxBlock && !entry.IsDeletedBy(ctxBlock))
+
+			if entry.IsLatest {
+				latest++
+				if !live {
+					problems = append(problems, fmt.Sprintf("%s: %s@%d: latest version of a deleted index",
+						fs.prefix, index, entry.Block))
+				}
+			}
+			if held && entry.Refcount == 0 {
+				problems = append(problems, fmt.Sprintf("%s: %s@%d: latest or future version without references",
+					fs.prefix, index, entry.Block))
+			}
+			if entry.Refcount == 0 && entry.StaleAt == math.MaxUint64 {
+				problems = append(problems, fmt.Sprintf("%s: %s@%d: unreferenced version never becomes stale",
+					fs.prefix, index, entry.Block))
+			}
+			if entry.Refcount > 0 && entry.StaleAt != math.MaxUint64 {
+				problems = append(problems, fmt.Sprintf("%s: %s@%d: version with refcount %d becomes stale at %d",
+					fs.prefix, index, entry.Block, entry.Refcount, entry.StaleAt))
+			}
+		}
+		iterator.Close()
+
+		if latest > 1 {
+			problems = append(problems, fmt.Sprintf("%s: %s: %d versions marked latest",
+				fs.prefix, index, latest))
+		}
+	}
+
+	return problems
+}
+
 func (fs *FixationStore) createEntryStoreKey(index string) string {
 	return fs.prefix + EntryPrefix + index
 }
@@ -144,6 +144,9 @@ func runPlaybook(t *testing.T, ctx sdk.Context, fs []*FixationStore, playbook []
 		case "getallprefix":
 			indexList := fs[play.store].GetAllEntryIndicesWithPrefix(ctx, index)
 			require.Equal(t, int(play.count), len(indexList), what)
+		case "refcounts":
+			problems := fs[play.store].CheckRefcounts(ctx)
+			require.Equal(t, int(play.count), len(problems), what+fmt.Sprintf(" %v", problems))
 		}
 	}
 }
@@ -301,6 +304,54 @@ func TestEntryStale(t *testing.T) {
 	testWithFixationTemplate(t, playbook, 3, 1)
 }
 
+// Test the refcount invariants along the entries' lifecycle and after corruption
+func TestCheckRefcounts(t *testing.T) {
+	ctx, fs := InitCtxAndFixationStore(t)
+
+	block0 := int64(10)
+	block1 := block0 + int64(10)
+	block2 := block1 + int64(10)
+
+	playbook := []FixationTemplate{
+		{op: "append", name: "entry #1", count: block0, coin: 0},
+		{op: "get", name: "refcount entry #1", count: block0, coin: 0},
+		{op: "append", name: "entry #2", count: block1, coin: 1},
+		{op: "append", name: "future entry #3", count: -block2, coin: 2},
+		{op: "refcounts", name: "entries with future", count: 0},
+		{op: "block", name: "advance to future", count: block2 - block1},
+		{op: "del", name: "entry #3", count: block2 + 1},
+		{op: "refcounts", name: "deleted entries", count: 0},
+		{op: "block", name: "add STALE_ENTRY_TIME+1", count: int64(mockGetStaleBlock(sdk.Context{})) + 1},
+		{op: "refcounts", name: "stale entries", count: 0},
+	}
+
+	runPlaybook(t, ctx, []*FixationStore{fs}, playbook, 3)
+
+	ctx = ctx.WithBlockHeight(block2 + int64(mockGetStaleBlock(sdk.Context{})) + 1)
+	safeIndex, err := SanitizeIndex("myindex")
+	require.Nil(t, err)
+
+	// entry #1 is still referenced (by "get"): drop its refcount without
+	// marking it stale
+	entry := fs.getEntry(ctx, safeIndex, uint64(block0))
+	require.Equal(t, uint64(1), entry.Refcount)
+	entry.Refcount = 0
+	fs.setEntry(ctx, entry)
+	require.Len(t, fs.CheckRefcounts(ctx), 1)
+
+	// drop the extra reference of the latest version of another index
+	err = fs.AppendEntry(ctx, "other", uint64(ctx.BlockHeight()), &sdk.Coin{Denom: "utest"})
+	require.Nil(t, err)
+	safeIndex, err = SanitizeIndex("other")
+	require.Nil(t, err)
+	entry = fs.getEntry(ctx, safeIndex, uint64(ctx.BlockHeight()))
+	require.True(t, entry.IsLatest)
+	entry.Refcount = 0
+	entry.StaleAt = uint64(ctx.BlockHeight())
+	fs.setEntry(ctx, entry)
+	require.Len(t, fs.CheckRefcounts(ctx), 2)
+}
+
 // Test adding entry versions with different fixation keys
 func TestDifferentFixationKeys(t *testing.T) {
 	block0 := int64(10)
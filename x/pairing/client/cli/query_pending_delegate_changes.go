@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	DelegatorFlagName = "delegator"
+	ProviderFlagName  = "provider"
+)
+
+func CmdPendingDelegateChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "pending-delegate-changes",
+		Short: `Query to show the pending changes of providers' delegation terms (commission and limit) and the block 
+		in which they take effect. Use --delegator to show only changes of the providers a delegator delegates to, 
+		and --provider to show only changes of a specific provider`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegator, err := cmd.Flags().GetString(DelegatorFlagName)
+			if err != nil {
+				return err
+			}
+			provider, err := cmd.Flags().GetString(ProviderFlagName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingDelegateChangesRequest{
+				Delegator: delegator,
+				Provider:  provider,
+			}
+
+			res, err := queryClient.PendingDelegateChanges(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(DelegatorFlagName, "", "show only changes of providers the delegator delegates to")
+	cmd.Flags().String(ProviderFlagName, "", "show only changes of a specific provider")
+
+	return cmd
+}
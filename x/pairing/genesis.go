@@ -28,6 +28,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	k.InitBadgeTimers(ctx, genState.BadgesTS)
 	k.InitProviderQoS(ctx, genState.ProviderQosFS)
+	k.InitDelegateChangeTimers(ctx, genState.DelegateChangeTS)
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -43,6 +44,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.BadgeUsedCuList = k.GetAllBadgeUsedCu(ctx)
 	genesis.BadgesTS = k.ExportBadgesTimers(ctx)
 	genesis.ProviderQosFS = k.ExportProviderQoS(ctx)
+	genesis.DelegateChangeTS = k.ExportDelegateChangeTimers(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
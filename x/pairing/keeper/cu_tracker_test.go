@@ -134,6 +134,61 @@ func TestTrackedCuWithExpiredSubscription(t *testing.T) {
 	require.Nil(t, res.Sub)
 }
 
+// TestTrackedCuWithTransferredSubscription tests that the provider gets paid for relays
+// served before a subscription transfer but paid for after it, and that no tracked CU
+// remains after the monthly payout
+func TestTrackedCuWithTransferredSubscription(t *testing.T) {
+	ts := newTester(t)
+
+	// buy subscription for 1 month
+	ts.plan.PlanPolicy.MaxProvidersToPair = 1
+	ts.AddPlan(ts.plan.Index, ts.plan)
+
+	clientAcct, clientAddr := ts.AddAccount(common.CONSUMER, 0, testBalance)
+	_, newClientAddr := ts.AddAccount(common.CONSUMER, 1, testBalance)
+	_, err := ts.TxSubscriptionBuy(clientAddr, clientAddr, ts.plan.Index, 1, false)
+	require.Nil(t, err)
+
+	err = ts.addProvider(1)
+	require.Nil(t, err)
+	ts.AdvanceEpoch()
+
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	// relay served before the transfer
+	relayPayment := sendRelay(ts, providerAddr, clientAcct, []string{ts.spec.Index})
+	ts.AdvanceBlock()
+
+	err = ts.TxSubscriptionTransferOffer(clientAddr, newClientAddr)
+	require.Nil(t, err)
+	err = ts.TxSubscriptionTransferAccept(newClientAddr, clientAddr)
+	require.Nil(t, err)
+	ts.AdvanceBlock()
+
+	// pay for the relay after the transfer: the CU is charged to the new consumer
+	_, err = ts.TxPairingRelayPayment(providerAddr, relayPayment.Relays...)
+	require.Nil(t, err)
+
+	sub, found := ts.Keepers.Subscription.GetSubscription(ts.Ctx, newClientAddr)
+	require.True(t, found)
+	require.Equal(t, sub.MonthCuTotal-relayCuSum, sub.MonthCuLeft)
+	cu, found, _ := ts.Keepers.Subscription.GetTrackedCu(ts.Ctx, newClientAddr, providerAddr, ts.spec.Index, sub.Block)
+	require.True(t, found)
+	require.Equal(t, relayCuSum, cu)
+
+	// advance month + blocksToSave + 1 to trigger the provider monthly payment
+	ts.AdvanceMonths(1)
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+
+	res, err := ts.QueryDualstakingDelegatorRewards(providerAddr, providerAddr, "")
+	require.Nil(t, err)
+	require.Len(t, res.Rewards, 1)
+	require.Equal(t, ts.plan.Price.Amount, res.Rewards[0].Amount.Amount)
+
+	require.Empty(t, ts.Keepers.Subscription.GetAllSubTrackedCuIndices(ts.Ctx, clientAddr))
+	require.Empty(t, ts.Keepers.Subscription.GetAllSubTrackedCuIndices(ts.Ctx, newClientAddr))
+}
+
 // TestTrackedCuWithDelegations checks that rewards are sent correctly with delegations in presence
 func TestTrackedCuWithDelegations(t *testing.T) {
 	ts := newTester(t)
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingDelegateChanges(goCtx context.Context, req *types.QueryPendingDelegateChangesRequest) (*types.QueryPendingDelegateChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Delegator == "" {
+		if req.Provider != "" {
+			return &types.QueryPendingDelegateChangesResponse{Changes: k.GetProviderPendingDelegateChanges(ctx, req.Provider)}, nil
+		}
+		return &types.QueryPendingDelegateChangesResponse{Changes: k.GetAllPendingDelegateChanges(ctx)}, nil
+	}
+
+	providers, err := k.dualstakingKeeper.GetDelegatorProviders(ctx, req.Delegator, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []types.DelegateChange{}
+	for _, provider := range providers {
+		if req.Provider != "" && provider != req.Provider {
+			continue
+		}
+		changes = append(changes, k.GetProviderPendingDelegateChanges(ctx, provider)...)
+	}
+
+	return &types.QueryPendingDelegateChangesResponse{Changes: changes}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// RegisterInvariants registers the pairing module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "stake-entries", StakeEntriesInvariant(k))
+}
+
+// StakeEntriesInvariant checks that the (epochstorage) current stake storage of every
+// chain holds its own chain's stake entries, with a single stake entry per provider
+func StakeEntriesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		for _, chainID := range k.specKeeper.GetAllChainIDs(ctx) {
+			stakeStorage, found := k.epochStorageKeeper.GetStakeStorageCurrent(ctx, chainID)
+			if !found {
+				continue
+			}
+			providers := map[string]struct{}{}
+			for _, entry := range stakeStorage.StakeEntries {
+				if entry.Chain != chainID {
+					count++
+					msg += fmt.Sprintf("\tprovider %s: stake entry of chain %s in the stake storage of chain %s\n",
+						entry.Address, entry.Chain, chainID)
+				}
+				if _, ok := providers[entry.Address]; ok {
+					count++
+					msg += fmt.Sprintf("\tprovider %s: more than one stake entry in chain %s\n", entry.Address, chainID)
+				}
+				providers[entry.Address] = struct{}{}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "stake-entries", fmt.Sprintf(
+			"found %d invalid stake entries\n%s", count, msg,
+		)), count != 0
+	}
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/pairing/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStakeEntriesInvariant(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(2, 0, 0) // 2 providers, 0 clients, default providers-to-pair
+
+	invariant := keeper.StakeEntriesInvariant(ts.Keepers.Pairing)
+	msg, broken := invariant(ts.Ctx)
+	require.False(t, broken, msg)
+
+	// a second stake entry of a staked provider
+	providerAcct, _ := ts.GetAccount(common.PROVIDER, 0)
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	ts.Keepers.Epochstorage.AppendStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
+
+	msg, broken = invariant(ts.Ctx)
+	require.True(t, broken, msg)
+	require.Contains(t, msg, "found 1 invalid stake entries")
+}
@@ -30,6 +30,7 @@ type (
 		subscriptionKeeper types.SubscriptionKeeper
 		planKeeper         types.PlanKeeper
 		badgeTimerStore    timerstoretypes.TimerStore
+		delegateChangeTS   timerstoretypes.TimerStore
 		providerQosFS      fixationtypes.FixationStore
 		downtimeKeeper     types.DowntimeKeeper
 		dualstakingKeeper  types.DualstakingKeeper
@@ -96,6 +97,13 @@ func NewKeeper(
 		WithCallbackByBlockHeight(badgeTimerCallback)
 	keeper.badgeTimerStore = *badgeTimerStore
 
+	delegateChangeTimerCallback := func(ctx sdk.Context, _, data []byte) {
+		keeper.applyDelegateChange(ctx, data)
+	}
+	delegateChangeTS := timerStoreKeeper.NewTimerStoreBeginBlock(storeKey, types.DelegateChangeTimerStorePrefix).
+		WithCallbackByBlockHeight(delegateChangeTimerCallback)
+	keeper.delegateChangeTS = *delegateChangeTS
+
 	keeper.providerQosFS = *fixationStoreKeeper.NewFixationStore(storeKey, types.ProviderQosStorePrefix)
 
 	return keeper
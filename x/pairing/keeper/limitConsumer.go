@@ -23,7 +23,7 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, allowedCU
 	planPolicy := plan.GetPlanPolicy()
 	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
 
-	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+	sub, found := k.getCurrentSubscription(ctx, project.GetSubscription(), epoch)
 	if !found {
 		return 0, utils.LavaFormatError("can't find subscription", fmt.Errorf("EnforceClientCUsUsageInEpoch_cant_find_subscription"), utils.Attribute{Key: "subscriptionKey", Value: project.GetSubscription()})
 	}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateVersion2To3 implements store migration from v2 to v3:
+// - initialize the delegation terms change params (DelegateCommissionMaxChange, DelegateChangeCooldown)
+func (m Migrator) MigrateVersion2To3(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyDelegateCommissionMaxChange, types.DefaultDelegateCommissionMaxChange)
+	m.keeper.paramstore.Set(ctx, types.KeyDelegateChangeCooldown, types.DefaultDelegateChangeCooldown)
+	return nil
+}
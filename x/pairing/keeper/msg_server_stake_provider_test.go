@@ -3,9 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/client/cli"
+	"github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -721,3 +723,107 @@ func TestStakeEndpoints(t *testing.T) {
 		})
 	}
 }
+
+// TestModifyStakeProviderDelegateTerms checks that changes of the delegation terms (commission
+// and limit) in favor of the delegators apply immediately, and that changes against them are
+// rate limited and pending until their effective block
+func TestModifyStakeProviderDelegateTerms(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 0, 0) // 1 provider, 0 client, default providers-to-pair
+
+	providerAcct, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	params := ts.Keepers.Pairing.GetParams(ts.Ctx)
+	params.DelegateCommissionMaxChange = 5
+	params.DelegateChangeCooldown = 10
+	ts.Keepers.Pairing.SetParams(ts.Ctx, params)
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+
+	modify := func(commission uint64, limit int64) error {
+		validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+		msg := &types.MsgStakeProvider{
+			Creator:            provider,
+			Validator:          sdk.ValAddress(validator.Addr).String(),
+			ChainID:            ts.spec.Index,
+			Amount:             stakeEntry.Stake,
+			Geolocation:        stakeEntry.Geolocation,
+			Endpoints:          stakeEntry.Endpoints,
+			Moniker:            stakeEntry.Moniker,
+			DelegateLimit:      sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(limit)),
+			DelegateCommission: commission,
+		}
+		_, err := ts.Servers.PairingServer.StakeProvider(ts.GoCtx, msg)
+		return err
+	}
+
+	verify := func(commission uint64, limit int64) {
+		stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+		require.True(t, found)
+		require.Equal(t, commission, stakeEntry.DelegateCommission)
+		require.Equal(t, limit, stakeEntry.DelegateLimit.Amount.Int64())
+	}
+
+	pending := func(delegator string) []types.DelegateChange {
+		res, err := ts.QueryPairingPendingDelegateChanges(delegator, "")
+		require.NoError(t, err)
+		return res.Changes
+	}
+
+	// lower commission and higher limit apply immediately
+	require.NoError(t, modify(50, 1000))
+	verify(50, 1000)
+	require.Empty(t, pending(""))
+
+	// commission increase beyond max change fails
+	require.Error(t, modify(56, 1000))
+
+	// commission increase and limit decrease are pending until the effective block
+	require.NoError(t, modify(55, 500))
+	verify(50, 1000)
+	changes := pending("")
+	require.Len(t, changes, 1)
+	require.Equal(t, uint64(55), changes[0].DelegateCommission)
+	require.Equal(t, int64(500), changes[0].DelegateLimit.Amount.Int64())
+	require.Equal(t, ts.BlockHeight()+params.DelegateChangeCooldown, changes[0].EffectiveBlock)
+
+	// delegator-facing: the provider self-delegates, the consumer does not delegate
+	_, consumer := ts.AddAccount(common.CONSUMER, 0, testBalance)
+	require.Len(t, pending(provider), 1)
+	require.Empty(t, pending(consumer))
+
+	// re-submitting the pending terms is fine, other unfavorable changes are not
+	require.NoError(t, modify(55, 500))
+	require.Error(t, modify(54, 500))
+	require.Error(t, modify(50, 900))
+
+	// modifying other fields (current terms) keeps the pending change
+	require.NoError(t, modify(50, 1000))
+	verify(50, 1000)
+	change, found := ts.Keepers.Pairing.GetPendingDelegateChange(ts.Ctx, provider, ts.spec.Index)
+	require.True(t, found)
+	require.Equal(t, changes[0], change)
+	res, err := ts.QueryPairingPendingDelegateChanges("", provider)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	res, err = ts.QueryPairingPendingDelegateChanges("", consumer)
+	require.NoError(t, err)
+	require.Empty(t, res.Changes)
+
+	ts.AdvanceBlocks(params.DelegateChangeCooldown)
+	verify(55, 500)
+	require.Empty(t, pending(""))
+	_, found = ts.Keepers.Pairing.GetPendingDelegateChange(ts.Ctx, provider, ts.spec.Index)
+	require.False(t, found)
+
+	// favorable terms cancel a pending change
+	require.NoError(t, modify(60, 500))
+	require.Len(t, pending(""), 1)
+	require.NoError(t, modify(40, 500))
+	verify(40, 500)
+	require.Empty(t, pending(""))
+
+	ts.AdvanceBlocks(params.DelegateChangeCooldown)
+	verify(40, 500)
+}
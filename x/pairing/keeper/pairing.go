@@ -13,6 +13,7 @@ import (
 	planstypes "github.com/lavanet/lava/x/plans/types"
 	projectstypes "github.com/lavanet/lava/x/projects/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
 )
 
 func (k Keeper) VerifyPairingData(ctx sdk.Context, chainID string, clientAddress sdk.AccAddress, block uint64) (epoch uint64, providersType spectypes.Spec_ProvidersTypes, errorRet error) {
@@ -202,7 +203,7 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		return nil, "", err
 	}
 
-	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+	sub, found := k.getCurrentSubscription(ctx, project.GetSubscription(), block)
 	if !found {
 		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
 	}
@@ -223,6 +224,16 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 	return strictestPolicy, sub.Cluster, nil
 }
 
+// getCurrentSubscription returns the current subscription of the consumer that had the
+// subscription at the given block (the subscription may have been transferred since)
+func (k Keeper) getCurrentSubscription(ctx sdk.Context, consumer string, block uint64) (subscriptiontypes.Subscription, bool) {
+	sub, found := k.subscriptionKeeper.FindSubscriptionToCharge(ctx, consumer, block)
+	if !found {
+		return sub, false
+	}
+	return k.subscriptionKeeper.GetSubscription(ctx, sub.Consumer)
+}
+
 func (k Keeper) CalculateEffectiveSelectedProviders(policies []*planstypes.Policy) (planstypes.SELECTED_PROVIDERS_MODE, []string) {
 	selectedProvidersModeList := []planstypes.SELECTED_PROVIDERS_MODE{}
 	selectedProvidersList := [][]string{}
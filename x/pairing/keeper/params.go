@@ -16,6 +16,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.DataReliabilityReward(ctx),
 		k.QoSWeight(ctx),
 		k.RecommendedEpochNumToCollectPayment(ctx),
+		k.DelegateCommissionMaxChange(ctx),
+		k.DelegateChangeCooldown(ctx),
 	)
 }
 
@@ -70,3 +72,15 @@ func (k Keeper) RecommendedEpochNumToCollectPayment(ctx sdk.Context) (res uint64
 func (k Keeper) SetRecommendedEpochNumToCollectPayment(ctx sdk.Context, val uint64) {
 	k.paramstore.Set(ctx, types.KeyRecommendedEpochNumToCollectPayment, val)
 }
+
+// DelegateCommissionMaxChange returns the DelegateCommissionMaxChange param
+func (k Keeper) DelegateCommissionMaxChange(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDelegateCommissionMaxChange, &res)
+	return
+}
+
+// DelegateChangeCooldown returns the DelegateChangeCooldown param
+func (k Keeper) DelegateChangeCooldown(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDelegateChangeCooldown, &res)
+	return
+}
@@ -1,10 +1,225 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	timerstoretypes "github.com/lavanet/lava/x/timerstore/types"
 )
 
 func (k Keeper) CreditStakeEntry(ctx sdk.Context, chainID string, lookUpAddress sdk.AccAddress, creditAmount sdk.Coin) (bool, error) {
 	// TODO: add a way to rewards for conflict
 	return true, nil
 }
+
+// Delegation terms changes
+//
+// A provider may change its delegation terms (DelegateCommission, DelegateLimit) when
+// modifying its stake entry. Changes in favor of the delegators (lower commission, higher
+// limit) take effect immediately. Changes against them (higher commission, lower limit)
+// are rate limited, similar to the validators' commission in the staking module:
+//   - a commission increase is bounded by the DelegateCommissionMaxChange param.
+//   - the change is pending for DelegateChangeCooldown blocks before it takes effect,
+//     so delegators have time to react (see the PendingDelegateChanges query).
+//
+// A provider has at most one pending change per chain. Re-submitting the current terms
+// (e.g. when only modifying the endpoints) or the pending terms is a no-op; submitting
+// other terms with no unfavorable change cancels the pending change; other changes are
+// rejected until the pending change takes effect.
+//
+// Pending changes are kept in the store (keyed by provider and chain), and in a timer
+// store (same key, expiring at the effective block) whose callback applies them to the
+// provider's stake entry.
+
+// modifyDelegateTerms updates the delegation terms of a stake entry (that is being
+// modified), or schedules them if needed.
+func (k Keeper) modifyDelegateTerms(ctx sdk.Context, stakeEntry *epochstoragetypes.StakeEntry, commission uint64, limit sdk.Coin) error {
+	key := types.DelegateChangeKey(stakeEntry.Address, stakeEntry.Chain)
+
+	// delegation terms unchanged: keep the pending change (if any)
+	if stakeEntry.DelegateCommission == commission && stakeEntry.DelegateLimit.IsEqual(limit) {
+		return nil
+	}
+
+	pending, found := k.GetPendingDelegateChange(ctx, stakeEntry.Address, stakeEntry.Chain)
+	if found && pending.DelegateCommission == commission && pending.DelegateLimit.IsEqual(limit) {
+		return nil
+	}
+
+	increaseCommission := commission > stakeEntry.DelegateCommission
+	decreaseLimit := limit.IsLT(stakeEntry.DelegateLimit)
+
+	if found {
+		if increaseCommission || decreaseLimit {
+			return utils.LavaFormatWarning("delegation terms change already pending", fmt.Errorf("delegate change in cooldown"),
+				utils.Attribute{Key: "provider", Value: stakeEntry.Address},
+				utils.Attribute{Key: "chainID", Value: stakeEntry.Chain},
+				utils.Attribute{Key: "effectiveBlock", Value: pending.EffectiveBlock},
+			)
+		}
+
+		k.delegateChangeTS.DelTimerByBlockHeight(ctx, pending.EffectiveBlock, key)
+		k.RemovePendingDelegateChange(ctx, stakeEntry.Address, stakeEntry.Chain)
+
+		details := map[string]string{
+			"provider":       stakeEntry.Address,
+			"chainID":        stakeEntry.Chain,
+			"effectiveBlock": strconv.FormatUint(pending.EffectiveBlock, 10),
+		}
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderDelegateChangeCanceledEventName, details, "Provider Delegation Terms Change Canceled")
+	}
+
+	if increaseCommission {
+		maxChange := k.DelegateCommissionMaxChange(ctx)
+		if commission-stakeEntry.DelegateCommission > maxChange {
+			return utils.LavaFormatWarning("delegation commission increase exceeds max change", fmt.Errorf("invalid delegate commission"),
+				utils.Attribute{Key: "provider", Value: stakeEntry.Address},
+				utils.Attribute{Key: "chainID", Value: stakeEntry.Chain},
+				utils.Attribute{Key: "commission", Value: stakeEntry.DelegateCommission},
+				utils.Attribute{Key: "newCommission", Value: commission},
+				utils.Attribute{Key: "maxChange", Value: maxChange},
+			)
+		}
+	}
+
+	cooldown := k.DelegateChangeCooldown(ctx)
+
+	// changes in favor of the delegators (or without cooldown) apply immediately
+	if !increaseCommission || cooldown == 0 {
+		stakeEntry.DelegateCommission = commission
+	}
+	if !decreaseLimit || cooldown == 0 {
+		stakeEntry.DelegateLimit = limit
+	}
+	if (!increaseCommission && !decreaseLimit) || cooldown == 0 {
+		return nil
+	}
+
+	change := types.DelegateChange{
+		Provider:           stakeEntry.Address,
+		ChainId:            stakeEntry.Chain,
+		DelegateCommission: commission,
+		DelegateLimit:      limit,
+		EffectiveBlock:     uint64(ctx.BlockHeight()) + cooldown,
+	}
+	k.delegateChangeTS.AddTimerByBlockHeight(ctx, change.EffectiveBlock, key, k.cdc.MustMarshal(&change))
+	k.SetPendingDelegateChange(ctx, change)
+
+	details := map[string]string{
+		"provider":       change.Provider,
+		"chainID":        change.ChainId,
+		"commission":     strconv.FormatUint(change.DelegateCommission, 10),
+		"limit":          change.DelegateLimit.String(),
+		"effectiveBlock": strconv.FormatUint(change.EffectiveBlock, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderDelegateChangeScheduledEventName, details, "Provider Delegation Terms Change Scheduled")
+
+	return nil
+}
+
+// applyDelegateChange applies a pending delegate change (on its effective block)
+func (k Keeper) applyDelegateChange(ctx sdk.Context, data []byte) {
+	var change types.DelegateChange
+	if err := k.cdc.Unmarshal(data, &change); err != nil {
+		utils.LavaFormatError("critical: failed to unmarshal pending delegate change", err)
+		return
+	}
+
+	k.RemovePendingDelegateChange(ctx, change.Provider, change.ChainId)
+
+	providerAddr, err := sdk.AccAddressFromBech32(change.Provider)
+	if err != nil {
+		utils.LavaFormatError("critical: invalid provider address in pending delegate change", err,
+			utils.Attribute{Key: "provider", Value: change.Provider},
+		)
+		return
+	}
+
+	// the provider may have unstaked meanwhile
+	stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, change.ChainId, providerAddr)
+	if !found {
+		return
+	}
+
+	stakeEntry.DelegateCommission = change.DelegateCommission
+	stakeEntry.DelegateLimit = change.DelegateLimit
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, change.ChainId, stakeEntry, index)
+
+	details := map[string]string{
+		"provider":   change.Provider,
+		"chainID":    change.ChainId,
+		"commission": strconv.FormatUint(change.DelegateCommission, 10),
+		"limit":      change.DelegateLimit.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderDelegateChangeAppliedEventName, details, "Provider Delegation Terms Change Applied")
+}
+
+// SetPendingDelegateChange sets the pending delegate change of a provider (for a chain)
+func (k Keeper) SetPendingDelegateChange(ctx sdk.Context, change types.DelegateChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegateChangeKeyPrefix))
+	store.Set(types.DelegateChangeKey(change.Provider, change.ChainId), k.cdc.MustMarshal(&change))
+}
+
+// GetPendingDelegateChange returns the pending delegate change of a provider (for a chain)
+func (k Keeper) GetPendingDelegateChange(ctx sdk.Context, provider string, chainID string) (val types.DelegateChange, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegateChangeKeyPrefix))
+	b := store.Get(types.DelegateChangeKey(provider, chainID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingDelegateChange removes the pending delegate change of a provider (for a chain)
+func (k Keeper) RemovePendingDelegateChange(ctx sdk.Context, provider string, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegateChangeKeyPrefix))
+	store.Delete(types.DelegateChangeKey(provider, chainID))
+}
+
+// GetProviderPendingDelegateChanges returns the pending delegate changes of a provider (for all chains)
+func (k Keeper) GetProviderPendingDelegateChanges(ctx sdk.Context, provider string) []types.DelegateChange {
+	return k.getPendingDelegateChanges(ctx, types.DelegateChangeProviderPrefix(provider))
+}
+
+// GetAllPendingDelegateChanges returns all the pending delegate changes
+func (k Keeper) GetAllPendingDelegateChanges(ctx sdk.Context) []types.DelegateChange {
+	return k.getPendingDelegateChanges(ctx, []byte{})
+}
+
+func (k Keeper) getPendingDelegateChanges(ctx sdk.Context, keyPrefix []byte) []types.DelegateChange {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegateChangeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	changes := []types.DelegateChange{}
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.DelegateChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// InitDelegateChangeTimers imports the pending delegate changes timers (from genesis),
+// and the pending delegate changes they hold
+func (k Keeper) InitDelegateChangeTimers(ctx sdk.Context, gs timerstoretypes.GenesisState) {
+	k.delegateChangeTS.Init(ctx, gs)
+	for _, timer := range k.delegateChangeTS.DumpAllTimers(ctx, timerstoretypes.BlockHeight) {
+		var change types.DelegateChange
+		k.cdc.MustUnmarshal(timer.Data, &change)
+		k.SetPendingDelegateChange(ctx, change)
+	}
+}
+
+// ExportDelegateChangeTimers exports the pending delegate changes timers (for genesis)
+func (k Keeper) ExportDelegateChangeTimers(ctx sdk.Context) timerstoretypes.GenesisState {
+	return k.delegateChangeTS.Export(ctx)
+}
@@ -84,8 +84,13 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, validator, creator, chainID strin
 		existingEntry.Geolocation = geolocation
 		existingEntry.Endpoints = endpointsVerified
 		existingEntry.Moniker = moniker
-		existingEntry.DelegateCommission = delegationCommission
-		existingEntry.DelegateLimit = delegationLimit
+		// changes of the delegation terms may be rate limited and delayed
+		err = k.modifyDelegateTerms(ctx, &existingEntry, delegationCommission, delegationLimit)
+		if err != nil {
+			return utils.LavaFormatWarning("invalid delegation terms change", err,
+				details...,
+			)
+		}
 
 		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, existingEntry, indexInStakeStorage)
 
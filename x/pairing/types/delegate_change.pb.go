@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/pairing/delegate_change.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelegateChange is a pending change of a provider's delegation terms (for a chain)
+// that is applied on the effective block
+type DelegateChange struct {
+	Provider           string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainId            string     `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	DelegateCommission uint64     `protobuf:"varint,3,opt,name=delegate_commission,json=delegateCommission,proto3" json:"delegate_commission,omitempty"`
+	DelegateLimit      types.Coin `protobuf:"bytes,4,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+	EffectiveBlock     uint64     `protobuf:"varint,5,opt,name=effective_block,json=effectiveBlock,proto3" json:"effective_block,omitempty"`
+}
+
+func (m *DelegateChange) Reset()         { *m = DelegateChange{} }
+func (m *DelegateChange) String() string { return proto.CompactTextString(m) }
+func (*DelegateChange) ProtoMessage()    {}
+func (*DelegateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60b63f7530049568, []int{0}
+}
+func (m *DelegateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateChange.Merge(m, src)
+}
+func (m *DelegateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateChange proto.InternalMessageInfo
+
+func (m *DelegateChange) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *DelegateChange) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *DelegateChange) GetDelegateCommission() uint64 {
+	if m != nil {
+		return m.DelegateCommission
+	}
+	return 0
+}
+
+func (m *DelegateChange) GetDelegateLimit() types.Coin {
+	if m != nil {
+		return m.DelegateLimit
+	}
+	return types.Coin{}
+}
+
+func (m *DelegateChange) GetEffectiveBlock() uint64 {
+	if m != nil {
+		return m.EffectiveBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DelegateChange)(nil), "lavanet.lava.pairing.DelegateChange")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/pairing/delegate_change.proto", fileDescriptor_60b63f7530049568)
+}
+
+var fileDescriptor_60b63f7530049568 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0x31, 0x4f, 0xf3, 0x30,
+	0x14, 0x8c, 0xbf, 0xaf, 0x40, 0x31, 0xa2, 0x48, 0xa6, 0x43, 0xda, 0xc1, 0x54, 0x2c, 0xad, 0x18,
+	0x6c, 0x15, 0x7e, 0x01, 0x2d, 0x42, 0x42, 0x62, 0xea, 0xc8, 0x52, 0x39, 0x8e, 0x9b, 0x3e, 0x91,
+	0xf8, 0x45, 0x89, 0x89, 0xe0, 0x5f, 0xf0, 0xb3, 0x3a, 0x76, 0x64, 0x42, 0xa8, 0x9d, 0xf8, 0x17,
+	0x28, 0x69, 0x1a, 0xc4, 0xf4, 0xfc, 0xde, 0xdd, 0xe9, 0x4e, 0x3e, 0x7a, 0x15, 0xab, 0x42, 0x59,
+	0xe3, 0x64, 0x39, 0x65, 0xaa, 0x20, 0x03, 0x1b, 0xc9, 0xd0, 0xc4, 0x26, 0x52, 0xce, 0xcc, 0xf5,
+	0x52, 0xd9, 0xc8, 0x88, 0x34, 0x43, 0x87, 0xac, 0x5b, 0x73, 0x45, 0x39, 0x45, 0xcd, 0xed, 0x77,
+	0x23, 0x8c, 0xb0, 0x22, 0xc8, 0xf2, 0xb5, 0xe3, 0xf6, 0xb9, 0xc6, 0x3c, 0xc1, 0x5c, 0x06, 0x2a,
+	0x37, 0xb2, 0x18, 0x07, 0xc6, 0xa9, 0xb1, 0xd4, 0x08, 0x76, 0x87, 0x5f, 0x7e, 0x13, 0xda, 0xb9,
+	0xab, 0x5d, 0xa6, 0x95, 0x09, 0xeb, 0xd3, 0x76, 0x9a, 0x61, 0x01, 0xa1, 0xc9, 0x7c, 0x32, 0x20,
+	0xa3, 0xe3, 0x59, 0xb3, 0xb3, 0x1e, 0x6d, 0xeb, 0xa5, 0x02, 0x3b, 0x87, 0xd0, 0xff, 0x57, 0x61,
+	0x47, 0xd5, 0xfe, 0x10, 0x32, 0x49, 0xcf, 0x7f, 0xe3, 0x62, 0x92, 0x40, 0x9e, 0x03, 0x5a, 0xff,
+	0xff, 0x80, 0x8c, 0x5a, 0x33, 0xb6, 0x87, 0xa6, 0x0d, 0xc2, 0xee, 0x69, 0xa7, 0x11, 0xc4, 0x90,
+	0x80, 0xf3, 0x5b, 0x03, 0x32, 0x3a, 0xb9, 0xee, 0x89, 0x5d, 0x66, 0x51, 0x66, 0x16, 0x75, 0x66,
+	0x31, 0x45, 0xb0, 0x93, 0xd6, 0xea, 0xf3, 0xc2, 0x9b, 0x9d, 0xee, 0x65, 0x8f, 0xa5, 0x8a, 0x0d,
+	0xe9, 0x99, 0x59, 0x2c, 0x8c, 0x76, 0x50, 0x98, 0x79, 0x10, 0xa3, 0x7e, 0xf6, 0x0f, 0x2a, 0xd3,
+	0x4e, 0x73, 0x9e, 0x94, 0xd7, 0xc9, 0xed, 0x6a, 0xc3, 0xc9, 0x7a, 0xc3, 0xc9, 0xd7, 0x86, 0x93,
+	0xf7, 0x2d, 0xf7, 0xd6, 0x5b, 0xee, 0x7d, 0x6c, 0xb9, 0xf7, 0x34, 0x8c, 0xc0, 0x2d, 0x5f, 0x02,
+	0xa1, 0x31, 0x91, 0x7f, 0x8a, 0x78, 0x6d, 0xaa, 0x70, 0x6f, 0xa9, 0xc9, 0x83, 0xc3, 0xea, 0xd7,
+	0x6e, 0x7e, 0x06, 0x00, 0xb2, 0x67, 0x7c, 0x0d, 0xaf, 0x01, 0x00, 0x00,
+}
+
+func (m *DelegateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveBlock != 0 {
+		i = encodeVarintDelegateChange(dAtA, i, uint64(m.EffectiveBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.DelegateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDelegateChange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DelegateCommission != 0 {
+		i = encodeVarintDelegateChange(dAtA, i, uint64(m.DelegateCommission))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintDelegateChange(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintDelegateChange(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegateChange(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegateChange(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelegateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovDelegateChange(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovDelegateChange(uint64(l))
+	}
+	if m.DelegateCommission != 0 {
+		n += 1 + sovDelegateChange(uint64(m.DelegateCommission))
+	}
+	l = m.DelegateLimit.Size()
+	n += 1 + l + sovDelegateChange(uint64(l))
+	if m.EffectiveBlock != 0 {
+		n += 1 + sovDelegateChange(uint64(m.EffectiveBlock))
+	}
+	return n
+}
+
+func sovDelegateChange(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelegateChange(x uint64) (n int) {
+	return sovDelegateChange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegateChange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegateChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegateChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegateChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegateChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegateChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegateChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateCommission", wireType)
+			}
+			m.DelegateCommission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegateChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateCommission |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegateChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegateChange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegateChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlock", wireType)
+			}
+			m.EffectiveBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegateChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegateChange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegateChange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelegateChange(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelegateChange
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegateChange
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegateChange
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelegateChange
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelegateChange
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelegateChange
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelegateChange        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelegateChange          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelegateChange = fmt.Errorf("proto: unexpected end of group")
+)
@@ -86,6 +86,7 @@ type GenesisState struct {
 	BadgeUsedCuList                        []BadgeUsedCu                        `protobuf:"bytes,5,rep,name=badgeUsedCuList,proto3" json:"badgeUsedCuList"`
 	BadgesTS                               types.GenesisState                   `protobuf:"bytes,6,opt,name=badgesTS,proto3" json:"badgesTS"`
 	ProviderQosFS                          types1.GenesisState                  `protobuf:"bytes,7,opt,name=providerQosFS,proto3" json:"providerQosFS"`
+	DelegateChangeTS                       types.GenesisState                   `protobuf:"bytes,8,opt,name=delegateChangeTS,proto3" json:"delegateChangeTS"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -170,6 +171,13 @@ func (m *GenesisState) GetProviderQosFS() types1.GenesisState {
 	return types1.GenesisState{}
 }
 
+func (m *GenesisState) GetDelegateChangeTS() types.GenesisState {
+	if m != nil {
+		return m.DelegateChangeTS
+	}
+	return types.GenesisState{}
+}
+
 func init() {
 	proto.RegisterType((*BadgeUsedCu)(nil), "lavanet.lava.pairing.BadgeUsedCu")
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.pairing.GenesisState")
@@ -180,39 +188,40 @@ func init() {
 }
 
 var fileDescriptor_dbd1e49b8b57595b = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0x36, 0x4d, 0xd1, 0xa6, 0x80, 0xba, 0xaa, 0x84, 0x15, 0x21, 0x93, 0xa6, 0x02,
-	0x52, 0x09, 0xd9, 0x52, 0x7b, 0x41, 0xdc, 0x9a, 0x0a, 0x7a, 0x80, 0x43, 0x3e, 0x5a, 0x21, 0x71,
-	0xb1, 0x36, 0xc9, 0xe0, 0xae, 0x68, 0xbc, 0xc6, 0xbb, 0xae, 0x9a, 0xb7, 0xe0, 0xc4, 0x03, 0xf0,
-	0x34, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0x45, 0x90, 0xc7, 0x93, 0x34, 0x2e, 0xcb, 0xc7, 0xc9,
-	0xde, 0xf8, 0x37, 0xbf, 0xff, 0x66, 0x66, 0x97, 0xb5, 0x2e, 0xc4, 0xa5, 0x88, 0xc1, 0x04, 0xf9,
-	0x33, 0x48, 0x84, 0x4c, 0x65, 0x1c, 0x05, 0x11, 0xc4, 0xa0, 0xa5, 0xf6, 0x93, 0x54, 0x19, 0xc5,
-	0x77, 0x88, 0xf1, 0xf3, 0xa7, 0x4f, 0x4c, 0x63, 0x27, 0x52, 0x91, 0x42, 0x20, 0xc8, 0xdf, 0x0a,
-	0xb6, 0xb1, 0x6b, 0xf5, 0x25, 0x22, 0x15, 0x13, 0xd2, 0x35, 0x8e, 0xac, 0x48, 0x16, 0xcb, 0xcf,
-	0x19, 0x84, 0x89, 0x98, 0x4e, 0x20, 0x36, 0xa1, 0x36, 0x2a, 0x15, 0x11, 0x84, 0xa3, 0x0b, 0x99,
-	0x2f, 0x93, 0x54, 0x5d, 0xca, 0x31, 0xa4, 0xa4, 0x38, 0xb4, 0xa7, 0x10, 0x74, 0x57, 0x42, 0x45,
-	0xfb, 0xd6, 0x22, 0x48, 0xd4, 0xe8, 0x7c, 0x51, 0xa1, 0xad, 0xe8, 0x47, 0x79, 0x25, 0x8c, 0x54,
-	0x71, 0xae, 0x83, 0xe5, 0x8a, 0xd0, 0xbd, 0x12, 0x6a, 0xe4, 0x04, 0xd2, 0x82, 0xc3, 0xd7, 0x02,
-	0x6a, 0xf5, 0x58, 0xbd, 0x23, 0xc6, 0x11, 0x9c, 0x69, 0x18, 0x1f, 0x67, 0x7c, 0x9f, 0x6d, 0x0f,
-	0xf3, 0x65, 0x98, 0x69, 0x18, 0x87, 0xa3, 0x2c, 0xfc, 0x04, 0x53, 0xd7, 0x69, 0x3a, 0xed, 0xad,
-	0xfe, 0x83, 0xe1, 0x2d, 0xf7, 0x16, 0xa6, 0xfc, 0x11, 0xdb, 0x24, 0xc8, 0x5d, 0x6b, 0x3a, 0xed,
-	0x6a, 0xbf, 0x96, 0xe1, 0xb7, 0xd6, 0xb7, 0x0d, 0xb6, 0x75, 0x52, 0x8c, 0x69, 0x60, 0x84, 0x01,
-	0xfe, 0x8a, 0xd5, 0x8a, 0x36, 0xa3, 0xa9, 0x7e, 0xf0, 0xd8, 0xb7, 0x8d, 0xcd, 0xef, 0x22, 0xd3,
-	0xa9, 0x5e, 0xff, 0x78, 0x52, 0xe9, 0x53, 0x05, 0xff, 0xea, 0xb0, 0x67, 0xc5, 0x00, 0xba, 0x45,
-	0x23, 0x06, 0x45, 0xe7, 0x8e, 0xb1, 0xfb, 0x5d, 0xea, 0xeb, 0x3b, 0xa9, 0x8d, 0xbb, 0xd6, 0x5c,
-	0x6f, 0xd7, 0x0f, 0x5e, 0xda, 0xe5, 0x67, 0xff, 0x74, 0x50, 0xf0, 0x7f, 0xa6, 0xf1, 0x94, 0x35,
-	0x16, 0x53, 0x2d, 0xb3, 0xb8, 0x97, 0x75, 0xdc, 0xcb, 0x8b, 0x3f, 0xfc, 0x51, 0x6b, 0x1d, 0xe5,
-	0xff, 0xc5, 0xca, 0xdf, 0xb3, 0x6d, 0x3c, 0x14, 0xf4, 0x49, 0x63, 0x54, 0x15, 0xa3, 0xf6, 0xec,
-	0x51, 0xaf, 0x57, 0x71, 0x4a, 0xf8, 0xdd, 0xc1, 0x7b, 0xec, 0xe1, 0xca, 0x74, 0x51, 0xbb, 0x81,
-	0xda, 0x5d, 0xbb, 0x76, 0xe5, 0xc8, 0x90, 0xf4, 0x6e, 0x3d, 0x3f, 0x61, 0xf7, 0xf0, 0x27, 0x7d,
-	0x3a, 0x70, 0x6b, 0x38, 0xf6, 0xa7, 0x65, 0xd7, 0xed, 0x81, 0xf4, 0x57, 0x4f, 0x0b, 0xf9, 0x96,
-	0xc5, 0xfc, 0x94, 0xdd, 0x5f, 0xb4, 0xa4, 0xa7, 0xf4, 0x9b, 0x81, 0xbb, 0x89, 0xb6, 0x76, 0xd9,
-	0x56, 0xba, 0x09, 0x36, 0x61, 0x59, 0xd2, 0x39, 0xba, 0x9e, 0x79, 0xce, 0xcd, 0xcc, 0x73, 0x7e,
-	0xce, 0x3c, 0xe7, 0xcb, 0xdc, 0xab, 0xdc, 0xcc, 0xbd, 0xca, 0xf7, 0xb9, 0x57, 0xf9, 0xf0, 0x3c,
-	0x92, 0xe6, 0x3c, 0x1b, 0xfa, 0x23, 0x35, 0x09, 0x4a, 0x37, 0xe8, 0x6a, 0x79, 0x33, 0xcd, 0x34,
-	0x01, 0x3d, 0xac, 0xe1, 0x0d, 0x3a, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x86, 0x08, 0xe8, 0x13,
-	0xa9, 0x04, 0x00, 0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6f, 0xda, 0x30,
+	0x18, 0xc7, 0x49, 0x4b, 0x69, 0x65, 0xba, 0x97, 0x5a, 0x95, 0x16, 0xa1, 0x29, 0xa3, 0x54, 0xdb,
+	0xa8, 0x34, 0x25, 0x52, 0x7b, 0x99, 0x76, 0x2b, 0x68, 0xeb, 0x61, 0x3b, 0x00, 0xa1, 0xaa, 0xb4,
+	0x4b, 0x64, 0xc8, 0x33, 0x63, 0x0d, 0xe2, 0x2c, 0x76, 0xaa, 0xf2, 0x2d, 0x76, 0xda, 0x67, 0xea,
+	0xb1, 0xc7, 0x9d, 0xa6, 0x09, 0x3e, 0xc6, 0x2e, 0x53, 0x1c, 0x43, 0x49, 0xf1, 0x5e, 0x7a, 0x4a,
+	0x4c, 0x7e, 0xcf, 0xef, 0x6f, 0x9e, 0xc7, 0x46, 0x8d, 0x31, 0xb9, 0x24, 0x11, 0x48, 0x2f, 0x7b,
+	0x7a, 0x31, 0x61, 0x09, 0x8b, 0xa8, 0x47, 0x21, 0x02, 0xc1, 0x84, 0x1b, 0x27, 0x5c, 0x72, 0xbc,
+	0xaf, 0x19, 0x37, 0x7b, 0xba, 0x9a, 0xa9, 0xed, 0x53, 0x4e, 0xb9, 0x02, 0xbc, 0xec, 0x2d, 0x67,
+	0x6b, 0x07, 0x46, 0x5f, 0x4c, 0x12, 0x32, 0xd1, 0xba, 0xda, 0xa9, 0x11, 0x49, 0x23, 0xf6, 0x25,
+	0x85, 0x20, 0x26, 0xd3, 0x09, 0x44, 0x32, 0x10, 0x92, 0x27, 0x84, 0x42, 0x30, 0x1c, 0xb3, 0x6c,
+	0x19, 0x27, 0xfc, 0x92, 0x85, 0x90, 0x68, 0xc5, 0x89, 0x39, 0x45, 0x43, 0x77, 0x25, 0xba, 0xe8,
+	0xc8, 0x58, 0x04, 0x31, 0x1f, 0x8e, 0x16, 0x15, 0xc2, 0x88, 0x7e, 0x62, 0x57, 0x44, 0x32, 0x1e,
+	0x65, 0x3a, 0x58, 0xae, 0x34, 0x7a, 0x58, 0x40, 0x25, 0x9b, 0x40, 0x92, 0x73, 0xea, 0x35, 0x87,
+	0x1a, 0x5d, 0x54, 0x6d, 0x91, 0x90, 0xc2, 0xb9, 0x80, 0xb0, 0x9d, 0xe2, 0x23, 0xb4, 0x37, 0xc8,
+	0x96, 0x41, 0x2a, 0x20, 0x0c, 0x86, 0x69, 0xf0, 0x19, 0xa6, 0xb6, 0x55, 0xb7, 0x9a, 0xbb, 0xbd,
+	0x87, 0x83, 0x5b, 0xee, 0x3d, 0x4c, 0xf1, 0x13, 0xb4, 0xad, 0x21, 0x7b, 0xa3, 0x6e, 0x35, 0xcb,
+	0xbd, 0x4a, 0xaa, 0xbe, 0x35, 0x7e, 0x6d, 0xa1, 0xdd, 0xb3, 0x7c, 0x4c, 0xbe, 0x24, 0x12, 0xf0,
+	0x1b, 0x54, 0xc9, 0xdb, 0xac, 0x4c, 0xd5, 0xe3, 0xa7, 0xae, 0x69, 0x6c, 0x6e, 0x47, 0x31, 0xad,
+	0xf2, 0xf5, 0x8f, 0x67, 0xa5, 0x9e, 0xae, 0xc0, 0xdf, 0x2c, 0xf4, 0x22, 0x1f, 0x40, 0x27, 0x6f,
+	0x84, 0x9f, 0x77, 0xae, 0xad, 0xba, 0xdf, 0xd1, 0x7d, 0xfd, 0xc0, 0x84, 0xb4, 0x37, 0xea, 0x9b,
+	0xcd, 0xea, 0xf1, 0x6b, 0xb3, 0xfc, 0xfc, 0x9f, 0x0e, 0x1d, 0xfc, 0x9f, 0x69, 0x38, 0x41, 0xb5,
+	0xc5, 0x54, 0x8b, 0xac, 0xda, 0xcb, 0xa6, 0xda, 0xcb, 0xab, 0x3f, 0xfc, 0x51, 0x63, 0x9d, 0xce,
+	0xff, 0x8b, 0x15, 0x5f, 0xa0, 0x3d, 0x75, 0x28, 0xf4, 0x27, 0xa1, 0xa2, 0xca, 0x2a, 0xea, 0xd0,
+	0x1c, 0xf5, 0x76, 0x15, 0xd7, 0x09, 0xeb, 0x0e, 0xdc, 0x45, 0x8f, 0x56, 0xa6, 0xab, 0xb4, 0x5b,
+	0x4a, 0x7b, 0x60, 0xd6, 0xae, 0x1c, 0x19, 0x2d, 0xbd, 0x5b, 0x8f, 0xcf, 0xd0, 0x8e, 0xfa, 0x49,
+	0xf4, 0x7d, 0xbb, 0xa2, 0xc6, 0xfe, 0xbc, 0xe8, 0xba, 0x3d, 0x90, 0xee, 0xea, 0x69, 0xd1, 0xbe,
+	0x65, 0x31, 0xee, 0xa3, 0x07, 0x8b, 0x96, 0x74, 0xb9, 0x78, 0xe7, 0xdb, 0xdb, 0xca, 0xd6, 0x2c,
+	0xda, 0x0a, 0x37, 0xc1, 0x24, 0x2c, 0x4a, 0xf0, 0x05, 0x7a, 0x1c, 0xc2, 0x18, 0x28, 0x91, 0xd0,
+	0x1e, 0x91, 0x88, 0x42, 0xdf, 0xb7, 0x77, 0xee, 0xbf, 0xcd, 0x35, 0x49, 0xeb, 0xf4, 0x7a, 0xe6,
+	0x58, 0x37, 0x33, 0xc7, 0xfa, 0x39, 0x73, 0xac, 0xaf, 0x73, 0xa7, 0x74, 0x33, 0x77, 0x4a, 0xdf,
+	0xe7, 0x4e, 0xe9, 0xe3, 0x4b, 0xca, 0xe4, 0x28, 0x1d, 0xb8, 0x43, 0x3e, 0xf1, 0x0a, 0x57, 0xf3,
+	0x6a, 0x79, 0xe5, 0xe5, 0x34, 0x06, 0x31, 0xa8, 0xa8, 0xab, 0x79, 0xf2, 0x7b, 0x00, 0x16, 0x7a,
+	0xd7, 0xcb, 0x02, 0x05, 0x00, 0x00,
 }
 
 func (m *BadgeUsedCu) Marshal() (dAtA []byte, err error) {
@@ -270,6 +279,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.DelegateChangeTS.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.ProviderQosFS.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -422,6 +441,8 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ProviderQosFS.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DelegateChangeTS.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -798,6 +819,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateChangeTS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegateChangeTS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
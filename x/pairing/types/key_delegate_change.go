@@ -0,0 +1,20 @@
+package types
+
+const (
+	// DelegateChangeTimerStorePrefix is the prefix of the timer store of pending delegate changes
+	DelegateChangeTimerStorePrefix = "DelegateChangeTimerStore/"
+
+	// DelegateChangeKeyPrefix is the prefix to retrieve all pending DelegateChange
+	DelegateChangeKeyPrefix = "DelegateChange/value/"
+)
+
+// DelegateChangeKey returns the store (and timer) key of a provider's pending delegate change (for a chain)
+func DelegateChangeKey(provider string, chainID string) []byte {
+	return append(DelegateChangeProviderPrefix(provider), []byte(chainID)...)
+}
+
+// DelegateChangeProviderPrefix returns the prefix of all the pending delegate changes of a provider.
+// Using " " (space) as separator is safe because Bech32 forbids its use as part of the address.
+func DelegateChangeProviderPrefix(provider string) []byte {
+	return []byte(provider + " ")
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -53,6 +54,16 @@ var (
 	DefaultRecommendedEpochNumToCollectPayment uint64 = 3
 )
 
+var (
+	KeyDelegateCommissionMaxChange            = []byte("DelegateCommissionMaxChange") // the max increase of a provider's delegation commission (percentage points) in a single change
+	DefaultDelegateCommissionMaxChange uint64 = 5
+)
+
+var (
+	KeyDelegateChangeCooldown            = []byte("DelegateChangeCooldown") // the blocks until a commission increase (or delegation limit decrease) takes effect
+	DefaultDelegateChangeCooldown uint64 = 2880
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -68,6 +79,8 @@ func NewParams(
 	dataReliabilityReward sdk.Dec,
 	qoSWeight sdk.Dec,
 	recommendedEpochNumToCollectPayment uint64,
+	delegateCommissionMaxChange uint64,
+	delegateChangeCooldown uint64,
 ) Params {
 	return Params{
 		FraudStakeSlashingFactor:            fraudStakeSlashingFactor,
@@ -78,6 +91,8 @@ func NewParams(
 		DataReliabilityReward:               dataReliabilityReward,
 		QoSWeight:                           qoSWeight,
 		RecommendedEpochNumToCollectPayment: recommendedEpochNumToCollectPayment,
+		DelegateCommissionMaxChange:         delegateCommissionMaxChange,
+		DelegateChangeCooldown:              delegateChangeCooldown,
 	}
 }
 
@@ -92,6 +107,8 @@ func DefaultParams() Params {
 		DefaultDataReliabilityReward,
 		DefaultQoSWeight,
 		DefaultRecommendedEpochNumToCollectPayment,
+		DefaultDelegateCommissionMaxChange,
+		DefaultDelegateChangeCooldown,
 	)
 }
 
@@ -106,6 +123,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDataReliabilityReward, &p.DataReliabilityReward, validateDataReliabilityReward),
 		paramtypes.NewParamSetPair(KeyQoSWeight, &p.QoSWeight, validateQoSWeight),
 		paramtypes.NewParamSetPair(KeyRecommendedEpochNumToCollectPayment, &p.RecommendedEpochNumToCollectPayment, validateRecommendedEpochNumToCollectPayment),
+		paramtypes.NewParamSetPair(KeyDelegateCommissionMaxChange, &p.DelegateCommissionMaxChange, validateDelegateCommissionMaxChange),
+		paramtypes.NewParamSetPair(KeyDelegateChangeCooldown, &p.DelegateChangeCooldown, validateDelegateChangeCooldown),
 	}
 }
 
@@ -136,6 +155,12 @@ func (p Params) Validate() error {
 	if err := validateRecommendedEpochNumToCollectPayment(p.RecommendedEpochNumToCollectPayment); err != nil {
 		return err
 	}
+	if err := validateDelegateCommissionMaxChange(p.DelegateCommissionMaxChange); err != nil {
+		return err
+	}
+	if err := validateDelegateChangeCooldown(p.DelegateChangeCooldown); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -282,3 +307,32 @@ func validateRecommendedEpochNumToCollectPayment(v interface{}) error {
 
 	return nil
 }
+
+// validateDelegateCommissionMaxChange validates the DelegateCommissionMaxChange param
+func validateDelegateCommissionMaxChange(v interface{}) error {
+	delegateCommissionMaxChange, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if delegateCommissionMaxChange > 100 {
+		return fmt.Errorf("invalid parameter DelegateCommissionMaxChange (must be 0-100)")
+	}
+
+	return nil
+}
+
+// validateDelegateChangeCooldown validates the DelegateChangeCooldown param
+func validateDelegateChangeCooldown(v interface{}) error {
+	delegateChangeCooldown, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// the effective block of a pending change (block height + cooldown) must not overflow
+	if delegateChangeCooldown > math.MaxInt64 {
+		return fmt.Errorf("invalid parameter DelegateChangeCooldown (must not exceed %d)", int64(math.MaxInt64))
+	}
+
+	return nil
+}
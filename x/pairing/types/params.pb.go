@@ -34,6 +34,8 @@ type Params struct {
 	DataReliabilityReward               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=dataReliabilityReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dataReliabilityReward" yaml:"data_reliability_reward"`
 	QoSWeight                           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=QoSWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"QoSWeight" yaml:"data_reliability_reward"`
 	RecommendedEpochNumToCollectPayment uint64                                 `protobuf:"varint,14,opt,name=recommendedEpochNumToCollectPayment,proto3" json:"recommendedEpochNumToCollectPayment,omitempty" yaml:"recommended_epoch_num_to_collect_payment"`
+	DelegateCommissionMaxChange         uint64                                 `protobuf:"varint,15,opt,name=delegateCommissionMaxChange,proto3" json:"delegateCommissionMaxChange,omitempty" yaml:"delegate_commission_max_change"`
+	DelegateChangeCooldown              uint64                                 `protobuf:"varint,16,opt,name=delegateChangeCooldown,proto3" json:"delegateChangeCooldown,omitempty" yaml:"delegate_change_cooldown"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -89,6 +91,20 @@ func (m *Params) GetRecommendedEpochNumToCollectPayment() uint64 {
 	return 0
 }
 
+func (m *Params) GetDelegateCommissionMaxChange() uint64 {
+	if m != nil {
+		return m.DelegateCommissionMaxChange
+	}
+	return 0
+}
+
+func (m *Params) GetDelegateChangeCooldown() uint64 {
+	if m != nil {
+		return m.DelegateChangeCooldown
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.pairing.Params")
 }
@@ -96,41 +112,46 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/pairing/params.proto", fileDescriptor_fc338fce33b3b67a) }
 
 var fileDescriptor_fc338fce33b3b67a = []byte{
-	// 535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0x2d, 0xdb, 0x52, 0xf3, 0x47, 0x51, 0x18, 0x52, 0x04, 0x28, 0x29, 0x41, 0x82,
-	0x5d, 0x68, 0x0e, 0xbb, 0xed, 0xb6, 0x32, 0x38, 0x44, 0x88, 0x95, 0x74, 0x12, 0x12, 0x17, 0xcb,
-	0x4d, 0xbc, 0xd4, 0xaa, 0x1d, 0x47, 0x8e, 0x33, 0xe8, 0x03, 0xc0, 0x79, 0x47, 0x8e, 0xbc, 0x05,
-	0xaf, 0xb0, 0xe3, 0x8e, 0x88, 0x43, 0x84, 0xda, 0x37, 0xe8, 0x13, 0xa0, 0xd8, 0x65, 0xed, 0xa4,
-	0x22, 0x31, 0x21, 0x4e, 0xae, 0x9a, 0xef, 0xf7, 0xf3, 0x51, 0x7e, 0xb1, 0x7e, 0xe0, 0x31, 0x45,
-	0x67, 0x28, 0xc7, 0x32, 0x6c, 0xce, 0xb0, 0x40, 0x44, 0x90, 0x3c, 0x0b, 0x0b, 0x24, 0x10, 0x2b,
-	0xbb, 0x85, 0xe0, 0x92, 0x3b, 0xbb, 0x8b, 0x48, 0xb7, 0x39, 0xbb, 0x8b, 0xc8, 0x83, 0xdd, 0x8c,
-	0x67, 0x5c, 0x05, 0xc2, 0xe6, 0x97, 0xce, 0x06, 0xdf, 0x76, 0xc0, 0x76, 0x5f, 0x95, 0x9d, 0x73,
-	0x03, 0xb8, 0xa7, 0x02, 0x55, 0xe9, 0x40, 0xa2, 0x31, 0x1e, 0x50, 0x54, 0x8e, 0x48, 0x9e, 0xbd,
-	0x42, 0x89, 0xe4, 0xc2, 0xdd, 0xea, 0x18, 0x7b, 0xed, 0xde, 0xc9, 0x45, 0xed, 0xb7, 0x7e, 0xd4,
-	0xfe, 0xd3, 0x8c, 0xc8, 0x51, 0x35, 0xec, 0x26, 0x9c, 0x85, 0x09, 0x2f, 0x19, 0x2f, 0x17, 0xc7,
-	0xf3, 0x32, 0x1d, 0x87, 0x72, 0x52, 0xe0, 0xb2, 0x7b, 0x84, 0x93, 0x79, 0xed, 0x07, 0x13, 0xc4,
-	0xe8, 0x41, 0xa0, 0xb8, 0xb0, 0x6c, 0xc0, 0xb0, 0x5c, 0x90, 0xe1, 0xa9, 0x42, 0x07, 0xf1, 0x1f,
-	0xad, 0x4e, 0x0c, 0xee, 0xe9, 0x67, 0x8b, 0xbf, 0x0f, 0x19, 0xaf, 0x72, 0xe9, 0x6e, 0x77, 0x8c,
-	0x3d, 0xb3, 0xd7, 0x99, 0xd7, 0xfe, 0xa3, 0x6b, 0xf8, 0xdf, 0x60, 0xa4, 0x62, 0x41, 0xbc, 0xae,
-	0xec, 0x1c, 0x03, 0x07, 0x17, 0x3c, 0x19, 0xf5, 0x28, 0x4f, 0xc6, 0xe5, 0xf1, 0x19, 0x16, 0x14,
-	0x15, 0xae, 0xa5, 0x90, 0xfe, 0xbc, 0xf6, 0x1f, 0x6a, 0xa4, 0xca, 0xc0, 0xa1, 0x0a, 0x41, 0xae,
-	0x53, 0x41, 0xbc, 0xa6, 0xea, 0xa4, 0x00, 0x54, 0x79, 0x81, 0x26, 0xaf, 0x09, 0x23, 0xd2, 0x05,
-	0x6a, 0x50, 0x47, 0x37, 0x1e, 0x94, 0xa3, 0xb5, 0x8a, 0x04, 0x69, 0x83, 0x0a, 0xe2, 0x15, 0x6e,
-	0x63, 0x51, 0xef, 0xa7, 0x2d, 0xb7, 0xfe, 0xcd, 0xa2, 0x48, 0x57, 0x96, 0x25, 0xd7, 0xf9, 0x6c,
-	0x80, 0xfb, 0x29, 0x92, 0x28, 0xc6, 0x94, 0xa0, 0x21, 0xa1, 0x44, 0x4e, 0x62, 0xfc, 0x01, 0x89,
-	0xd4, 0xbd, 0xad, 0x8c, 0xfd, 0x1b, 0x1b, 0x3d, 0x6d, 0x6c, 0xa0, 0x50, 0x2c, 0xa9, 0x50, 0x28,
-	0x6c, 0x10, 0xaf, 0xd7, 0x39, 0x39, 0x68, 0xbf, 0xe5, 0x83, 0x77, 0x98, 0x64, 0x23, 0xe9, 0xde,
-	0xf9, 0x4f, 0xee, 0xa5, 0xc2, 0xf9, 0x64, 0x80, 0x27, 0x02, 0x27, 0x9c, 0x31, 0x9c, 0xa7, 0x38,
-	0x7d, 0xd9, 0x7c, 0xe6, 0x37, 0x15, 0x3b, 0xe1, 0x2f, 0x38, 0xa5, 0x38, 0x91, 0x7d, 0x34, 0x61,
-	0x38, 0x97, 0xee, 0x5d, 0x75, 0x4f, 0xf6, 0xe7, 0xb5, 0x1f, 0x6a, 0xf8, 0x4a, 0x09, 0xea, 0x3b,
-	0x93, 0x57, 0x0c, 0x4a, 0x0e, 0x13, 0x5d, 0x84, 0x85, 0x6e, 0x06, 0xf1, 0xdf, 0xf0, 0x0f, 0xcc,
-	0x2f, 0x5f, 0xfd, 0x56, 0x64, 0x5a, 0x86, 0xbd, 0x11, 0x99, 0xd6, 0x86, 0xbd, 0x19, 0x99, 0xd6,
-	0xa6, 0x6d, 0x46, 0xa6, 0x65, 0xda, 0x5b, 0x91, 0x69, 0xed, 0xd8, 0x56, 0x64, 0x5a, 0x6d, 0x1b,
-	0xf4, 0x0e, 0x2f, 0xa6, 0x9e, 0x71, 0x39, 0xf5, 0x8c, 0x9f, 0x53, 0xcf, 0x38, 0x9f, 0x79, 0xad,
-	0xcb, 0x99, 0xd7, 0xfa, 0x3e, 0xf3, 0x5a, 0xef, 0x9f, 0xad, 0x0c, 0xe8, 0xda, 0xb6, 0xf8, 0x78,
-	0xb5, 0x2f, 0xd4, 0x94, 0x86, 0xdb, 0x6a, 0x07, 0xec, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x34,
-	0x53, 0x60, 0x6e, 0x54, 0x04, 0x00, 0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4d, 0x4f, 0xd4, 0x4c,
+	0x00, 0xc7, 0xb7, 0x50, 0x78, 0xba, 0xf3, 0xf8, 0xd2, 0x54, 0x34, 0x8d, 0x98, 0x16, 0x4b, 0x54,
+	0x3c, 0xb8, 0x3d, 0x70, 0xe3, 0xc6, 0x2e, 0x7a, 0xd8, 0xa8, 0x60, 0x21, 0x31, 0xd1, 0xc3, 0x64,
+	0x76, 0x3a, 0x74, 0x27, 0x3b, 0x2f, 0x4d, 0x3b, 0x0b, 0xec, 0xd1, 0x83, 0x9e, 0x39, 0x7a, 0xf4,
+	0xe3, 0x70, 0xe4, 0x68, 0x3c, 0x34, 0x06, 0xbe, 0x41, 0x3f, 0x81, 0xe9, 0xb4, 0x2e, 0x60, 0xc0,
+	0x48, 0x8c, 0xa7, 0x69, 0x3a, 0xff, 0xff, 0xef, 0x97, 0x79, 0xc9, 0x80, 0x87, 0x0c, 0xed, 0x21,
+	0x41, 0x54, 0x58, 0x8d, 0x61, 0x8a, 0x68, 0x46, 0x45, 0x12, 0xa6, 0x28, 0x43, 0x3c, 0xef, 0xa4,
+	0x99, 0x54, 0xd2, 0x59, 0x68, 0x22, 0x9d, 0x6a, 0xec, 0x34, 0x91, 0xfb, 0x0b, 0x89, 0x4c, 0xa4,
+	0x0e, 0x84, 0xd5, 0x57, 0x9d, 0x0d, 0x3e, 0xb4, 0xc1, 0xfc, 0x96, 0x2e, 0x3b, 0x87, 0x06, 0x70,
+	0x77, 0x33, 0x34, 0x8e, 0xb7, 0x15, 0x1a, 0x91, 0x6d, 0x86, 0xf2, 0x21, 0x15, 0xc9, 0x0b, 0x84,
+	0x95, 0xcc, 0xdc, 0xb9, 0x25, 0x63, 0xa5, 0xdd, 0xdd, 0x39, 0x2a, 0xfc, 0xd6, 0xb7, 0xc2, 0x7f,
+	0x9c, 0x50, 0x35, 0x1c, 0x0f, 0x3a, 0x58, 0xf2, 0x10, 0xcb, 0x9c, 0xcb, 0xbc, 0x19, 0x9e, 0xe5,
+	0xf1, 0x28, 0x54, 0x93, 0x94, 0xe4, 0x9d, 0x0d, 0x82, 0xcb, 0xc2, 0x0f, 0x26, 0x88, 0xb3, 0xb5,
+	0x40, 0x73, 0x61, 0x5e, 0x81, 0x61, 0xde, 0x90, 0xe1, 0xae, 0x46, 0x07, 0xd1, 0x95, 0x56, 0x27,
+	0x02, 0x77, 0xea, 0xb9, 0xe6, 0xf7, 0x3a, 0x97, 0x63, 0xa1, 0xdc, 0xf9, 0x25, 0x63, 0xc5, 0xec,
+	0x2e, 0x95, 0x85, 0xff, 0xe0, 0x02, 0xfe, 0x27, 0x18, 0xe9, 0x58, 0x10, 0x5d, 0x56, 0x76, 0x36,
+	0x81, 0x43, 0x52, 0x89, 0x87, 0x5d, 0x26, 0xf1, 0x28, 0xdf, 0xdc, 0x23, 0x19, 0x43, 0xa9, 0x6b,
+	0x69, 0xa4, 0x5f, 0x16, 0xfe, 0x62, 0x8d, 0xd4, 0x19, 0x38, 0xd0, 0x21, 0x28, 0xeb, 0x54, 0x10,
+	0x5d, 0x52, 0x75, 0x62, 0x00, 0xc6, 0x22, 0x45, 0x93, 0x97, 0x94, 0x53, 0xe5, 0x02, 0xbd, 0x51,
+	0x1b, 0xd7, 0xde, 0x28, 0xa7, 0xd6, 0x6a, 0x12, 0x64, 0x15, 0x2a, 0x88, 0xce, 0x71, 0x2b, 0x8b,
+	0x5e, 0x5f, 0x6d, 0xf9, 0xff, 0xef, 0x2c, 0x9a, 0x34, 0xb5, 0x9c, 0x71, 0x9d, 0x4f, 0x06, 0xb8,
+	0x1b, 0x23, 0x85, 0x22, 0xc2, 0x28, 0x1a, 0x50, 0x46, 0xd5, 0x24, 0x22, 0xfb, 0x28, 0x8b, 0xdd,
+	0x1b, 0xda, 0xb8, 0x75, 0x6d, 0xa3, 0x57, 0x1b, 0x2b, 0x28, 0xcc, 0xce, 0xa8, 0x30, 0xd3, 0xd8,
+	0x20, 0xba, 0x5c, 0xe7, 0x08, 0xd0, 0x7e, 0x23, 0xb7, 0xdf, 0x12, 0x9a, 0x0c, 0x95, 0x7b, 0xf3,
+	0x1f, 0xb9, 0xcf, 0x14, 0xce, 0x47, 0x03, 0x2c, 0x67, 0x04, 0x4b, 0xce, 0x89, 0x88, 0x49, 0xfc,
+	0xbc, 0x3a, 0xe6, 0xd7, 0x63, 0xbe, 0x23, 0x7b, 0x92, 0x31, 0x82, 0xd5, 0x16, 0x9a, 0x70, 0x22,
+	0x94, 0x7b, 0x4b, 0xdf, 0x93, 0xd5, 0xb2, 0xf0, 0xc3, 0x1a, 0x7e, 0xae, 0x04, 0xeb, 0x3b, 0x23,
+	0xc6, 0x1c, 0x2a, 0x09, 0x71, 0x5d, 0x84, 0x69, 0xdd, 0x0c, 0xa2, 0x3f, 0xe1, 0x3b, 0x23, 0xb0,
+	0x18, 0x13, 0x46, 0x12, 0xa4, 0x48, 0x4f, 0x72, 0x4e, 0xf3, 0x9c, 0x4a, 0xf1, 0x0a, 0x1d, 0xf4,
+	0x86, 0x48, 0x24, 0xc4, 0xbd, 0xad, 0xf5, 0x4f, 0xcb, 0xc2, 0x7f, 0xd4, 0xac, 0xad, 0x09, 0x43,
+	0x3c, 0x4d, 0x43, 0x8e, 0x0e, 0x20, 0xd6, 0xf9, 0x20, 0xfa, 0x1d, 0xcd, 0x79, 0x0f, 0xee, 0x4d,
+	0xa7, 0xf5, 0x9f, 0x9e, 0x94, 0x2c, 0x96, 0xfb, 0xc2, 0xb5, 0xb5, 0x67, 0xb9, 0x2c, 0x7c, 0xff,
+	0x57, 0x8f, 0x0e, 0x42, 0xdc, 0x24, 0x83, 0xe8, 0x0a, 0xc4, 0x9a, 0xf9, 0xf9, 0x8b, 0xdf, 0xea,
+	0x9b, 0x96, 0x61, 0xcf, 0xf4, 0x4d, 0x6b, 0xc6, 0x9e, 0xed, 0x9b, 0xd6, 0xac, 0x6d, 0xf6, 0x4d,
+	0xcb, 0xb4, 0xe7, 0xfa, 0xa6, 0xf5, 0x9f, 0x6d, 0xf5, 0x4d, 0xab, 0x6d, 0x83, 0xee, 0xfa, 0xd1,
+	0x89, 0x67, 0x1c, 0x9f, 0x78, 0xc6, 0xf7, 0x13, 0xcf, 0x38, 0x3c, 0xf5, 0x5a, 0xc7, 0xa7, 0x5e,
+	0xeb, 0xeb, 0xa9, 0xd7, 0x7a, 0xf7, 0xe4, 0xdc, 0x51, 0x5f, 0x78, 0xf7, 0x0e, 0xa6, 0x2f, 0x9f,
+	0x3e, 0xef, 0xc1, 0xbc, 0x7e, 0xcd, 0x56, 0x7f, 0x0c, 0x00, 0x5b, 0xa8, 0xb3, 0x32, 0x1e, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -153,6 +174,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegateChangeCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DelegateChangeCooldown))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.DelegateCommissionMaxChange != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DelegateCommissionMaxChange))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.RecommendedEpochNumToCollectPayment != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.RecommendedEpochNumToCollectPayment))
 		i--
@@ -257,6 +290,12 @@ func (m *Params) Size() (n int) {
 	if m.RecommendedEpochNumToCollectPayment != 0 {
 		n += 1 + sovParams(uint64(m.RecommendedEpochNumToCollectPayment))
 	}
+	if m.DelegateCommissionMaxChange != 0 {
+		n += 1 + sovParams(uint64(m.DelegateCommissionMaxChange))
+	}
+	if m.DelegateChangeCooldown != 0 {
+		n += 2 + sovParams(uint64(m.DelegateChangeCooldown))
+	}
 	return n
 }
 
@@ -522,6 +561,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateCommissionMaxChange", wireType)
+			}
+			m.DelegateCommissionMaxChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateCommissionMaxChange |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateChangeCooldown", wireType)
+			}
+			m.DelegateChangeCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateChangeCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1781,6 +1781,102 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) GetDetails() []*ChainIDPayout {
 	return nil
 }
 
+type QueryPendingDelegateChangesRequest struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Provider  string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *QueryPendingDelegateChangesRequest) Reset()         { *m = QueryPendingDelegateChangesRequest{} }
+func (m *QueryPendingDelegateChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDelegateChangesRequest) ProtoMessage()    {}
+func (*QueryPendingDelegateChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{34}
+}
+func (m *QueryPendingDelegateChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDelegateChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDelegateChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDelegateChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDelegateChangesRequest.Merge(m, src)
+}
+func (m *QueryPendingDelegateChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDelegateChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDelegateChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDelegateChangesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingDelegateChangesRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *QueryPendingDelegateChangesRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type QueryPendingDelegateChangesResponse struct {
+	Changes []DelegateChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *QueryPendingDelegateChangesResponse) Reset()         { *m = QueryPendingDelegateChangesResponse{} }
+func (m *QueryPendingDelegateChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDelegateChangesResponse) ProtoMessage()    {}
+func (*QueryPendingDelegateChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{35}
+}
+func (m *QueryPendingDelegateChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDelegateChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDelegateChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDelegateChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDelegateChangesResponse.Merge(m, src)
+}
+func (m *QueryPendingDelegateChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDelegateChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDelegateChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDelegateChangesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDelegateChangesResponse) GetChanges() []DelegateChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.pairing.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.pairing.QueryParamsResponse")
@@ -1816,138 +1912,145 @@ func init() {
 	proto.RegisterType((*ChainIDPayout)(nil), "lavanet.lava.pairing.ChainIDPayout")
 	proto.RegisterType((*QuerySubscriptionMonthlyPayoutRequest)(nil), "lavanet.lava.pairing.QuerySubscriptionMonthlyPayoutRequest")
 	proto.RegisterType((*QuerySubscriptionMonthlyPayoutResponse)(nil), "lavanet.lava.pairing.QuerySubscriptionMonthlyPayoutResponse")
+	proto.RegisterType((*QueryPendingDelegateChangesRequest)(nil), "lavanet.lava.pairing.QueryPendingDelegateChangesRequest")
+	proto.RegisterType((*QueryPendingDelegateChangesResponse)(nil), "lavanet.lava.pairing.QueryPendingDelegateChangesResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/pairing/query.proto", fileDescriptor_9e149ce9d21da0d8) }
 
 var fileDescriptor_9e149ce9d21da0d8 = []byte{
-	// 2001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0xdc, 0x6a,
-	0x15, 0xaf, 0x27, 0x8f, 0x26, 0xa7, 0x4d, 0x5b, 0x7d, 0x37, 0x49, 0x13, 0x93, 0x9b, 0xa6, 0x5f,
-	0x5f, 0x09, 0x0d, 0xe3, 0x9b, 0xe9, 0xe3, 0x46, 0x7d, 0x41, 0xd2, 0x17, 0x29, 0x81, 0x9b, 0x4c,
-	0x08, 0x0b, 0x36, 0x96, 0xe3, 0xf9, 0x32, 0x71, 0xe3, 0xb1, 0x5d, 0x3f, 0xa6, 0x09, 0xa3, 0x01,
-	0x04, 0x62, 0x7b, 0x85, 0xc4, 0x65, 0x81, 0xc4, 0xf2, 0x4a, 0x88, 0x05, 0xec, 0x91, 0xd8, 0x21,
-	0xd0, 0x5d, 0x20, 0x74, 0xa5, 0x6e, 0x58, 0x00, 0x42, 0x2d, 0xff, 0x00, 0xff, 0x01, 0xf2, 0xf7,
-	0x1d, 0x7b, 0xec, 0x89, 0xc7, 0x33, 0xd3, 0x44, 0x6c, 0xda, 0x7c, 0xf6, 0x79, 0xfc, 0xce, 0xef,
-	0x1c, 0xfb, 0x9c, 0xe3, 0x81, 0x39, 0x53, 0xab, 0x6b, 0x16, 0xf3, 0x95, 0xf0, 0x7f, 0xc5, 0xd1,
-	0x0c, 0xd7, 0xb0, 0xaa, 0xca, 0xab, 0x80, 0xb9, 0x87, 0x45, 0xc7, 0xb5, 0x7d, 0x9b, 0x8c, 0xa3,
-	0x44, 0x31, 0xfc, 0xbf, 0x88, 0x12, 0xf2, 0x78, 0xd5, 0xae, 0xda, 0x5c, 0x40, 0x09, 0xff, 0x12,
-	0xb2, 0xf2, 0x4c, 0xd5, 0xb6, 0xab, 0x26, 0x53, 0x34, 0xc7, 0x50, 0x34, 0xcb, 0xb2, 0x7d, 0xcd,
-	0x37, 0x6c, 0xcb, 0xc3, 0xbb, 0x5f, 0xd5, 0x6d, 0xaf, 0x66, 0x7b, 0xca, 0x8e, 0xe6, 0x31, 0xe1,
-	0x42, 0xa9, 0x2f, 0xed, 0x30, 0x5f, 0x5b, 0x52, 0x1c, 0xad, 0x6a, 0x58, 0x5c, 0x18, 0x65, 0x2f,
-	0x67, 0xe2, 0x72, 0x34, 0x57, 0xab, 0x45, 0xe6, 0x16, 0x32, 0x45, 0x98, 0x63, 0xeb, 0x7b, 0xaa,
-	0xa3, 0x1d, 0xd6, 0x98, 0xe5, 0x47, 0xa2, 0x33, 0x29, 0x51, 0xcf, 0x61, 0x3a, 0xff, 0x07, 0xef,
-	0x5e, 0x4a, 0x1b, 0x32, 0x35, 0xcb, 0x53, 0x1c, 0xdb, 0x34, 0x74, 0xa4, 0x40, 0xbe, 0x95, 0x0d,
-	0xc6, 0xb5, 0xeb, 0x46, 0x85, 0xb9, 0x91, 0x33, 0xd5, 0xf3, 0x6d, 0x57, 0xab, 0x32, 0x54, 0x5a,
-	0xc9, 0x54, 0x0a, 0x2c, 0xe3, 0x55, 0xc0, 0xda, 0x55, 0x54, 0xdd, 0x34, 0xc2, 0x63, 0x64, 0x12,
-	0x4d, 0xdc, 0x4c, 0x99, 0xe0, 0x91, 0xa1, 0x82, 0xe2, 0xf9, 0xda, 0x3e, 0x53, 0x99, 0xe5, 0x47,
-	0x79, 0x92, 0x17, 0xd3, 0x31, 0x06, 0x3b, 0x9e, 0xee, 0x1a, 0x4e, 0x48, 0x69, 0xea, 0x80, 0xd2,
-	0x57, 0xd2, 0xe8, 0x5c, 0xfb, 0x25, 0xd3, 0x7d, 0x2f, 0xfa, 0x03, 0x85, 0x6e, 0xa4, 0x84, 0x2a,
-	0xf6, 0x6b, 0xcb, 0x37, 0x6a, 0x4c, 0xa9, 0x2f, 0xc5, 0x7f, 0x0b, 0x41, 0x3a, 0x0e, 0x64, 0x33,
-	0xcc, 0xe7, 0x06, 0xcf, 0x4f, 0x99, 0xbd, 0x0a, 0x98, 0xe7, 0xd3, 0x4d, 0xf8, 0x20, 0x75, 0xd5,
-	0x73, 0x6c, 0xcb, 0x63, 0xe4, 0x1e, 0x0c, 0x8b, 0x3c, 0x4e, 0x49, 0x73, 0xd2, 0xfc, 0x99, 0xd2,
-	0x4c, 0x31, 0xab, 0xc2, 0x8a, 0x42, 0x6b, 0x75, 0xf0, 0x8b, 0x7f, 0x5d, 0x3a, 0x55, 0x46, 0x0d,
-	0xba, 0x09, 0x13, 0xc2, 0x24, 0x12, 0x15, 0xf9, 0x22, 0x53, 0x70, 0x5a, 0xdf, 0xd3, 0x0c, 0x6b,
-	0xed, 0x09, 0xb7, 0x3a, 0x5a, 0x8e, 0x8e, 0x64, 0x16, 0xc0, 0xdb, 0xb3, 0x5f, 0x3f, 0x73, 0xed,
-	0x1f, 0x30, 0x6b, 0xaa, 0x30, 0x27, 0xcd, 0x8f, 0x94, 0x13, 0x57, 0xe8, 0x3e, 0x4c, 0xb6, 0x9b,
-	0x44, 0xa0, 0xdf, 0x02, 0xe0, 0x34, 0x3f, 0x0d, 0x59, 0x9e, 0x92, 0xe6, 0x06, 0xe6, 0xcf, 0x94,
-	0xae, 0xa5, 0xc1, 0x26, 0x73, 0x52, 0xdc, 0x8a, 0x85, 0x11, 0x75, 0x42, 0xfd, 0xc5, 0xe0, 0x48,
-	0xe1, 0xc2, 0x00, 0x7d, 0x81, 0xce, 0x9e, 0x33, 0x7f, 0x43, 0xc4, 0xd9, 0x3d, 0x80, 0x49, 0x18,
-	0x16, 0xe5, 0xc1, 0xc1, 0x8f, 0x96, 0xf1, 0x44, 0x7f, 0x57, 0x80, 0x8b, 0x47, 0x8c, 0x21, 0xf4,
-	0x35, 0x18, 0x8d, 0x6a, 0xc9, 0x7b, 0x1f, 0xe4, 0x2d, 0x6d, 0x72, 0x05, 0xc6, 0xf4, 0xc0, 0x75,
-	0xc3, 0xf2, 0xe4, 0x3a, 0x1c, 0xc5, 0x60, 0xf9, 0x2c, 0x5e, 0x7c, 0x1a, 0x5e, 0x23, 0xcb, 0x30,
-	0x1d, 0x96, 0x83, 0x6a, 0xb2, 0x5d, 0x5f, 0xf5, 0x6d, 0xd5, 0x62, 0x07, 0xbe, 0x8a, 0x99, 0x9c,
-	0x1a, 0xe0, 0x0a, 0x13, 0xa1, 0xc0, 0x3a, 0xdb, 0xf5, 0xbf, 0x6b, 0x7f, 0x87, 0x1d, 0x44, 0x88,
-	0xc9, 0x1d, 0xb8, 0x18, 0x3e, 0x8a, 0xaa, 0xa9, 0x79, 0xbe, 0x1a, 0x38, 0x15, 0xcd, 0x67, 0x15,
-	0x75, 0xc7, 0xb4, 0xf5, 0xfd, 0xa9, 0x41, 0xae, 0x37, 0x1e, 0xde, 0x5e, 0xd7, 0x3c, 0x7f, 0x5b,
-	0xdc, 0x5c, 0x0d, 0xef, 0x91, 0x25, 0x98, 0xe0, 0x42, 0xaa, 0xbd, 0x9b, 0x76, 0x36, 0xc4, 0x95,
-	0x08, 0xbf, 0xf9, 0xc9, 0x6e, 0xc2, 0x13, 0xfd, 0x11, 0x4c, 0x73, 0xba, 0xbe, 0xc7, 0x5c, 0x63,
-	0xf7, 0xf0, 0xb8, 0xf4, 0x13, 0x19, 0x46, 0x22, 0x92, 0x78, 0x84, 0xa3, 0xe5, 0xf8, 0x4c, 0xc6,
-	0x61, 0x28, 0x19, 0x82, 0x38, 0xd0, 0xcf, 0x25, 0x90, 0xb3, 0x10, 0x60, 0xce, 0xc6, 0x61, 0xa8,
-	0xae, 0x99, 0x46, 0x85, 0x03, 0x18, 0x29, 0x8b, 0x03, 0x59, 0x80, 0x0b, 0x61, 0x68, 0xac, 0xa2,
-	0xb6, 0x12, 0x2a, 0x08, 0x3d, 0x2f, 0xae, 0xc7, 0x75, 0x4b, 0xe6, 0xe0, 0xac, 0x1e, 0xa8, 0x0e,
-	0x73, 0x31, 0x51, 0xc2, 0x39, 0xe8, 0xc1, 0x06, 0x73, 0x45, 0x9a, 0x3e, 0x04, 0xc0, 0x27, 0x5c,
-	0x35, 0x2a, 0x9c, 0xaa, 0x51, 0x9e, 0xea, 0xf0, 0xca, 0x5a, 0x05, 0x6b, 0x74, 0x0d, 0x96, 0xa2,
-	0xb2, 0xda, 0xe6, 0x6f, 0xab, 0x0d, 0xf1, 0xb2, 0xda, 0x12, 0xc5, 0xf2, 0x98, 0x87, 0x1f, 0x79,
-	0x8d, 0xf8, 0x1b, 0x87, 0x21, 0xc3, 0xaa, 0xb0, 0x03, 0x64, 0x4f, 0x1c, 0xe8, 0x9f, 0x25, 0x28,
-	0xf5, 0x63, 0x0b, 0x99, 0xf8, 0x54, 0x02, 0x1a, 0x74, 0x15, 0xc7, 0xd7, 0xc7, 0x72, 0xf6, 0xeb,
-	0xa3, 0xbb, 0x3b, 0x2c, 0xf5, 0x1e, 0x3c, 0xd1, 0x06, 0x52, 0xb2, 0x62, 0x9a, 0xbd, 0x53, 0xf2,
-	0x0c, 0xa0, 0xd5, 0xd6, 0x10, 0xec, 0xf5, 0xa2, 0xe8, 0x81, 0xc5, 0xb0, 0x07, 0x16, 0x45, 0x9b,
-	0xc5, 0x1e, 0x58, 0xdc, 0xd0, 0xaa, 0x0c, 0x75, 0xcb, 0x09, 0x4d, 0xfa, 0x69, 0x01, 0x49, 0xec,
-	0xd1, 0x7b, 0xbf, 0x24, 0x0e, 0xfc, 0x7f, 0x48, 0x24, 0xcf, 0x53, 0x7c, 0x14, 0x38, 0x1f, 0x37,
-	0xba, 0xf2, 0x21, 0xa2, 0x49, 0x11, 0xf2, 0x10, 0xae, 0xc5, 0xef, 0x3d, 0x34, 0x9e, 0x76, 0x9c,
-	0x5f, 0x94, 0x9f, 0x49, 0x70, 0xbd, 0x9b, 0x3e, 0x72, 0xf8, 0x12, 0x26, 0x9d, 0x4c, 0x09, 0x4c,
-	0xe7, 0x62, 0x87, 0xd6, 0x95, 0xa9, 0x83, 0x54, 0x75, 0xb0, 0x48, 0x6d, 0x8c, 0x6a, 0xc5, 0x34,
-	0xf3, 0xa3, 0x3a, 0xa9, 0xba, 0xfa, 0x67, 0xc4, 0x43, 0x8e, 0xc7, 0x1e, 0x78, 0x18, 0x38, 0x59,
-	0x1e, 0x4e, 0xae, 0x4c, 0x6e, 0xc3, 0x4c, 0x94, 0x66, 0xfe, 0xf6, 0x43, 0x3f, 0x5e, 0x7e, 0x75,
-	0x38, 0xf0, 0x61, 0x07, 0x2d, 0xe4, 0xe2, 0x13, 0x18, 0x63, 0xc9, 0x1b, 0x98, 0x81, 0x2b, 0xd9,
-	0x14, 0xa4, 0x6c, 0x60, 0xe4, 0x69, 0x7d, 0xba, 0x8b, 0x38, 0x57, 0x4c, 0x33, 0x13, 0xe7, 0x49,
-	0xe5, 0xfb, 0x0f, 0x12, 0x86, 0x76, 0xd4, 0x51, 0xe7, 0xd0, 0x06, 0x8e, 0x13, 0xda, 0xc9, 0xe5,
-	0x52, 0xc3, 0xb9, 0x6f, 0xdb, 0x63, 0x2e, 0x9f, 0x53, 0x12, 0x7d, 0x5b, 0xab, 0x54, 0x5c, 0xe6,
-	0x79, 0x51, 0xdf, 0xc6, 0x63, 0xb2, 0xa3, 0x17, 0xd2, 0x1d, 0x3d, 0xee, 0xce, 0x03, 0xc9, 0xee,
-	0xfc, 0x1a, 0x47, 0xb3, 0x84, 0x0b, 0xa4, 0xe5, 0x39, 0x8c, 0xe8, 0xb6, 0xe5, 0x05, 0xb5, 0xb8,
-	0xe7, 0xf4, 0x35, 0x4b, 0xc5, 0xca, 0xa1, 0xe3, 0x9a, 0x76, 0xf0, 0x78, 0x1b, 0x47, 0x28, 0x71,
-	0xa0, 0xf7, 0xe1, 0x12, 0x77, 0xbc, 0x15, 0x6e, 0x4b, 0x7a, 0xdc, 0xce, 0xd7, 0x0d, 0xcf, 0xef,
-	0x3a, 0x9d, 0xd0, 0x1a, 0xcc, 0x75, 0x56, 0x3e, 0xf1, 0x61, 0x90, 0x6e, 0xc2, 0x57, 0xb8, 0xbb,
-	0xa7, 0xbb, 0xbb, 0x4c, 0xf7, 0x8d, 0x3a, 0xdb, 0xe0, 0x7b, 0x52, 0x84, 0x53, 0x6e, 0x63, 0x6a,
-	0x34, 0x11, 0xfc, 0x24, 0x0c, 0x87, 0x93, 0x5c, 0x9c, 0x0e, 0x3c, 0xd1, 0x5f, 0x4a, 0x58, 0xff,
-	0x47, 0x6c, 0x22, 0xfc, 0x12, 0x0c, 0x8b, 0x6d, 0x0c, 0xc9, 0x97, 0xdb, 0xca, 0x31, 0xdc, 0xd7,
-	0x8a, 0xa8, 0x83, 0x92, 0x64, 0x05, 0xce, 0x39, 0xcc, 0xaa, 0x18, 0x56, 0x55, 0x45, 0xdd, 0x42,
-	0x57, 0xdd, 0x31, 0xd4, 0x10, 0x47, 0xfa, 0x5f, 0x09, 0xc7, 0xeb, 0xad, 0xca, 0x7e, 0xfb, 0xa8,
-	0xf6, 0x1c, 0x4e, 0x47, 0xf3, 0xa6, 0xc0, 0xf4, 0xb5, 0xec, 0x47, 0xa4, 0xc3, 0x78, 0x5e, 0x8e,
-	0xb4, 0xc9, 0x04, 0x0c, 0xd7, 0xb4, 0x03, 0x55, 0x0f, 0x92, 0x25, 0x11, 0x90, 0x9b, 0x30, 0x18,
-	0xb2, 0xc3, 0x0b, 0xf4, 0x4c, 0xe9, 0x62, 0xda, 0x38, 0xdf, 0x5c, 0xb7, 0x1c, 0xa6, 0x97, 0xb9,
-	0x10, 0x59, 0x83, 0xf3, 0xd1, 0x3a, 0xa6, 0xe2, 0x62, 0x35, 0xc8, 0xf5, 0xe6, 0xd2, 0x7a, 0xf1,
-	0xce, 0x56, 0x5f, 0xc2, 0xe5, 0xaa, 0x7c, 0x2e, 0xba, 0x26, 0xce, 0xf4, 0xeb, 0x70, 0x39, 0xb5,
-	0x0b, 0x7d, 0xdb, 0xb6, 0xfc, 0x3d, 0xf3, 0x70, 0x43, 0x3b, 0xb4, 0x03, 0x3f, 0x91, 0x64, 0x27,
-	0x39, 0x82, 0x25, 0x06, 0x5f, 0xba, 0x0f, 0x64, 0x2b, 0xb1, 0x6c, 0x0a, 0x45, 0x42, 0xe1, 0x6c,
-	0x72, 0x05, 0x45, 0xad, 0xd4, 0x35, 0x32, 0x0d, 0x23, 0xbc, 0xa6, 0xc3, 0xc1, 0x34, 0xf5, 0xbc,
-	0x56, 0xc2, 0xca, 0xd1, 0x6a, 0x76, 0x60, 0xf9, 0xf8, 0xc0, 0xe2, 0x89, 0xfe, 0x10, 0x68, 0x1e,
-	0xda, 0xd6, 0x58, 0xed, 0xdb, 0xbe, 0x66, 0x72, 0xaf, 0x83, 0x65, 0x71, 0x20, 0xab, 0x70, 0xba,
-	0xc2, 0x7c, 0xcd, 0x30, 0xbd, 0xa9, 0x02, 0x7f, 0x22, 0xe6, 0xb3, 0x33, 0x78, 0x34, 0x9a, 0x72,
-	0xa4, 0x48, 0x9f, 0xc0, 0xb9, 0x44, 0x87, 0x0b, 0x03, 0xcd, 0xa1, 0x26, 0x11, 0x45, 0x21, 0x15,
-	0xc5, 0x4b, 0x18, 0x7b, 0x2c, 0x1e, 0x66, 0x34, 0x92, 0x64, 0x42, 0x4a, 0x33, 0xf1, 0x28, 0xac,
-	0xbb, 0x50, 0x28, 0x42, 0x7d, 0xb5, 0x6b, 0xe3, 0xe5, 0x88, 0x51, 0x89, 0x3e, 0xc6, 0x19, 0x23,
-	0x19, 0x55, 0xa7, 0x1c, 0x77, 0x7a, 0x90, 0x69, 0x13, 0xc7, 0x86, 0x1c, 0x23, 0xb9, 0xd4, 0x3f,
-	0x6c, 0xa7, 0xbe, 0x43, 0x7f, 0x49, 0xb1, 0x12, 0xb3, 0x5e, 0xfa, 0xf5, 0x14, 0x0c, 0x71, 0xff,
-	0xe4, 0xa7, 0x12, 0x0c, 0x8b, 0xc2, 0x25, 0xf3, 0x39, 0xcf, 0x5f, 0xea, 0xa3, 0x84, 0xbc, 0xd0,
-	0x83, 0xa4, 0x80, 0x4f, 0xaf, 0xfe, 0xe4, 0xcd, 0x7f, 0x7e, 0x51, 0x98, 0x25, 0x33, 0x4a, 0xce,
-	0xc7, 0x28, 0xf2, 0x2b, 0x09, 0x46, 0x5b, 0x3b, 0xd8, 0xcd, 0x3c, 0xf3, 0x6d, 0x1f, 0x2d, 0xe4,
-	0xc5, 0xde, 0x84, 0x11, 0xce, 0x12, 0x87, 0x73, 0x93, 0x2c, 0x28, 0xb9, 0x9f, 0xa3, 0x3c, 0xa5,
-	0x81, 0xcd, 0xa1, 0x49, 0x7e, 0x23, 0x01, 0xb4, 0x5e, 0x3f, 0x64, 0xb1, 0xc7, 0xb7, 0x94, 0x40,
-	0xd7, 0xdf, 0x3b, 0x8d, 0x3e, 0xe0, 0xf0, 0xee, 0x92, 0xdb, 0xd9, 0xf0, 0xaa, 0x2c, 0xde, 0xd1,
-	0x5b, 0x00, 0x95, 0x86, 0x58, 0xa6, 0x9b, 0xe4, 0x2f, 0x12, 0x8c, 0xa5, 0xd6, 0x62, 0xa2, 0xe4,
-	0xb8, 0xcf, 0x5a, 0xe1, 0xe5, 0x8f, 0x7a, 0x57, 0x40, 0xc8, 0x65, 0x0e, 0x79, 0x9d, 0xbc, 0xc8,
-	0x86, 0x5c, 0xe7, 0x4a, 0x39, 0xa8, 0x95, 0x46, 0x44, 0x7a, 0x53, 0x69, 0xf0, 0x29, 0xa2, 0x49,
-	0x7e, 0x56, 0x00, 0xba, 0xdd, 0xc3, 0x32, 0x94, 0x4f, 0x6e, 0xcf, 0x5b, 0xa6, 0xfc, 0xcd, 0xe3,
-	0x1b, 0x42, 0x36, 0xd6, 0x39, 0x1b, 0xcf, 0xc8, 0x13, 0xe5, 0x18, 0x5f, 0x2e, 0x95, 0x06, 0x1f,
-	0xa3, 0x9b, 0xe4, 0xc7, 0x05, 0xb8, 0xd6, 0xdd, 0xf9, 0x8a, 0x69, 0xe6, 0x52, 0xd1, 0xcf, 0xc2,
-	0x9d, 0x4b, 0x45, 0x5f, 0xbb, 0x33, 0x7d, 0xc2, 0xa9, 0x78, 0x44, 0x1e, 0x1c, 0x87, 0x0a, 0xf2,
-	0x46, 0x82, 0xc9, 0xec, 0x15, 0x88, 0xdc, 0xef, 0xf2, 0x6c, 0xe5, 0x2d, 0x80, 0xf2, 0x83, 0xf7,
-	0x53, 0xc6, 0xd8, 0x1e, 0xf1, 0xd8, 0x96, 0xc9, 0x5d, 0xa5, 0xaf, 0xaf, 0xda, 0x71, 0x62, 0xff,
-	0x26, 0xc1, 0x74, 0xb6, 0x8b, 0x30, 0x99, 0xf7, 0xf3, 0x73, 0xf0, 0xfe, 0x81, 0x75, 0x5d, 0x52,
-	0xe9, 0x5d, 0x1e, 0xd8, 0x47, 0xa4, 0xd8, 0x5f, 0x60, 0xe4, 0xf7, 0x12, 0x8c, 0xa5, 0x76, 0x19,
-	0x52, 0xca, 0x27, 0x38, 0x6b, 0x4b, 0x93, 0x6f, 0xf5, 0xa5, 0x83, 0x90, 0x6f, 0x73, 0xc8, 0x45,
-	0xb2, 0xa8, 0xf4, 0xf0, 0x5b, 0x46, 0x9c, 0x81, 0xdf, 0x4a, 0x70, 0x21, 0x65, 0x2f, 0x24, 0xbe,
-	0x94, 0xcf, 0x5d, 0xdf, 0x98, 0x3b, 0x2d, 0x89, 0x74, 0x91, 0x63, 0xbe, 0x4e, 0xae, 0xf6, 0x82,
-	0x99, 0x7c, 0x2e, 0xc1, 0x68, 0xbc, 0x51, 0xe5, 0x76, 0xc7, 0xf6, 0xd5, 0x2e, 0xb7, 0x3b, 0x1e,
-	0x59, 0xd2, 0xba, 0xb5, 0x9f, 0xc0, 0x63, 0xae, 0xf8, 0xb9, 0x44, 0x69, 0xe0, 0x86, 0xd8, 0x4c,
-	0x34, 0xca, 0x3f, 0x49, 0xf0, 0x41, 0xc6, 0x0a, 0x45, 0xee, 0xe4, 0x60, 0xe8, 0xbc, 0xaf, 0xc9,
-	0x77, 0xfb, 0x55, 0xc3, 0x20, 0x1e, 0xf2, 0x20, 0x3e, 0x26, 0x77, 0xb2, 0x83, 0xf0, 0xb8, 0x6a,
-	0xeb, 0x43, 0xb0, 0x6a, 0x1a, 0x9e, 0x9f, 0x88, 0xe2, 0x8f, 0x12, 0x9c, 0x6f, 0xdb, 0xa2, 0xc8,
-	0x52, 0x0e, 0x94, 0xec, 0x2d, 0x4e, 0x2e, 0xf5, 0xa3, 0x82, 0xc8, 0x57, 0x39, 0xf2, 0x07, 0xe4,
-	0x5e, 0x87, 0xaa, 0x88, 0xd4, 0x70, 0x1d, 0x53, 0x1a, 0xd1, 0x38, 0xd9, 0x54, 0x1a, 0x62, 0x11,
-	0x6c, 0x92, 0xbf, 0x4a, 0x30, 0x91, 0x39, 0xcb, 0x93, 0x8f, 0x7b, 0x18, 0x94, 0xb2, 0xe6, 0x58,
-	0x79, 0xb9, 0x7f, 0x45, 0x0c, 0xe8, 0x1b, 0x3c, 0xa0, 0x7b, 0x64, 0xb9, 0xcb, 0xdb, 0xa4, 0x26,
-	0xb4, 0x55, 0x31, 0x62, 0x27, 0x26, 0x02, 0xf2, 0x0f, 0x09, 0xa6, 0x3b, 0xce, 0xc8, 0xb9, 0x2f,
-	0xca, 0x6e, 0xe3, 0x79, 0xee, 0x8b, 0xb2, 0xeb, 0x58, 0xde, 0xad, 0xbb, 0x25, 0xd7, 0xb2, 0x23,
-	0xe1, 0xc5, 0x69, 0x23, 0x9f, 0x49, 0x00, 0xad, 0xd5, 0xf8, 0x04, 0x67, 0xcb, 0xa3, 0xfb, 0x36,
-	0x5d, 0xe0, 0x88, 0xaf, 0x90, 0xcb, 0x1d, 0x10, 0x57, 0xf6, 0xa3, 0x29, 0x6d, 0x75, 0xe5, 0x8b,
-	0xb7, 0xb3, 0xd2, 0x97, 0x6f, 0x67, 0xa5, 0x7f, 0xbf, 0x9d, 0x95, 0x7e, 0xfe, 0x6e, 0xf6, 0xd4,
-	0x97, 0xef, 0x66, 0x4f, 0xfd, 0xfd, 0xdd, 0xec, 0xa9, 0xef, 0xdf, 0xa8, 0x1a, 0xfe, 0x5e, 0xb0,
-	0x53, 0xd4, 0xed, 0x5a, 0xda, 0xcc, 0x41, 0x6c, 0xc8, 0x3f, 0x74, 0x98, 0xb7, 0x33, 0xcc, 0x7f,
-	0xd4, 0xbc, 0xf5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0x5f, 0xe2, 0xef, 0x1c, 0x1f, 0x00,
-	0x00,
+	// 2090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdd, 0x6f, 0xdc, 0x58,
+	0x15, 0xaf, 0x27, 0x69, 0x9a, 0x39, 0x6d, 0xda, 0xea, 0x6e, 0x92, 0x26, 0x26, 0x4d, 0x53, 0xa7,
+	0x1f, 0x09, 0x0d, 0xe3, 0xcd, 0xf4, 0x63, 0x43, 0x9b, 0x16, 0xf2, 0xd1, 0x76, 0x53, 0x02, 0x9b,
+	0x4e, 0x08, 0x0f, 0x3c, 0x60, 0x39, 0x9e, 0x9b, 0x89, 0x1b, 0x8f, 0xed, 0x8e, 0xed, 0x34, 0x61,
+	0x34, 0x80, 0x40, 0xbc, 0xae, 0x90, 0x58, 0x1e, 0x78, 0x5f, 0x09, 0xf1, 0x00, 0xef, 0x48, 0xbc,
+	0xa1, 0x45, 0xfb, 0x80, 0xd0, 0x4a, 0xfb, 0xc2, 0x03, 0x20, 0xd4, 0xee, 0x3f, 0xc0, 0x7f, 0x80,
+	0x7c, 0xef, 0xb9, 0x1e, 0x7b, 0xe2, 0xf1, 0xcc, 0x24, 0x11, 0x2f, 0x6d, 0xae, 0x7d, 0x7e, 0xe7,
+	0xe3, 0x77, 0xae, 0xef, 0x39, 0xe7, 0x0e, 0x4c, 0x59, 0xfa, 0xbe, 0x6e, 0x53, 0x5f, 0x0d, 0xff,
+	0x57, 0x5d, 0xdd, 0xac, 0x99, 0x76, 0x45, 0x7d, 0x1d, 0xd0, 0xda, 0x61, 0xc1, 0xad, 0x39, 0xbe,
+	0x43, 0x86, 0x51, 0xa2, 0x10, 0xfe, 0x5f, 0x40, 0x09, 0x79, 0xb8, 0xe2, 0x54, 0x1c, 0x26, 0xa0,
+	0x86, 0x7f, 0x71, 0x59, 0x79, 0xa2, 0xe2, 0x38, 0x15, 0x8b, 0xaa, 0xba, 0x6b, 0xaa, 0xba, 0x6d,
+	0x3b, 0xbe, 0xee, 0x9b, 0x8e, 0xed, 0xe1, 0xdb, 0xaf, 0x1b, 0x8e, 0x57, 0x75, 0x3c, 0x75, 0x5b,
+	0xf7, 0x28, 0x37, 0xa1, 0xee, 0xcf, 0x6f, 0x53, 0x5f, 0x9f, 0x57, 0x5d, 0xbd, 0x62, 0xda, 0x4c,
+	0x18, 0x65, 0xaf, 0xa7, 0xfa, 0xe5, 0xea, 0x35, 0xbd, 0x2a, 0xd4, 0xcd, 0xa6, 0x8a, 0x50, 0xd7,
+	0x31, 0x76, 0x35, 0x57, 0x3f, 0xac, 0x52, 0xdb, 0x17, 0xa2, 0x13, 0x09, 0x51, 0xcf, 0xa5, 0x06,
+	0xfb, 0x07, 0xdf, 0x5e, 0x4b, 0x2a, 0xb2, 0x74, 0xdb, 0x53, 0x5d, 0xc7, 0x32, 0x0d, 0xa4, 0x40,
+	0xbe, 0x9b, 0xee, 0x4c, 0xcd, 0xd9, 0x37, 0xcb, 0xb4, 0x26, 0x8c, 0x69, 0x9e, 0xef, 0xd4, 0xf4,
+	0x0a, 0x45, 0xd0, 0x52, 0x2a, 0x28, 0xb0, 0xcd, 0xd7, 0x01, 0x6d, 0x85, 0x68, 0x86, 0x65, 0x86,
+	0x4b, 0xa1, 0x12, 0x55, 0xdc, 0x49, 0xa8, 0x60, 0x91, 0x21, 0x40, 0xf5, 0x7c, 0x7d, 0x8f, 0x6a,
+	0xd4, 0xf6, 0x45, 0x9e, 0xe4, 0xb9, 0x64, 0x8c, 0xc1, 0xb6, 0x67, 0xd4, 0x4c, 0x37, 0xa4, 0x34,
+	0xb1, 0x40, 0xe9, 0xe9, 0xa4, 0x77, 0x35, 0xe7, 0x15, 0x35, 0x7c, 0x4f, 0xfc, 0x81, 0x42, 0xb7,
+	0x13, 0x42, 0x65, 0xe7, 0x8d, 0xed, 0x9b, 0x55, 0xaa, 0xee, 0xcf, 0x47, 0x7f, 0x8b, 0xcc, 0xa6,
+	0xc6, 0x5a, 0xa6, 0x16, 0xad, 0xe8, 0x3e, 0xd5, 0x8c, 0x5d, 0xdd, 0x16, 0xbc, 0x28, 0xc3, 0x40,
+	0x5e, 0x86, 0xb9, 0xdf, 0x60, 0xb9, 0x2c, 0xd1, 0xd7, 0x01, 0xf5, 0x7c, 0xe5, 0x25, 0xbc, 0x97,
+	0x78, 0xea, 0xb9, 0x8e, 0xed, 0x51, 0xf2, 0x10, 0x06, 0x78, 0xce, 0xc7, 0xa4, 0x29, 0x69, 0xe6,
+	0x7c, 0x71, 0xa2, 0x90, 0xb6, 0x1b, 0x0b, 0x1c, 0xb5, 0xdc, 0xff, 0xf9, 0xbf, 0xaf, 0x9d, 0x29,
+	0x21, 0x42, 0x79, 0x09, 0x23, 0x5c, 0x25, 0x92, 0x2a, 0x6c, 0x91, 0x31, 0x38, 0x67, 0xec, 0xea,
+	0xa6, 0xbd, 0xb6, 0xca, 0xb4, 0xe6, 0x4b, 0x62, 0x49, 0x26, 0x01, 0xbc, 0x5d, 0xe7, 0xcd, 0xb3,
+	0x9a, 0xf3, 0x63, 0x6a, 0x8f, 0xe5, 0xa6, 0xa4, 0x99, 0xc1, 0x52, 0xec, 0x89, 0xb2, 0x07, 0xa3,
+	0xad, 0x2a, 0xd1, 0xd1, 0xef, 0x00, 0xb0, 0x94, 0x3c, 0x0d, 0x33, 0x32, 0x26, 0x4d, 0xf5, 0xcd,
+	0x9c, 0x2f, 0xde, 0x4c, 0x3a, 0x1b, 0xcf, 0x5f, 0x61, 0x33, 0x12, 0x46, 0xaf, 0x63, 0xf0, 0x17,
+	0xfd, 0x83, 0xb9, 0xcb, 0x7d, 0xca, 0x0b, 0x34, 0xf6, 0x9c, 0xfa, 0x1b, 0x3c, 0xce, 0xce, 0x01,
+	0x8c, 0xc2, 0x00, 0xdf, 0x4a, 0xcc, 0xf9, 0x7c, 0x09, 0x57, 0xca, 0x1f, 0x72, 0x70, 0xe5, 0x88,
+	0x32, 0x74, 0x7d, 0x0d, 0xf2, 0x62, 0xdf, 0x79, 0xc7, 0xf1, 0xbc, 0x89, 0x26, 0xd3, 0x30, 0x64,
+	0x04, 0xb5, 0x5a, 0xb8, 0x95, 0x19, 0x86, 0x79, 0xd1, 0x5f, 0xba, 0x80, 0x0f, 0x9f, 0x86, 0xcf,
+	0xc8, 0x02, 0x8c, 0x87, 0x5b, 0x47, 0xb3, 0xe8, 0x8e, 0xaf, 0xf9, 0x8e, 0x66, 0xd3, 0x03, 0x5f,
+	0xc3, 0x4c, 0x8e, 0xf5, 0x31, 0xc0, 0x48, 0x28, 0xb0, 0x4e, 0x77, 0xfc, 0xef, 0x3b, 0xdf, 0xa3,
+	0x07, 0xc2, 0x63, 0x72, 0x1f, 0xae, 0x84, 0x9f, 0xad, 0x66, 0xe9, 0x9e, 0xaf, 0x05, 0x6e, 0x59,
+	0xf7, 0x69, 0x59, 0xdb, 0xb6, 0x1c, 0x63, 0x6f, 0xac, 0x9f, 0xe1, 0x86, 0xc3, 0xd7, 0xeb, 0xba,
+	0xe7, 0x6f, 0xf1, 0x97, 0xcb, 0xe1, 0x3b, 0x32, 0x0f, 0x23, 0x4c, 0x48, 0x73, 0x76, 0x92, 0xc6,
+	0xce, 0x32, 0x10, 0x61, 0x2f, 0x3f, 0xda, 0x89, 0x59, 0x52, 0x7e, 0x0a, 0xe3, 0x8c, 0xae, 0x1f,
+	0xd0, 0x9a, 0xb9, 0x73, 0x78, 0x52, 0xfa, 0x89, 0x0c, 0x83, 0x82, 0x24, 0x16, 0x61, 0xbe, 0x14,
+	0xad, 0xc9, 0x30, 0x9c, 0x8d, 0x87, 0xc0, 0x17, 0xca, 0xa7, 0x12, 0xc8, 0x69, 0x1e, 0x60, 0xce,
+	0x86, 0xe1, 0xec, 0xbe, 0x6e, 0x99, 0x65, 0xe6, 0xc0, 0x60, 0x89, 0x2f, 0xc8, 0x2c, 0x5c, 0x0e,
+	0x43, 0xa3, 0x65, 0xad, 0x99, 0x50, 0x4e, 0xe8, 0x25, 0xfe, 0x3c, 0xda, 0xb7, 0x64, 0x0a, 0x2e,
+	0x18, 0x81, 0xe6, 0xd2, 0x1a, 0x26, 0x8a, 0x1b, 0x07, 0x23, 0xd8, 0xa0, 0x35, 0x9e, 0xa6, 0xab,
+	0x00, 0x78, 0x1a, 0x68, 0x66, 0x99, 0x51, 0x95, 0x2f, 0xe5, 0xf1, 0xc9, 0x5a, 0x19, 0xf7, 0xe8,
+	0x1a, 0xcc, 0x8b, 0x6d, 0xb5, 0xc5, 0x4e, 0xb6, 0x0d, 0x7e, 0xb0, 0x6d, 0xf2, 0xcd, 0xb2, 0xc2,
+	0xc2, 0x17, 0x56, 0x05, 0x7f, 0xc3, 0x70, 0xd6, 0xb4, 0xcb, 0xf4, 0x00, 0xd9, 0xe3, 0x0b, 0xe5,
+	0x33, 0x09, 0x8a, 0xbd, 0xe8, 0x42, 0x26, 0x3e, 0x96, 0x40, 0x09, 0x3a, 0x8a, 0xe3, 0xf1, 0xb1,
+	0x90, 0x7e, 0x7c, 0x74, 0x36, 0x87, 0x5b, 0xbd, 0x0b, 0x4b, 0x4a, 0x1d, 0x29, 0x59, 0xb2, 0xac,
+	0xee, 0x29, 0x79, 0x06, 0xd0, 0x2c, 0x81, 0xe8, 0xec, 0xad, 0x02, 0xaf, 0x97, 0x85, 0xb0, 0x5e,
+	0x16, 0x78, 0x49, 0xc6, 0x7a, 0x59, 0xd8, 0xd0, 0x2b, 0x14, 0xb1, 0xa5, 0x18, 0x52, 0xf9, 0x38,
+	0x07, 0xc5, 0x5e, 0xac, 0xf7, 0x4a, 0x62, 0xdf, 0xff, 0x87, 0x44, 0xf2, 0x3c, 0xc1, 0x47, 0x8e,
+	0xf1, 0x71, 0xbb, 0x23, 0x1f, 0x3c, 0x9a, 0x04, 0x21, 0x8f, 0xe1, 0x66, 0x74, 0xee, 0xa1, 0xf2,
+	0xa4, 0xe1, 0xec, 0x4d, 0xf9, 0x89, 0x04, 0xb7, 0x3a, 0xe1, 0x91, 0xc3, 0x57, 0x30, 0xea, 0xa6,
+	0x4a, 0x60, 0x3a, 0xe7, 0xda, 0x94, 0xae, 0x54, 0x0c, 0x52, 0xd5, 0x46, 0xa3, 0xe2, 0x60, 0x54,
+	0x4b, 0x96, 0x95, 0x1d, 0xd5, 0x69, 0xed, 0xab, 0x7f, 0x09, 0x1e, 0x32, 0x2c, 0x76, 0xc1, 0x43,
+	0xdf, 0xe9, 0xf2, 0x70, 0x7a, 0xdb, 0xe4, 0x1e, 0x4c, 0x88, 0x34, 0xb3, 0xd3, 0x0f, 0xed, 0x78,
+	0xd9, 0xbb, 0xc3, 0x85, 0xab, 0x6d, 0x50, 0xc8, 0xc5, 0x47, 0x30, 0x44, 0xe3, 0x2f, 0x30, 0x03,
+	0xd3, 0xe9, 0x14, 0x24, 0x74, 0x60, 0xe4, 0x49, 0xbc, 0xb2, 0x83, 0x7e, 0x2e, 0x59, 0x56, 0xaa,
+	0x9f, 0xa7, 0x95, 0xef, 0x3f, 0x49, 0x70, 0xb5, 0x8d, 0xa1, 0xf6, 0xa1, 0xf5, 0x9d, 0x24, 0xb4,
+	0xd3, 0xcb, 0xa5, 0x8e, 0x7d, 0xdf, 0x96, 0x47, 0x6b, 0xac, 0x4f, 0x89, 0xd5, 0x6d, 0xbd, 0x5c,
+	0xae, 0x51, 0xcf, 0x13, 0x75, 0x1b, 0x97, 0xf1, 0x8a, 0x9e, 0x4b, 0x56, 0xf4, 0xa8, 0x3a, 0xf7,
+	0xc5, 0xab, 0xf3, 0x1b, 0x18, 0x6d, 0x35, 0x81, 0xb4, 0x3c, 0x87, 0x41, 0xc3, 0xb1, 0xbd, 0xa0,
+	0x1a, 0xd5, 0x9c, 0x9e, 0x7a, 0xa9, 0x08, 0x1c, 0x1a, 0xae, 0xea, 0x07, 0x2b, 0x5b, 0xd8, 0x42,
+	0xf1, 0x85, 0xf2, 0x08, 0xae, 0x31, 0xc3, 0x9b, 0xbe, 0xee, 0x9b, 0x46, 0x54, 0xce, 0xd7, 0x4d,
+	0xcf, 0xef, 0xd8, 0x9d, 0x28, 0x55, 0x98, 0x6a, 0x0f, 0x3e, 0xf5, 0x66, 0x50, 0x79, 0x09, 0x5f,
+	0x63, 0xe6, 0x9e, 0xee, 0xec, 0x50, 0xc3, 0x37, 0xf7, 0xe9, 0x06, 0x9b, 0xa9, 0x84, 0x9f, 0x72,
+	0x0b, 0x53, 0xf9, 0x58, 0xf0, 0xa3, 0x30, 0x10, 0x76, 0x72, 0x51, 0x3a, 0x70, 0xa5, 0xfc, 0x46,
+	0x82, 0x89, 0x74, 0x9d, 0xe8, 0x7e, 0x11, 0x06, 0xf8, 0xe4, 0x86, 0xe4, 0xcb, 0x2d, 0xdb, 0x31,
+	0x9c, 0xed, 0x0a, 0x88, 0x41, 0x49, 0xb2, 0x04, 0x17, 0x5d, 0x6a, 0x97, 0x4d, 0xbb, 0xa2, 0x21,
+	0x36, 0xd7, 0x11, 0x3b, 0x84, 0x08, 0xbe, 0x54, 0xfe, 0x2b, 0x61, 0x7b, 0xbd, 0x59, 0xde, 0x6b,
+	0x6d, 0xd5, 0x9e, 0xc3, 0x39, 0xd1, 0x6f, 0x72, 0x9f, 0xbe, 0x91, 0xfe, 0x89, 0xb4, 0x69, 0xcf,
+	0x4b, 0x02, 0x4d, 0x46, 0x60, 0xa0, 0xaa, 0x1f, 0x68, 0x46, 0x10, 0xdf, 0x12, 0x01, 0xb9, 0x03,
+	0xfd, 0x21, 0x3b, 0x6c, 0x83, 0x9e, 0x2f, 0x5e, 0x49, 0x2a, 0x0f, 0xdf, 0x14, 0x36, 0x5d, 0x6a,
+	0x94, 0x98, 0x10, 0x59, 0x83, 0x4b, 0x62, 0x74, 0xd3, 0x70, 0xb0, 0xea, 0x67, 0xb8, 0xa9, 0x24,
+	0x4e, 0x08, 0x15, 0xf6, 0xe7, 0x71, 0xb8, 0x2a, 0x5d, 0x14, 0xcf, 0xf8, 0x5a, 0xf9, 0x16, 0x5c,
+	0x4f, 0xcc, 0x42, 0xdf, 0x75, 0x6c, 0x7f, 0xd7, 0x3a, 0xdc, 0xd0, 0x0f, 0x9d, 0xc0, 0x8f, 0x25,
+	0xd9, 0x8d, 0xb7, 0x60, 0xb1, 0xc6, 0x57, 0xd9, 0x03, 0xb2, 0x19, 0x1b, 0x4c, 0x39, 0x90, 0x28,
+	0x70, 0x21, 0x3e, 0xae, 0x22, 0x2a, 0xf1, 0x8c, 0x8c, 0xc3, 0x20, 0xdb, 0xd3, 0x61, 0x63, 0x9a,
+	0xf8, 0x5e, 0xcb, 0xe1, 0xce, 0xd1, 0xab, 0x4e, 0x60, 0xfb, 0xf8, 0xc1, 0xe2, 0x4a, 0xf9, 0x09,
+	0x28, 0x59, 0xde, 0x36, 0xdb, 0x6a, 0xdf, 0xf1, 0x75, 0x8b, 0x59, 0xed, 0x2f, 0xf1, 0x05, 0x59,
+	0x86, 0x73, 0x65, 0xea, 0xeb, 0xa6, 0xe5, 0x8d, 0xe5, 0xd8, 0x17, 0x31, 0x93, 0x9e, 0xc1, 0xa3,
+	0xd1, 0x94, 0x04, 0x50, 0x59, 0x85, 0x8b, 0xb1, 0x0a, 0xe7, 0x04, 0x99, 0xd4, 0xc4, 0xa2, 0xc8,
+	0x25, 0xa2, 0x78, 0x05, 0x43, 0x2b, 0xfc, 0x63, 0x46, 0x25, 0x71, 0x26, 0xa4, 0x24, 0x13, 0x4f,
+	0xc2, 0x7d, 0x17, 0x0a, 0x09, 0xaf, 0x6f, 0x74, 0x2c, 0xbc, 0xcc, 0x63, 0x04, 0x29, 0x2b, 0xd8,
+	0x63, 0xc4, 0xa3, 0x6a, 0x97, 0xe3, 0x76, 0x1f, 0xb2, 0xd2, 0x80, 0x5b, 0x9d, 0x94, 0x64, 0x52,
+	0xff, 0xb8, 0x95, 0xfa, 0x36, 0xf5, 0x25, 0xc1, 0x4a, 0x93, 0xf5, 0x1f, 0x89, 0xac, 0xf3, 0xaf,
+	0x75, 0x15, 0x2f, 0x24, 0x56, 0xd8, 0x7d, 0x44, 0x54, 0x34, 0x27, 0x20, 0x8f, 0x57, 0x15, 0x8e,
+	0x88, 0xa0, 0xf9, 0x20, 0x91, 0xa7, 0xdc, 0x91, 0x2d, 0x3c, 0x9d, 0xa9, 0x1f, 0x63, 0x5b, 0x65,
+	0x47, 0x72, 0xf8, 0x68, 0x4c, 0xca, 0x4a, 0x45, 0x12, 0x8f, 0x27, 0xaa, 0x80, 0x16, 0xbf, 0x1a,
+	0x87, 0xb3, 0xcc, 0x1a, 0xf9, 0x85, 0x04, 0x03, 0xfc, 0x2b, 0x24, 0x33, 0x19, 0x87, 0x49, 0xe2,
+	0x86, 0x45, 0x9e, 0xed, 0x42, 0x92, 0xfb, 0xab, 0xdc, 0xf8, 0xf9, 0x97, 0x5f, 0xfd, 0x3a, 0x37,
+	0x49, 0x26, 0xd4, 0x8c, 0x5b, 0x38, 0xf2, 0x5b, 0x09, 0xf2, 0xcd, 0x81, 0xf2, 0x4e, 0x96, 0xfa,
+	0x96, 0x1b, 0x18, 0x79, 0xae, 0x3b, 0x61, 0x74, 0x67, 0x9e, 0xb9, 0x73, 0x87, 0xcc, 0xaa, 0x99,
+	0xf7, 0x70, 0x9e, 0x5a, 0xc7, 0x4a, 0xd7, 0x20, 0xbf, 0x93, 0x00, 0x9a, 0x67, 0x29, 0x99, 0xeb,
+	0xf2, 0xc8, 0xe5, 0xde, 0xf5, 0x76, 0x40, 0x2b, 0x8b, 0xcc, 0xbd, 0x07, 0xe4, 0x5e, 0xba, 0x7b,
+	0x15, 0x1a, 0x5d, 0x38, 0x34, 0x1d, 0x54, 0xeb, 0xfc, 0x66, 0xa0, 0x41, 0xfe, 0x2a, 0xc1, 0x50,
+	0x62, 0xc6, 0x27, 0x6a, 0x86, 0xf9, 0xb4, 0xfb, 0x08, 0xf9, 0xfd, 0xee, 0x01, 0xe8, 0x72, 0x89,
+	0xb9, 0xbc, 0x4e, 0x5e, 0xa4, 0xbb, 0xbc, 0xcf, 0x40, 0x19, 0x5e, 0xab, 0x75, 0x41, 0x7a, 0x43,
+	0xad, 0xb3, 0x96, 0xa8, 0x41, 0x7e, 0x99, 0x03, 0x65, 0xab, 0x8b, 0xc9, 0x2e, 0x9b, 0xdc, 0xae,
+	0x47, 0x66, 0xf9, 0xc3, 0x93, 0x2b, 0x42, 0x36, 0xd6, 0x19, 0x1b, 0xcf, 0xc8, 0xaa, 0x7a, 0x82,
+	0x2b, 0x5b, 0xb5, 0xce, 0x66, 0x82, 0x06, 0xf9, 0x59, 0x0e, 0x6e, 0x76, 0x36, 0xbe, 0x64, 0x59,
+	0x99, 0x54, 0xf4, 0x72, 0x7b, 0x20, 0x7f, 0x78, 0x72, 0x45, 0x48, 0xc5, 0x2a, 0xa3, 0xe2, 0x09,
+	0x59, 0x3c, 0x09, 0x15, 0xe4, 0x4b, 0x09, 0x46, 0xd3, 0xe7, 0x39, 0xf2, 0xa8, 0xc3, 0xb7, 0x95,
+	0x35, 0xcd, 0xca, 0x8b, 0xc7, 0x03, 0x63, 0x6c, 0x4f, 0x58, 0x6c, 0x0b, 0xe4, 0x81, 0xda, 0xd3,
+	0x75, 0x7e, 0x94, 0xd8, 0xbf, 0x4b, 0x30, 0x9e, 0x6e, 0x22, 0x4c, 0xe6, 0xa3, 0xec, 0x1c, 0x1c,
+	0x3f, 0xb0, 0x8e, 0x13, 0xb7, 0xf2, 0x80, 0x05, 0xf6, 0x3e, 0x29, 0xf4, 0x16, 0x18, 0xf9, 0xa3,
+	0x04, 0x43, 0x89, 0xc1, 0x8c, 0x14, 0xb3, 0x09, 0x4e, 0x1b, 0x39, 0xe5, 0xbb, 0x3d, 0x61, 0xd0,
+	0xe5, 0x7b, 0xcc, 0xe5, 0x02, 0x99, 0x53, 0xbb, 0xf8, 0x11, 0x27, 0xca, 0xc0, 0xef, 0x25, 0xb8,
+	0x9c, 0xd0, 0x17, 0x12, 0x5f, 0xcc, 0xe6, 0xae, 0x67, 0x9f, 0xdb, 0x4d, 0xbc, 0xca, 0x1c, 0xf3,
+	0xf9, 0x16, 0xb9, 0xd1, 0x8d, 0xcf, 0xe4, 0x53, 0x09, 0xf2, 0xd1, 0x78, 0x98, 0x59, 0x1d, 0x5b,
+	0xe7, 0x54, 0x79, 0xae, 0x3b, 0xe1, 0xee, 0xca, 0x4f, 0xe0, 0x85, 0x77, 0xbc, 0x21, 0x42, 0xad,
+	0xe3, 0xb8, 0xdb, 0x88, 0x15, 0xca, 0xbf, 0x48, 0xf0, 0x5e, 0xca, 0x3c, 0x48, 0xee, 0x67, 0xf8,
+	0xd0, 0x7e, 0xf8, 0x94, 0x1f, 0xf4, 0x0a, 0xc3, 0x20, 0x1e, 0xb3, 0x20, 0x3e, 0x20, 0xf7, 0xd3,
+	0x83, 0xf0, 0x18, 0xb4, 0x79, 0xab, 0xad, 0x59, 0xa6, 0xe7, 0xc7, 0xa2, 0xf8, 0xb3, 0x04, 0x97,
+	0x5a, 0x46, 0x42, 0x32, 0x9f, 0xe1, 0x4a, 0xfa, 0x48, 0x2a, 0x17, 0x7b, 0x81, 0xa0, 0xe7, 0xcb,
+	0xcc, 0xf3, 0x45, 0xf2, 0xb0, 0xcd, 0xae, 0x10, 0x30, 0x9c, 0x2d, 0xd5, 0xba, 0xe8, 0x8d, 0x1b,
+	0x6a, 0x9d, 0x4f, 0xb5, 0x0d, 0xf2, 0x37, 0x09, 0x46, 0x52, 0x07, 0x13, 0xf2, 0x41, 0x17, 0x8d,
+	0x52, 0x5a, 0x53, 0x2e, 0x2f, 0xf4, 0x0e, 0xc4, 0x80, 0xbe, 0xcd, 0x02, 0x7a, 0x48, 0x16, 0x3a,
+	0x9c, 0x26, 0x55, 0x8e, 0xd6, 0xf8, 0xbc, 0x10, 0xeb, 0x08, 0xc8, 0x3f, 0x25, 0x18, 0x6f, 0xdb,
+	0xf0, 0x67, 0x1e, 0x94, 0x9d, 0x66, 0x0d, 0x79, 0xf1, 0x78, 0xe0, 0xee, 0xaa, 0x5b, 0x7c, 0xc6,
+	0x3c, 0x12, 0x5e, 0x94, 0x36, 0xf2, 0x59, 0x58, 0xdd, 0x52, 0x1b, 0x7e, 0x92, 0xc9, 0x7a, 0xd6,
+	0x0c, 0x22, 0x7f, 0xf3, 0x18, 0xc8, 0x2e, 0x8f, 0x7f, 0x8e, 0xd6, 0x5a, 0x7e, 0x8d, 0xf5, 0xc8,
+	0x27, 0x12, 0x40, 0xf3, 0xbe, 0xe2, 0x14, 0x7b, 0xe4, 0xa3, 0x97, 0x20, 0xca, 0x2c, 0xf3, 0x71,
+	0x9a, 0x5c, 0x6f, 0xc3, 0x7c, 0x79, 0x4f, 0x74, 0x9b, 0xcb, 0x4b, 0x9f, 0xbf, 0x9d, 0x94, 0xbe,
+	0x78, 0x3b, 0x29, 0xfd, 0xe7, 0xed, 0xa4, 0xf4, 0xab, 0x77, 0x93, 0x67, 0xbe, 0x78, 0x37, 0x79,
+	0xe6, 0x1f, 0xef, 0x26, 0xcf, 0xfc, 0xf0, 0x76, 0xc5, 0xf4, 0x77, 0x83, 0xed, 0x82, 0xe1, 0x54,
+	0x93, 0x6a, 0x0e, 0x22, 0x45, 0xfe, 0xa1, 0x4b, 0xbd, 0xed, 0x01, 0xf6, 0x4b, 0xf3, 0xdd, 0xff,
+	0x0d, 0x00, 0x12, 0x74, 0xc6, 0x15, 0xdd, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1992,6 +2095,8 @@ type QueryClient interface {
 	ProviderMonthlyPayout(ctx context.Context, in *QueryProviderMonthlyPayoutRequest, opts ...grpc.CallOption) (*QueryProviderMonthlyPayoutResponse, error)
 	// Queries the expected monthly payout of a specific subscription
 	SubscriptionMonthlyPayout(ctx context.Context, in *QuerySubscriptionMonthlyPayoutRequest, opts ...grpc.CallOption) (*QuerySubscriptionMonthlyPayoutResponse, error)
+	// Queries a list of pending changes of providers' delegation terms (commission/limit).
+	PendingDelegateChanges(ctx context.Context, in *QueryPendingDelegateChangesRequest, opts ...grpc.CallOption) (*QueryPendingDelegateChangesResponse, error)
 	// this line is used by starport scaffolding # 2
 	// Queries a list of SdkPairing items.
 	SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error)
@@ -2140,6 +2245,15 @@ func (c *queryClient) SubscriptionMonthlyPayout(ctx context.Context, in *QuerySu
 	return out, nil
 }
 
+func (c *queryClient) PendingDelegateChanges(ctx context.Context, in *QueryPendingDelegateChangesRequest, opts ...grpc.CallOption) (*QueryPendingDelegateChangesResponse, error) {
+	out := new(QueryPendingDelegateChangesResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/PendingDelegateChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error) {
 	out := new(QuerySdkPairingResponse)
 	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/SdkPairing", in, out, opts...)
@@ -2181,6 +2295,8 @@ type QueryServer interface {
 	ProviderMonthlyPayout(context.Context, *QueryProviderMonthlyPayoutRequest) (*QueryProviderMonthlyPayoutResponse, error)
 	// Queries the expected monthly payout of a specific subscription
 	SubscriptionMonthlyPayout(context.Context, *QuerySubscriptionMonthlyPayoutRequest) (*QuerySubscriptionMonthlyPayoutResponse, error)
+	// Queries a list of pending changes of providers' delegation terms (commission/limit).
+	PendingDelegateChanges(context.Context, *QueryPendingDelegateChangesRequest) (*QueryPendingDelegateChangesResponse, error)
 	// this line is used by starport scaffolding # 2
 	// Queries a list of SdkPairing items.
 	SdkPairing(context.Context, *QueryGetPairingRequest) (*QuerySdkPairingResponse, error)
@@ -2235,6 +2351,9 @@ func (*UnimplementedQueryServer) ProviderMonthlyPayout(ctx context.Context, req
 func (*UnimplementedQueryServer) SubscriptionMonthlyPayout(ctx context.Context, req *QuerySubscriptionMonthlyPayoutRequest) (*QuerySubscriptionMonthlyPayoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscriptionMonthlyPayout not implemented")
 }
+func (*UnimplementedQueryServer) PendingDelegateChanges(ctx context.Context, req *QueryPendingDelegateChangesRequest) (*QueryPendingDelegateChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingDelegateChanges not implemented")
+}
 func (*UnimplementedQueryServer) SdkPairing(ctx context.Context, req *QueryGetPairingRequest) (*QuerySdkPairingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SdkPairing not implemented")
 }
@@ -2513,6 +2632,24 @@ func _Query_SubscriptionMonthlyPayout_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingDelegateChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDelegateChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingDelegateChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/PendingDelegateChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingDelegateChanges(ctx, req.(*QueryPendingDelegateChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SdkPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetPairingRequest)
 	if err := dec(in); err != nil {
@@ -2595,6 +2732,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubscriptionMonthlyPayout",
 			Handler:    _Query_SubscriptionMonthlyPayout_Handler,
 		},
+		{
+			MethodName: "PendingDelegateChanges",
+			Handler:    _Query_PendingDelegateChanges_Handler,
+		},
 		{
 			MethodName: "SdkPairing",
 			Handler:    _Query_SdkPairing_Handler,
@@ -3931,6 +4072,80 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingDelegateChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDelegateChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDelegateChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDelegateChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDelegateChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDelegateChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4488,6 +4703,38 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingDelegateChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDelegateChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8039,6 +8286,204 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingDelegateChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDelegateChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDelegateChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDelegateChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDelegateChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDelegateChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, DelegateChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32,9 +32,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ProbeRequest struct {
-	Guid         uint64 `protobuf:"varint,1,opt,name=guid,proto3" json:"guid,omitempty"`
-	SpecId       string `protobuf:"bytes,2,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
-	ApiInterface string `protobuf:"bytes,3,opt,name=api_interface,json=apiInterface,proto3" json:"api_interface,omitempty"`
+	Guid             uint64 `protobuf:"varint,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	SpecId           string `protobuf:"bytes,2,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
+	ApiInterface     string `protobuf:"bytes,3,opt,name=api_interface,json=apiInterface,proto3" json:"api_interface,omitempty"`
+	WithVerification bool   `protobuf:"varint,4,opt,name=with_verification,json=withVerification,proto3" json:"with_verification,omitempty"`
 }
 
 func (m *ProbeRequest) Reset()         { *m = ProbeRequest{} }
@@ -91,12 +92,20 @@ func (m *ProbeRequest) GetApiInterface() string {
 	return ""
 }
 
+func (m *ProbeRequest) GetWithVerification() bool {
+	if m != nil {
+		return m.WithVerification
+	}
+	return false
+}
+
 type ProbeReply struct {
-	Guid                  uint64 `protobuf:"varint,1,opt,name=guid,proto3" json:"guid,omitempty"`
-	LatestBlock           int64  `protobuf:"varint,2,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
-	FinalizedBlocksHashes []byte `protobuf:"bytes,3,opt,name=finalized_blocks_hashes,json=finalizedBlocksHashes,proto3" json:"finalized_blocks_hashes,omitempty"`
-	LavaEpoch             uint64 `protobuf:"varint,4,opt,name=lava_epoch,json=lavaEpoch,proto3" json:"lava_epoch,omitempty"`
-	LavaLatestBlock       uint64 `protobuf:"varint,5,opt,name=lava_latest_block,json=lavaLatestBlock,proto3" json:"lava_latest_block,omitempty"`
+	Guid                  uint64          `protobuf:"varint,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	LatestBlock           int64           `protobuf:"varint,2,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
+	FinalizedBlocksHashes []byte          `protobuf:"bytes,3,opt,name=finalized_blocks_hashes,json=finalizedBlocksHashes,proto3" json:"finalized_blocks_hashes,omitempty"`
+	LavaEpoch             uint64          `protobuf:"varint,4,opt,name=lava_epoch,json=lavaEpoch,proto3" json:"lava_epoch,omitempty"`
+	LavaLatestBlock       uint64          `protobuf:"varint,5,opt,name=lava_latest_block,json=lavaLatestBlock,proto3" json:"lava_latest_block,omitempty"`
+	Verifications         []*Verification `protobuf:"bytes,6,rep,name=verifications,proto3" json:"verifications,omitempty"`
 }
 
 func (m *ProbeReply) Reset()         { *m = ProbeReply{} }
@@ -167,6 +176,98 @@ func (m *ProbeReply) GetLavaLatestBlock() uint64 {
 	return 0
 }
 
+func (m *ProbeReply) GetVerifications() []*Verification {
+	if m != nil {
+		return m.Verifications
+	}
+	return nil
+}
+
+// Verification is the result of a spec verification run by the provider against its node
+type Verification struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Addon     string `protobuf:"bytes,2,opt,name=addon,proto3" json:"addon,omitempty"`
+	Extension string `protobuf:"bytes,3,opt,name=extension,proto3" json:"extension,omitempty"`
+	Passed    bool   `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
+	Critical  bool   `protobuf:"varint,5,opt,name=critical,proto3" json:"critical,omitempty"`
+	Error     string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *Verification) Reset()         { *m = Verification{} }
+func (m *Verification) String() string { return proto.CompactTextString(m) }
+func (*Verification) ProtoMessage()    {}
+func (*Verification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a61d253b10eeeb9e, []int{2}
+}
+func (m *Verification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Verification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Verification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Verification.Merge(m, src)
+}
+func (m *Verification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Verification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Verification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Verification proto.InternalMessageInfo
+
+func (m *Verification) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Verification) GetAddon() string {
+	if m != nil {
+		return m.Addon
+	}
+	return ""
+}
+
+func (m *Verification) GetExtension() string {
+	if m != nil {
+		return m.Extension
+	}
+	return ""
+}
+
+func (m *Verification) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *Verification) GetCritical() bool {
+	if m != nil {
+		return m.Critical
+	}
+	return false
+}
+
+func (m *Verification) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type RelaySession struct {
 	SpecId                string                  `protobuf:"bytes,1,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
 	ContentHash           []byte                  `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
@@ -187,7 +288,7 @@ func (m *RelaySession) Reset()         { *m = RelaySession{} }
 func (m *RelaySession) String() string { return proto.CompactTextString(m) }
 func (*RelaySession) ProtoMessage()    {}
 func (*RelaySession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{2}
+	return fileDescriptor_a61d253b10eeeb9e, []int{3}
 }
 func (m *RelaySession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +421,7 @@ func (m *Badge) Reset()         { *m = Badge{} }
 func (m *Badge) String() string { return proto.CompactTextString(m) }
 func (*Badge) ProtoMessage()    {}
 func (*Badge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{3}
+	return fileDescriptor_a61d253b10eeeb9e, []int{4}
 }
 func (m *Badge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +509,7 @@ func (m *RelayPrivateData) Reset()         { *m = RelayPrivateData{} }
 func (m *RelayPrivateData) String() string { return proto.CompactTextString(m) }
 func (*RelayPrivateData) ProtoMessage()    {}
 func (*RelayPrivateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{4}
+	return fileDescriptor_a61d253b10eeeb9e, []int{5}
 }
 func (m *RelayPrivateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +619,7 @@ func (m *ReportedProvider) Reset()         { *m = ReportedProvider{} }
 func (m *ReportedProvider) String() string { return proto.CompactTextString(m) }
 func (*ReportedProvider) ProtoMessage()    {}
 func (*ReportedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{5}
+	return fileDescriptor_a61d253b10eeeb9e, []int{6}
 }
 func (m *ReportedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +685,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{6}
+	return fileDescriptor_a61d253b10eeeb9e, []int{7}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +737,7 @@ func (m *RelayRequest) Reset()         { *m = RelayRequest{} }
 func (m *RelayRequest) String() string { return proto.CompactTextString(m) }
 func (*RelayRequest) ProtoMessage()    {}
 func (*RelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{7}
+	return fileDescriptor_a61d253b10eeeb9e, []int{8}
 }
 func (m *RelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,7 +793,7 @@ func (m *RelayReply) Reset()         { *m = RelayReply{} }
 func (m *RelayReply) String() string { return proto.CompactTextString(m) }
 func (*RelayReply) ProtoMessage()    {}
 func (*RelayReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{8}
+	return fileDescriptor_a61d253b10eeeb9e, []int{9}
 }
 func (m *RelayReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,7 +874,7 @@ func (m *QualityOfServiceReport) Reset()         { *m = QualityOfServiceReport{}
 func (m *QualityOfServiceReport) String() string { return proto.CompactTextString(m) }
 func (*QualityOfServiceReport) ProtoMessage()    {}
 func (*QualityOfServiceReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a61d253b10eeeb9e, []int{9}
+	return fileDescriptor_a61d253b10eeeb9e, []int{10}
 }
 func (m *QualityOfServiceReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,6 +906,7 @@ var xxx_messageInfo_QualityOfServiceReport proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*ProbeRequest)(nil), "lavanet.lava.pairing.ProbeRequest")
 	proto.RegisterType((*ProbeReply)(nil), "lavanet.lava.pairing.ProbeReply")
+	proto.RegisterType((*Verification)(nil), "lavanet.lava.pairing.Verification")
 	proto.RegisterType((*RelaySession)(nil), "lavanet.lava.pairing.RelaySession")
 	proto.RegisterType((*Badge)(nil), "lavanet.lava.pairing.Badge")
 	proto.RegisterType((*RelayPrivateData)(nil), "lavanet.lava.pairing.RelayPrivateData")
@@ -818,83 +920,89 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/pairing/relay.proto", fileDescriptor_a61d253b10eeeb9e) }
 
 var fileDescriptor_a61d253b10eeeb9e = []byte{
-	// 1206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xf6, 0xfa, 0x27, 0xb6, 0x8f, 0xb7, 0x69, 0x99, 0x36, 0xad, 0x95, 0x0a, 0xc7, 0x5d, 0xa4,
-	0x34, 0x42, 0x60, 0x43, 0x40, 0x5c, 0x20, 0x21, 0xb5, 0xa6, 0x11, 0x04, 0x0a, 0x4d, 0x37, 0x70,
-	0x13, 0x09, 0x6d, 0xc7, 0xbb, 0x93, 0xcd, 0xd0, 0xf5, 0xce, 0x66, 0x66, 0xd6, 0xc4, 0xbc, 0x00,
-	0x57, 0x48, 0x3c, 0x04, 0x4f, 0xc0, 0x43, 0x54, 0xbd, 0xec, 0x25, 0x42, 0x22, 0x42, 0xc9, 0x1b,
-	0x20, 0x1e, 0x00, 0xcd, 0x8f, 0xff, 0x12, 0x27, 0x28, 0xc0, 0xd5, 0xce, 0x7c, 0x73, 0xf6, 0x9c,
-	0x33, 0xdf, 0x39, 0xe7, 0xdb, 0x85, 0x76, 0x82, 0x87, 0x38, 0x25, 0xb2, 0xab, 0x9e, 0xdd, 0x0c,
-	0x53, 0x4e, 0xd3, 0xb8, 0xcb, 0x49, 0x82, 0x47, 0x9d, 0x8c, 0x33, 0xc9, 0xd0, 0x2d, 0x6b, 0xd1,
-	0x51, 0xcf, 0x8e, 0xb5, 0x58, 0xbd, 0x15, 0xb3, 0x98, 0x69, 0x83, 0xae, 0x5a, 0x19, 0xdb, 0xd5,
-	0x56, 0xcc, 0x58, 0x9c, 0x90, 0xae, 0xde, 0xf5, 0xf3, 0xfd, 0xee, 0x77, 0x1c, 0x67, 0x19, 0xe1,
-	0xc2, 0x9e, 0xaf, 0x9d, 0x3d, 0x97, 0x74, 0x40, 0x84, 0xc4, 0x83, 0xcc, 0x18, 0x78, 0xcf, 0xc0,
-	0xdd, 0xe1, 0xac, 0x4f, 0x7c, 0x72, 0x98, 0x13, 0x21, 0x11, 0x82, 0x72, 0x9c, 0xd3, 0xa8, 0xe9,
-	0xb4, 0x9d, 0x8d, 0xb2, 0xaf, 0xd7, 0xe8, 0x0e, 0x54, 0x45, 0x46, 0xc2, 0x80, 0x46, 0xcd, 0x62,
-	0xdb, 0xd9, 0xa8, 0xfb, 0x4b, 0x6a, 0xbb, 0x1d, 0xa1, 0x37, 0xe0, 0x1a, 0xce, 0x68, 0x40, 0x53,
-	0x49, 0xf8, 0x3e, 0x0e, 0x49, 0xb3, 0xa4, 0x8f, 0x5d, 0x9c, 0xd1, 0xed, 0x31, 0xe6, 0xbd, 0x70,
-	0x00, 0x6c, 0x88, 0x2c, 0x19, 0x2d, 0x0c, 0x70, 0x0f, 0xdc, 0x04, 0x4b, 0x22, 0x64, 0xd0, 0x4f,
-	0x58, 0xf8, 0x5c, 0x47, 0x29, 0xf9, 0x0d, 0x83, 0xf5, 0x14, 0x84, 0x3e, 0x80, 0x3b, 0xfb, 0x34,
-	0xc5, 0x09, 0xfd, 0x9e, 0x44, 0xc6, 0x4a, 0x04, 0x07, 0x58, 0x1c, 0x10, 0xa1, 0x83, 0xba, 0xfe,
-	0xca, 0xe4, 0x58, 0xbf, 0x20, 0x3e, 0xd5, 0x87, 0xe8, 0x75, 0x00, 0x45, 0x63, 0x40, 0x32, 0x16,
-	0x1e, 0x34, 0xcb, 0x3a, 0x68, 0x5d, 0x21, 0x5b, 0x0a, 0x40, 0x6f, 0xc2, 0x6b, 0xfa, 0x78, 0x2e,
-	0x7c, 0x45, 0x5b, 0x5d, 0x57, 0x07, 0x8f, 0xa7, 0x29, 0x78, 0x2f, 0xca, 0xe0, 0xfa, 0xaa, 0x4e,
-	0xbb, 0x44, 0x08, 0xca, 0xd2, 0x59, 0x5e, 0x9c, 0x39, 0x5e, 0xee, 0x81, 0x1b, 0xb2, 0x54, 0x92,
-	0x54, 0xea, 0x1c, 0xf5, 0x7d, 0x5c, 0xbf, 0x61, 0x31, 0x95, 0x99, 0xca, 0x4b, 0x18, 0x37, 0xea,
-	0xf5, 0x92, 0xc9, 0xcb, 0x22, 0xdb, 0x11, 0x5a, 0x81, 0xa5, 0x30, 0x0f, 0x44, 0x3e, 0xb0, 0x29,
-	0x57, 0xc2, 0x7c, 0x37, 0x1f, 0xa0, 0x55, 0xa8, 0x65, 0x9c, 0x0d, 0x69, 0x44, 0xb8, 0xce, 0xb2,
-	0xee, 0x4f, 0xf6, 0xe8, 0x2e, 0xd4, 0x75, 0x17, 0x05, 0x69, 0x3e, 0x68, 0x2e, 0xe9, 0xb7, 0x6a,
-	0x1a, 0xf8, 0x32, 0x1f, 0xa0, 0xcf, 0x01, 0x0e, 0x99, 0x08, 0x38, 0xc9, 0x18, 0x97, 0xcd, 0x6a,
-	0xdb, 0xd9, 0x68, 0x6c, 0xbe, 0xd5, 0x59, 0xd4, 0x68, 0x9d, 0xa7, 0x39, 0x4e, 0xa8, 0x1c, 0x3d,
-	0xd9, 0xdf, 0x25, 0x7c, 0x48, 0x43, 0x55, 0x36, 0xc6, 0xa5, 0x5f, 0x3f, 0x64, 0xc2, 0x2c, 0xd1,
-	0x2d, 0xa8, 0x18, 0x3a, 0x6b, 0xba, 0x4e, 0x66, 0x83, 0xbe, 0x81, 0xdb, 0x79, 0xca, 0x89, 0xc8,
-	0x58, 0x2a, 0xe8, 0x90, 0x04, 0xe3, 0xc4, 0x44, 0xb3, 0xde, 0x2e, 0x6d, 0x34, 0x36, 0xd7, 0x17,
-	0x87, 0x33, 0x3e, 0x49, 0xb4, 0x63, 0xcd, 0xfd, 0x95, 0x59, 0x2f, 0x63, 0x54, 0x20, 0x0f, 0xae,
-	0xe9, 0x4a, 0x85, 0x07, 0x98, 0x6a, 0xce, 0x40, 0xdf, 0xbf, 0xa1, 0xc0, 0x8f, 0x15, 0xb6, 0x1d,
-	0xa1, 0x1b, 0x50, 0x12, 0x34, 0x6e, 0x36, 0x34, 0xdd, 0x6a, 0x89, 0xde, 0x85, 0x4a, 0x1f, 0x47,
-	0x31, 0x69, 0xba, 0xfa, 0xca, 0x77, 0x17, 0xe7, 0xd0, 0x53, 0x26, 0xbe, 0xb1, 0x44, 0xcf, 0x60,
-	0x45, 0x51, 0x45, 0x8e, 0x42, 0x92, 0x24, 0x24, 0x0d, 0xc9, 0x98, 0xb5, 0x6b, 0xff, 0x82, 0xb5,
-	0x9b, 0x87, 0x4c, 0x6c, 0x4d, 0x3c, 0x19, 0x50, 0x4d, 0x44, 0x45, 0x87, 0x54, 0x03, 0x14, 0xe6,
-	0x01, 0x4e, 0x12, 0x16, 0x62, 0x49, 0x59, 0x6a, 0xa7, 0xc2, 0x0d, 0xf3, 0x87, 0x13, 0x6c, 0x4a,
-	0x77, 0xd1, 0xb4, 0x82, 0xa1, 0xbb, 0x09, 0x55, 0x1c, 0x45, 0x9c, 0x08, 0x61, 0xa7, 0x6e, 0xbc,
-	0x3d, 0xcf, 0x54, 0xf9, 0x3c, 0x53, 0x6b, 0xd0, 0xc8, 0x38, 0xfb, 0x96, 0x84, 0x32, 0x50, 0x8c,
-	0x55, 0x34, 0x63, 0x60, 0xa1, 0x5d, 0x1a, 0xab, 0xcc, 0x86, 0x94, 0xcb, 0x1c, 0x27, 0x76, 0x74,
-	0x4c, 0x47, 0xb9, 0x16, 0xd4, 0xd3, 0xe3, 0xfd, 0x5e, 0x84, 0x1b, 0x7a, 0x22, 0x76, 0x38, 0x1d,
-	0x62, 0x49, 0x1e, 0x61, 0x89, 0xd1, 0x7d, 0xb8, 0x1e, 0xb2, 0x34, 0x25, 0xa1, 0x4a, 0x3e, 0x90,
-	0xa3, 0x8c, 0xd8, 0xe9, 0x58, 0x9e, 0xc2, 0x5f, 0x8d, 0x32, 0xa2, 0xc6, 0x47, 0xa9, 0x47, 0xce,
-	0x93, 0xb1, 0xac, 0xe0, 0x8c, 0x7e, 0xcd, 0x13, 0x25, 0x11, 0x11, 0x96, 0xd8, 0x0e, 0xb6, 0x5e,
-	0xab, 0x7c, 0xb8, 0x91, 0x28, 0x3b, 0xa4, 0x65, 0xdd, 0x7b, 0xae, 0x05, 0x8d, 0x48, 0x9c, 0xd3,
-	0xa3, 0xca, 0x79, 0x3d, 0x52, 0xde, 0x05, 0x4e, 0xa4, 0xbe, 0x90, 0xeb, 0xeb, 0x35, 0x7a, 0x00,
-	0xb5, 0x01, 0x91, 0x58, 0x47, 0xad, 0xea, 0x6e, 0x6d, 0x2d, 0x2e, 0xf3, 0x17, 0xd6, 0xaa, 0x57,
-	0x7e, 0x79, 0xbc, 0x56, 0xf0, 0x27, 0x6f, 0xa9, 0x22, 0xe1, 0x28, 0x62, 0xa9, 0x9e, 0x89, 0xba,
-	0x6f, 0x36, 0xa8, 0x05, 0x40, 0x8e, 0x24, 0x49, 0xd5, 0x54, 0x9b, 0x39, 0xa8, 0xfb, 0x33, 0x88,
-	0x51, 0x01, 0x92, 0xda, 0x2b, 0x81, 0xbe, 0x52, 0x5d, 0x21, 0x46, 0x71, 0x7e, 0x74, 0x14, 0xbf,
-	0xf3, 0xf3, 0x31, 0x5b, 0x78, 0x67, 0xbe, 0xf0, 0xeb, 0xb0, 0x1c, 0x51, 0x31, 0x65, 0x59, 0xd8,
-	0x8e, 0x39, 0x83, 0xa2, 0xdb, 0xb0, 0x44, 0x38, 0x67, 0x5c, 0x58, 0xdd, 0xb1, 0x3b, 0xd5, 0x14,
-	0x93, 0xcf, 0x43, 0x20, 0x2c, 0xc3, 0x30, 0x81, 0x76, 0xbd, 0xf7, 0xa1, 0x36, 0x26, 0x40, 0xd1,
-	0x98, 0xe2, 0xc1, 0xb8, 0xb6, 0x7a, 0xad, 0x48, 0x18, 0xe2, 0x24, 0x27, 0xb6, 0x9e, 0x66, 0xe3,
-	0xfd, 0xec, 0x58, 0xdd, 0x1c, 0x7f, 0x63, 0x3e, 0x51, 0xb5, 0x54, 0x4a, 0x65, 0xf5, 0x4e, 0xfb,
-	0x68, 0x6c, 0x7a, 0x17, 0x09, 0xc4, 0x54, 0x72, 0x55, 0xbd, 0x67, 0x04, 0x78, 0x0b, 0xc0, 0x38,
-	0xd2, 0x85, 0x2b, 0x6a, 0x2f, 0xeb, 0x97, 0x78, 0x99, 0x69, 0x53, 0xdf, 0x88, 0xa5, 0x5a, 0x7e,
-	0x56, 0xae, 0x95, 0x6e, 0x94, 0xbd, 0xbf, 0x1c, 0x00, 0x9b, 0xa6, 0xfd, 0x4e, 0x69, 0xaf, 0xce,
-	0x4c, 0x13, 0x5a, 0x7d, 0x29, 0x4e, 0xf5, 0xe5, 0xec, 0x97, 0xab, 0x7c, 0xa5, 0x2f, 0x57, 0xe5,
-	0x1f, 0xbe, 0x5c, 0x82, 0xc6, 0xf6, 0x0d, 0xdb, 0xad, 0x75, 0x41, 0x63, 0x63, 0xf4, 0xdf, 0x5b,
-	0xd6, 0x5e, 0xfb, 0x97, 0x22, 0xdc, 0x5e, 0x2c, 0x5e, 0x68, 0x0f, 0xaa, 0xea, 0x22, 0x69, 0x38,
-	0x32, 0x55, 0xee, 0x3d, 0x50, 0x1e, 0x7e, 0x3b, 0x5e, 0x5b, 0x8f, 0xa9, 0x3c, 0xc8, 0xfb, 0x9d,
-	0x90, 0x0d, 0xba, 0x21, 0x13, 0x03, 0x26, 0xec, 0xe3, 0x6d, 0x11, 0x3d, 0xef, 0xaa, 0x91, 0x17,
-	0x9d, 0x47, 0x24, 0xfc, 0xf3, 0x78, 0x6d, 0x79, 0x84, 0x07, 0xc9, 0x87, 0xde, 0x63, 0xe3, 0xc6,
-	0xf3, 0xc7, 0x0e, 0x11, 0x05, 0x17, 0x0f, 0x31, 0x4d, 0x70, 0x9f, 0xaa, 0xd0, 0xa6, 0x63, 0x7a,
-	0x5b, 0x57, 0x0e, 0x70, 0xd3, 0x04, 0x98, 0xf5, 0xe5, 0xf9, 0x73, 0xae, 0xd1, 0x53, 0x28, 0x8b,
-	0x51, 0x1a, 0x1a, 0x99, 0xec, 0x7d, 0x74, 0xe5, 0x10, 0x0d, 0x13, 0x42, 0xf9, 0xf0, 0x7c, 0xed,
-	0x6a, 0xf3, 0x87, 0x22, 0x54, 0x75, 0xaf, 0x10, 0x8e, 0x9e, 0x40, 0x45, 0x2f, 0xd1, 0x65, 0xfd,
-	0x6b, 0x5b, 0x7f, 0xb5, 0x7d, 0xa9, 0x4d, 0x96, 0x8c, 0xbc, 0x02, 0xda, 0x83, 0x65, 0xd3, 0xf3,
-	0x79, 0x5f, 0x84, 0x9c, 0xf6, 0xc9, 0xff, 0xe5, 0xf9, 0x1d, 0x47, 0x25, 0xab, 0xff, 0xc5, 0x2e,
-	0x72, 0x39, 0xfb, 0x2f, 0x78, 0x91, 0xcb, 0xe9, 0xcf, 0x9c, 0x57, 0xe8, 0x3d, 0x7c, 0x79, 0xd2,
-	0x72, 0x5e, 0x9d, 0xb4, 0x9c, 0x3f, 0x4e, 0x5a, 0xce, 0x4f, 0xa7, 0xad, 0xc2, 0xab, 0xd3, 0x56,
-	0xe1, 0xd7, 0xd3, 0x56, 0x61, 0xef, 0xfe, 0x0c, 0xc1, 0x73, 0xff, 0xbc, 0x47, 0x93, 0xbf, 0x5e,
-	0xcd, 0x72, 0x7f, 0x49, 0xff, 0x89, 0xbe, 0xf7, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0x6e,
-	0xf9, 0x4b, 0x1a, 0x0b, 0x00, 0x00,
+	// 1302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0xce, 0x3a, 0xeb, 0xc4, 0x3e, 0xde, 0xa4, 0xe9, 0xb4, 0x69, 0xad, 0xf4, 0xf7, 0x73, 0xdc,
+	0x45, 0x6a, 0x23, 0xfe, 0xd8, 0x50, 0x10, 0x17, 0x48, 0x48, 0xad, 0x69, 0x44, 0x03, 0x85, 0xb6,
+	0x1b, 0xe0, 0xa2, 0x12, 0x5a, 0xc6, 0xbb, 0x13, 0x67, 0xe8, 0x7a, 0x77, 0x33, 0x33, 0xeb, 0xd6,
+	0xbc, 0x00, 0x57, 0x48, 0x7d, 0x04, 0x2e, 0x78, 0x02, 0x1e, 0xa2, 0xea, 0x65, 0x2f, 0x11, 0x12,
+	0x15, 0x6a, 0xdf, 0x00, 0xf1, 0x00, 0x68, 0xce, 0x8c, 0xed, 0x75, 0xea, 0x06, 0x15, 0xb8, 0xf2,
+	0x9c, 0x6f, 0xce, 0x9c, 0x39, 0x73, 0xce, 0x37, 0xdf, 0xac, 0xa1, 0x9d, 0xd0, 0x11, 0x4d, 0x99,
+	0xea, 0xea, 0xdf, 0x6e, 0x4e, 0xb9, 0xe0, 0xe9, 0xa0, 0x2b, 0x58, 0x42, 0xc7, 0x9d, 0x5c, 0x64,
+	0x2a, 0x23, 0x67, 0xad, 0x47, 0x47, 0xff, 0x76, 0xac, 0xc7, 0xd6, 0xd9, 0x41, 0x36, 0xc8, 0xd0,
+	0xa1, 0xab, 0x47, 0xc6, 0x77, 0xab, 0x35, 0xc8, 0xb2, 0x41, 0xc2, 0xba, 0x68, 0xf5, 0x8b, 0x83,
+	0xee, 0x7d, 0x41, 0xf3, 0x9c, 0x09, 0x69, 0xe7, 0xb7, 0x8f, 0xcf, 0x2b, 0x3e, 0x64, 0x52, 0xd1,
+	0x61, 0x6e, 0x1c, 0xfc, 0x1f, 0x1c, 0xf0, 0x6e, 0x8b, 0xac, 0xcf, 0x02, 0x76, 0x54, 0x30, 0xa9,
+	0x08, 0x01, 0x77, 0x50, 0xf0, 0xb8, 0xe9, 0xb4, 0x9d, 0x1d, 0x37, 0xc0, 0x31, 0x39, 0x0f, 0xab,
+	0x32, 0x67, 0x51, 0xc8, 0xe3, 0x66, 0xa5, 0xed, 0xec, 0xd4, 0x83, 0x15, 0x6d, 0xee, 0xc5, 0xe4,
+	0x35, 0x58, 0xa3, 0x39, 0x0f, 0x79, 0xaa, 0x98, 0x38, 0xa0, 0x11, 0x6b, 0x2e, 0xe3, 0xb4, 0x47,
+	0x73, 0xbe, 0x37, 0xc1, 0xc8, 0x1b, 0x70, 0xfa, 0x3e, 0x57, 0x87, 0xe1, 0x88, 0x09, 0x7e, 0xc0,
+	0x23, 0xaa, 0x78, 0x96, 0x36, 0xdd, 0xb6, 0xb3, 0x53, 0x0b, 0x36, 0xf4, 0xc4, 0x57, 0x25, 0xdc,
+	0x7f, 0x58, 0x01, 0xb0, 0xf9, 0xe4, 0xc9, 0x78, 0x61, 0x36, 0x17, 0xc1, 0x4b, 0xa8, 0x62, 0x52,
+	0x85, 0xfd, 0x24, 0x8b, 0xee, 0x61, 0x4a, 0xcb, 0x41, 0xc3, 0x60, 0x3d, 0x0d, 0x91, 0xf7, 0xe1,
+	0xfc, 0x01, 0x4f, 0x69, 0xc2, 0xbf, 0x63, 0xb1, 0xf1, 0x92, 0xe1, 0x21, 0x95, 0x87, 0x4c, 0x62,
+	0x86, 0x5e, 0xb0, 0x39, 0x9d, 0xc6, 0x05, 0xf2, 0x06, 0x4e, 0x92, 0xff, 0x03, 0xe8, 0xa2, 0x87,
+	0x2c, 0xcf, 0xa2, 0x43, 0xcc, 0xd1, 0x0d, 0xea, 0x1a, 0xd9, 0xd5, 0x00, 0x79, 0x1d, 0x4e, 0xe3,
+	0xf4, 0xdc, 0xf6, 0x55, 0xf4, 0x3a, 0xa5, 0x27, 0x6e, 0x96, 0x52, 0xb8, 0x01, 0x6b, 0xe5, 0x03,
+	0xcb, 0xe6, 0x4a, 0x7b, 0x79, 0xa7, 0x71, 0xc5, 0xef, 0x2c, 0xea, 0x6e, 0xa7, 0x5c, 0x83, 0x60,
+	0x7e, 0xa1, 0xff, 0xa3, 0x03, 0x5e, 0x79, 0x5e, 0x17, 0x25, 0xa5, 0x43, 0x86, 0x45, 0xa9, 0x07,
+	0x38, 0x26, 0x67, 0xa1, 0x4a, 0xe3, 0x38, 0x4b, 0x6d, 0x83, 0x8c, 0x41, 0xfe, 0x07, 0x75, 0xf6,
+	0x40, 0xb1, 0x54, 0xea, 0x92, 0x9b, 0xde, 0xcc, 0x00, 0x72, 0x0e, 0x56, 0x72, 0x2a, 0x25, 0x8b,
+	0x6d, 0x37, 0xac, 0x45, 0xb6, 0xa0, 0x16, 0x09, 0xae, 0x78, 0x44, 0x13, 0x3c, 0x5d, 0x2d, 0x98,
+	0xda, 0x7a, 0x1f, 0x26, 0x44, 0x26, 0x9a, 0x2b, 0x66, 0x1f, 0x34, 0xfc, 0x47, 0x2e, 0x78, 0x81,
+	0xa6, 0xf0, 0x3e, 0x93, 0x18, 0xba, 0xc4, 0x18, 0x67, 0x8e, 0x31, 0x17, 0xc1, 0x8b, 0xb2, 0x54,
+	0xb1, 0x54, 0x61, 0x43, 0x30, 0x5d, 0x2f, 0x68, 0x58, 0x4c, 0xb7, 0x41, 0x37, 0x41, 0x9a, 0x30,
+	0x7a, 0xf9, 0xb2, 0x69, 0x82, 0x45, 0xf6, 0x62, 0xb2, 0x09, 0x2b, 0x51, 0x11, 0xca, 0x62, 0x68,
+	0xfb, 0x53, 0x8d, 0x8a, 0xfd, 0x62, 0xa8, 0x93, 0xce, 0x45, 0x36, 0xe2, 0x31, 0x13, 0x98, 0x74,
+	0x3d, 0x98, 0xda, 0xe4, 0x02, 0xd4, 0xf1, 0x82, 0x85, 0x69, 0x31, 0xc4, 0xc4, 0xdd, 0xa0, 0x86,
+	0xc0, 0xe7, 0xc5, 0x90, 0x7c, 0x0a, 0x70, 0x94, 0xc9, 0x50, 0xb0, 0x3c, 0x13, 0xaa, 0xb9, 0xda,
+	0x76, 0x76, 0x1a, 0x57, 0xde, 0x5c, 0xdc, 0xa5, 0x3b, 0x05, 0x4d, 0xb8, 0x1a, 0xdf, 0x3a, 0xd8,
+	0x67, 0x62, 0xc4, 0x23, 0xcd, 0xd1, 0x4c, 0xa8, 0xa0, 0x7e, 0x94, 0x49, 0x33, 0xc4, 0xf2, 0x20,
+	0x77, 0x6a, 0x48, 0x4a, 0x63, 0x90, 0xaf, 0xe1, 0x5c, 0x91, 0x0a, 0x26, 0xf3, 0x2c, 0x95, 0x7c,
+	0xc4, 0xc2, 0x49, 0x62, 0xb2, 0x59, 0x47, 0x52, 0x5c, 0x5a, 0xbc, 0x9d, 0x89, 0xc9, 0xe2, 0xdb,
+	0xd6, 0x3d, 0xd8, 0x2c, 0x47, 0x99, 0xa0, 0x92, 0xf8, 0xb0, 0x86, 0xb4, 0x8c, 0x0e, 0x29, 0xc7,
+	0x9a, 0x01, 0x9e, 0xbf, 0xa1, 0xc1, 0x8f, 0x34, 0xb6, 0x17, 0x93, 0x0d, 0x58, 0x96, 0x7c, 0xd0,
+	0x6c, 0x60, 0xb9, 0xf5, 0x90, 0xbc, 0x03, 0xd5, 0x3e, 0x8d, 0x07, 0xac, 0xe9, 0xe1, 0x91, 0x2f,
+	0x2c, 0xce, 0xa1, 0xa7, 0x5d, 0x02, 0xe3, 0x49, 0xbe, 0x81, 0x4d, 0x5d, 0x2a, 0xf6, 0x20, 0x62,
+	0x49, 0xc2, 0xd2, 0x88, 0x4d, 0xaa, 0xb6, 0xf6, 0x0f, 0xaa, 0x76, 0xe6, 0x28, 0x93, 0xbb, 0xd3,
+	0x48, 0x06, 0xf4, 0x1f, 0x39, 0x50, 0xc5, 0x2d, 0xb5, 0xb4, 0x44, 0x45, 0x48, 0x93, 0x24, 0xb3,
+	0x8a, 0x61, 0x24, 0xc0, 0x8b, 0x8a, 0x6b, 0x53, 0x6c, 0x56, 0xee, 0x8a, 0xa1, 0x02, 0x1a, 0xa4,
+	0x09, 0xab, 0x34, 0x8e, 0x05, 0x93, 0xd2, 0x72, 0x7e, 0x62, 0xbe, 0x58, 0x29, 0xf7, 0xc5, 0x4a,
+	0x6d, 0x43, 0x23, 0x17, 0xd9, 0xb7, 0x2c, 0x52, 0xa1, 0xae, 0x58, 0x15, 0x2b, 0x06, 0x16, 0xda,
+	0xe7, 0x03, 0x9d, 0xd9, 0x88, 0x0b, 0x55, 0xd0, 0xc4, 0xea, 0x84, 0x61, 0x94, 0x67, 0x41, 0x94,
+	0x0a, 0xff, 0xb7, 0x0a, 0x6c, 0xe0, 0x8d, 0xb8, 0x2d, 0xf8, 0x88, 0x2a, 0x76, 0x9d, 0x2a, 0x4a,
+	0x2e, 0xc3, 0xa9, 0x28, 0x4b, 0x53, 0x16, 0xe9, 0xe4, 0x43, 0x35, 0xce, 0x27, 0x77, 0x78, 0x7d,
+	0x06, 0x7f, 0x31, 0xce, 0x99, 0xbe, 0x3e, 0x5a, 0x57, 0x0b, 0x91, 0x4c, 0x04, 0x97, 0xe6, 0xfc,
+	0x4b, 0x91, 0xe8, 0xab, 0x1f, 0x53, 0x45, 0xad, 0x8a, 0xe1, 0x58, 0xe7, 0x23, 0x8c, 0x78, 0x5b,
+	0x45, 0x72, 0x91, 0x7b, 0x9e, 0x05, 0x8d, 0x1c, 0xbd, 0xa0, 0xd4, 0xd5, 0x05, 0x4a, 0x4d, 0xc0,
+	0x95, 0x34, 0x51, 0x78, 0x20, 0x2f, 0xc0, 0x31, 0xb9, 0x0a, 0xb5, 0x21, 0x53, 0x14, 0x77, 0x5d,
+	0x45, 0xb6, 0xb6, 0x16, 0xb7, 0xf9, 0x33, 0xeb, 0xd5, 0x73, 0x1f, 0x3f, 0xdd, 0x5e, 0x0a, 0xa6,
+	0xab, 0x66, 0xd2, 0x54, 0x2b, 0x4b, 0x53, 0x0b, 0x60, 0xaa, 0x44, 0xe6, 0x1e, 0xd4, 0x83, 0x12,
+	0x62, 0x54, 0x80, 0xa5, 0xf6, 0x48, 0x80, 0x47, 0xaa, 0x6b, 0x04, 0xcf, 0xa3, 0xdf, 0xad, 0x8d,
+	0xe3, 0xf7, 0xa3, 0xdc, 0x78, 0x67, 0xbe, 0xf1, 0x97, 0x60, 0x3d, 0xe6, 0x72, 0x56, 0x65, 0x69,
+	0x19, 0x73, 0x0c, 0xd5, 0x92, 0x88, 0x8a, 0x26, 0xad, 0xee, 0x58, 0x4b, 0x93, 0x62, 0xfa, 0x72,
+	0x86, 0xd2, 0x56, 0x18, 0xa6, 0xd0, 0xbe, 0xff, 0x1e, 0xd4, 0x26, 0x05, 0x78, 0x99, 0x3e, 0x8f,
+	0x68, 0x52, 0xb0, 0x89, 0x3e, 0xa3, 0xe1, 0xff, 0xe4, 0x58, 0xdd, 0x9c, 0xbc, 0xbe, 0x1f, 0xc3,
+	0x9a, 0x51, 0x2a, 0xab, 0x77, 0x18, 0xe3, 0xa5, 0xaf, 0x46, 0x59, 0x72, 0x75, 0xbf, 0x67, 0x16,
+	0xd9, 0x05, 0x30, 0x81, 0xb0, 0x71, 0x95, 0xb6, 0x73, 0x92, 0xcc, 0xcc, 0xd3, 0x34, 0x30, 0x62,
+	0xa9, 0x87, 0x9f, 0xb8, 0xb5, 0xe5, 0x0d, 0xd7, 0xff, 0xd3, 0x01, 0xb0, 0x69, 0xda, 0x47, 0x19,
+	0xa3, 0x3a, 0x25, 0x12, 0x5a, 0x7d, 0xa9, 0xcc, 0xf4, 0xe5, 0xf8, 0x33, 0xed, 0xbe, 0xd2, 0x33,
+	0x5d, 0xfd, 0x9b, 0x67, 0x5a, 0xf2, 0x81, 0x5d, 0x61, 0xd9, 0x5a, 0x97, 0x7c, 0x60, 0x9c, 0xfe,
+	0x3d, 0x65, 0xed, 0xb1, 0x7f, 0xae, 0xc0, 0xb9, 0xc5, 0xe2, 0x45, 0xee, 0xc2, 0xaa, 0x3e, 0x48,
+	0x1a, 0x8d, 0x4d, 0x97, 0x7b, 0x57, 0x75, 0x84, 0x5f, 0x9f, 0x6e, 0x5f, 0x1a, 0x70, 0x75, 0x58,
+	0xf4, 0x3b, 0x51, 0x36, 0xec, 0x46, 0x99, 0x1c, 0x66, 0xd2, 0xfe, 0xbc, 0x25, 0xe3, 0x7b, 0x5d,
+	0x7d, 0xe5, 0x65, 0xe7, 0x3a, 0x8b, 0xfe, 0x78, 0xba, 0xbd, 0x3e, 0xa6, 0xc3, 0xe4, 0x03, 0xff,
+	0xa6, 0x09, 0xe3, 0x07, 0x93, 0x80, 0x84, 0x83, 0x47, 0x47, 0x94, 0x27, 0xb4, 0xcf, 0xf5, 0xd6,
+	0x86, 0x31, 0xbd, 0xdd, 0x57, 0xde, 0xe0, 0x8c, 0xd9, 0xa0, 0x1c, 0xcb, 0x0f, 0xe6, 0x42, 0x93,
+	0x3b, 0xe0, 0xca, 0x71, 0x1a, 0x19, 0x99, 0xec, 0x7d, 0xf8, 0xca, 0x5b, 0x34, 0xcc, 0x16, 0x3a,
+	0x86, 0x1f, 0x60, 0xa8, 0x2b, 0xdf, 0x57, 0x60, 0x15, 0xb9, 0xc2, 0x04, 0xb9, 0x05, 0x55, 0x1c,
+	0x92, 0x93, 0xf8, 0x6b, 0xa9, 0xbf, 0xd5, 0x3e, 0xd1, 0x27, 0x4f, 0xc6, 0xfe, 0x12, 0xb9, 0x0b,
+	0xeb, 0x86, 0xf3, 0x45, 0x5f, 0x46, 0x82, 0xf7, 0xd9, 0x7f, 0x15, 0xf9, 0x6d, 0x47, 0x27, 0x8b,
+	0x1f, 0x9e, 0x2f, 0x0b, 0x59, 0xfe, 0x4a, 0xde, 0x6a, 0x9f, 0xe8, 0x83, 0x21, 0x7b, 0xd7, 0x1e,
+	0x3f, 0x6b, 0x39, 0x4f, 0x9e, 0xb5, 0x9c, 0xdf, 0x9f, 0xb5, 0x9c, 0x87, 0xcf, 0x5b, 0x4b, 0x4f,
+	0x9e, 0xb7, 0x96, 0x7e, 0x79, 0xde, 0x5a, 0xba, 0x7b, 0xb9, 0x54, 0xe0, 0xb9, 0xbf, 0x03, 0x0f,
+	0xa6, 0x7f, 0x08, 0xb0, 0xca, 0xfd, 0x15, 0xfc, 0x48, 0x7f, 0xf7, 0xaf, 0x01, 0x00, 0x61, 0x2f,
+	0x0b, 0x26, 0x35, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1097,6 +1205,16 @@ func (m *ProbeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithVerification {
+		i--
+		if m.WithVerification {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ApiInterface) > 0 {
 		i -= len(m.ApiInterface)
 		copy(dAtA[i:], m.ApiInterface)
@@ -1139,6 +1257,20 @@ func (m *ProbeReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.LavaLatestBlock != 0 {
 		i = encodeVarintRelay(dAtA, i, uint64(m.LavaLatestBlock))
 		i--
@@ -1169,6 +1301,77 @@ func (m *ProbeReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Verification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Critical {
+		i--
+		if m.Critical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addon) > 0 {
+		i -= len(m.Addon)
+		copy(dAtA[i:], m.Addon)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Addon)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RelaySession) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1734,6 +1937,9 @@ func (m *ProbeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRelay(uint64(l))
 	}
+	if m.WithVerification {
+		n += 2
+	}
 	return n
 }
 
@@ -1759,6 +1965,43 @@ func (m *ProbeReply) Size() (n int) {
 	if m.LavaLatestBlock != 0 {
 		n += 1 + sovRelay(uint64(m.LavaLatestBlock))
 	}
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovRelay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Verification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	l = len(m.Addon)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	if m.Critical {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
 	return n
 }
 
@@ -2125,6 +2368,26 @@ func (m *ProbeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ApiInterface = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithVerification", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithVerification = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelay(dAtA[iNdEx:])
@@ -2285,6 +2548,258 @@ func (m *ProbeReply) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifications = append(m.Verifications, &Verification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Verification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Critical", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Critical = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelay(dAtA[iNdEx:])
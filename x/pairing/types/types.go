@@ -5,6 +5,10 @@ const (
 	ProviderStakeUpdateEventName = "stake_update_provider"
 	ProviderUnstakeEventName     = "provider_unstake_commit"
 
+	ProviderDelegateChangeScheduledEventName = "provider_delegate_change_scheduled"
+	ProviderDelegateChangeCanceledEventName  = "provider_delegate_change_canceled"
+	ProviderDelegateChangeAppliedEventName   = "provider_delegate_change_applied"
+
 	ConsumerInsufficientFundsToStayStakedEventName = "consumer_insufficient_funds_to_stay_staked"
 	RelayPaymentEventName                          = "relay_payment"
 	UnresponsiveProviderUnstakeFailedEventName     = "unresponsive_provider"
@@ -92,6 +92,16 @@ func (k Keeper) PutPlan(ctx sdk.Context, index string, block uint64) {
 	k.plansFS.PutEntry(ctx, index, block)
 }
 
+// GetPlanRefcount gets the refcount of a specific plan version (including the extra
+// reference held by the latest version), for the subscription invariants
+func (k Keeper) GetPlanRefcount(ctx sdk.Context, index string, block uint64) (refcount uint64, isLatest bool, found bool) {
+	entry, err := k.plansFS.FindRawEntry(ctx, index, block)
+	if err != nil || entry.Block != block {
+		return 0, false, false
+	}
+	return entry.Refcount, entry.IsLatest, true
+}
+
 // GetAllPlanIndices gets from the KVStore all the plans' indices
 func (k Keeper) GetAllPlanIndices(ctx sdk.Context) (val []string) {
 	return k.plansFS.GetAllEntryIndices(ctx)
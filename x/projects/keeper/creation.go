@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -37,6 +38,15 @@ import (
 //   -> unregisterKey(all-keys, project, nextEpoch) (see below)
 //   -> DelEntry(project, nextEpoch)
 //
+// upon TransferProjects(old-sub, new-sub)
+//   for each project of old-sub:
+//   -> find project (now) and project-next (next-epoch), skip if deleted by next-epoch
+//   -> for devel keys: AppendEntry(dev-key, new-project, now)
+//   -> for old-sub key: DelEntry(dev-key, now), registerKey(new-sub, now)
+//   -> AppendEntry(new-project, now)
+//   -> if project-next differs: AppendEntry(new-project-next, next-epoch)
+//   -> DelEntry(project, now)
+//
 // upon registerKey(project, epoch)
 //   -> if admin: add to project
 //   -> if devel:
@@ -150,6 +160,147 @@ func (k Keeper) DeleteProject(ctx sdk.Context, creator, projectID string) error
 	return k.projectsFS.DelEntry(ctx, project.Index, nextEpoch)
 }
 
+// TransferProjects moves all the projects of a subscription to a new subscription
+// address: each project is re-created under the new subscription with the same keys,
+// policies and CU usage, and its developer keys are re-mapped to the new project. The
+// old subscription address is replaced by the new one in the projects keys.
+// (takes effect immediately, in the same block as the subscription transfer; changes
+// pending for the next epoch carry over to the new projects)
+func (k Keeper) TransferProjects(ctx sdk.Context, oldSubAddr, newSubAddr string) error {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	// pending project changes take effect at the beginning of the next epoch
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatError("critical: TransferProjects failed to get next epoch", err,
+			utils.Attribute{Key: "subscription", Value: oldSubAddr},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	for _, projectID := range k.GetAllProjectsForSubscription(ctx, oldSubAddr) {
+		var project, nextProject types.Project
+		if found := k.projectsFS.FindEntry(ctx, projectID, ctxBlock, &project); !found {
+			// project is already deleted
+			continue
+		}
+		if found := k.projectsFS.FindEntry(ctx, projectID, nextEpoch, &nextProject); !found {
+			// project is marked for deletion by next epoch, along with its keys
+			continue
+		}
+
+		err = k.transferProject(ctx, project, nextProject, newSubAddr, ctxBlock, nextEpoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) transferProject(ctx sdk.Context, project, nextProject types.Project, newSubAddr string, block, nextEpoch uint64) error {
+	oldSubAddr := project.Subscription
+	name := strings.TrimPrefix(project.Index, types.ProjectIndex(oldSubAddr, ""))
+
+	newProject := project
+	newProject.Index = types.ProjectIndex(newSubAddr, name)
+	newProject.Subscription = newSubAddr
+	newProject.ProjectKeys = []types.ProjectKey{}
+
+	// the new subscription must not already have a project by that name
+	var emptyProject types.Project
+	if found := k.projectsFS.FindEntry(ctx, newProject.Index, nextEpoch, &emptyProject); found {
+		return utils.LavaFormatWarning("transfer project failed",
+			fmt.Errorf("project name already exist for new subscription"),
+			utils.Attribute{Key: "project", Value: project.Index},
+			utils.Attribute{Key: "subscription", Value: newSubAddr},
+		)
+	}
+
+	for _, projectKey := range project.GetProjectKeys() {
+		if projectKey.Key == oldSubAddr {
+			// the old subscription address loses its access to the project, and
+			// the new subscription address takes its place (with the same kinds)
+			if projectKey.IsType(types.ProjectKey_DEVELOPER) {
+				err := k.developerKeysFS.DelEntry(ctx, oldSubAddr, block)
+				if err != nil {
+					return utils.LavaFormatError("critical: transfer project failed to unregister key", err,
+						utils.Attribute{Key: "project", Value: project.Index},
+						utils.Attribute{Key: "key", Value: oldSubAddr},
+					)
+				}
+			}
+			projectKey.Key = newSubAddr
+			err := k.registerKey(ctx, projectKey, &newProject, block)
+			if err != nil {
+				return utils.LavaFormatWarning("transfer project failed", err,
+					utils.Attribute{Key: "project", Value: project.Index},
+					utils.Attribute{Key: "key", Value: newSubAddr},
+				)
+			}
+			continue
+		}
+
+		if projectKey.IsType(types.ProjectKey_ADMIN) {
+			newProject.AppendKey(types.ProjectAdminKey(projectKey.Key))
+		}
+
+		if projectKey.IsType(types.ProjectKey_DEVELOPER) {
+			var devkeyData types.ProtoDeveloperData
+			found := k.developerKeysFS.FindEntry(ctx, projectKey.Key, block, &devkeyData)
+			if !found || devkeyData.ProjectID != project.Index {
+				return utils.LavaFormatError("critical: transfer project with unregistered developer key", legacyerrors.ErrNotFound,
+					utils.Attribute{Key: "project", Value: project.Index},
+					utils.Attribute{Key: "key", Value: projectKey.Key},
+				)
+			}
+
+			// re-map the developer key to the new project (a pending removal of
+			// the key at next epoch carries over to the re-mapped entry)
+			devkeyData.ProjectID = newProject.Index
+			err := k.developerKeysFS.AppendEntry(ctx, projectKey.Key, block, &devkeyData)
+			if err != nil {
+				return utils.LavaFormatError("critical: transfer project failed to re-map key", err,
+					utils.Attribute{Key: "project", Value: project.Index},
+					utils.Attribute{Key: "key", Value: projectKey.Key},
+				)
+			}
+			newProject.AppendKey(types.ProjectDeveloperKey(projectKey.Key))
+		}
+	}
+
+	err := k.projectsFS.AppendEntry(ctx, newProject.Index, block, &newProject)
+	if err != nil {
+		return utils.LavaFormatWarning("transfer project failed", err,
+			utils.Attribute{Key: "project", Value: project.Index},
+			utils.Attribute{Key: "newProject", Value: newProject.Index},
+		)
+	}
+
+	// changes pending for the next epoch (e.g. removed keys) apply to the new project
+	if !nextProject.Equal(project) {
+		newNextProject := nextProject
+		newNextProject.Index = newProject.Index
+		newNextProject.Subscription = newSubAddr
+		newNextProject.ProjectKeys = make([]types.ProjectKey, len(nextProject.ProjectKeys))
+		for i, projectKey := range nextProject.ProjectKeys {
+			if projectKey.Key == oldSubAddr {
+				projectKey.Key = newSubAddr
+			}
+			newNextProject.ProjectKeys[i] = projectKey
+		}
+		err = k.projectsFS.AppendEntry(ctx, newProject.Index, nextEpoch, &newNextProject)
+		if err != nil {
+			return utils.LavaFormatWarning("transfer project failed", err,
+				utils.Attribute{Key: "project", Value: project.Index},
+				utils.Attribute{Key: "newProject", Value: newProject.Index},
+			)
+		}
+	}
+
+	return k.projectsFS.DelEntry(ctx, project.Index, block)
+}
+
 // registerKey adds a key to a project. For developer keys it also updates the
 // developer key registry (that maps them to projects). The block argument is
 // expected to be current block height (takes effect immediately).
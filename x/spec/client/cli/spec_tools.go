@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lavanet/lava/x/spec/client/utils"
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagMaxCU    = "max-cu"
+	flagChainIDs = "chain-ids"
+)
+
+// CreateSpecToolsCobraCommand returns offline tools for spec proposal files
+func CreateSpecToolsCobraCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spec",
+		Short: "Offline tools for spec proposal files",
+	}
+	cmd.AddCommand(CmdLintSpecs())
+	cmd.AddCommand(CmdDiffSpecs())
+	return cmd
+}
+
+func CmdLintSpecs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint [spec-file-or-dir,...]",
+		Short: "Expand the specs imports (like the spec module) and report problems in them",
+		Long: `Loads the specs from the given proposal files (or all the .json files of the given directories),
+expands their imports like the spec module does, and reports duplicate apis, missing parse directives
+for tagged functions, verifications without matching apis, zero compute units apis and spec validation errors.
+Imports must be found among the given specs.`,
+		Example: `lavad spec lint cookbook/specs
+lavad spec lint cookbook/specs/spec_add_ethereum.json,cookbook/specs/spec_add_lava.json`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			maxCU, err := cmd.Flags().GetUint64(flagMaxCU)
+			if err != nil {
+				return err
+			}
+			specFiles, err := utils.LoadSpecFiles(args)
+			if err != nil {
+				return err
+			}
+			issues := utils.LintSpecs(specFiles, maxCU)
+			for _, issue := range issues {
+				cmd.Println(issue.String())
+			}
+			if len(issues) > 0 {
+				return fmt.Errorf("found %d issues in %d specs", len(issues), len(specFiles))
+			}
+			cmd.Printf("no issues found in %d specs\n", len(specFiles))
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(flagMaxCU, types.DefaultParams().MaxCU, "max compute units of an api (spec module param)")
+	return cmd
+}
+
+func CmdDiffSpecs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [old-spec-file-or-dir,...] [new-spec-file-or-dir,...]",
+		Short: "Print a semantic diff between two versions of the expanded specs",
+		Long: `Loads and expands (resolves imports of) two versions of the specs, and prints the added (+), removed (-)
+and changed (~) specs, fields, api collections, apis, headers, parse directives, extensions and verifications.
+Useful to review spec proposals, as changes to imported specs are reflected in the importing specs.`,
+		Example: `lavad spec diff old/cookbook/specs cookbook/specs --chain-ids ETH1,LAV1`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainIDs, err := cmd.Flags().GetString(flagChainIDs)
+			if err != nil {
+				return err
+			}
+			oldSpecs, err := loadExpandedSpecs(args[0], chainIDs)
+			if err != nil {
+				return err
+			}
+			newSpecs, err := loadExpandedSpecs(args[1], chainIDs)
+			if err != nil {
+				return err
+			}
+			lines := utils.DiffSpecs(oldSpecs, newSpecs)
+			for _, line := range lines {
+				cmd.Println(line)
+			}
+			if len(lines) == 0 {
+				cmd.Println("no changes")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagChainIDs, "", "comma separated chain IDs to diff (default: all)")
+	return cmd
+}
+
+// loadExpandedSpecs loads and expands the specs, and keeps the requested chain IDs (all if empty)
+func loadExpandedSpecs(paths string, chainIDs string) (map[string]types.Spec, error) {
+	specFiles, err := utils.LoadSpecFiles([]string{paths})
+	if err != nil {
+		return nil, err
+	}
+	specs, err := utils.ExpandSpecFiles(specFiles)
+	if err != nil {
+		return nil, err
+	}
+	if chainIDs == "" {
+		return specs, nil
+	}
+	filtered := map[string]types.Spec{}
+	for _, chainID := range strings.Split(chainIDs, listSeparator) {
+		if spec, ok := specs[chainID]; ok {
+			filtered[chainID] = spec
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/lavanet/lava/x/spec/types"
+)
+
+// DiffSpecs returns a semantic diff of two sets of expanded specs (by index): added and
+// removed specs, and for each changed spec its changed fields, api collections, apis,
+// headers, parse directives, extensions and verifications. Lines are prefixed by
+// "+" (added), "-" (removed) or "~" (changed).
+func DiffSpecs(oldSpecs, newSpecs map[string]types.Spec) []string {
+	lines := []string{}
+	for _, index := range sortedKeys(oldSpecs, newSpecs) {
+		oldSpec, inOld := oldSpecs[index]
+		newSpec, inNew := newSpecs[index]
+		switch {
+		case !inNew:
+			lines = append(lines, "- spec "+index)
+		case !inOld:
+			lines = append(lines, "+ spec "+index)
+		default:
+			lines = append(lines, diffSpec(oldSpec, newSpec)...)
+		}
+	}
+	return lines
+}
+
+func diffSpec(oldSpec, newSpec types.Spec) []string {
+	scope := "spec " + oldSpec.Index
+	lines := fieldChanges(scope, oldSpec, newSpec, "api_collections", "block_last_updated")
+
+	collectionKey := func(collection *types.ApiCollection) string {
+		return collectionName(collection.CollectionData)
+	}
+	oldCollections := keyed(oldSpec.ApiCollections, collectionKey)
+	newCollections := keyed(newSpec.ApiCollections, collectionKey)
+	for _, key := range sortedKeys(oldCollections, newCollections) {
+		oldCollection, inOld := oldCollections[key]
+		newCollection, inNew := newCollections[key]
+		switch {
+		case !inNew:
+			lines = append(lines, fmt.Sprintf("- %s: api collection %s", scope, key))
+		case !inOld:
+			lines = append(lines, fmt.Sprintf("+ %s: api collection %s", scope, key))
+		default:
+			lines = append(lines, diffCollection(scope+" "+key, oldCollection, newCollection)...)
+		}
+	}
+	return lines
+}
+
+func diffCollection(scope string, oldCollection, newCollection *types.ApiCollection) []string {
+	lines := fieldChanges(scope, oldCollection, newCollection, "collection_data", "apis", "headers", "parse_directives", "extensions", "verifications")
+
+	lines = append(lines, diffKeyed(scope, "api", oldCollection.Apis, newCollection.Apis, func(api *types.Api) string {
+		return api.Name
+	})...)
+	lines = append(lines, diffKeyed(scope, "header", oldCollection.Headers, newCollection.Headers, func(header *types.Header) string {
+		return header.Name
+	})...)
+	lines = append(lines, diffKeyed(scope, "parse directive", oldCollection.ParseDirectives, newCollection.ParseDirectives, func(parsing *types.ParseDirective) string {
+		if parsing.ApiName == "" {
+			return parsing.FunctionTag.String()
+		}
+		return parsing.FunctionTag.String() + "(" + parsing.ApiName + ")"
+	})...)
+	lines = append(lines, diffKeyed(scope, "extension", oldCollection.Extensions, newCollection.Extensions, func(extension *types.Extension) string {
+		return extension.Name
+	})...)
+	lines = append(lines, diffKeyed(scope, "verification", oldCollection.Verifications, newCollection.Verifications, func(verification *types.Verification) string {
+		return verification.Name
+	})...)
+	return lines
+}
+
+// diffKeyed diffs two lists of items by their keys, and the changed fields of items in both
+func diffKeyed[T any](scope, kind string, oldItems, newItems []T, key func(T) string) []string {
+	lines := []string{}
+	oldByKey := keyed(oldItems, key)
+	newByKey := keyed(newItems, key)
+	for _, itemKey := range sortedKeys(oldByKey, newByKey) {
+		oldItem, inOld := oldByKey[itemKey]
+		newItem, inNew := newByKey[itemKey]
+		switch {
+		case !inNew:
+			lines = append(lines, fmt.Sprintf("- %s: %s %s", scope, kind, itemKey))
+		case !inOld:
+			lines = append(lines, fmt.Sprintf("+ %s: %s %s", scope, kind, itemKey))
+		default:
+			lines = append(lines, fieldChanges(fmt.Sprintf("%s: %s %s", scope, kind, itemKey), oldItem, newItem)...)
+		}
+	}
+	return lines
+}
+
+// fieldChanges compares the (json) fields of two objects, except the skipped ones
+func fieldChanges(scope string, oldObj, newObj interface{}, skip ...string) []string {
+	oldFields, newFields := jsonFields(oldObj), jsonFields(newObj)
+	skipped := map[string]struct{}{}
+	for _, field := range skip {
+		skipped[field] = struct{}{}
+	}
+
+	lines := []string{}
+	for _, field := range sortedKeys(oldFields, newFields) {
+		if _, ok := skipped[field]; ok {
+			continue
+		}
+		oldValue, newValue := oldFields[field], newFields[field]
+		if string(oldValue) == string(newValue) {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("~ %s: %s: %s -> %s", scope, field, jsonValue(oldValue), jsonValue(newValue)))
+	}
+	return lines
+}
+
+func jsonFields(obj interface{}) map[string]json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	encoded, err := json.Marshal(obj)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(encoded, &fields)
+	return fields
+}
+
+func jsonValue(value json.RawMessage) string {
+	if len(value) == 0 {
+		return "<unset>"
+	}
+	return string(value)
+}
+
+func keyed[T any](items []T, key func(T) string) map[string]T {
+	byKey := make(map[string]T, len(items))
+	for _, item := range items {
+		byKey[key(item)] = item
+	}
+	return byKey
+}
+
+func sortedKeys[T any](maps ...map[string]T) []string {
+	seen := map[string]struct{}{}
+	keys := []string{}
+	for _, m := range maps {
+		for key := range m {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,252 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lavanet/lava/x/spec/types"
+)
+
+// SpecFile is a (non-expanded) spec read from a spec proposal file
+type SpecFile struct {
+	Spec types.Spec
+	File string
+}
+
+// SpecIssue is a problem found in a spec by LintSpecs
+type SpecIssue struct {
+	Spec    string
+	File    string
+	Message string
+}
+
+func (si SpecIssue) String() string {
+	return fmt.Sprintf("%s (%s): %s", si.Spec, si.File, si.Message)
+}
+
+// LoadSpecFiles reads the specs from spec proposal files. Paths are files or directories
+// (all the .json files in them), and may be comma separated.
+func LoadSpecFiles(paths []string) ([]SpecFile, error) {
+	files := []string{}
+	for _, path := range paths {
+		for _, path := range strings.Split(path, ",") {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, err
+			}
+			if !info.IsDir() {
+				files = append(files, path)
+				continue
+			}
+			matches, err := filepath.Glob(filepath.Join(path, "*.json"))
+			if err != nil {
+				return nil, err
+			}
+			sort.Strings(matches)
+			files = append(files, matches...)
+		}
+	}
+
+	specFiles := []SpecFile{}
+	for _, file := range files {
+		proposal, err := ParseSpecAddProposalJSON(nil, file)
+		if err != nil {
+			return nil, err
+		}
+		for _, spec := range proposal.Proposal.Specs {
+			specFiles = append(specFiles, SpecFile{Spec: spec, File: file})
+		}
+	}
+	return specFiles, nil
+}
+
+// specGetter returns a getter of the specs (by index), as used to expand imports
+func specGetter(specFiles []SpecFile) types.SpecGetter {
+	specs := map[string]types.Spec{}
+	for _, specFile := range specFiles {
+		specs[specFile.Spec.Index] = specFile.Spec
+	}
+	return func(index string) (types.Spec, bool) {
+		spec, ok := specs[index]
+		if !ok {
+			return types.Spec{}, false
+		}
+		return copySpec(spec), true
+	}
+}
+
+// copySpec deep copies a spec, since expanding a spec modifies its (and its imports) api collections
+func copySpec(spec types.Spec) types.Spec {
+	var copied types.Spec
+	data, err := spec.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	if err := copied.Unmarshal(data); err != nil {
+		panic(err)
+	}
+	return copied
+}
+
+// ExpandSpecFiles expands the imports of all the specs, like the spec module does
+// (imports are resolved among the given specs)
+func ExpandSpecFiles(specFiles []SpecFile) (map[string]types.Spec, error) {
+	getSpec := specGetter(specFiles)
+	expanded := map[string]types.Spec{}
+	for _, specFile := range specFiles {
+		spec, err := types.ExpandSpec(getSpec, copySpec(specFile.Spec))
+		if err != nil {
+			return nil, fmt.Errorf("%s (%s): %w", specFile.Spec.Index, specFile.File, err)
+		}
+		expanded[spec.Index] = spec
+	}
+	return expanded, nil
+}
+
+// LintSpecs expands the specs and reports the problems found in them, including
+// the spec module validation (with maxCU as the max compute units param)
+func LintSpecs(specFiles []SpecFile, maxCU uint64) []SpecIssue {
+	issues := []SpecIssue{}
+	getSpec := specGetter(specFiles)
+
+	files := map[string]string{}
+	for _, specFile := range specFiles {
+		raw := specFile.Spec
+		report := func(format string, args ...interface{}) {
+			issues = append(issues, SpecIssue{Spec: raw.Index, File: specFile.File, Message: fmt.Sprintf(format, args...)})
+		}
+
+		if file, ok := files[raw.Index]; ok {
+			report("spec defined twice (also in %s)", file)
+			continue
+		}
+		files[raw.Index] = specFile.File
+
+		collections := map[types.CollectionData]struct{}{}
+		for _, collection := range raw.ApiCollections {
+			if _, ok := collections[collection.CollectionData]; ok {
+				report("api collection %s defined twice", collectionName(collection.CollectionData))
+			}
+			collections[collection.CollectionData] = struct{}{}
+		}
+
+		spec, err := types.ExpandSpec(getSpec, copySpec(raw))
+		if err != nil {
+			report("%s", err)
+			continue
+		}
+		for _, message := range lintExpandedSpec(spec) {
+			report("%s", message)
+		}
+		if _, err := spec.ValidateSpec(maxCU); err != nil {
+			report("spec validation failed: %s", err)
+		}
+	}
+	return issues
+}
+
+// lintExpandedSpec checks the enabled api collections of an expanded spec
+func lintExpandedSpec(spec types.Spec) (messages []string) {
+	// apis and parse directives are looked up by api interface (across collections)
+	apisByInterface := map[string]map[string]struct{}{}
+	tagsByInterface := map[string]map[types.FUNCTION_TAG]struct{}{}
+	for _, collection := range spec.ApiCollections {
+		if !collection.Enabled {
+			continue
+		}
+		apiInterface := collection.CollectionData.ApiInterface
+		if apisByInterface[apiInterface] == nil {
+			apisByInterface[apiInterface] = map[string]struct{}{}
+			tagsByInterface[apiInterface] = map[types.FUNCTION_TAG]struct{}{}
+		}
+		for _, api := range collection.Apis {
+			apisByInterface[apiInterface][api.Name] = struct{}{}
+		}
+	}
+
+	verifications := 0
+	for _, collection := range spec.ApiCollections {
+		if !collection.Enabled {
+			continue
+		}
+		name := collectionName(collection.CollectionData)
+		apis := apisByInterface[collection.CollectionData.ApiInterface]
+
+		seen := map[string]struct{}{}
+		for _, api := range collection.Apis {
+			if _, ok := seen[api.Name]; ok {
+				messages = append(messages, fmt.Sprintf("%s: api %s defined twice", name, api.Name))
+			}
+			seen[api.Name] = struct{}{}
+			if api.Enabled && api.ComputeUnits == 0 {
+				messages = append(messages, fmt.Sprintf("%s: api %s has zero compute units", name, api.Name))
+			}
+		}
+
+		for _, parsing := range collection.ParseDirectives {
+			tagsByInterface[collection.CollectionData.ApiInterface][parsing.FunctionTag] = struct{}{}
+			switch parsing.FunctionTag {
+			case types.FUNCTION_TAG_SET_LATEST_IN_METADATA, types.FUNCTION_TAG_SET_LATEST_IN_BODY:
+				// these refer to a header or a field, not to an api
+				continue
+			}
+			if parsing.ApiName == "" {
+				continue
+			}
+			if _, ok := apis[parsing.ApiName]; !ok {
+				messages = append(messages, fmt.Sprintf("%s: parse directive %s refers to a missing api %s", name, parsing.FunctionTag, parsing.ApiName))
+			}
+		}
+
+		for _, verification := range collection.Verifications {
+			verifications++
+			switch {
+			case verification.ParseDirective == nil:
+				messages = append(messages, fmt.Sprintf("%s: verification %s has no parse directive", name, verification.Name))
+			case verification.ParseDirective.ApiName == "":
+				messages = append(messages, fmt.Sprintf("%s: verification %s has no api", name, verification.Name))
+			default:
+				if _, ok := apis[verification.ParseDirective.ApiName]; !ok {
+					messages = append(messages, fmt.Sprintf("%s: verification %s has no matching api %s", name, verification.Name, verification.ParseDirective.ApiName))
+				}
+			}
+		}
+	}
+
+	// data reliability compares blocks of all the api interfaces
+	if spec.Enabled && spec.DataReliabilityEnabled {
+		apiInterfaces := make([]string, 0, len(tagsByInterface))
+		for apiInterface := range tagsByInterface {
+			apiInterfaces = append(apiInterfaces, apiInterface)
+		}
+		sort.Strings(apiInterfaces)
+		for _, apiInterface := range apiInterfaces {
+			for _, tag := range []types.FUNCTION_TAG{types.FUNCTION_TAG_GET_BLOCKNUM, types.FUNCTION_TAG_GET_BLOCK_BY_NUM} {
+				if _, ok := tagsByInterface[apiInterface][tag]; !ok {
+					messages = append(messages, fmt.Sprintf("%s: missing parse directive for tagged function %s", apiInterface, tag))
+				}
+			}
+		}
+	}
+	if spec.Enabled && verifications == 0 {
+		messages = append(messages, "enabled spec has no verifications")
+	}
+	return messages
+}
+
+func collectionName(collectionData types.CollectionData) string {
+	name := collectionData.ApiInterface
+	if collectionData.Type != "" {
+		name += "/" + collectionData.Type
+	}
+	if collectionData.InternalPath != "" {
+		name += " path:" + collectionData.InternalPath
+	}
+	if collectionData.AddOn != "" {
+		name += " addon:" + collectionData.AddOn
+	}
+	return name
+}
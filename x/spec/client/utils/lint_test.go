@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+const cookbookSpecs = "../../../../cookbook/specs/"
+
+func TestLintCookbookSpecs(t *testing.T) {
+	specFiles, err := LoadSpecFiles([]string{cookbookSpecs})
+	require.NoError(t, err)
+	require.NotEmpty(t, specFiles)
+	require.Empty(t, LintSpecs(specFiles, types.DefaultParams().MaxCU))
+
+	expanded, err := ExpandSpecFiles(specFiles)
+	require.NoError(t, err)
+	require.Len(t, expanded, len(specFiles))
+	require.Empty(t, DiffSpecs(expanded, expanded))
+}
+
+func lintTestSpecs() []SpecFile {
+	collectionData := types.CollectionData{ApiInterface: "jsonrpc", Type: "POST"}
+	base := types.Spec{
+		Index:   "BASE",
+		Name:    "base",
+		Enabled: false,
+		ApiCollections: []*types.ApiCollection{
+			{
+				Enabled:        true,
+				CollectionData: collectionData,
+				Apis: []*types.Api{
+					{Name: "block", Enabled: true, ComputeUnits: 10},
+					{Name: "free", Enabled: true, ComputeUnits: 0},
+				},
+				ParseDirectives: []*types.ParseDirective{
+					{FunctionTag: types.FUNCTION_TAG_GET_BLOCKNUM, ApiName: "blockNumber"},
+				},
+			},
+		},
+	}
+	child := types.Spec{
+		Index:                  "CHILD",
+		Name:                   "child",
+		Enabled:                true,
+		DataReliabilityEnabled: true,
+		Imports:                []string{"BASE"},
+		ApiCollections: []*types.ApiCollection{
+			{
+				Enabled:        true,
+				CollectionData: collectionData,
+				Apis: []*types.Api{
+					{Name: "chainId", Enabled: true, ComputeUnits: 10},
+					{Name: "chainId", Enabled: true, ComputeUnits: 10},
+				},
+				Verifications: []*types.Verification{
+					{Name: "chain-id", ParseDirective: &types.ParseDirective{ApiName: "missing"}},
+				},
+			},
+		},
+	}
+	orphan := types.Spec{Index: "ORPHAN", Name: "orphan", Imports: []string{"NONE"}}
+	return []SpecFile{{Spec: base, File: "base.json"}, {Spec: child, File: "child.json"}, {Spec: orphan, File: "orphan.json"}}
+}
+
+func TestLintSpecs(t *testing.T) {
+	specFiles := lintTestSpecs()
+	specFiles = append(specFiles, specFiles[0])
+
+	messages := map[string][]string{}
+	for _, issue := range LintSpecs(specFiles, types.DefaultParams().MaxCU) {
+		messages[issue.Spec] = append(messages[issue.Spec], issue.Message)
+	}
+
+	contains := func(spec string, substr string) {
+		for _, message := range messages[spec] {
+			if strings.Contains(message, substr) {
+				return
+			}
+		}
+		require.Failf(t, "missing issue", "%s: %q not in %v", spec, substr, messages[spec])
+	}
+	contains("BASE", "spec defined twice")
+	contains("BASE", "api free has zero compute units")
+	contains("BASE", "parse directive GET_BLOCKNUM refers to a missing api blockNumber")
+	// issues of imported apis are reported also in the importing spec
+	contains("CHILD", "api free has zero compute units")
+	contains("CHILD", "api chainId defined twice")
+	contains("CHILD", "missing parse directive for tagged function GET_BLOCK_BY_NUM")
+	contains("CHILD", "verification chain-id has no matching api missing")
+	contains("ORPHAN", "NONE")
+}
+
+func TestDiffSpecs(t *testing.T) {
+	specFiles := lintTestSpecs()[:2]
+	oldSpecs, err := ExpandSpecFiles(specFiles)
+	require.NoError(t, err)
+
+	// change the imported spec
+	base := specFiles[0].Spec
+	collection := *base.ApiCollections[0]
+	collection.Apis = []*types.Api{{Name: "block", Enabled: true, ComputeUnits: 20}, {Name: "newApi", Enabled: true, ComputeUnits: 10}}
+	base.ApiCollections = []*types.ApiCollection{&collection}
+	base.AverageBlockTime = 1000
+	specFiles[0].Spec = base
+	newSpecs, err := ExpandSpecFiles(specFiles)
+	require.NoError(t, err)
+	newSpecs["NEW"] = types.Spec{Index: "NEW"}
+
+	require.Equal(t, []string{
+		"~ spec BASE: average_block_time: <unset> -> 1000",
+		"~ spec BASE jsonrpc/POST: api block: compute_units: 10 -> 20",
+		"- spec BASE jsonrpc/POST: api free",
+		"+ spec BASE jsonrpc/POST: api newApi",
+		"~ spec CHILD jsonrpc/POST: api block: compute_units: 10 -> 20",
+		"- spec CHILD jsonrpc/POST: api free",
+		"+ spec CHILD jsonrpc/POST: api newApi",
+		"+ spec NEW",
+	}, DiffSpecs(oldSpecs, newSpecs))
+}
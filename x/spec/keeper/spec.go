@@ -137,83 +137,10 @@ func (k Keeper) doExpandSpec(
 	inherit *map[string]bool,
 	details string,
 ) (string, error) {
-	parentsCollections := map[types.CollectionData][]*types.ApiCollection{}
-
-	if len(spec.Imports) != 0 {
-		var parents []types.Spec
-
-		// update (cumulative) inherit
-		for _, index := range spec.Imports {
-			(*inherit)[index] = true
-		}
-
-		// visual markers when import deepens
-		details += "->["
-
-		// recursion to get all parent specs (DFS)
-		comma := ""
-		for _, index := range spec.Imports {
-			imported, found := k.GetSpec(ctx, index)
-			// import of unknown Spec not allowed
-			if !found {
-				details += fmt.Sprintf("%s%s(unknown)", comma, index)
-				return details, fmt.Errorf("imported spec unknown: %s", index)
-			}
-
-			details += fmt.Sprintf("%s%s", comma, index)
-
-			// loop in the recursion not allowed
-			if _, found := depends[index]; found {
-				return details, fmt.Errorf("import loops not allowed for spec: %s", index)
-			}
-
-			depends[index] = true
-			details, err := k.doExpandSpec(ctx, &imported, depends, inherit, details)
-			if err != nil {
-				return details, err
-			}
-			delete(depends, index)
-
-			parents = append(parents, imported)
-			comma = ","
-		}
-
-		details += "]"
-
-		for _, parent := range parents {
-			for _, parentCollection := range parent.ApiCollections {
-				// ignore disabled apiCollections
-				if !parentCollection.Enabled {
-					continue
-				}
-				if parentsCollections[parentCollection.CollectionData] == nil {
-					parentsCollections[parentCollection.CollectionData] = []*types.ApiCollection{}
-				}
-				parentsCollections[parentCollection.CollectionData] = append(parentsCollections[parentCollection.CollectionData], parentCollection)
-			}
-		}
-	}
-
-	myCollections := map[types.CollectionData]*types.ApiCollection{}
-	for _, collection := range spec.ApiCollections {
-		myCollections[collection.CollectionData] = collection
+	getSpec := func(index string) (types.Spec, bool) {
+		return k.GetSpec(ctx, index)
 	}
-
-	for _, collection := range spec.ApiCollections {
-		err := collection.InheritAllFields(myCollections, parentsCollections[collection.CollectionData])
-		if err != nil {
-			return details, err
-		}
-		delete(parentsCollections, collection.CollectionData)
-	}
-
-	// combine left over apis not overwritten by current spec
-	err := spec.CombineCollections(parentsCollections)
-	if err != nil {
-		return details, err
-	}
-
-	return details, nil
+	return types.DoExpandSpec(getSpec, spec, depends, inherit, details)
 }
 
 func (k Keeper) ValidateSpec(ctx sdk.Context, spec types.Spec) (map[string]string, error) {
@@ -95,7 +95,8 @@ const (
 	PARSER_FUNC_PARSE_DICTIONARY            PARSER_FUNC = 3
 	PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED PARSER_FUNC = 4
 	// reserved
-	PARSER_FUNC_DEFAULT PARSER_FUNC = 6
+	PARSER_FUNC_DEFAULT    PARSER_FUNC = 6
+	PARSER_FUNC_PARSE_PATH PARSER_FUNC = 7
 )
 
 var PARSER_FUNC_name = map[int32]string{
@@ -105,6 +106,7 @@ var PARSER_FUNC_name = map[int32]string{
 	3: "PARSE_DICTIONARY",
 	4: "PARSE_DICTIONARY_OR_ORDERED",
 	6: "DEFAULT",
+	7: "PARSE_PATH",
 }
 
 var PARSER_FUNC_value = map[string]int32{
@@ -114,6 +116,7 @@ var PARSER_FUNC_value = map[string]int32{
 	"PARSE_DICTIONARY":            3,
 	"PARSE_DICTIONARY_OR_ORDERED": 4,
 	"DEFAULT":                     6,
+	"PARSE_PATH":                  7,
 }
 
 func (x PARSER_FUNC) String() string {
@@ -124,6 +127,52 @@ func (PARSER_FUNC) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c9f7567a181f534f, []int{2}
 }
 
+type ParamRule_Operator int32
+
+const (
+	ParamRule_EQUAL         ParamRule_Operator = 0
+	ParamRule_NOT_EQUAL     ParamRule_Operator = 1
+	ParamRule_GREATER       ParamRule_Operator = 2
+	ParamRule_GREATER_EQUAL ParamRule_Operator = 3
+	ParamRule_LESS          ParamRule_Operator = 4
+	ParamRule_LESS_EQUAL    ParamRule_Operator = 5
+	ParamRule_EXISTS        ParamRule_Operator = 6
+	ParamRule_CONTAINS      ParamRule_Operator = 7
+	ParamRule_PREFIX        ParamRule_Operator = 8
+)
+
+var ParamRule_Operator_name = map[int32]string{
+	0: "EQUAL",
+	1: "NOT_EQUAL",
+	2: "GREATER",
+	3: "GREATER_EQUAL",
+	4: "LESS",
+	5: "LESS_EQUAL",
+	6: "EXISTS",
+	7: "CONTAINS",
+	8: "PREFIX",
+}
+
+var ParamRule_Operator_value = map[string]int32{
+	"EQUAL":         0,
+	"NOT_EQUAL":     1,
+	"GREATER":       2,
+	"GREATER_EQUAL": 3,
+	"LESS":          4,
+	"LESS_EQUAL":    5,
+	"EXISTS":        6,
+	"CONTAINS":      7,
+	"PREFIX":        8,
+}
+
+func (x ParamRule_Operator) String() string {
+	return proto.EnumName(ParamRule_Operator_name, int32(x))
+}
+
+func (ParamRule_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9f7567a181f534f, []int{3, 0}
+}
+
 type Verification_VerificationSeverity int32
 
 const (
@@ -146,7 +195,7 @@ func (x Verification_VerificationSeverity) String() string {
 }
 
 func (Verification_VerificationSeverity) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{3, 0}
+	return fileDescriptor_c9f7567a181f534f, []int{4, 0}
 }
 
 type Header_HeaderType int32
@@ -177,7 +226,35 @@ func (x Header_HeaderType) String() string {
 }
 
 func (Header_HeaderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{6, 0}
+	return fileDescriptor_c9f7567a181f534f, []int{7, 0}
+}
+
+type CuFormula_Kind int32
+
+const (
+	CuFormula_NONE         CuFormula_Kind = 0
+	CuFormula_BLOCK_RANGE  CuFormula_Kind = 1
+	CuFormula_ARRAY_LENGTH CuFormula_Kind = 2
+)
+
+var CuFormula_Kind_name = map[int32]string{
+	0: "NONE",
+	1: "BLOCK_RANGE",
+	2: "ARRAY_LENGTH",
+}
+
+var CuFormula_Kind_value = map[string]int32{
+	"NONE":         0,
+	"BLOCK_RANGE":  1,
+	"ARRAY_LENGTH": 2,
+}
+
+func (x CuFormula_Kind) String() string {
+	return proto.EnumName(CuFormula_Kind_name, int32(x))
+}
+
+func (CuFormula_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9f7567a181f534f, []int{9, 0}
 }
 
 type ApiCollection struct {
@@ -342,6 +419,11 @@ func (m *Extension) GetRule() *Rule {
 
 type Rule struct {
 	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	// content rules (for non archive extensions): the extension applies to requests of apis by these names or name prefixes (any of them)
+	// and whose parsed params match all the param rules
+	ApiNames       []string    `protobuf:"bytes,2,rep,name=api_names,json=apiNames,proto3" json:"api_names,omitempty"`
+	MethodPrefixes []string    `protobuf:"bytes,3,rep,name=method_prefixes,json=methodPrefixes,proto3" json:"method_prefixes,omitempty"`
+	Params         []ParamRule `protobuf:"bytes,4,rep,name=params,proto3" json:"params"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
@@ -384,6 +466,87 @@ func (m *Rule) GetBlock() uint64 {
 	return 0
 }
 
+func (m *Rule) GetApiNames() []string {
+	if m != nil {
+		return m.ApiNames
+	}
+	return nil
+}
+
+func (m *Rule) GetMethodPrefixes() []string {
+	if m != nil {
+		return m.MethodPrefixes
+	}
+	return nil
+}
+
+func (m *Rule) GetParams() []ParamRule {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type ParamRule struct {
+	Parser   BlockParser        `protobuf:"bytes,1,opt,name=parser,proto3" json:"parser"`
+	Operator ParamRule_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=lavanet.lava.spec.ParamRule_Operator" json:"operator,omitempty"`
+	Value    string             `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ParamRule) Reset()         { *m = ParamRule{} }
+func (m *ParamRule) String() string { return proto.CompactTextString(m) }
+func (*ParamRule) ProtoMessage()    {}
+func (*ParamRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f7567a181f534f, []int{3}
+}
+func (m *ParamRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamRule.Merge(m, src)
+}
+func (m *ParamRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamRule proto.InternalMessageInfo
+
+func (m *ParamRule) GetParser() BlockParser {
+	if m != nil {
+		return m.Parser
+	}
+	return BlockParser{}
+}
+
+func (m *ParamRule) GetOperator() ParamRule_Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return ParamRule_EQUAL
+}
+
+func (m *ParamRule) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type Verification struct {
 	Name           string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ParseDirective *ParseDirective                   `protobuf:"bytes,2,opt,name=parse_directive,json=parseDirective,proto3" json:"parse_directive,omitempty"`
@@ -395,7 +558,7 @@ func (m *Verification) Reset()         { *m = Verification{} }
 func (m *Verification) String() string { return proto.CompactTextString(m) }
 func (*Verification) ProtoMessage()    {}
 func (*Verification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{3}
+	return fileDescriptor_c9f7567a181f534f, []int{4}
 }
 func (m *Verification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +625,7 @@ func (m *ParseValue) Reset()         { *m = ParseValue{} }
 func (m *ParseValue) String() string { return proto.CompactTextString(m) }
 func (*ParseValue) ProtoMessage()    {}
 func (*ParseValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{4}
+	return fileDescriptor_c9f7567a181f534f, []int{5}
 }
 func (m *ParseValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +686,7 @@ func (m *CollectionData) Reset()         { *m = CollectionData{} }
 func (m *CollectionData) String() string { return proto.CompactTextString(m) }
 func (*CollectionData) ProtoMessage()    {}
 func (*CollectionData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{5}
+	return fileDescriptor_c9f7567a181f534f, []int{6}
 }
 func (m *CollectionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +753,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{6}
+	return fileDescriptor_c9f7567a181f534f, []int{7}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,13 +811,14 @@ type Api struct {
 	Category          SpecCategory `protobuf:"bytes,6,opt,name=category,proto3" json:"category"`
 	BlockParsing      BlockParser  `protobuf:"bytes,7,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
 	TimeoutMs         uint64       `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	CuFormula         *CuFormula   `protobuf:"bytes,9,opt,name=cu_formula,json=cuFormula,proto3" json:"cu_formula,omitempty"`
 }
 
 func (m *Api) Reset()         { *m = Api{} }
 func (m *Api) String() string { return proto.CompactTextString(m) }
 func (*Api) ProtoMessage()    {}
 func (*Api) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{7}
+	return fileDescriptor_c9f7567a181f534f, []int{8}
 }
 func (m *Api) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,6 +896,100 @@ func (m *Api) GetTimeoutMs() uint64 {
 	return 0
 }
 
+func (m *Api) GetCuFormula() *CuFormula {
+	if m != nil {
+		return m.CuFormula
+	}
+	return nil
+}
+
+// CuFormula adds compute units to an api according to the parameters of the request:
+// cu = compute_units + cu_per_unit * ceil(min(units, max_units) / unit_size)
+// requests whose units can't be resolved from the parameters are charged for max_units
+type CuFormula struct {
+	Kind      CuFormula_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=lavanet.lava.spec.CuFormula_Kind" json:"kind,omitempty"`
+	From      BlockParser    `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	To        BlockParser    `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+	CuPerUnit uint64         `protobuf:"varint,4,opt,name=cu_per_unit,json=cuPerUnit,proto3" json:"cu_per_unit,omitempty"`
+	UnitSize  uint64         `protobuf:"varint,5,opt,name=unit_size,json=unitSize,proto3" json:"unit_size,omitempty"`
+	MaxUnits  uint64         `protobuf:"varint,6,opt,name=max_units,json=maxUnits,proto3" json:"max_units,omitempty"`
+}
+
+func (m *CuFormula) Reset()         { *m = CuFormula{} }
+func (m *CuFormula) String() string { return proto.CompactTextString(m) }
+func (*CuFormula) ProtoMessage()    {}
+func (*CuFormula) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f7567a181f534f, []int{9}
+}
+func (m *CuFormula) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CuFormula) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CuFormula.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CuFormula) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CuFormula.Merge(m, src)
+}
+func (m *CuFormula) XXX_Size() int {
+	return m.Size()
+}
+func (m *CuFormula) XXX_DiscardUnknown() {
+	xxx_messageInfo_CuFormula.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CuFormula proto.InternalMessageInfo
+
+func (m *CuFormula) GetKind() CuFormula_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return CuFormula_NONE
+}
+
+func (m *CuFormula) GetFrom() BlockParser {
+	if m != nil {
+		return m.From
+	}
+	return BlockParser{}
+}
+
+func (m *CuFormula) GetTo() BlockParser {
+	if m != nil {
+		return m.To
+	}
+	return BlockParser{}
+}
+
+func (m *CuFormula) GetCuPerUnit() uint64 {
+	if m != nil {
+		return m.CuPerUnit
+	}
+	return 0
+}
+
+func (m *CuFormula) GetUnitSize() uint64 {
+	if m != nil {
+		return m.UnitSize
+	}
+	return 0
+}
+
+func (m *CuFormula) GetMaxUnits() uint64 {
+	if m != nil {
+		return m.MaxUnits
+	}
+	return 0
+}
+
 type ParseDirective struct {
 	FunctionTag      FUNCTION_TAG `protobuf:"varint,1,opt,name=function_tag,json=functionTag,proto3,enum=lavanet.lava.spec.FUNCTION_TAG" json:"function_tag,omitempty"`
 	FunctionTemplate string       `protobuf:"bytes,2,opt,name=function_template,json=functionTemplate,proto3" json:"function_template,omitempty"`
@@ -743,7 +1001,7 @@ func (m *ParseDirective) Reset()         { *m = ParseDirective{} }
 func (m *ParseDirective) String() string { return proto.CompactTextString(m) }
 func (*ParseDirective) ProtoMessage()    {}
 func (*ParseDirective) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{8}
+	return fileDescriptor_c9f7567a181f534f, []int{10}
 }
 func (m *ParseDirective) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1069,7 @@ func (m *BlockParser) Reset()         { *m = BlockParser{} }
 func (m *BlockParser) String() string { return proto.CompactTextString(m) }
 func (*BlockParser) ProtoMessage()    {}
 func (*BlockParser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{9}
+	return fileDescriptor_c9f7567a181f534f, []int{11}
 }
 func (m *BlockParser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +1138,7 @@ func (m *SpecCategory) Reset()         { *m = SpecCategory{} }
 func (m *SpecCategory) String() string { return proto.CompactTextString(m) }
 func (*SpecCategory) ProtoMessage()    {}
 func (*SpecCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{10}
+	return fileDescriptor_c9f7567a181f534f, []int{12}
 }
 func (m *SpecCategory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -948,16 +1206,20 @@ func init() {
 	proto.RegisterEnum("lavanet.lava.spec.EXTENSION", EXTENSION_name, EXTENSION_value)
 	proto.RegisterEnum("lavanet.lava.spec.FUNCTION_TAG", FUNCTION_TAG_name, FUNCTION_TAG_value)
 	proto.RegisterEnum("lavanet.lava.spec.PARSER_FUNC", PARSER_FUNC_name, PARSER_FUNC_value)
+	proto.RegisterEnum("lavanet.lava.spec.ParamRule_Operator", ParamRule_Operator_name, ParamRule_Operator_value)
 	proto.RegisterEnum("lavanet.lava.spec.Verification_VerificationSeverity", Verification_VerificationSeverity_name, Verification_VerificationSeverity_value)
 	proto.RegisterEnum("lavanet.lava.spec.Header_HeaderType", Header_HeaderType_name, Header_HeaderType_value)
+	proto.RegisterEnum("lavanet.lava.spec.CuFormula_Kind", CuFormula_Kind_name, CuFormula_Kind_value)
 	proto.RegisterType((*ApiCollection)(nil), "lavanet.lava.spec.ApiCollection")
 	proto.RegisterType((*Extension)(nil), "lavanet.lava.spec.Extension")
 	proto.RegisterType((*Rule)(nil), "lavanet.lava.spec.Rule")
+	proto.RegisterType((*ParamRule)(nil), "lavanet.lava.spec.ParamRule")
 	proto.RegisterType((*Verification)(nil), "lavanet.lava.spec.Verification")
 	proto.RegisterType((*ParseValue)(nil), "lavanet.lava.spec.ParseValue")
 	proto.RegisterType((*CollectionData)(nil), "lavanet.lava.spec.CollectionData")
 	proto.RegisterType((*Header)(nil), "lavanet.lava.spec.Header")
 	proto.RegisterType((*Api)(nil), "lavanet.lava.spec.Api")
+	proto.RegisterType((*CuFormula)(nil), "lavanet.lava.spec.CuFormula")
 	proto.RegisterType((*ParseDirective)(nil), "lavanet.lava.spec.ParseDirective")
 	proto.RegisterType((*BlockParser)(nil), "lavanet.lava.spec.BlockParser")
 	proto.RegisterType((*SpecCategory)(nil), "lavanet.lava.spec.SpecCategory")
@@ -968,95 +1230,117 @@ func init() {
 }
 
 var fileDescriptor_c9f7567a181f534f = []byte{
-	// 1400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x13, 0x35, 0x25, 0xda, 0x96, 0x46, 0x7f, 0xcc, 0x6c, 0xfc, 0xcb, 0x4f, 0x49, 0x1d, 0xc9, 0x65,
-	0xd2, 0xd6, 0x70, 0x50, 0x1b, 0x75, 0x5a, 0xa0, 0x08, 0x0a, 0x14, 0x94, 0x44, 0x27, 0x6a, 0x6c,
-	0xc9, 0x58, 0xcb, 0x6e, 0xdd, 0x0b, 0xb1, 0x26, 0xd7, 0xf2, 0x22, 0x14, 0xc9, 0x92, 0x4b, 0xc3,
-	0xee, 0xb5, 0xb7, 0x9e, 0xfa, 0x29, 0x8a, 0x02, 0x05, 0x0a, 0xf4, 0xd0, 0xef, 0x90, 0x63, 0x8e,
-	0x3d, 0x19, 0x85, 0x73, 0x28, 0x9a, 0x63, 0xee, 0x05, 0x8a, 0x5d, 0x52, 0xb2, 0xe8, 0x28, 0x09,
-	0x72, 0x92, 0xe6, 0xcd, 0xdb, 0xb7, 0xb3, 0x33, 0xb3, 0xb3, 0x84, 0x0f, 0x5d, 0x72, 0x42, 0x3c,
-	0xca, 0xd7, 0xc5, 0xef, 0x7a, 0x14, 0x50, 0x7b, 0x9d, 0x04, 0xcc, 0xb2, 0x7d, 0xd7, 0xa5, 0x36,
-	0x67, 0xbe, 0xb7, 0x16, 0x84, 0x3e, 0xf7, 0xd1, 0xb5, 0x94, 0xb7, 0x26, 0x7e, 0xd7, 0x04, 0xef,
-	0xd6, 0xe2, 0xc0, 0x1f, 0xf8, 0xd2, 0xbb, 0x2e, 0xfe, 0x25, 0x44, 0xfd, 0xdf, 0x3c, 0x54, 0x8c,
-	0x80, 0xb5, 0xc6, 0x02, 0xa8, 0x06, 0xf3, 0xd4, 0x23, 0x87, 0x2e, 0x75, 0x6a, 0xca, 0xb2, 0xb2,
-	0x52, 0xc0, 0x23, 0x13, 0xed, 0xc0, 0xc2, 0xe5, 0x46, 0x96, 0x43, 0x38, 0xa9, 0xe5, 0x96, 0x95,
-	0x95, 0xd2, 0xc6, 0xfb, 0x6b, 0xaf, 0x6c, 0xb7, 0x76, 0xa9, 0xd8, 0x26, 0x9c, 0x34, 0xd5, 0xa7,
-	0xe7, 0x8d, 0x19, 0x5c, 0xb5, 0x33, 0x28, 0x5a, 0x05, 0x95, 0x04, 0x2c, 0xaa, 0xe5, 0x97, 0xf3,
-	0x2b, 0xa5, 0x8d, 0x1b, 0x53, 0x64, 0x8c, 0x80, 0x61, 0xc9, 0x41, 0xf7, 0x61, 0xfe, 0x98, 0x12,
-	0x87, 0x86, 0x51, 0x4d, 0x95, 0xf4, 0x9b, 0x53, 0xe8, 0x8f, 0x24, 0x03, 0x8f, 0x98, 0x68, 0x0b,
-	0x34, 0xe6, 0x1d, 0xd3, 0x90, 0x71, 0xe2, 0xd9, 0xd4, 0x92, 0x9b, 0xcd, 0xca, 0xd5, 0x6f, 0x8f,
-	0x19, 0x2f, 0x4c, 0x2c, 0x35, 0x44, 0x08, 0x5b, 0xa0, 0x05, 0x24, 0x8c, 0xa8, 0xe5, 0xb0, 0x50,
-	0xf0, 0x4e, 0x68, 0x54, 0x9b, 0x7b, 0xad, 0xda, 0x8e, 0xa0, 0xb6, 0x47, 0x4c, 0xbc, 0x10, 0x64,
-	0xec, 0x08, 0x7d, 0x01, 0x40, 0x4f, 0x39, 0xf5, 0x22, 0xe6, 0x7b, 0x51, 0x6d, 0x5e, 0xea, 0x2c,
-	0x4d, 0xd1, 0x31, 0x47, 0x24, 0x3c, 0xc1, 0x47, 0x26, 0x54, 0x4e, 0x68, 0xc8, 0x8e, 0x98, 0x4d,
-	0xb8, 0x14, 0x28, 0x48, 0x81, 0xc6, 0x14, 0x81, 0xfd, 0x09, 0x1e, 0xce, 0xae, 0xd2, 0xbf, 0x83,
-	0xe2, 0x58, 0x1f, 0x21, 0x50, 0x3d, 0x32, 0xa4, 0xb2, 0xee, 0x45, 0x2c, 0xff, 0xa3, 0x3b, 0x50,
-	0xb1, 0x63, 0x6b, 0x18, 0xbb, 0x9c, 0x05, 0x2e, 0xa3, 0xa1, 0x2c, 0x79, 0x0e, 0x97, 0xed, 0x78,
-	0x7b, 0x8c, 0xa1, 0x7b, 0xa0, 0x86, 0xb1, 0x4b, 0x6b, 0x79, 0xd9, 0x0e, 0xff, 0x9f, 0x12, 0x03,
-	0x8e, 0x5d, 0x8a, 0x25, 0x49, 0x5f, 0x02, 0x55, 0x58, 0x68, 0x11, 0x66, 0x0f, 0x5d, 0xdf, 0x7e,
-	0x22, 0xb7, 0x53, 0x71, 0x62, 0xe8, 0x3f, 0xe7, 0xa0, 0x3c, 0x19, 0xf0, 0xd4, 0xa0, 0xbe, 0x82,
-	0x85, 0x2b, 0x85, 0x78, 0x43, 0x27, 0x5e, 0xa9, 0x43, 0x35, 0x5b, 0x07, 0xf4, 0x19, 0xcc, 0x9d,
-	0x10, 0x37, 0xa6, 0xa3, 0x2e, 0xbc, 0xfd, 0x3a, 0x89, 0x7d, 0xc1, 0xc2, 0x29, 0x19, 0xed, 0x40,
-	0x21, 0xa2, 0x22, 0x97, 0xfc, 0xac, 0xa6, 0x2e, 0x2b, 0x2b, 0xd5, 0x8d, 0x4f, 0xdf, 0x92, 0xfa,
-	0x8c, 0xb1, 0x9b, 0xae, 0xc5, 0x63, 0x15, 0xfd, 0x63, 0x58, 0x9c, 0xc6, 0x40, 0x05, 0x50, 0x37,
-	0x09, 0x73, 0xb5, 0x19, 0x54, 0x82, 0xf9, 0xaf, 0x49, 0xe8, 0x31, 0x6f, 0xa0, 0x29, 0xfa, 0xf7,
-	0x00, 0x97, 0x61, 0xa1, 0x25, 0x28, 0x8e, 0x9b, 0x23, 0x4d, 0xd5, 0x25, 0x80, 0x3e, 0x80, 0x2a,
-	0x3d, 0x0d, 0xa8, 0xcd, 0xa9, 0x63, 0xc9, 0xf8, 0x65, 0xba, 0x8a, 0xb8, 0x32, 0x42, 0x13, 0x91,
-	0x8f, 0x60, 0xc1, 0x25, 0x9c, 0x46, 0xdc, 0x72, 0x58, 0x24, 0xdb, 0x5e, 0x56, 0x54, 0xc5, 0xd5,
-	0x04, 0x6e, 0xa7, 0xa8, 0xfe, 0x7b, 0x0e, 0xaa, 0xd9, 0xcb, 0x82, 0xf6, 0xa1, 0x22, 0x26, 0x11,
-	0xf3, 0x38, 0x0d, 0x8f, 0x88, 0x9d, 0xd6, 0xab, 0xf9, 0xc9, 0x8b, 0xf3, 0x46, 0xd6, 0xf1, 0xf2,
-	0xbc, 0xb1, 0x34, 0x24, 0x41, 0xc4, 0xc3, 0xd8, 0xe6, 0x71, 0x48, 0x1f, 0xe8, 0x19, 0xb7, 0x8e,
-	0xcb, 0x24, 0x60, 0x9d, 0x91, 0x29, 0x74, 0xa5, 0xcf, 0x23, 0xae, 0x15, 0x10, 0x7e, 0x9c, 0x44,
-	0x9e, 0xe8, 0x66, 0x1c, 0xaf, 0xea, 0x66, 0xdc, 0x3a, 0x2e, 0x8f, 0xec, 0x1d, 0xc2, 0x8f, 0xd1,
-	0x7d, 0x50, 0xf9, 0x59, 0x90, 0x1c, 0xb0, 0xd8, 0x6c, 0xbc, 0x38, 0x6f, 0x48, 0xfb, 0xe5, 0x79,
-	0xe3, 0x7a, 0x56, 0x45, 0xa0, 0x3a, 0x96, 0x4e, 0xf4, 0x00, 0xe6, 0x88, 0xe3, 0x58, 0xbe, 0x27,
-	0x4b, 0x5e, 0x6c, 0xde, 0x79, 0x71, 0xde, 0x48, 0x91, 0x97, 0xe7, 0x8d, 0xff, 0x5d, 0x39, 0x96,
-	0xc4, 0x75, 0x3c, 0x4b, 0x1c, 0xa7, 0xe7, 0xe9, 0x7f, 0x2b, 0x30, 0x97, 0x8c, 0xa7, 0xa9, 0x2d,
-	0xfd, 0x39, 0xa8, 0x4f, 0x98, 0xe7, 0xc8, 0xe3, 0x55, 0x37, 0xee, 0xbe, 0x76, 0xb6, 0xa5, 0x3f,
-	0xfd, 0xb3, 0x80, 0x62, 0xb9, 0x02, 0x35, 0xa1, 0x7c, 0x14, 0x7b, 0xc9, 0x50, 0xe6, 0x64, 0x20,
-	0x4f, 0x54, 0x9d, 0x3a, 0x08, 0x36, 0xf7, 0xba, 0xad, 0x7e, 0xa7, 0xd7, 0xb5, 0xfa, 0xc6, 0x43,
-	0x5c, 0x1a, 0x2d, 0xea, 0x93, 0x81, 0xfe, 0x18, 0xe0, 0x52, 0x17, 0x55, 0xa0, 0x18, 0x90, 0x28,
-	0xb2, 0x22, 0xea, 0x39, 0xda, 0x0c, 0xaa, 0x02, 0x48, 0x33, 0xa4, 0x81, 0x7b, 0xa6, 0x29, 0x63,
-	0xf7, 0xa1, 0xcf, 0x8f, 0xb5, 0x1c, 0x5a, 0x80, 0x92, 0x34, 0xd9, 0xc0, 0xf3, 0x43, 0xaa, 0xe5,
-	0xf5, 0x3f, 0x72, 0x90, 0x37, 0x02, 0xf6, 0x86, 0x97, 0x64, 0x94, 0x80, 0xdc, 0x95, 0x41, 0xe3,
-	0x0f, 0x83, 0x98, 0x53, 0x2b, 0xf6, 0x18, 0x8f, 0xd2, 0xd6, 0x2b, 0xa7, 0xe0, 0x9e, 0xc0, 0xd0,
-	0x1a, 0x5c, 0xa7, 0xa7, 0x3c, 0x24, 0x56, 0x96, 0xaa, 0x4a, 0xea, 0x35, 0xe9, 0x6a, 0x4d, 0xf2,
-	0x0d, 0x28, 0xd8, 0x84, 0xd3, 0x81, 0x1f, 0x9e, 0xd5, 0xe6, 0xe4, 0x84, 0x98, 0x96, 0x97, 0xdd,
-	0x80, 0xda, 0xad, 0x94, 0x96, 0xbe, 0x54, 0xe3, 0x65, 0xa8, 0x03, 0x15, 0x39, 0x99, 0x2c, 0x31,
-	0x37, 0x98, 0x37, 0xa8, 0xcd, 0x4b, 0x9d, 0xfa, 0x14, 0x9d, 0xa6, 0xe0, 0xc9, 0x4b, 0x19, 0xa6,
-	0x32, 0xe5, 0xc3, 0x11, 0xc4, 0xbc, 0x01, 0xba, 0x0d, 0xc0, 0xd9, 0x90, 0xfa, 0x31, 0xb7, 0x86,
-	0x62, 0x60, 0x8b, 0xa0, 0x8b, 0x29, 0xb2, 0x1d, 0xe9, 0xff, 0x28, 0x50, 0xcd, 0x0e, 0xab, 0x57,
-	0x6a, 0xab, 0xbc, 0x7b, 0x6d, 0xd1, 0x3d, 0xb8, 0x76, 0xa9, 0x41, 0x87, 0x81, 0xb8, 0xcb, 0x69,
-	0xe6, 0xb5, 0x31, 0x2f, 0xc5, 0xd1, 0x63, 0xa8, 0x86, 0x34, 0x8a, 0x5d, 0x3e, 0x3e, 0x6e, 0xfe,
-	0x1d, 0x8e, 0x5b, 0x49, 0xd6, 0x8e, 0xce, 0x7b, 0x13, 0x0a, 0xe2, 0x6e, 0xcb, 0x52, 0xcb, 0x0b,
-	0x83, 0xe7, 0x49, 0xc0, 0xba, 0x64, 0x48, 0xf5, 0xdf, 0x14, 0x28, 0x4d, 0xac, 0x17, 0xa9, 0x91,
-	0x73, 0x39, 0xb4, 0x48, 0x28, 0x8e, 0x99, 0x17, 0x03, 0x2c, 0x41, 0x8c, 0x70, 0x80, 0xbe, 0x14,
-	0x3d, 0x26, 0xdd, 0x22, 0xe2, 0xf4, 0x92, 0x4c, 0x8b, 0x69, 0xc7, 0xc0, 0xbb, 0x26, 0xb6, 0x44,
-	0x36, 0x70, 0xaa, 0xb8, 0x19, 0x7b, 0xb6, 0xe8, 0x2e, 0x87, 0x1e, 0x11, 0x71, 0xb0, 0x64, 0x00,
-	0xca, 0x7b, 0x8f, 0xcb, 0x29, 0x98, 0xcc, 0xbf, 0x5b, 0x50, 0xa0, 0x9e, 0xed, 0x3b, 0xe2, 0xd8,
-	0x49, 0xbc, 0x63, 0x5b, 0xff, 0x55, 0x81, 0xf2, 0x64, 0x9f, 0xa0, 0xbb, 0x42, 0x91, 0xd3, 0x70,
-	0xc8, 0x3c, 0x16, 0x71, 0x66, 0xa7, 0x3d, 0x9e, 0x05, 0xc5, 0x23, 0xe7, 0xfa, 0x36, 0x71, 0x65,
-	0xc8, 0x05, 0x9c, 0x18, 0x48, 0x87, 0x72, 0x14, 0x1f, 0x46, 0x76, 0xc8, 0x02, 0x91, 0x7d, 0x19,
-	0x4c, 0x01, 0x67, 0x30, 0x11, 0x4c, 0xc4, 0x09, 0xa7, 0x47, 0xb1, 0x2b, 0x83, 0xa9, 0xe0, 0xb1,
-	0x8d, 0x1a, 0x50, 0x3a, 0x26, 0xde, 0x80, 0x79, 0x03, 0xf1, 0x49, 0x53, 0x9b, 0x95, 0xcb, 0x21,
-	0x85, 0x8c, 0x80, 0xad, 0xea, 0x50, 0x34, 0xbf, 0xe9, 0x9b, 0xdd, 0xdd, 0x4e, 0xaf, 0x2b, 0x1e,
-	0x90, 0x6e, 0xaf, 0x6b, 0x26, 0x0f, 0x88, 0x81, 0x5b, 0x8f, 0x3a, 0xfb, 0xa6, 0xa6, 0xac, 0xfe,
-	0xa8, 0x40, 0x79, 0xb2, 0x6b, 0x50, 0x19, 0x0a, 0xed, 0xce, 0xae, 0xd1, 0xdc, 0x32, 0xdb, 0xda,
-	0x0c, 0xd2, 0xa0, 0xfc, 0xd0, 0xec, 0x5b, 0xcd, 0xad, 0x5e, 0xeb, 0x71, 0x77, 0x6f, 0x5b, 0x53,
-	0xd0, 0x22, 0x68, 0x63, 0xc4, 0x6a, 0x1e, 0x58, 0x02, 0xcd, 0xa1, 0x5b, 0x70, 0x63, 0xd7, 0xec,
-	0x5b, 0x5b, 0x46, 0xdf, 0xdc, 0xed, 0x5b, 0x9d, 0xae, 0xb5, 0x6d, 0xf6, 0x8d, 0xb6, 0xd1, 0x37,
-	0xb4, 0x3c, 0xba, 0x01, 0x28, 0xeb, 0x6b, 0xf6, 0xda, 0x07, 0x9a, 0x2a, 0xb4, 0xf7, 0x4d, 0xdc,
-	0xd9, 0xec, 0xb4, 0x0c, 0xb1, 0xbb, 0x36, 0xbb, 0xfa, 0x83, 0x02, 0xa5, 0x89, 0xda, 0xa1, 0x22,
-	0xcc, 0x9a, 0xdb, 0x3b, 0xfd, 0x83, 0x24, 0x10, 0xe9, 0x11, 0x5b, 0x1a, 0xf8, 0xa1, 0xa6, 0xa0,
-	0xeb, 0xb0, 0x90, 0x20, 0x2d, 0xa3, 0xdb, 0xeb, 0x76, 0x5a, 0xc6, 0x96, 0x96, 0x13, 0xd1, 0x25,
-	0x60, 0xbb, 0x23, 0x8f, 0x64, 0xe0, 0x03, 0x2d, 0x8f, 0x1a, 0xf0, 0xde, 0x55, 0xd4, 0xea, 0x61,
-	0xab, 0x87, 0xdb, 0x26, 0x36, 0xdb, 0x9a, 0x2a, 0x52, 0xd2, 0x36, 0x37, 0x8d, 0xbd, 0xad, 0xbe,
-	0x36, 0xd7, 0x6c, 0xfe, 0x72, 0x51, 0x57, 0x9e, 0x5e, 0xd4, 0x95, 0x67, 0x17, 0x75, 0xe5, 0xaf,
-	0x8b, 0xba, 0xf2, 0xd3, 0xf3, 0xfa, 0xcc, 0xb3, 0xe7, 0xf5, 0x99, 0x3f, 0x9f, 0xd7, 0x67, 0xbe,
-	0xbd, 0x3b, 0x60, 0xfc, 0x38, 0x3e, 0x5c, 0xb3, 0xfd, 0xe1, 0x7a, 0xe6, 0x3b, 0xfc, 0x34, 0xf9,
-	0x12, 0x17, 0x4f, 0x44, 0x74, 0x38, 0x27, 0x3f, 0xac, 0xef, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0xd6, 0x1c, 0x3e, 0x83, 0xab, 0x0b, 0x00, 0x00,
+	// 1752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0x36, 0x29, 0x5a, 0x16, 0x9f, 0x7e, 0x98, 0x99, 0xa4, 0xa9, 0x36, 0x9b, 0x95, 0xbc, 0xdc,
+	0x6c, 0x37, 0xc8, 0xa2, 0x0a, 0x9a, 0xec, 0x02, 0x8b, 0xed, 0x02, 0x05, 0x25, 0xd1, 0x8e, 0x1a,
+	0x59, 0x52, 0x47, 0xb4, 0x1b, 0xf7, 0x42, 0x8c, 0xa9, 0xb1, 0x3c, 0x58, 0x8a, 0x64, 0xc9, 0x61,
+	0x60, 0xe7, 0xdc, 0x53, 0x4f, 0x05, 0x7a, 0xef, 0xb1, 0x68, 0x51, 0xa0, 0x40, 0xff, 0x84, 0xde,
+	0xf6, 0xb8, 0xc7, 0x9e, 0x8c, 0x22, 0x39, 0x14, 0xcd, 0x31, 0xf7, 0x02, 0xc5, 0x0c, 0x29, 0x59,
+	0x72, 0x94, 0xb4, 0x3e, 0x49, 0xef, 0x7b, 0xdf, 0x7c, 0xf3, 0x66, 0xe6, 0xbd, 0x37, 0x43, 0xf8,
+	0x91, 0x4f, 0x9e, 0x93, 0x80, 0xf2, 0x87, 0xe2, 0xf7, 0x61, 0x12, 0x51, 0xef, 0x21, 0x89, 0x98,
+	0xeb, 0x85, 0xbe, 0x4f, 0x3d, 0xce, 0xc2, 0xa0, 0x15, 0xc5, 0x21, 0x0f, 0xd1, 0x8d, 0x9c, 0xd7,
+	0x12, 0xbf, 0x2d, 0xc1, 0xbb, 0x73, 0x6b, 0x1a, 0x4e, 0x43, 0xe9, 0x7d, 0x28, 0xfe, 0x65, 0x44,
+	0xf3, 0x3f, 0x05, 0xa8, 0x5a, 0x11, 0xeb, 0x2c, 0x04, 0x50, 0x1d, 0xb6, 0x68, 0x40, 0x8e, 0x7d,
+	0x3a, 0xa9, 0x2b, 0x3b, 0xca, 0xfd, 0x12, 0x9e, 0x9b, 0x68, 0x04, 0xdb, 0x97, 0x13, 0xb9, 0x13,
+	0xc2, 0x49, 0x5d, 0xdd, 0x51, 0xee, 0x97, 0x1f, 0x7d, 0xdc, 0x7a, 0x6b, 0xba, 0xd6, 0xa5, 0x62,
+	0x97, 0x70, 0xd2, 0xd6, 0xbe, 0xbb, 0x68, 0x6e, 0xe0, 0x9a, 0xb7, 0x82, 0xa2, 0x07, 0xa0, 0x91,
+	0x88, 0x25, 0xf5, 0xc2, 0x4e, 0xe1, 0x7e, 0xf9, 0xd1, 0xed, 0x35, 0x32, 0x56, 0xc4, 0xb0, 0xe4,
+	0xa0, 0xc7, 0xb0, 0x75, 0x4a, 0xc9, 0x84, 0xc6, 0x49, 0x5d, 0x93, 0xf4, 0x0f, 0xd6, 0xd0, 0x9f,
+	0x48, 0x06, 0x9e, 0x33, 0x51, 0x1f, 0x0c, 0x16, 0x9c, 0xd2, 0x98, 0x71, 0x12, 0x78, 0xd4, 0x95,
+	0x93, 0x6d, 0xee, 0x14, 0xfe, 0xaf, 0x98, 0xf1, 0xf6, 0xd2, 0x50, 0x4b, 0x84, 0xd0, 0x07, 0x23,
+	0x22, 0x71, 0x42, 0xdd, 0x09, 0x8b, 0x05, 0xef, 0x39, 0x4d, 0xea, 0xc5, 0x77, 0xaa, 0x8d, 0x04,
+	0xb5, 0x3b, 0x67, 0xe2, 0xed, 0x68, 0xc5, 0x4e, 0xd0, 0x37, 0x00, 0xf4, 0x8c, 0xd3, 0x20, 0x61,
+	0x61, 0x90, 0xd4, 0xb7, 0xa4, 0xce, 0xdd, 0x35, 0x3a, 0xf6, 0x9c, 0x84, 0x97, 0xf8, 0xc8, 0x86,
+	0xea, 0x73, 0x1a, 0xb3, 0x13, 0xe6, 0x11, 0x2e, 0x05, 0x4a, 0x52, 0xa0, 0xb9, 0x46, 0xe0, 0x70,
+	0x89, 0x87, 0x57, 0x47, 0x99, 0xbf, 0x06, 0x7d, 0xa1, 0x8f, 0x10, 0x68, 0x01, 0x99, 0x51, 0x79,
+	0xee, 0x3a, 0x96, 0xff, 0xd1, 0x27, 0x50, 0xf5, 0x52, 0x77, 0x96, 0xfa, 0x9c, 0x45, 0x3e, 0xa3,
+	0xb1, 0x3c, 0x72, 0x15, 0x57, 0xbc, 0x74, 0x7f, 0x81, 0xa1, 0xcf, 0x41, 0x8b, 0x53, 0x9f, 0xd6,
+	0x0b, 0x32, 0x1d, 0x7e, 0xb8, 0x26, 0x06, 0x9c, 0xfa, 0x14, 0x4b, 0x92, 0xf9, 0x07, 0x05, 0x34,
+	0x61, 0xa2, 0x5b, 0xb0, 0x79, 0xec, 0x87, 0xde, 0xb7, 0x72, 0x3e, 0x0d, 0x67, 0x06, 0xfa, 0x10,
+	0x74, 0x91, 0xd2, 0x62, 0xf2, 0xa4, 0xae, 0xee, 0x14, 0xee, 0xeb, 0xb8, 0x44, 0x22, 0x36, 0x10,
+	0x36, 0xfa, 0x0c, 0xb6, 0x67, 0x94, 0x9f, 0x86, 0x13, 0x37, 0x8a, 0xe9, 0x09, 0x3b, 0xa3, 0x59,
+	0xee, 0xe8, 0xb8, 0x96, 0xc1, 0xa3, 0x1c, 0x45, 0x5f, 0x43, 0x31, 0x22, 0x31, 0x99, 0xcd, 0x93,
+	0xe5, 0xee, 0xfa, 0x03, 0x22, 0x33, 0x11, 0x49, 0x9e, 0x9d, 0xf9, 0x08, 0xf3, 0xcf, 0x2a, 0xe8,
+	0x0b, 0x1f, 0xfa, 0x46, 0x2a, 0x25, 0x34, 0x96, 0x61, 0x96, 0x1f, 0x35, 0xd6, 0x28, 0xb5, 0x45,
+	0xe4, 0xf2, 0xbc, 0xe3, 0x25, 0xad, 0x84, 0xc6, 0xc8, 0x82, 0x52, 0x18, 0xd1, 0x98, 0xf0, 0x30,
+	0xdb, 0xb9, 0xda, 0xa3, 0x4f, 0xdf, 0x17, 0x49, 0x6b, 0x98, 0x93, 0xf1, 0x62, 0x98, 0xd8, 0xa6,
+	0xe7, 0xc4, 0x4f, 0xb3, 0xdd, 0xd5, 0x71, 0x66, 0x98, 0xbf, 0x51, 0xa0, 0x34, 0x27, 0x23, 0x1d,
+	0x36, 0xed, 0x5f, 0x1c, 0x58, 0x7d, 0x63, 0x03, 0x55, 0x41, 0x1f, 0x0c, 0x1d, 0x37, 0x33, 0x15,
+	0x54, 0x86, 0xad, 0x3d, 0x6c, 0x5b, 0x8e, 0x8d, 0x0d, 0x15, 0xdd, 0x80, 0x6a, 0x6e, 0xe4, 0xfe,
+	0x02, 0x2a, 0x81, 0xd6, 0xb7, 0xc7, 0x63, 0x43, 0x43, 0x35, 0x00, 0xf1, 0x2f, 0xf7, 0x6c, 0x22,
+	0x80, 0xa2, 0xfd, 0xac, 0x37, 0x76, 0xc6, 0x46, 0x11, 0x55, 0xa0, 0xd4, 0x19, 0x0e, 0x1c, 0xab,
+	0x37, 0x18, 0x1b, 0x5b, 0xc2, 0x33, 0xc2, 0xf6, 0x6e, 0xef, 0x99, 0x51, 0x32, 0xff, 0xa8, 0x42,
+	0x65, 0x39, 0xbf, 0xd6, 0xe6, 0xd0, 0xcf, 0x61, 0xfb, 0x4a, 0xdd, 0xbc, 0xa7, 0x71, 0x5c, 0x29,
+	0x9b, 0xda, 0x6a, 0xd9, 0xa0, 0x2f, 0xa1, 0x28, 0x37, 0x60, 0xde, 0x34, 0x3e, 0x7a, 0x97, 0xc4,
+	0xa1, 0x60, 0xe1, 0x9c, 0x8c, 0x46, 0x50, 0x4a, 0xa8, 0x48, 0x7d, 0x7e, 0x5e, 0xd7, 0xe4, 0x39,
+	0x7c, 0xf1, 0x3f, 0x2a, 0x65, 0xc5, 0x18, 0xe7, 0x63, 0xf1, 0x42, 0xc5, 0xfc, 0x31, 0xdc, 0x5a,
+	0xc7, 0x10, 0x3b, 0xba, 0x4b, 0x98, 0x6f, 0x6c, 0x88, 0xbd, 0xff, 0x25, 0x89, 0x03, 0x16, 0x4c,
+	0x0d, 0xc5, 0x7c, 0x01, 0x70, 0x19, 0x16, 0xba, 0x0b, 0xfa, 0xa2, 0x96, 0xf3, 0xad, 0xba, 0x04,
+	0xd0, 0xa7, 0x50, 0xa3, 0x67, 0x11, 0xf5, 0x38, 0x9d, 0xb8, 0xd9, 0xd1, 0xab, 0x92, 0x52, 0x9d,
+	0xa3, 0x99, 0xc8, 0x67, 0xb0, 0xed, 0x13, 0x4e, 0x13, 0xee, 0x4e, 0x58, 0x22, 0xbb, 0x94, 0x4c,
+	0x11, 0x0d, 0xd7, 0x32, 0xb8, 0x9b, 0xa3, 0xe6, 0xdf, 0x54, 0xa8, 0xad, 0xf6, 0x36, 0x74, 0x08,
+	0x55, 0x51, 0x65, 0x2c, 0xe0, 0x34, 0x3e, 0x21, 0x5e, 0x7e, 0x5e, 0xed, 0x9f, 0xbc, 0xbe, 0x68,
+	0xae, 0x3a, 0xde, 0x5c, 0x34, 0xef, 0xce, 0x48, 0x94, 0xf0, 0x38, 0xf5, 0x78, 0x1a, 0xd3, 0xaf,
+	0xcd, 0x15, 0xb7, 0x89, 0x2b, 0x24, 0x62, 0xbd, 0xb9, 0x29, 0x74, 0xa5, 0x2f, 0x20, 0xbe, 0x1b,
+	0x11, 0x7e, 0x5a, 0x57, 0x2f, 0x75, 0x57, 0x1c, 0x6f, 0xeb, 0xae, 0xb8, 0x4d, 0x5c, 0x99, 0xdb,
+	0x23, 0xc2, 0x4f, 0xd1, 0x63, 0xd0, 0xf8, 0x79, 0x94, 0xd7, 0x40, 0xbb, 0xf9, 0xfa, 0xa2, 0x29,
+	0xed, 0x37, 0x17, 0xcd, 0x9b, 0xab, 0x2a, 0x02, 0x35, 0xb1, 0x74, 0x8a, 0x26, 0x40, 0x26, 0x13,
+	0x37, 0x0c, 0xe4, 0x91, 0xeb, 0xed, 0x4f, 0x5e, 0x5f, 0x34, 0x73, 0xe4, 0xcd, 0x45, 0xf3, 0x07,
+	0x57, 0x96, 0x25, 0x71, 0x13, 0x6f, 0x92, 0xc9, 0x64, 0x18, 0x98, 0xff, 0x52, 0xa0, 0x98, 0xdd,
+	0x26, 0x6b, 0x53, 0xfa, 0x2b, 0xd0, 0xbe, 0x65, 0xc1, 0x24, 0xaf, 0xe9, 0x7b, 0xef, 0xbc, 0x8a,
+	0xf2, 0x1f, 0xe7, 0x3c, 0xa2, 0x58, 0x8e, 0x40, 0x6d, 0xa8, 0x9c, 0xa4, 0x41, 0x76, 0x87, 0x72,
+	0x32, 0x95, 0x2b, 0xaa, 0xad, 0xed, 0xdb, 0xbb, 0x07, 0x83, 0x8e, 0xd3, 0x1b, 0x0e, 0x5c, 0xc7,
+	0xda, 0xc3, 0xe5, 0xf9, 0x20, 0x87, 0x4c, 0xcd, 0xa7, 0x00, 0x97, 0xba, 0xa2, 0xe4, 0x23, 0x92,
+	0x24, 0x6e, 0x42, 0x83, 0x89, 0xb1, 0x21, 0x0a, 0x59, 0x9a, 0x31, 0x8d, 0xfc, 0x73, 0x43, 0x59,
+	0xb8, 0x8f, 0x43, 0x7e, 0x6a, 0xa8, 0x68, 0x1b, 0xca, 0xd2, 0x64, 0xd3, 0x20, 0x8c, 0xa9, 0x51,
+	0x30, 0xdf, 0xa8, 0x50, 0xb0, 0x22, 0xf6, 0x9e, 0x8b, 0x7f, 0xbe, 0x01, 0xea, 0x95, 0x7b, 0x21,
+	0x9c, 0x45, 0x29, 0xa7, 0x6e, 0x1a, 0x30, 0x9e, 0xe4, 0xa9, 0x57, 0xc9, 0xc1, 0x03, 0x81, 0xa1,
+	0x16, 0xdc, 0xa4, 0x67, 0x3c, 0x26, 0xee, 0x2a, 0x55, 0x93, 0xd4, 0x1b, 0xd2, 0xd5, 0x59, 0xe6,
+	0x5b, 0x50, 0xf2, 0x08, 0xa7, 0xd3, 0x30, 0x3e, 0xaf, 0x17, 0x65, 0x87, 0x58, 0xb7, 0x2f, 0xe3,
+	0x88, 0x7a, 0x9d, 0x9c, 0x96, 0xb7, 0xdb, 0xc5, 0x30, 0xd4, 0x83, 0xaa, 0xbc, 0x47, 0x5c, 0xd1,
+	0x37, 0x58, 0x30, 0xad, 0x6f, 0x5d, 0xa3, 0x6b, 0x57, 0x8e, 0xe7, 0x10, 0x0b, 0xa6, 0xe8, 0x23,
+	0x00, 0xce, 0x66, 0x34, 0x4c, 0xb9, 0x3b, 0x13, 0xf7, 0xab, 0x08, 0x5a, 0xcf, 0x91, 0xfd, 0x04,
+	0xfd, 0x14, 0xc0, 0x4b, 0xdd, 0x93, 0x30, 0x9e, 0xa5, 0x3e, 0xa9, 0xeb, 0x3b, 0xca, 0x3b, 0xae,
+	0x99, 0x4e, 0xba, 0x9b, 0x71, 0xb0, 0xee, 0xcd, 0xff, 0x9a, 0x7f, 0x57, 0x41, 0x5f, 0x38, 0xd0,
+	0x97, 0x79, 0x36, 0x29, 0x32, 0x17, 0x3e, 0x7e, 0x9f, 0x48, 0xeb, 0x29, 0x0b, 0x26, 0x79, 0x2a,
+	0x7d, 0x05, 0xda, 0x49, 0x1c, 0xce, 0xea, 0xea, 0x35, 0x96, 0x28, 0x47, 0xa0, 0x2f, 0x40, 0xe5,
+	0x61, 0xbd, 0x70, 0x8d, 0x71, 0x2a, 0x0f, 0x51, 0x03, 0xca, 0x5e, 0xea, 0x46, 0x34, 0x96, 0xe7,
+	0x98, 0x1f, 0xa3, 0xee, 0xa5, 0x23, 0x1a, 0x8b, 0xf3, 0x13, 0x57, 0xb7, 0x70, 0xb8, 0x09, 0x7b,
+	0x41, 0xeb, 0x9b, 0xd2, 0x5b, 0x12, 0xc0, 0x98, 0xbd, 0xa0, 0xc2, 0x39, 0x23, 0x67, 0x79, 0x06,
+	0x14, 0x33, 0xe7, 0x8c, 0x9c, 0xc9, 0x83, 0x37, 0x1f, 0x83, 0x26, 0xd6, 0x25, 0x9a, 0xe7, 0x60,
+	0x38, 0xb0, 0x8d, 0x0d, 0x91, 0xa6, 0xed, 0xfe, 0xb0, 0xf3, 0xd4, 0xc5, 0xd6, 0x60, 0xcf, 0x36,
+	0x14, 0x64, 0x40, 0xc5, 0xc2, 0xd8, 0x3a, 0x72, 0xfb, 0xf6, 0x60, 0xcf, 0x79, 0x62, 0xa8, 0xe6,
+	0xbf, 0x15, 0xa8, 0xad, 0xde, 0x16, 0x6f, 0x15, 0x97, 0x72, 0xfd, 0xe2, 0x42, 0x9f, 0xc3, 0x8d,
+	0x4b, 0x0d, 0x3a, 0x8b, 0x44, 0x33, 0xcd, 0x53, 0xdf, 0x58, 0xf0, 0x72, 0x1c, 0x3d, 0x85, 0x5a,
+	0x4c, 0x93, 0xd4, 0xe7, 0x8b, 0x7c, 0xbb, 0xce, 0xa6, 0x56, 0xb3, 0xb1, 0xf3, 0x84, 0xfb, 0x00,
+	0x4a, 0xf3, 0xa7, 0x4f, 0xd6, 0xb1, 0xf0, 0x56, 0xfe, 0xf2, 0x31, 0xff, 0xaa, 0x40, 0x79, 0x69,
+	0xbc, 0xc8, 0xcd, 0xec, 0x85, 0xe1, 0x92, 0x58, 0x2c, 0x53, 0xbc, 0x81, 0xf4, 0x0c, 0xb1, 0xe2,
+	0x29, 0xfa, 0x19, 0x94, 0x33, 0xc3, 0x15, 0x11, 0xe7, 0x5d, 0x6a, 0x5d, 0x4c, 0x23, 0x0b, 0x8f,
+	0x6d, 0xec, 0x8a, 0xdd, 0xc0, 0xb9, 0xe2, 0x6e, 0x1a, 0x78, 0xa2, 0xbc, 0x27, 0xf4, 0x84, 0x88,
+	0x85, 0x2d, 0x3f, 0x3e, 0x2a, 0x39, 0x98, 0x5d, 0x40, 0x77, 0xa0, 0x44, 0x03, 0x2f, 0x9c, 0x88,
+	0x65, 0x67, 0xf1, 0x2e, 0x6c, 0xf3, 0x2f, 0x0a, 0x54, 0x96, 0x0b, 0x15, 0xdd, 0x13, 0x8a, 0x9c,
+	0xc6, 0x33, 0x16, 0xb0, 0x84, 0x33, 0x2f, 0x6f, 0x32, 0xab, 0xa0, 0x78, 0xec, 0xf8, 0xa1, 0x47,
+	0x7c, 0x19, 0x72, 0x09, 0x67, 0x06, 0x32, 0xa1, 0x92, 0xa4, 0xc7, 0x89, 0x17, 0xb3, 0x48, 0xec,
+	0xbe, 0x0c, 0xa6, 0x84, 0x57, 0x30, 0x11, 0x4c, 0xc2, 0x09, 0xa7, 0x27, 0xa9, 0x2f, 0x83, 0xa9,
+	0xe2, 0x85, 0x8d, 0x9a, 0x50, 0x3e, 0x25, 0xc1, 0x94, 0x05, 0x53, 0xf1, 0x09, 0x20, 0x53, 0xb3,
+	0x84, 0x21, 0x87, 0xac, 0x88, 0x3d, 0x30, 0x41, 0xb7, 0x9f, 0x39, 0xf6, 0x60, 0xdc, 0x1b, 0x0e,
+	0x96, 0x92, 0xb0, 0x0c, 0x5b, 0x16, 0xee, 0x3c, 0xe9, 0x1d, 0xda, 0x86, 0xf2, 0xe0, 0xb7, 0x0a,
+	0x54, 0x96, 0xb3, 0x46, 0xbc, 0x8a, 0xba, 0xbd, 0xb1, 0xd5, 0xee, 0xdb, 0x5d, 0x63, 0x43, 0xe4,
+	0xe7, 0x9e, 0xed, 0xb8, 0x32, 0x69, 0x07, 0x07, 0xfb, 0x86, 0x82, 0x6e, 0x81, 0xb1, 0x40, 0xdc,
+	0xf6, 0x91, 0x2b, 0x50, 0x15, 0xdd, 0x81, 0xdb, 0x63, 0xdb, 0x71, 0xfb, 0x96, 0x63, 0x8f, 0x1d,
+	0xb7, 0x37, 0x70, 0xf7, 0x6d, 0xc7, 0xea, 0x5a, 0x8e, 0x65, 0x14, 0xd0, 0x6d, 0x40, 0xab, 0xbe,
+	0xf6, 0xb0, 0x7b, 0x64, 0x68, 0x42, 0xfb, 0xd0, 0xc6, 0xbd, 0xdd, 0x5e, 0xc7, 0x12, 0xb3, 0x1b,
+	0x9b, 0x0f, 0x7e, 0xaf, 0x40, 0x79, 0xe9, 0xec, 0xe4, 0x0b, 0x70, 0x7f, 0xe4, 0x1c, 0x65, 0x81,
+	0x48, 0x8f, 0x98, 0xd2, 0xc2, 0x7b, 0x86, 0x82, 0x6e, 0xc2, 0x76, 0x86, 0x74, 0xac, 0xc1, 0x70,
+	0xd0, 0xeb, 0x58, 0x7d, 0x43, 0x15, 0xd1, 0x65, 0x60, 0xb7, 0x27, 0x97, 0x64, 0xe1, 0x23, 0xa3,
+	0x80, 0x9a, 0xf0, 0xe1, 0x55, 0xd4, 0x1d, 0x62, 0x77, 0x88, 0xbb, 0x36, 0xb6, 0xbb, 0x86, 0x26,
+	0xb6, 0xa4, 0x6b, 0xef, 0x5a, 0x07, 0x7d, 0xc7, 0x28, 0x8a, 0xab, 0x26, 0x63, 0x8f, 0x2c, 0xe7,
+	0x89, 0xb1, 0xd5, 0x6e, 0xff, 0xe9, 0x65, 0x43, 0xf9, 0xee, 0x65, 0x43, 0xf9, 0xfe, 0x65, 0x43,
+	0xf9, 0xe7, 0xcb, 0x86, 0xf2, 0xbb, 0x57, 0x8d, 0x8d, 0xef, 0x5f, 0x35, 0x36, 0xfe, 0xf1, 0xaa,
+	0xb1, 0xf1, 0xab, 0x7b, 0x53, 0xc6, 0x4f, 0xd3, 0xe3, 0x96, 0x17, 0xce, 0x1e, 0xae, 0x7c, 0xc7,
+	0x9e, 0x65, 0x5f, 0xb2, 0xe2, 0xce, 0x4e, 0x8e, 0x8b, 0xf2, 0xc3, 0xf4, 0xf1, 0x7f, 0x07, 0x00,
+	0x06, 0xa7, 0x4f, 0xe9, 0xeb, 0x0e, 0x00, 0x00,
 }
 
 func (this *ApiCollection) Equal(that interface{}) bool {
@@ -1186,6 +1470,60 @@ func (this *Rule) Equal(that interface{}) bool {
 	if this.Block != that1.Block {
 		return false
 	}
+	if len(this.ApiNames) != len(that1.ApiNames) {
+		return false
+	}
+	for i := range this.ApiNames {
+		if this.ApiNames[i] != that1.ApiNames[i] {
+			return false
+		}
+	}
+	if len(this.MethodPrefixes) != len(that1.MethodPrefixes) {
+		return false
+	}
+	for i := range this.MethodPrefixes {
+		if this.MethodPrefixes[i] != that1.MethodPrefixes[i] {
+			return false
+		}
+	}
+	if len(this.Params) != len(that1.Params) {
+		return false
+	}
+	for i := range this.Params {
+		if !this.Params[i].Equal(&that1.Params[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ParamRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParamRule)
+	if !ok {
+		that2, ok := that.(ParamRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Parser.Equal(&that1.Parser) {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
 	return true
 }
 func (this *Verification) Equal(that interface{}) bool {
@@ -1359,6 +1697,48 @@ func (this *Api) Equal(that interface{}) bool {
 	if this.TimeoutMs != that1.TimeoutMs {
 		return false
 	}
+	if !this.CuFormula.Equal(that1.CuFormula) {
+		return false
+	}
+	return true
+}
+func (this *CuFormula) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CuFormula)
+	if !ok {
+		that2, ok := that.(CuFormula)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if !this.From.Equal(&that1.From) {
+		return false
+	}
+	if !this.To.Equal(&that1.To) {
+		return false
+	}
+	if this.CuPerUnit != that1.CuPerUnit {
+		return false
+	}
+	if this.UnitSize != that1.UnitSize {
+		return false
+	}
+	if this.MaxUnits != that1.MaxUnits {
+		return false
+	}
 	return true
 }
 func (this *ParseDirective) Equal(that interface{}) bool {
@@ -1663,6 +2043,38 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApiCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MethodPrefixes) > 0 {
+		for iNdEx := len(m.MethodPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MethodPrefixes[iNdEx])
+			copy(dAtA[i:], m.MethodPrefixes[iNdEx])
+			i = encodeVarintApiCollection(dAtA, i, uint64(len(m.MethodPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ApiNames) > 0 {
+		for iNdEx := len(m.ApiNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApiNames[iNdEx])
+			copy(dAtA[i:], m.ApiNames[iNdEx])
+			i = encodeVarintApiCollection(dAtA, i, uint64(len(m.ApiNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Block != 0 {
 		i = encodeVarintApiCollection(dAtA, i, uint64(m.Block))
 		i--
@@ -1671,7 +2083,7 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Verification) Marshal() (dAtA []byte, err error) {
+func (m *ParamRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1681,31 +2093,76 @@ func (m *Verification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamRule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Severity != 0 {
-		i = encodeVarintApiCollection(dAtA, i, uint64(m.Severity))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintApiCollection(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1a
 	}
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApiCollection(dAtA, i, uint64(size))
-			}
+	if m.Operator != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Parser.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApiCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Verification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApiCollection(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -1885,6 +2342,18 @@ func (m *Api) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CuFormula != nil {
+		{
+			size, err := m.CuFormula.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApiCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.TimeoutMs != 0 {
 		i = encodeVarintApiCollection(dAtA, i, uint64(m.TimeoutMs))
 		i--
@@ -1940,6 +2409,69 @@ func (m *Api) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CuFormula) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CuFormula) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CuFormula) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUnits != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.MaxUnits))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnitSize != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.UnitSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CuPerUnit != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.CuPerUnit))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApiCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApiCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Kind != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ParseDirective) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2201,6 +2733,42 @@ func (m *Rule) Size() (n int) {
 	if m.Block != 0 {
 		n += 1 + sovApiCollection(uint64(m.Block))
 	}
+	if len(m.ApiNames) > 0 {
+		for _, s := range m.ApiNames {
+			l = len(s)
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
+	if len(m.MethodPrefixes) > 0 {
+		for _, s := range m.MethodPrefixes {
+			l = len(s)
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parser.Size()
+	n += 1 + l + sovApiCollection(uint64(l))
+	if m.Operator != 0 {
+		n += 1 + sovApiCollection(uint64(m.Operator))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovApiCollection(uint64(l))
+	}
 	return n
 }
 
@@ -2320,6 +2888,35 @@ func (m *Api) Size() (n int) {
 	if m.TimeoutMs != 0 {
 		n += 1 + sovApiCollection(uint64(m.TimeoutMs))
 	}
+	if m.CuFormula != nil {
+		l = m.CuFormula.Size()
+		n += 1 + l + sovApiCollection(uint64(l))
+	}
+	return n
+}
+
+func (m *CuFormula) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovApiCollection(uint64(m.Kind))
+	}
+	l = m.From.Size()
+	n += 1 + l + sovApiCollection(uint64(l))
+	l = m.To.Size()
+	n += 1 + l + sovApiCollection(uint64(l))
+	if m.CuPerUnit != 0 {
+		n += 1 + sovApiCollection(uint64(m.CuPerUnit))
+	}
+	if m.UnitSize != 0 {
+		n += 1 + sovApiCollection(uint64(m.UnitSize))
+	}
+	if m.MaxUnits != 0 {
+		n += 1 + sovApiCollection(uint64(m.MaxUnits))
+	}
 	return n
 }
 
@@ -2885,59 +3482,9 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApiCollection(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApiCollection
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Verification) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApiCollection
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2965,13 +3512,13 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ApiNames = append(m.ApiNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParseDirective", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodPrefixes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApiCollection
@@ -2981,31 +3528,27 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApiCollection
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApiCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParseDirective == nil {
-				m.ParseDirective = &ParseDirective{}
-			}
-			if err := m.ParseDirective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MethodPrefixes = append(m.MethodPrefixes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3032,30 +3575,11 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, &ParseValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Params = append(m.Params, ParamRule{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
-			}
-			m.Severity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApiCollection
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Severity |= Verification_VerificationSeverity(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApiCollection(dAtA[iNdEx:])
@@ -3077,7 +3601,7 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParseValue) Unmarshal(dAtA []byte) error {
+func (m *ParamRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3100,17 +3624,17 @@ func (m *ParseValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParseValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParseValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parser", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApiCollection
@@ -3120,7 +3644,312 @@ func (m *ParseValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= ParamRule_Operator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApiCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Verification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApiCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParseDirective", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParseDirective == nil {
+				m.ParseDirective = &ParseDirective{}
+			}
+			if err := m.ParseDirective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &ParseValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= Verification_VerificationSeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApiCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParseValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApiCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParseValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParseValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3712,6 +4541,234 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuFormula", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CuFormula == nil {
+				m.CuFormula = &CuFormula{}
+			}
+			if err := m.CuFormula.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApiCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CuFormula) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApiCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CuFormula: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CuFormula: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= CuFormula_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuPerUnit", wireType)
+			}
+			m.CuPerUnit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuPerUnit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitSize", wireType)
+			}
+			m.UnitSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnitSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnits", wireType)
+			}
+			m.MaxUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApiCollection(dAtA[iNdEx:])
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/lavanet/lava/utils/jsonpath"
+)
+
+// Validate checks the parser arguments that can be checked statically
+func (bp *BlockParser) Validate() error {
+	if bp.ParserFunc != PARSER_FUNC_PARSE_PATH {
+		return nil
+	}
+	if len(bp.ParserArg) == 0 {
+		return fmt.Errorf("parse path must have at least one path expression")
+	}
+	for _, expr := range bp.ParserArg {
+		if _, err := jsonpath.Compile(expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
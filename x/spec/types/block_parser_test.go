@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSpecParsePath(t *testing.T) {
+	spec := Spec{
+		Name:                      "test",
+		Index:                     "TEST",
+		ReliabilityThreshold:      1,
+		BlocksInFinalizationProof: 1,
+		AverageBlockTime:          1000,
+		AllowedBlockLagForQosSync: 1,
+		MinStakeProvider:          sdk.NewCoin("ulava", sdk.NewInt(1)),
+		ApiCollections: []*ApiCollection{{
+			Enabled:        true,
+			CollectionData: CollectionData{ApiInterface: APIInterfaceJsonRPC, Type: "POST"},
+			Apis: []*Api{{
+				Enabled:      true,
+				Name:         "getBlock",
+				ComputeUnits: 10,
+				BlockParsing: BlockParser{
+					ParserArg:  []string{"$[0].block_id.block_number", "$[0].block_id"},
+					ParserFunc: PARSER_FUNC_PARSE_PATH,
+				},
+			}},
+		}},
+	}
+	_, err := spec.ValidateSpec(100)
+	require.NoError(t, err)
+
+	spec.ApiCollections[0].Apis[0].BlockParsing.ParserArg = []string{"$[0].block_id.", "$[0].block_id"}
+	_, err = spec.ValidateSpec(100)
+	require.Error(t, err)
+
+	spec.ApiCollections[0].Apis[0].BlockParsing.ParserArg = []string{}
+	_, err = spec.ValidateSpec(100)
+	require.Error(t, err)
+
+	spec.ApiCollections[0].Apis[0].BlockParsing.ParserArg = []string{"$[0]"}
+	spec.ApiCollections[0].ParseDirectives = []*ParseDirective{{
+		FunctionTag:   FUNCTION_TAG_GET_BLOCKNUM,
+		ResultParsing: BlockParser{ParserArg: []string{"$.result[x]"}, ParserFunc: PARSER_FUNC_PARSE_PATH},
+	}}
+	_, err = spec.ValidateSpec(100)
+	require.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package types
+
+import "fmt"
+
+func (formula *CuFormula) unitSize() uint64 {
+	if formula.UnitSize == 0 {
+		return 1
+	}
+	return formula.UnitSize
+}
+
+// ComputeUnits returns the compute units of a request (of an api with apiCu compute
+// units) with the given units, the units are capped by MaxUnits
+func (formula *CuFormula) ComputeUnits(apiCu uint64, units uint64) uint64 {
+	if units > formula.MaxUnits {
+		units = formula.MaxUnits
+	}
+	unitSize := formula.unitSize()
+	return apiCu + formula.CuPerUnit*((units+unitSize-1)/unitSize)
+}
+
+// Validate checks the formula is well defined and that it adds at most maxExtraCu compute units
+func (formula *CuFormula) Validate(maxExtraCu uint64) error {
+	switch formula.Kind {
+	case CuFormula_NONE:
+		return nil
+	case CuFormula_BLOCK_RANGE:
+		if formula.From.ParserFunc == PARSER_FUNC_EMPTY || formula.To.ParserFunc == PARSER_FUNC_EMPTY {
+			return fmt.Errorf("block range cu formula must set both from and to parsers")
+		}
+	case CuFormula_ARRAY_LENGTH:
+		if formula.From.ParserFunc == PARSER_FUNC_EMPTY {
+			return fmt.Errorf("array length cu formula must set the from parser")
+		}
+	default:
+		return fmt.Errorf("unsupported cu formula kind %s", formula.Kind)
+	}
+
+	for _, parser := range []BlockParser{formula.From, formula.To} {
+		if err := parser.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if formula.CuPerUnit == 0 || formula.MaxUnits == 0 {
+		return fmt.Errorf("cu formula must set cu_per_unit and max_units")
+	}
+	unitSize := formula.unitSize()
+	maxUnits := (formula.MaxUnits + unitSize - 1) / unitSize
+	// checked separately so the product can't overflow
+	if formula.CuPerUnit > maxExtraCu || maxUnits > maxExtraCu || formula.CuPerUnit*maxUnits > maxExtraCu {
+		return fmt.Errorf("cu formula max compute units exceed the allowed %d extra compute units", maxExtraCu)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCuFormula(t *testing.T) {
+	parser := BlockParser{ParserArg: []string{"0"}, ParserFunc: PARSER_FUNC_PARSE_BY_ARG}
+	formula := CuFormula{
+		Kind:      CuFormula_BLOCK_RANGE,
+		From:      parser,
+		To:        parser,
+		CuPerUnit: 2,
+		UnitSize:  100,
+		MaxUnits:  1000,
+	}
+
+	// cu per started unit of 100 blocks, capped at 1000 blocks
+	require.Equal(t, uint64(10), formula.ComputeUnits(10, 0))
+	require.Equal(t, uint64(12), formula.ComputeUnits(10, 1))
+	require.Equal(t, uint64(12), formula.ComputeUnits(10, 100))
+	require.Equal(t, uint64(14), formula.ComputeUnits(10, 101))
+	require.Equal(t, uint64(30), formula.ComputeUnits(10, 100000))
+
+	require.NoError(t, formula.Validate(20))
+	require.Error(t, formula.Validate(19))
+
+	invalid := formula
+	invalid.To = BlockParser{}
+	require.Error(t, invalid.Validate(100))
+
+	invalid = formula
+	invalid.MaxUnits = 0
+	require.Error(t, invalid.Validate(100))
+
+	invalid = formula
+	invalid.CuPerUnit = 1 << 40
+	invalid.UnitSize = 1
+	invalid.MaxUnits = 1 << 40
+	require.Error(t, invalid.Validate(100))
+}
@@ -0,0 +1,107 @@
+package types
+
+import "fmt"
+
+// SpecGetter returns a (non-expanded) spec by its index
+type SpecGetter func(index string) (Spec, bool)
+
+// ExpandSpec returns the spec with its imports expanded (see DoExpandSpec)
+func ExpandSpec(getSpec SpecGetter, spec Spec) (Spec, error) {
+	depends := map[string]bool{spec.Index: true}
+	inherit := map[string]bool{}
+
+	details, err := DoExpandSpec(getSpec, &spec, depends, &inherit, spec.Index)
+	if err != nil {
+		return spec, fmt.Errorf("spec expand failed (imports: %s): %w", details, err)
+	}
+	return spec, nil
+}
+
+// DoExpandSpec expands the "imports" of a spec (recursively, DFS) and inherits
+// their api collections. Imported specs are fetched with getSpec, so the same
+// expansion is used on-chain (from the store) and offline (e.g. from spec files).
+func DoExpandSpec(
+	getSpec SpecGetter,
+	spec *Spec,
+	depends map[string]bool,
+	inherit *map[string]bool,
+	details string,
+) (string, error) {
+	parentsCollections := map[CollectionData][]*ApiCollection{}
+
+	if len(spec.Imports) != 0 {
+		var parents []Spec
+
+		// update (cumulative) inherit
+		for _, index := range spec.Imports {
+			(*inherit)[index] = true
+		}
+
+		// visual markers when import deepens
+		details += "->["
+
+		// recursion to get all parent specs (DFS)
+		comma := ""
+		for _, index := range spec.Imports {
+			imported, found := getSpec(index)
+			// import of unknown Spec not allowed
+			if !found {
+				details += fmt.Sprintf("%s%s(unknown)", comma, index)
+				return details, fmt.Errorf("imported spec unknown: %s", index)
+			}
+
+			details += fmt.Sprintf("%s%s", comma, index)
+
+			// loop in the recursion not allowed
+			if _, found := depends[index]; found {
+				return details, fmt.Errorf("import loops not allowed for spec: %s", index)
+			}
+
+			depends[index] = true
+			details, err := DoExpandSpec(getSpec, &imported, depends, inherit, details)
+			if err != nil {
+				return details, err
+			}
+			delete(depends, index)
+
+			parents = append(parents, imported)
+			comma = ","
+		}
+
+		details += "]"
+
+		for _, parent := range parents {
+			for _, parentCollection := range parent.ApiCollections {
+				// ignore disabled apiCollections
+				if !parentCollection.Enabled {
+					continue
+				}
+				if parentsCollections[parentCollection.CollectionData] == nil {
+					parentsCollections[parentCollection.CollectionData] = []*ApiCollection{}
+				}
+				parentsCollections[parentCollection.CollectionData] = append(parentsCollections[parentCollection.CollectionData], parentCollection)
+			}
+		}
+	}
+
+	myCollections := map[CollectionData]*ApiCollection{}
+	for _, collection := range spec.ApiCollections {
+		myCollections[collection.CollectionData] = collection
+	}
+
+	for _, collection := range spec.ApiCollections {
+		err := collection.InheritAllFields(myCollections, parentsCollections[collection.CollectionData])
+		if err != nil {
+			return details, err
+		}
+		delete(parentsCollections, collection.CollectionData)
+	}
+
+	// combine left over apis not overwritten by current spec
+	err := spec.CombineCollections(parentsCollections)
+	if err != nil {
+		return details, err
+	}
+
+	return details, nil
+}
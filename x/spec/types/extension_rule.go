@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// HasContentRules returns true if the rule routes requests by their content (api name or params)
+func (r *Rule) HasContentRules() bool {
+	return r != nil && (len(r.ApiNames) > 0 || len(r.MethodPrefixes) > 0 || len(r.Params) > 0)
+}
+
+// MatchApiName returns true if the api is selected by the rule's api names and method
+// prefixes (any of them), or if the rule doesn't select apis by name
+func (r *Rule) MatchApiName(apiName string) bool {
+	if len(r.ApiNames) == 0 && len(r.MethodPrefixes) == 0 {
+		return true
+	}
+	for _, name := range r.ApiNames {
+		if apiName == name {
+			return true
+		}
+	}
+	for _, prefix := range r.MethodPrefixes {
+		if strings.HasPrefix(apiName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) Validate() error {
+	if r == nil {
+		return nil
+	}
+	for _, name := range r.ApiNames {
+		if name == "" {
+			return fmt.Errorf("empty api name in rule")
+		}
+	}
+	for _, prefix := range r.MethodPrefixes {
+		if prefix == "" {
+			return fmt.Errorf("empty method prefix in rule")
+		}
+	}
+	for i := range r.Params {
+		if err := r.Params[i].Validate(); err != nil {
+			return fmt.Errorf("invalid param rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (pr *ParamRule) isNumeric() bool {
+	switch pr.Operator {
+	case ParamRule_GREATER, ParamRule_GREATER_EQUAL, ParamRule_LESS, ParamRule_LESS_EQUAL:
+		return true
+	default:
+		return false
+	}
+}
+
+func (pr *ParamRule) Validate() error {
+	if pr.Parser.ParserFunc == PARSER_FUNC_EMPTY {
+		return fmt.Errorf("param rule must have a parser")
+	}
+	if err := pr.Parser.Validate(); err != nil {
+		return err
+	}
+	if _, ok := ParamRule_Operator_name[int32(pr.Operator)]; !ok {
+		return fmt.Errorf("unsupported operator %d", pr.Operator)
+	}
+	if pr.isNumeric() {
+		if _, ok := parseNumber(pr.Value); !ok {
+			return fmt.Errorf("operator %s requires a numeric value, got %q", pr.Operator, pr.Value)
+		}
+	}
+	return nil
+}
+
+// Match returns true if a parsed param value matches the rule. Params that were not
+// found (and have no default value in the parser) never match.
+func (pr *ParamRule) Match(value string, found bool) bool {
+	if !found {
+		return false
+	}
+	switch pr.Operator {
+	case ParamRule_EXISTS:
+		return true
+	case ParamRule_EQUAL:
+		return valuesEqual(value, pr.Value)
+	case ParamRule_NOT_EQUAL:
+		return !valuesEqual(value, pr.Value)
+	case ParamRule_CONTAINS:
+		return strings.Contains(value, pr.Value)
+	case ParamRule_PREFIX:
+		return strings.HasPrefix(value, pr.Value)
+	}
+
+	number, ok := parseNumber(value)
+	if !ok {
+		return false
+	}
+	ruleNumber, ok := parseNumber(pr.Value)
+	if !ok {
+		return false
+	}
+	cmp := number.Cmp(ruleNumber)
+	switch pr.Operator {
+	case ParamRule_GREATER:
+		return cmp > 0
+	case ParamRule_GREATER_EQUAL:
+		return cmp >= 0
+	case ParamRule_LESS:
+		return cmp < 0
+	case ParamRule_LESS_EQUAL:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
+
+// valuesEqual compares values as strings, and as numbers if both are numeric (e.g. "0x10" and "16")
+func valuesEqual(value, other string) bool {
+	if value == other {
+		return true
+	}
+	number, ok := parseNumber(value)
+	if !ok {
+		return false
+	}
+	otherNumber, ok := parseNumber(other)
+	return ok && number.Cmp(otherNumber) == 0
+}
+
+// parseNumber parses decimal, hex (0x) and floating point numbers
+func parseNumber(value string) (*big.Float, bool) {
+	value = strings.Trim(value, "\"")
+	if value == "" {
+		return nil, false
+	}
+	if integer, ok := new(big.Int).SetString(value, 0); ok {
+		return new(big.Float).SetInt(integer), true
+	}
+	number, ok := new(big.Float).SetString(value)
+	return number, ok
+}
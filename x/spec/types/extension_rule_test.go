@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleMatchApiName(t *testing.T) {
+	var nilRule *Rule
+	require.False(t, nilRule.HasContentRules())
+	require.False(t, (&Rule{Block: 100}).HasContentRules())
+
+	rule := &Rule{ApiNames: []string{"eth_getLogs"}, MethodPrefixes: []string{"debug_", "trace_"}}
+	require.True(t, rule.HasContentRules())
+	require.True(t, rule.MatchApiName("eth_getLogs"))
+	require.True(t, rule.MatchApiName("debug_traceTransaction"))
+	require.True(t, rule.MatchApiName("trace_block"))
+	require.False(t, rule.MatchApiName("eth_getLogsX"))
+	require.False(t, rule.MatchApiName("eth_call"))
+
+	// params only rules select all apis
+	require.True(t, (&Rule{Params: []ParamRule{{}}}).MatchApiName("eth_call"))
+}
+
+func TestParamRuleMatch(t *testing.T) {
+	playbook := []struct {
+		name     string
+		operator ParamRule_Operator
+		ruleVal  string
+		value    string
+		found    bool
+		expected bool
+	}{
+		{"EqualString", ParamRule_EQUAL, "callTracer", "callTracer", true, true},
+		{"EqualNumbers", ParamRule_EQUAL, "16", "0x10", true, true},
+		{"NotEqual", ParamRule_NOT_EQUAL, "callTracer", "prestateTracer", true, true},
+		{"NotFound", ParamRule_NOT_EQUAL, "callTracer", "", false, false},
+		{"Exists", ParamRule_EXISTS, "", "", true, true},
+		{"Greater", ParamRule_GREATER, "1000", "0x3e9", true, true},
+		{"GreaterEqual", ParamRule_GREATER_EQUAL, "1000", "1000", true, true},
+		{"Less", ParamRule_LESS, "1000", "1000", true, false},
+		{"LessEqual", ParamRule_LESS_EQUAL, "10.5", "10", true, true},
+		{"NotNumeric", ParamRule_GREATER, "10", "latest", true, false},
+		{"Contains", ParamRule_CONTAINS, "Tracer", "callTracer", true, true},
+		{"Prefix", ParamRule_PREFIX, "call", "prestateTracer", true, false},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			rule := ParamRule{Operator: play.operator, Value: play.ruleVal}
+			require.Equal(t, play.expected, rule.Match(play.value, play.found))
+		})
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	parser := BlockParser{ParserArg: []string{"$[1].tracer"}, ParserFunc: PARSER_FUNC_PARSE_PATH}
+	var nilRule *Rule
+	require.NoError(t, nilRule.Validate())
+	require.NoError(t, (&Rule{ApiNames: []string{"a"}, Params: []ParamRule{{Parser: parser, Operator: ParamRule_GREATER, Value: "0x10"}}}).Validate())
+
+	require.Error(t, (&Rule{ApiNames: []string{""}}).Validate())
+	require.Error(t, (&Rule{MethodPrefixes: []string{""}}).Validate())
+	require.Error(t, (&Rule{Params: []ParamRule{{Operator: ParamRule_EXISTS}}}).Validate())
+	require.Error(t, (&Rule{Params: []ParamRule{{Parser: parser, Operator: ParamRule_LESS, Value: "latest"}}}).Validate())
+	require.Error(t, (&Rule{Params: []ParamRule{{Parser: parser, Operator: ParamRule_Operator(100)}}}).Validate())
+	require.Error(t, (&Rule{Params: []ParamRule{{Parser: BlockParser{ParserFunc: PARSER_FUNC_PARSE_PATH}}}}).Validate())
+}
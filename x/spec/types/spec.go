@@ -26,6 +26,7 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 		APIInterfaceTendermintRPC: {},
 		APIInterfaceRest:          {},
 		APIInterfaceGrpc:          {},
+		APIInterfaceGraphQL:       {},
 	}
 	availavleEncodings := map[string]struct{}{
 		EncodingBase64: {},
@@ -89,12 +90,32 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 			}
 			functionTags[parsing.FunctionTag] = true
 
+			if err := parsing.ResultParsing.Validate(); err != nil {
+				details["apiCollection"] = fmt.Sprintf("%v", apiCollection.CollectionData)
+				return details, fmt.Errorf("invalid result parsing for %s: %w", parsing.FunctionTag, err)
+			}
+
 			if parsing.ResultParsing.Encoding != "" {
 				if _, ok := availavleEncodings[parsing.ResultParsing.Encoding]; !ok {
 					return details, fmt.Errorf("unsupported api encoding %s in apiCollection %v ", parsing.ResultParsing.Encoding, apiCollection.CollectionData)
 				}
 			}
 		}
+		for _, verification := range apiCollection.Verifications {
+			if verification.ParseDirective == nil {
+				continue
+			}
+			if err := verification.ParseDirective.ResultParsing.Validate(); err != nil {
+				details["verification"] = verification.Name
+				return details, fmt.Errorf("invalid result parsing in verification %s: %w", verification.Name, err)
+			}
+		}
+		for _, extension := range apiCollection.Extensions {
+			if err := extension.Rule.Validate(); err != nil {
+				details["extension"] = extension.Name
+				return details, fmt.Errorf("invalid rule in extension %s: %w", extension.Name, err)
+			}
+		}
 		currentApis := map[string]struct{}{}
 		// validate apis
 		for _, api := range apiCollection.Apis {
@@ -108,6 +129,16 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 				details["api"] = api.Name
 				return details, fmt.Errorf("compute units out or range %s", api.Name)
 			}
+			if err := api.BlockParsing.Validate(); err != nil {
+				details["api"] = api.Name
+				return details, fmt.Errorf("invalid block parsing %s: %w", api.Name, err)
+			}
+			if api.CuFormula != nil {
+				if err := api.CuFormula.Validate(maxCU - api.ComputeUnits); err != nil {
+					details["api"] = api.Name
+					return details, fmt.Errorf("invalid cu formula %s: %w", api.Name, err)
+				}
+			}
 		}
 		currentHeaders := map[string]struct{}{}
 		for _, header := range apiCollection.Headers {
@@ -32,6 +32,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddProject())
 	cmd.AddCommand(CmdDelProject())
 	cmd.AddCommand(CmdAutoRenewal())
+	cmd.AddCommand(CmdTransferOffer())
+	cmd.AddCommand(CmdTransferAccept())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
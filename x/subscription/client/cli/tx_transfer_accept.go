@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdTransferAccept() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-accept [consumer]",
+		Short: "Accept the ownership of a subscription offered by its consumer",
+		Long: `The transfer-accept command allows a consumer to accept a subscription that was offered
+		to it (using transfer-offer). The subscription, its projects, its tracked CU and its remaining
+		duration move to the accepting consumer, which becomes the subscription owner and payer`,
+		Example: `required flags: --from <new_consumer>
+
+		lavad tx subscription transfer-accept <subscription_consumer> --from <new_consumer>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			consumer := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+
+			msg := types.NewMsgTransferAccept(
+				creator,
+				consumer,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
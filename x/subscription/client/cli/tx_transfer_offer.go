@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdTransferOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-offer [new-consumer (optional)]",
+		Short: "Offer the ownership of a subscription to another consumer",
+		Long: `The transfer-offer command allows the subscription owner (consumer) to offer the
+		subscription to another consumer address. The transfer completes once the new consumer
+		accepts it (using transfer-accept). Omit the new consumer to withdraw a pending offer`,
+		Example: `required flags: --from <subscription_consumer>
+
+		lavad tx subscription transfer-offer <new_consumer> --from <subscription_consumer>
+		lavad tx subscription transfer-offer --from <subscription_consumer>`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var newConsumer string
+			if len(args) > 0 {
+				newConsumer = args[0]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+
+			msg := types.NewMsgTransferOffer(
+				creator,
+				newConsumer,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -30,6 +30,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgAutoRenewal:
 			res, err := msgServer.AutoRenewal(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferOffer:
+			res, err := msgServer.TransferOffer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferAccept:
+			res, err := msgServer.TransferAccept(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// RegisterInvariants registers the subscription module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "month-cu", MonthCuInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "plan-references", PlanReferencesInvariant(k))
+}
+
+// getAllLiveSubscriptions gets the current version of all the subscriptions
+func (k Keeper) getAllLiveSubscriptions(ctx sdk.Context) (subs []types.Subscription) {
+	block := uint64(ctx.BlockHeight())
+	for _, consumer := range k.subsFS.GetAllEntryIndices(ctx) {
+		var sub types.Subscription
+		if found := k.subsFS.FindEntry(ctx, consumer, block, &sub); found {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// MonthCuInvariant checks that the CU left for the month of every subscription is
+// within its monthly CU, and that the CU tracked for the month's payments (which
+// the QoS may only reduce) does not exceed the CU charged from the subscription
+func MonthCuInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		for _, sub := range k.getAllLiveSubscriptions(ctx) {
+			if sub.MonthCuLeft > sub.MonthCuTotal {
+				count++
+				msg += fmt.Sprintf("\tsubscription %s: month CU left %d exceeds month CU total %d\n",
+					sub.Consumer, sub.MonthCuLeft, sub.MonthCuTotal)
+				continue
+			}
+
+			var trackedCu uint64
+			for _, key := range k.GetAllSubTrackedCuIndices(ctx, sub.Consumer) {
+				_, provider, chainID := types.DecodeCuTrackerKey(key)
+				cu, _, _ := k.GetTrackedCu(ctx, sub.Consumer, provider, chainID, sub.Block)
+				trackedCu += cu
+			}
+
+			usedCu := sub.MonthCuTotal - sub.MonthCuLeft
+			if trackedCu > usedCu {
+				count++
+				msg += fmt.Sprintf("\tsubscription %s: tracked CU %d exceeds used CU %d (month CU total %d, left %d)\n",
+					sub.Consumer, trackedCu, usedCu, sub.MonthCuTotal, sub.MonthCuLeft)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "month-cu", fmt.Sprintf(
+			"found %d subscriptions with inconsistent month CU\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// PlanReferencesInvariant checks that the plan version of every subscription holds
+// a reference for it. A subscription takes a reference when it buys (or renews) its
+// plan, and references of subscriptions that ended are not dropped, so the refcount
+// of a plan version may only exceed the number of subscriptions that use it
+func PlanReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		type planKey struct {
+			index string
+			block uint64
+		}
+		refs := map[planKey]uint64{}
+		var keys []planKey
+		for _, sub := range k.getAllLiveSubscriptions(ctx) {
+			key := planKey{index: sub.PlanIndex, block: sub.PlanBlock}
+			if _, ok := refs[key]; !ok {
+				keys = append(keys, key)
+			}
+			refs[key] += 1
+		}
+
+		var msg string
+		var count int
+		for _, key := range keys {
+			refcount, isLatest, found := k.plansKeeper.GetPlanRefcount(ctx, key.index, key.block)
+			if !found {
+				count++
+				msg += fmt.Sprintf("\tplan %s@%d: used by %d subscriptions but not found\n",
+					key.index, key.block, refs[key])
+				continue
+			}
+			expected := refs[key]
+			if isLatest {
+				expected += 1
+			}
+			if refcount < expected {
+				count++
+				msg += fmt.Sprintf("\tplan %s@%d: refcount %d below %d references (used by %d subscriptions, latest %t)\n",
+					key.index, key.block, refcount, expected, refs[key], isLatest)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "plan-references", fmt.Sprintf(
+			"found %d plan versions with missing references\n%s", count, msg,
+		)), count != 0
+	}
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/x/subscription/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonthCuInvariant(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 1) // 2 sub, 0 adm, 1 dev
+
+	_, sub1Addr := ts.Account("sub1")
+	_, sub2Addr := ts.Account("sub2")
+	_, dev1Addr := ts.Account("dev1")
+	plan := ts.Plan("free")
+	subKeeper := ts.Keepers.Subscription
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, plan.Index, 1, false)
+	require.Nil(t, err)
+	_, err = ts.TxSubscriptionBuy(sub2Addr, sub2Addr, plan.Index, 1, false)
+	require.Nil(t, err)
+
+	ts.AdvanceEpoch()
+
+	invariant := keeper.MonthCuInvariant(subKeeper)
+	msg, broken := invariant(ts.Ctx)
+	require.False(t, broken, msg)
+
+	// charged CU cover the tracked CU
+	sub, found := ts.getSubscription(sub1Addr)
+	require.True(t, found)
+	_, err = subKeeper.ChargeComputeUnitsToSubscription(ts.Ctx, sub1Addr, ts.BlockHeight(), 100)
+	require.Nil(t, err)
+	err = subKeeper.AddTrackedCu(ts.Ctx, sub1Addr, dev1Addr, "mockspec", 100, sub.Block)
+	require.Nil(t, err)
+
+	msg, broken = invariant(ts.Ctx)
+	require.False(t, broken, msg)
+
+	// tracked CU that was never charged
+	sub, found = ts.getSubscription(sub2Addr)
+	require.True(t, found)
+	err = subKeeper.AddTrackedCu(ts.Ctx, sub2Addr, dev1Addr, "mockspec", 100, sub.Block)
+	require.Nil(t, err)
+
+	msg, broken = invariant(ts.Ctx)
+	require.True(t, broken, msg)
+	require.Contains(t, msg, "found 1 subscriptions with inconsistent month CU")
+}
+
+func TestPlanReferencesInvariant(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 0) // 2 sub, 0 adm, 0 dev
+
+	_, sub1Addr := ts.Account("sub1")
+	_, sub2Addr := ts.Account("sub2")
+	plan := ts.Plan("free")
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, plan.Index, 1, false)
+	require.Nil(t, err)
+
+	ts.AdvanceEpoch()
+
+	// a new plan version, while sub1 still uses the old one
+	plan.Price = plan.Price.AddAmount(plan.Price.Amount)
+	ts.AddPlan("free", plan)
+	ts.AdvanceBlock()
+
+	_, err = ts.TxSubscriptionBuy(sub2Addr, sub2Addr, plan.Index, 1, false)
+	require.Nil(t, err)
+
+	msg, broken := keeper.PlanReferencesInvariant(ts.Keepers.Subscription)(ts.Ctx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) TransferAccept(goCtx context.Context, msg *types.MsgTransferAccept) (*types.MsgTransferAcceptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.TransferSubscription(ctx, msg.Consumer, msg.Creator)
+	if err == nil {
+		logger := k.Keeper.Logger(ctx)
+		details := map[string]string{
+			"consumer":     msg.Consumer,
+			"new_consumer": msg.Creator,
+		}
+		utils.LogLavaEvent(ctx, logger, types.TransferSubscriptionEventName, details, "subscription transferred")
+	}
+	return &types.MsgTransferAcceptResponse{}, err
+}
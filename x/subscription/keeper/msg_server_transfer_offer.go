@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) TransferOffer(goCtx context.Context, msg *types.MsgTransferOffer) (*types.MsgTransferOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.OfferSubscriptionTransfer(ctx, msg.Creator, msg.NewConsumer)
+	if err == nil {
+		logger := k.Keeper.Logger(ctx)
+		details := map[string]string{
+			"consumer":     msg.Creator,
+			"new_consumer": msg.NewConsumer,
+		}
+		utils.LogLavaEvent(ctx, logger, types.TransferOfferSubscriptionEventName, details, "subscription transfer offered")
+	}
+	return &types.MsgTransferOfferResponse{}, err
+}
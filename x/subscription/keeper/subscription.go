@@ -325,6 +325,14 @@ func (k Keeper) RemoveExpiredSubscription(ctx sdk.Context, consumer string, bloc
 	// delete all projects before deleting
 	k.delAllProjectsFromSubscription(ctx, consumer)
 
+	// withdraw a pending transfer offer, since a deleted subscription with a
+	// transfer offer marks a transferred subscription
+	var sub types.Subscription
+	if found := k.subsFS.FindEntry(ctx, consumer, block, &sub); found && sub.TransferOffer != "" {
+		sub.TransferOffer = ""
+		k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
+	}
+
 	// delete subscription effective now (don't wait for end of epoch)
 	k.subsFS.DelEntry(ctx, consumer, block)
 
@@ -381,6 +389,135 @@ func (k Keeper) DelProjectFromSubscription(ctx sdk.Context, consumer, name strin
 	return k.projectsKeeper.DeleteProject(ctx, consumer, projectID)
 }
 
+// OfferSubscriptionTransfer offers the ownership of a subscription to another consumer
+// address (pending its accept). An empty newConsumer withdraws a pending offer.
+func (k Keeper) OfferSubscriptionTransfer(ctx sdk.Context, consumer, newConsumer string) error {
+	sub, found := k.GetSubscription(ctx, consumer)
+	if !found {
+		return utils.LavaFormatWarning("could not offer subscription transfer", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	if newConsumer != "" {
+		if newConsumer == consumer {
+			return utils.LavaFormatWarning("could not offer subscription transfer", fmt.Errorf("cannot transfer subscription to itself"),
+				utils.Attribute{Key: "consumer", Value: consumer},
+			)
+		}
+		if _, found := k.GetSubscription(ctx, newConsumer); found {
+			return utils.LavaFormatWarning("could not offer subscription transfer", fmt.Errorf("new consumer already has a subscription"),
+				utils.Attribute{Key: "consumer", Value: consumer},
+				utils.Attribute{Key: "new_consumer", Value: newConsumer},
+			)
+		}
+	} else if sub.TransferOffer == "" {
+		return utils.LavaFormatWarning("could not withdraw subscription transfer offer", fmt.Errorf("no pending transfer offer"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	sub.TransferOffer = newConsumer
+	k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
+
+	return nil
+}
+
+// TransferSubscription moves a subscription to the consumer address it was offered to:
+// the subscription (with its remaining duration and CU), its projects and the tracked
+// CU of the current month are moved to the new consumer address, which also becomes
+// the subscription's creator (and pays for future renewals). Tracked CU of previous
+// months remains with the old consumer address, until paid out to the providers.
+func (k Keeper) TransferSubscription(ctx sdk.Context, consumer, newConsumer string) error {
+	block := uint64(ctx.BlockHeight())
+
+	sub, found := k.GetSubscription(ctx, consumer)
+	if !found {
+		return utils.LavaFormatWarning("could not transfer subscription", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	if sub.TransferOffer == "" || sub.TransferOffer != newConsumer {
+		return utils.LavaFormatWarning("could not transfer subscription", fmt.Errorf("subscription was not offered to new consumer"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+			utils.Attribute{Key: "transfer_offer", Value: sub.TransferOffer},
+		)
+	}
+
+	if _, found := k.GetSubscription(ctx, newConsumer); found {
+		return utils.LavaFormatWarning("could not transfer subscription", fmt.Errorf("new consumer already has a subscription"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	if !k.subsTS.HasTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(consumer)) {
+		return utils.LavaFormatError("critical: could not transfer subscription", fmt.Errorf("subscription month expiry timer not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "month_expiry", Value: sub.MonthExpiryTime},
+		)
+	}
+
+	err := k.projectsKeeper.TransferProjects(ctx, consumer, newConsumer)
+	if err != nil {
+		return utils.LavaFormatWarning("could not transfer subscription projects", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	// the new subscription version starts now, so the tracked CU of the current
+	// month moves to the new consumer with the new subscription block
+	for _, key := range k.GetAllSubTrackedCuIndices(ctx, consumer) {
+		_, provider, chainID := types.DecodeCuTrackerKey(key)
+		cu, found, _ := k.GetTrackedCu(ctx, consumer, provider, chainID, sub.Block)
+		if !found {
+			// tracked CU of a previous month (pending payout)
+			continue
+		}
+
+		newKey := types.CuTrackerKey(newConsumer, provider, chainID)
+		err = k.cuTrackerFS.AppendEntry(ctx, newKey, block, &types.TrackedCu{Cu: cu})
+		if err != nil {
+			return utils.LavaFormatError("could not transfer tracked CU", err,
+				utils.Attribute{Key: "tracked_cu_key", Value: key},
+				utils.Attribute{Key: "new_tracked_cu_key", Value: newKey},
+			)
+		}
+		err = k.cuTrackerFS.DelEntry(ctx, key, block)
+		if err != nil {
+			return utils.LavaFormatError("could not transfer tracked CU", err,
+				utils.Attribute{Key: "tracked_cu_key", Value: key},
+				utils.Attribute{Key: "new_tracked_cu_key", Value: newKey},
+			)
+		}
+	}
+
+	// move the month expiry timer to the new consumer
+	k.subsTS.DelTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(consumer))
+	k.subsTS.AddTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(newConsumer), []byte{})
+
+	sub.Creator = newConsumer
+	sub.Consumer = newConsumer
+	sub.Block = block
+	sub.TransferOffer = ""
+
+	err = k.subsFS.AppendEntry(ctx, newConsumer, block, &sub)
+	if err != nil {
+		return utils.LavaFormatError("could not transfer subscription", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	// delete the old subscription effective now (older versions remain available
+	// for pending payments of relays that were served before the transfer, and the
+	// transfer offer of the deleted version directs their charges to the new consumer)
+	return k.subsFS.DelEntry(ctx, consumer, block)
+}
+
 func (k Keeper) delAllProjectsFromSubscription(ctx sdk.Context, consumer string) {
 	allProjectsIDs := k.projectsKeeper.GetAllProjectsForSubscription(ctx, consumer)
 	for _, projectID := range allProjectsIDs {
@@ -397,9 +534,33 @@ func (k Keeper) delAllProjectsFromSubscription(ctx sdk.Context, consumer string)
 	}
 }
 
-func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer string, block, cuAmount uint64) (types.Subscription, error) {
+// FindSubscriptionToCharge returns the subscription version that should be charged for
+// relays of the consumer at the given block. If the subscription was transferred since,
+// then this is the version of the new consumer's subscription that the transfer created,
+// so that late charges are tracked (and paid out) with the new consumer's month.
+func (k Keeper) FindSubscriptionToCharge(ctx sdk.Context, consumer string, block uint64) (types.Subscription, bool) {
 	var sub types.Subscription
-	if found := k.subsFS.FindEntry(ctx, consumer, block, &sub); !found {
+	for {
+		if found := k.subsFS.FindEntry(ctx, consumer, block, &sub); !found {
+			return sub, false
+		}
+		if sub.TransferOffer == "" {
+			return sub, true
+		}
+
+		// a pending transfer offer on a deleted version means that the subscription
+		// was transferred at the deletion block (expiry clears the offer)
+		entry, err := k.subsFS.FindRawEntry(ctx, consumer, block)
+		if err != nil || !entry.HasDeleteAt() {
+			return sub, true
+		}
+		consumer, block = sub.TransferOffer, entry.DeleteAt
+	}
+}
+
+func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer string, block, cuAmount uint64) (types.Subscription, error) {
+	sub, found := k.FindSubscriptionToCharge(ctx, consumer, block)
+	if !found {
 		return sub, utils.LavaFormatError("can't charge cu to subscription",
 			fmt.Errorf("subscription not found"),
 			utils.Attribute{Key: "subscription", Value: consumer},
@@ -413,6 +574,6 @@ func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer strin
 		sub.MonthCuLeft -= cuAmount
 	}
 
-	k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
+	k.subsFS.ModifyEntry(ctx, sub.Consumer, sub.Block, &sub)
 	return sub, nil
 }
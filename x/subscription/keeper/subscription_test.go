@@ -874,3 +874,137 @@ func TestNextToMonthExpiryQuery(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, 0, len(res.Subscriptions))
 }
+
+// TestSubscriptionTransfer checks that a subscription, its projects, tracked CU and
+// remaining duration move to the new consumer once it accepts the transfer offer
+func TestSubscriptionTransfer(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(3, 0, 2) // 3 sub, 0 adm, 2 dev
+
+	_, sub1Addr := ts.Account("sub1")
+	_, sub2Addr := ts.Account("sub2")
+	_, sub3Addr := ts.Account("sub3")
+	_, dev1Addr := ts.Account("dev1")
+	_, dev2Addr := ts.Account("dev2")
+	plan := ts.Plan("free")
+	keeper := ts.Keepers.Subscription
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, plan.Index, 3, false)
+	require.Nil(t, err)
+	_, err = ts.TxSubscriptionBuy(sub3Addr, sub3Addr, plan.Index, 1, false)
+	require.Nil(t, err)
+
+	projData := projectstypes.ProjectData{
+		Name:        "proj",
+		Enabled:     true,
+		ProjectKeys: []projectstypes.ProjectKey{projectstypes.ProjectDeveloperKey(dev1Addr), projectstypes.ProjectDeveloperKey(dev2Addr)},
+		Policy:      &plan.PlanPolicy,
+	}
+	err = ts.TxSubscriptionAddProject(sub1Addr, projData)
+	require.Nil(t, err)
+
+	ts.AdvanceEpoch()
+
+	sub, found := ts.getSubscription(sub1Addr)
+	require.True(t, found)
+	_, err = keeper.ChargeComputeUnitsToSubscription(ts.Ctx, sub1Addr, ts.BlockHeight(), 100)
+	require.Nil(t, err)
+	err = keeper.AddTrackedCu(ts.Ctx, sub1Addr, dev1Addr, "mockspec", 100, sub.Block)
+	require.Nil(t, err)
+	sub, found = ts.getSubscription(sub1Addr)
+	require.True(t, found)
+
+	// accept without an offer fails
+	err = ts.TxSubscriptionTransferAccept(sub2Addr, sub1Addr)
+	require.NotNil(t, err)
+
+	// offer to a consumer that already has a subscription fails
+	err = ts.TxSubscriptionTransferOffer(sub1Addr, sub3Addr)
+	require.NotNil(t, err)
+
+	// offer and withdraw, then accept fails
+	err = ts.TxSubscriptionTransferOffer(sub1Addr, sub2Addr)
+	require.Nil(t, err)
+	err = ts.TxSubscriptionTransferOffer(sub1Addr, "")
+	require.Nil(t, err)
+	err = ts.TxSubscriptionTransferAccept(sub2Addr, sub1Addr)
+	require.NotNil(t, err)
+
+	// offer again, only the offered consumer may accept
+	err = ts.TxSubscriptionTransferOffer(sub1Addr, sub2Addr)
+	require.Nil(t, err)
+	offered, found := ts.getSubscription(sub1Addr)
+	require.True(t, found)
+	require.Equal(t, sub2Addr, offered.TransferOffer)
+	err = ts.TxSubscriptionTransferAccept(dev1Addr, sub1Addr)
+	require.NotNil(t, err)
+
+	ts.AdvanceBlock()
+
+	// a key removal pending for the next epoch carries over to the transferred project
+	projID := projectstypes.ProjectIndex(sub1Addr, projData.Name)
+	err = ts.TxProjectDelKeys(projID, sub1Addr, projectstypes.ProjectDeveloperKey(dev2Addr))
+	require.Nil(t, err)
+
+	err = ts.TxSubscriptionTransferAccept(sub2Addr, sub1Addr)
+	require.Nil(t, err)
+
+	_, found = ts.getSubscription(sub1Addr)
+	require.False(t, found)
+	newSub, found := ts.getSubscription(sub2Addr)
+	require.True(t, found)
+	require.Equal(t, sub2Addr, newSub.Consumer)
+	require.Equal(t, sub2Addr, newSub.Creator)
+	require.Equal(t, "", newSub.TransferOffer)
+	require.Equal(t, sub.DurationLeft, newSub.DurationLeft)
+	require.Equal(t, sub.MonthCuLeft, newSub.MonthCuLeft)
+	require.Equal(t, sub.MonthExpiryTime, newSub.MonthExpiryTime)
+	require.Equal(t, ts.BlockHeight(), newSub.Block)
+
+	// tracked CU moved to the new consumer
+	cu, found, _ := keeper.GetTrackedCu(ts.Ctx, sub2Addr, dev1Addr, "mockspec", newSub.Block)
+	require.True(t, found)
+	require.Equal(t, uint64(100), cu)
+	require.Empty(t, keeper.GetAllSubTrackedCuIndices(ts.Ctx, sub1Addr))
+
+	// projects move along with the subscription
+	checkProjects := func() {
+		proj, err := ts.GetProjectForDeveloper(dev1Addr, ts.BlockHeight())
+		require.Nil(t, err)
+		require.Equal(t, projectstypes.ProjectIndex(sub2Addr, projData.Name), proj.Index)
+		require.Equal(t, sub2Addr, proj.Subscription)
+
+		proj, err = ts.GetProjectForDeveloper(sub2Addr, ts.BlockHeight())
+		require.Nil(t, err)
+		require.Equal(t, projectstypes.ProjectIndex(sub2Addr, projectstypes.ADMIN_PROJECT_NAME), proj.Index)
+		require.Equal(t, sub2Addr, proj.Subscription)
+
+		_, err = ts.GetProjectForDeveloper(sub1Addr, ts.BlockHeight())
+		require.NotNil(t, err)
+
+		res, err := ts.QuerySubscriptionListProjects(sub2Addr)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(res.Projects))
+		_, err = ts.QuerySubscriptionListProjects(sub1Addr)
+		require.NotNil(t, err)
+	}
+	checkProjects()
+	// the removed key keeps its access until the next epoch
+	proj, err := ts.GetProjectForDeveloper(dev2Addr, ts.BlockHeight())
+	require.Nil(t, err)
+	require.Equal(t, projectstypes.ProjectIndex(sub2Addr, projData.Name), proj.Index)
+
+	ts.AdvanceEpoch()
+
+	checkProjects()
+	_, err = ts.GetProjectForDeveloper(dev2Addr, ts.BlockHeight())
+	require.NotNil(t, err)
+
+	// the month expiry timer moved with the subscription
+	ts.AdvanceMonths(1).AdvanceEpoch()
+
+	newSub, found = ts.getSubscription(sub2Addr)
+	require.True(t, found)
+	require.Equal(t, sub.DurationLeft-1, newSub.DurationLeft)
+	require.Equal(t, newSub.MonthCuTotal, newSub.MonthCuLeft)
+}
@@ -41,6 +41,14 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgAutoRenewal int = 100
 
+	opWeightMsgTransferOffer = "op_weight_msg_transfer_offer"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTransferOffer int = 100
+
+	opWeightMsgTransferAccept = "op_weight_msg_transfer_accept"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTransferAccept int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -113,6 +121,28 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		subscriptionsimulation.SimulateMsgAutoRenewal(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgTransferOffer int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgTransferOffer, &weightMsgTransferOffer, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferOffer = defaultWeightMsgTransferOffer
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferOffer,
+		subscriptionsimulation.SimulateMsgTransferOffer(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTransferAccept int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgTransferAccept, &weightMsgTransferAccept, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferAccept = defaultWeightMsgTransferAccept
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferAccept,
+		subscriptionsimulation.SimulateMsgTransferAccept(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -14,6 +14,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddProject{}, "subscription/AddProject", nil)
 	cdc.RegisterConcrete(&MsgDelProject{}, "subscription/DelProject", nil)
 	cdc.RegisterConcrete(&MsgAutoRenewal{}, "subscription/AutoRenewal", nil)
+	cdc.RegisterConcrete(&MsgTransferOffer{}, "subscription/TransferOffer", nil)
+	cdc.RegisterConcrete(&MsgTransferAccept{}, "subscription/TransferAccept", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +32,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAutoRenewal{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferOffer{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferAccept{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -37,6 +37,7 @@ type ProjectsKeeper interface {
 	DeleteProject(ctx sdk.Context, creator, index string) error
 	SnapshotSubscriptionProjects(ctx sdk.Context, subscriptionAddr string)
 	GetAllProjectsForSubscription(ctx sdk.Context, subscription string) []string
+	TransferProjects(ctx sdk.Context, oldSubscriptionAddress, newSubscriptionAddress string) error
 	// Methods imported from projectskeeper should be defined here
 }
 
@@ -46,6 +47,7 @@ type PlansKeeper interface {
 	FindPlan(ctx sdk.Context, index string, block uint64) (val planstypes.Plan, found bool)
 	PutPlan(ctx sdk.Context, index string, block uint64)
 	GetAllPlanIndices(ctx sdk.Context) []string
+	GetPlanRefcount(ctx sdk.Context, index string, block uint64) (refcount uint64, isLatest bool, found bool)
 	// Methods imported from planskeeper should be defined here
 }
 
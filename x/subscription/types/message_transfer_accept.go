@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferAccept = "transfer_accept"
+
+var _ sdk.Msg = &MsgTransferAccept{}
+
+func NewMsgTransferAccept(creator string, consumer string) *MsgTransferAccept {
+	return &MsgTransferAccept{
+		Creator:  creator,
+		Consumer: consumer,
+	}
+}
+
+func (msg *MsgTransferAccept) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferAccept) Type() string {
+	return TypeMsgTransferAccept
+}
+
+func (msg *MsgTransferAccept) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferAccept) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferAccept) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferOffer = "transfer_offer"
+
+var _ sdk.Msg = &MsgTransferOffer{}
+
+func NewMsgTransferOffer(creator string, newConsumer string) *MsgTransferOffer {
+	return &MsgTransferOffer{
+		Creator:     creator,
+		NewConsumer: newConsumer,
+	}
+}
+
+func (msg *MsgTransferOffer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferOffer) Type() string {
+	return TypeMsgTransferOffer
+}
+
+func (msg *MsgTransferOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// empty new consumer withdraws a pending offer
+	if msg.NewConsumer != "" {
+		_, err = sdk.AccAddressFromBech32(msg.NewConsumer)
+		if err != nil {
+			return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid new consumer address (%s)", err)
+		}
+		if msg.NewConsumer == msg.Creator {
+			return sdkerrors.Wrapf(ErrInvalidParameter, "new consumer must differ from creator (%s)", msg.Creator)
+		}
+	}
+
+	return nil
+}
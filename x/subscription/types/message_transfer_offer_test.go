@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferOffer_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgTransferOffer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferOffer{
+				Creator:     "invalid_address",
+				NewConsumer: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid new consumer address",
+			msg: MsgTransferOffer{
+				Creator:     creator,
+				NewConsumer: "invalid_address",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "transfer to self",
+			msg: MsgTransferOffer{
+				Creator:     creator,
+				NewConsumer: creator,
+			},
+			err: ErrInvalidParameter,
+		}, {
+			name: "valid address",
+			msg: MsgTransferOffer{
+				Creator:     creator,
+				NewConsumer: sample.AccAddress(),
+			},
+		}, {
+			name: "withdraw offer",
+			msg: MsgTransferOffer{
+				Creator:     creator,
+				NewConsumer: "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
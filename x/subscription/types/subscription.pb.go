@@ -36,6 +36,7 @@ type Subscription struct {
 	Cluster         string `protobuf:"bytes,13,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	DurationTotal   uint64 `protobuf:"varint,14,opt,name=duration_total,json=durationTotal,proto3" json:"duration_total,omitempty"`
 	AutoRenewal     bool   `protobuf:"varint,15,opt,name=auto_renewal,json=autoRenewal,proto3" json:"auto_renewal,omitempty"`
+	TransferOffer   string `protobuf:"bytes,16,opt,name=transfer_offer,json=transferOffer,proto3" json:"transfer_offer,omitempty"`
 }
 
 func (m *Subscription) Reset()         { *m = Subscription{} }
@@ -162,6 +163,13 @@ func (m *Subscription) GetAutoRenewal() bool {
 	return false
 }
 
+func (m *Subscription) GetTransferOffer() string {
+	if m != nil {
+		return m.TransferOffer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Subscription)(nil), "lavanet.lava.subscription.Subscription")
 }
@@ -171,32 +179,33 @@ func init() {
 }
 
 var fileDescriptor_c3bc5507ca237d79 = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xc1, 0xae, 0xd2, 0x40,
-	0x14, 0x86, 0xa9, 0xb7, 0xf7, 0x52, 0x0e, 0x2d, 0xe0, 0xc4, 0xc5, 0x68, 0x62, 0x83, 0xa8, 0x91,
-	0x18, 0x52, 0x16, 0xbe, 0x01, 0x46, 0x13, 0x89, 0xab, 0xca, 0xca, 0x4d, 0x33, 0x2d, 0x03, 0x34,
-	0xb6, 0x9d, 0x66, 0x3a, 0xa3, 0xf0, 0x16, 0x3e, 0x96, 0x0b, 0x17, 0x2c, 0x5d, 0x1a, 0x78, 0x91,
-	0x9b, 0x39, 0x2d, 0x0d, 0xac, 0x26, 0xe7, 0x3b, 0xdf, 0xdf, 0xd3, 0x99, 0x1c, 0x98, 0x65, 0xec,
-	0x27, 0x2b, 0xb8, 0x9a, 0x9b, 0x73, 0x5e, 0xe9, 0xb8, 0x4a, 0x64, 0x5a, 0xaa, 0x54, 0x14, 0x37,
-	0x45, 0x50, 0x4a, 0xa1, 0x04, 0x79, 0xde, 0xd8, 0x81, 0x39, 0x83, 0x6b, 0x61, 0xf2, 0xf7, 0x0e,
-	0xdc, 0x6f, 0x57, 0x80, 0x50, 0xe8, 0x26, 0x92, 0x33, 0x25, 0x24, 0xb5, 0xc6, 0xd6, 0xb4, 0x17,
-	0x5e, 0x4a, 0xf2, 0x02, 0x9c, 0x44, 0x14, 0x95, 0xce, 0xb9, 0xa4, 0x4f, 0xb0, 0xd5, 0xd6, 0xe4,
-	0x19, 0xdc, 0xc7, 0x99, 0x48, 0x7e, 0xd0, 0xbb, 0xb1, 0x35, 0xb5, 0xc3, 0xba, 0x20, 0x2f, 0x01,
-	0xca, 0x8c, 0x15, 0x51, 0x5a, 0xac, 0xf9, 0x9e, 0xda, 0x98, 0xe9, 0x19, 0xf2, 0xc5, 0x80, 0xb6,
-	0x5d, 0x27, 0xef, 0x31, 0x89, 0xed, 0x05, 0xa6, 0xdf, 0xc1, 0x70, 0xad, 0x25, 0x33, 0x7f, 0x15,
-	0xc5, 0x42, 0x6f, 0x77, 0x8a, 0x3e, 0xa0, 0x33, 0xb8, 0xe0, 0x05, 0x52, 0xf2, 0x1a, 0xbc, 0x56,
-	0xcc, 0xf8, 0x46, 0xd1, 0x2e, 0x6a, 0xee, 0x05, 0x7e, 0xe5, 0x1b, 0x45, 0xde, 0xc3, 0xd3, 0x5c,
-	0x14, 0x6a, 0x17, 0xf1, 0x7d, 0x99, 0xca, 0x43, 0xa4, 0xd2, 0x9c, 0x53, 0x07, 0xc5, 0x21, 0x36,
-	0x3e, 0x21, 0x5f, 0xa5, 0x39, 0x27, 0x6f, 0x60, 0x50, 0xbb, 0x89, 0x8e, 0x94, 0x50, 0x2c, 0xa3,
-	0x50, 0x7f, 0x11, 0xe9, 0x47, 0xbd, 0x32, 0x8c, 0x4c, 0xc0, 0x6b, 0x2d, 0x1c, 0xdb, 0x47, 0xa9,
-	0xdf, 0x48, 0x38, 0xd5, 0xbc, 0x66, 0xa6, 0x2b, 0xc5, 0x25, 0xf5, 0x9a, 0xd7, 0xac, 0x4b, 0xf2,
-	0x16, 0xda, 0x6b, 0x34, 0x33, 0x06, 0x18, 0x6f, 0xaf, 0x52, 0x0f, 0x79, 0x05, 0x2e, 0xd3, 0x4a,
-	0x44, 0x92, 0x17, 0xfc, 0x17, 0xcb, 0xe8, 0x70, 0x6c, 0x4d, 0x9d, 0xb0, 0x6f, 0x58, 0x58, 0xa3,
-	0xa5, 0xed, 0xf4, 0x46, 0xb0, 0xb4, 0x1d, 0x77, 0xe4, 0x2d, 0x3e, 0xff, 0x39, 0xf9, 0xd6, 0xf1,
-	0xe4, 0x5b, 0xff, 0x4f, 0xbe, 0xf5, 0xfb, 0xec, 0x77, 0x8e, 0x67, 0xbf, 0xf3, 0xef, 0xec, 0x77,
-	0xbe, 0xcf, 0xb6, 0xa9, 0xda, 0xe9, 0x38, 0x48, 0x44, 0x3e, 0xbf, 0x59, 0x9e, 0xfd, 0xed, 0xfa,
-	0xa8, 0x43, 0xc9, 0xab, 0xf8, 0x01, 0x17, 0xe7, 0xc3, 0x63, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea,
-	0x29, 0x17, 0x34, 0x68, 0x02, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0x63, 0x9a, 0xb6, 0xce, 0x24, 0x4e, 0xc2, 0x8a, 0xc3, 0x82, 0x84, 0x15, 0x0a, 0x88,
+	0x08, 0x55, 0xc9, 0x81, 0x37, 0x08, 0x02, 0x89, 0x0a, 0x09, 0xc9, 0xf4, 0xc4, 0xc5, 0x5a, 0xbb,
+	0xeb, 0xc6, 0xc2, 0xde, 0xb5, 0xd6, 0xb3, 0x90, 0xbe, 0x05, 0x8f, 0xc5, 0xb1, 0x47, 0x8e, 0x28,
+	0x79, 0x04, 0x5e, 0x00, 0xed, 0xf8, 0x8f, 0x9a, 0x93, 0x35, 0xdf, 0x7c, 0xb3, 0x3f, 0xef, 0x6a,
+	0xe0, 0xb2, 0x10, 0x3f, 0x84, 0x92, 0xb8, 0x76, 0xdf, 0x75, 0x6d, 0x93, 0x3a, 0x35, 0x79, 0x85,
+	0xb9, 0x56, 0x47, 0xc5, 0xaa, 0x32, 0x1a, 0x35, 0x7b, 0xda, 0xda, 0x2b, 0xf7, 0x5d, 0x3d, 0x14,
+	0x2e, 0xfe, 0x9d, 0xc0, 0xe4, 0xeb, 0x03, 0xc0, 0x38, 0x9c, 0xa7, 0x46, 0x0a, 0xd4, 0x86, 0x7b,
+	0x0b, 0x6f, 0x39, 0x8a, 0xba, 0x92, 0x3d, 0x03, 0x3f, 0xd5, 0xaa, 0xb6, 0xa5, 0x34, 0xfc, 0x11,
+	0xb5, 0xfa, 0x9a, 0x3d, 0x81, 0xd3, 0xa4, 0xd0, 0xe9, 0x77, 0x7e, 0xb2, 0xf0, 0x96, 0xc3, 0xa8,
+	0x29, 0xd8, 0x73, 0x80, 0xaa, 0x10, 0x2a, 0xce, 0xd5, 0x8d, 0xdc, 0xf1, 0x21, 0xcd, 0x8c, 0x1c,
+	0xf9, 0xe4, 0x40, 0xdf, 0x6e, 0x26, 0x4f, 0x69, 0x92, 0xda, 0x1b, 0x9a, 0x7e, 0x03, 0xb3, 0x1b,
+	0x6b, 0x84, 0xfb, 0xab, 0x38, 0xd1, 0xf6, 0x76, 0x8b, 0xfc, 0x8c, 0x9c, 0x69, 0x87, 0x37, 0x44,
+	0xd9, 0x4b, 0x08, 0x7a, 0xb1, 0x90, 0x19, 0xf2, 0x73, 0xd2, 0x26, 0x1d, 0xfc, 0x2c, 0x33, 0x64,
+	0x6f, 0xe1, 0x71, 0xa9, 0x15, 0x6e, 0x63, 0xb9, 0xab, 0x72, 0x73, 0x17, 0x63, 0x5e, 0x4a, 0xee,
+	0x93, 0x38, 0xa3, 0xc6, 0x07, 0xe2, 0xd7, 0x79, 0x29, 0xd9, 0x2b, 0x98, 0x36, 0x6e, 0x6a, 0x63,
+	0xd4, 0x28, 0x0a, 0x0e, 0xcd, 0x89, 0x44, 0xdf, 0xdb, 0x6b, 0xc7, 0xd8, 0x05, 0x04, 0xbd, 0x45,
+	0xb1, 0x63, 0x92, 0xc6, 0xad, 0x44, 0xa9, 0xee, 0x35, 0x0b, 0x5b, 0xa3, 0x34, 0x3c, 0x68, 0x5f,
+	0xb3, 0x29, 0xd9, 0x6b, 0xe8, 0xaf, 0xd1, 0x66, 0x4c, 0x69, 0xbc, 0xbf, 0x4a, 0x13, 0xf2, 0x02,
+	0x26, 0xc2, 0xa2, 0x8e, 0x8d, 0x54, 0xf2, 0xa7, 0x28, 0xf8, 0x6c, 0xe1, 0x2d, 0xfd, 0x68, 0xec,
+	0x58, 0xd4, 0x20, 0x77, 0x12, 0x1a, 0xa1, 0xea, 0x4c, 0x9a, 0x58, 0x67, 0x99, 0x34, 0x7c, 0x4e,
+	0x51, 0x41, 0x47, 0xbf, 0x38, 0x78, 0x35, 0xf4, 0x47, 0x73, 0xb8, 0x1a, 0xfa, 0x93, 0x79, 0xb0,
+	0xf9, 0xf8, 0x7b, 0x1f, 0x7a, 0xf7, 0xfb, 0xd0, 0xfb, 0xbb, 0x0f, 0xbd, 0x5f, 0x87, 0x70, 0x70,
+	0x7f, 0x08, 0x07, 0x7f, 0x0e, 0xe1, 0xe0, 0xdb, 0xe5, 0x6d, 0x8e, 0x5b, 0x9b, 0xac, 0x52, 0x5d,
+	0xae, 0x8f, 0x76, 0x6c, 0x77, 0xbc, 0x65, 0x78, 0x57, 0xc9, 0x3a, 0x39, 0xa3, 0xfd, 0x7a, 0xf7,
+	0x7f, 0x00, 0xff, 0x9a, 0xd2, 0xc8, 0x8f, 0x02, 0x00, 0x00,
 }
 
 func (m *Subscription) Marshal() (dAtA []byte, err error) {
@@ -219,6 +228,15 @@ func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferOffer) > 0 {
+		i -= len(m.TransferOffer)
+		copy(dAtA[i:], m.TransferOffer)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.TransferOffer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.AutoRenewal {
 		i--
 		if m.AutoRenewal {
@@ -360,6 +378,10 @@ func (m *Subscription) Size() (n int) {
 	if m.AutoRenewal {
 		n += 2
 	}
+	l = len(m.TransferOffer)
+	if l > 0 {
+		n += 2 + l + sovSubscription(uint64(l))
+	}
 	return n
 }
 
@@ -698,6 +720,38 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AutoRenewal = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferOffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferOffer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubscription(dAtA[iNdEx:])
@@ -405,6 +405,182 @@ func (m *MsgAutoRenewalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAutoRenewalResponse proto.InternalMessageInfo
 
+type MsgTransferOffer struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	NewConsumer string `protobuf:"bytes,2,opt,name=new_consumer,json=newConsumer,proto3" json:"new_consumer,omitempty"`
+}
+
+func (m *MsgTransferOffer) Reset()         { *m = MsgTransferOffer{} }
+func (m *MsgTransferOffer) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOffer) ProtoMessage()    {}
+func (*MsgTransferOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{8}
+}
+func (m *MsgTransferOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOffer.Merge(m, src)
+}
+func (m *MsgTransferOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOffer proto.InternalMessageInfo
+
+func (m *MsgTransferOffer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferOffer) GetNewConsumer() string {
+	if m != nil {
+		return m.NewConsumer
+	}
+	return ""
+}
+
+type MsgTransferOfferResponse struct {
+}
+
+func (m *MsgTransferOfferResponse) Reset()         { *m = MsgTransferOfferResponse{} }
+func (m *MsgTransferOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOfferResponse) ProtoMessage()    {}
+func (*MsgTransferOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{9}
+}
+func (m *MsgTransferOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOfferResponse.Merge(m, src)
+}
+func (m *MsgTransferOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOfferResponse proto.InternalMessageInfo
+
+type MsgTransferAccept struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+}
+
+func (m *MsgTransferAccept) Reset()         { *m = MsgTransferAccept{} }
+func (m *MsgTransferAccept) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAccept) ProtoMessage()    {}
+func (*MsgTransferAccept) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{10}
+}
+func (m *MsgTransferAccept) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAccept.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferAccept) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAccept.Merge(m, src)
+}
+func (m *MsgTransferAccept) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAccept) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAccept.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferAccept proto.InternalMessageInfo
+
+func (m *MsgTransferAccept) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferAccept) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+type MsgTransferAcceptResponse struct {
+}
+
+func (m *MsgTransferAcceptResponse) Reset()         { *m = MsgTransferAcceptResponse{} }
+func (m *MsgTransferAcceptResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAcceptResponse) ProtoMessage()    {}
+func (*MsgTransferAcceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{11}
+}
+func (m *MsgTransferAcceptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAcceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAcceptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferAcceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAcceptResponse.Merge(m, src)
+}
+func (m *MsgTransferAcceptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAcceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAcceptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferAcceptResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBuy)(nil), "lavanet.lava.subscription.MsgBuy")
 	proto.RegisterType((*MsgBuyResponse)(nil), "lavanet.lava.subscription.MsgBuyResponse")
@@ -414,6 +590,10 @@ func init() {
 	proto.RegisterType((*MsgDelProjectResponse)(nil), "lavanet.lava.subscription.MsgDelProjectResponse")
 	proto.RegisterType((*MsgAutoRenewal)(nil), "lavanet.lava.subscription.MsgAutoRenewal")
 	proto.RegisterType((*MsgAutoRenewalResponse)(nil), "lavanet.lava.subscription.MsgAutoRenewalResponse")
+	proto.RegisterType((*MsgTransferOffer)(nil), "lavanet.lava.subscription.MsgTransferOffer")
+	proto.RegisterType((*MsgTransferOfferResponse)(nil), "lavanet.lava.subscription.MsgTransferOfferResponse")
+	proto.RegisterType((*MsgTransferAccept)(nil), "lavanet.lava.subscription.MsgTransferAccept")
+	proto.RegisterType((*MsgTransferAcceptResponse)(nil), "lavanet.lava.subscription.MsgTransferAcceptResponse")
 }
 
 func init() {
@@ -421,37 +601,42 @@ func init() {
 }
 
 var fileDescriptor_b1bb075a6865b817 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x89, 0x6b, 0xc2, 0xa4, 0xa0, 0x6a, 0x55, 0x8a, 0xf1, 0xc1, 0xa4, 0xe6, 0x12, 0x24,
-	0xb4, 0x86, 0x72, 0xe6, 0xd0, 0xa8, 0xe2, 0x00, 0x8a, 0x84, 0xcc, 0x8d, 0x4b, 0xb5, 0xb1, 0x57,
-	0x6e, 0x20, 0xd9, 0xb5, 0x76, 0xd7, 0x25, 0xfd, 0x0b, 0xae, 0x88, 0x1f, 0xea, 0xb1, 0x47, 0x4e,
-	0x08, 0x25, 0x3f, 0x82, 0xbc, 0x6b, 0xc7, 0x36, 0xa8, 0x71, 0x4f, 0x3b, 0x33, 0xfb, 0xe6, 0xcd,
-	0x9b, 0x19, 0xaf, 0x21, 0x58, 0x90, 0x4b, 0xc2, 0xa8, 0x0a, 0x8b, 0x33, 0x94, 0xf9, 0x4c, 0xc6,
-	0x62, 0x9e, 0xa9, 0x39, 0x67, 0xa1, 0x5a, 0xe1, 0x4c, 0x70, 0xc5, 0xd1, 0xd3, 0x12, 0x83, 0x8b,
-	0x13, 0x37, 0x31, 0xde, 0xf3, 0x56, 0x7a, 0x26, 0xf8, 0x17, 0x1a, 0x2b, 0x59, 0x19, 0x26, 0xdf,
-	0x3b, 0x4c, 0x79, 0xca, 0xb5, 0x19, 0x16, 0x96, 0x89, 0x06, 0x3f, 0x2c, 0x70, 0xa6, 0x32, 0x9d,
-	0xe4, 0x57, 0xc8, 0x85, 0xfb, 0xb1, 0xa0, 0x44, 0x71, 0xe1, 0x5a, 0x23, 0x6b, 0xfc, 0x20, 0xaa,
-	0x5c, 0xe4, 0xc1, 0x20, 0xe6, 0x4c, 0xe6, 0x4b, 0x2a, 0xdc, 0x7b, 0xfa, 0x6a, 0xeb, 0xa3, 0x43,
-	0xd8, 0x9b, 0xb3, 0x84, 0xae, 0xdc, 0xbe, 0xbe, 0x30, 0x4e, 0x91, 0x91, 0xe4, 0x82, 0x14, 0xea,
-	0x5c, 0x7b, 0x64, 0x8d, 0xed, 0x68, 0xeb, 0xa3, 0x63, 0xd8, 0x27, 0xb9, 0xe2, 0xe7, 0x82, 0x32,
-	0xfa, 0x8d, 0x2c, 0x5c, 0x67, 0x64, 0x8d, 0x07, 0xd1, 0xb0, 0x88, 0x45, 0x26, 0xf4, 0xde, 0x1e,
-	0xec, 0x1d, 0x38, 0xc1, 0x01, 0x3c, 0x32, 0xd2, 0x22, 0x2a, 0x33, 0xce, 0x24, 0x0d, 0x2e, 0xe1,
-	0xe1, 0x54, 0xa6, 0xa7, 0x49, 0xf2, 0xd1, 0xb4, 0xb6, 0x43, 0xf3, 0x07, 0xd8, 0x2f, 0xfb, 0x3f,
-	0x4f, 0x88, 0x22, 0x5a, 0xf7, 0xf0, 0x24, 0xc0, 0xad, 0x29, 0x56, 0xa3, 0xc2, 0x25, 0xdf, 0x19,
-	0x51, 0x64, 0x62, 0x5f, 0xff, 0x7e, 0xd6, 0x8b, 0x86, 0x59, 0x1d, 0x0a, 0x9e, 0xc0, 0xe3, 0x56,
-	0xdd, 0xad, 0xa0, 0xb7, 0x5a, 0xd0, 0x19, 0x5d, 0x74, 0x0b, 0x42, 0x60, 0x33, 0xb2, 0xa4, 0xe5,
-	0x00, 0xb5, 0x5d, 0xf2, 0xd6, 0xe9, 0x5b, 0xde, 0x89, 0x6e, 0xfd, 0xb4, 0x1e, 0xc9, 0x0e, 0xe2,
-	0x23, 0x70, 0x28, 0x23, 0xb3, 0x85, 0xa1, 0x1e, 0x44, 0xa5, 0x17, 0xb8, 0x70, 0xd4, 0xe6, 0xa8,
-	0xd8, 0x4f, 0x7e, 0xf6, 0xa1, 0x3f, 0x95, 0x29, 0xfa, 0x04, 0xfd, 0x62, 0xf1, 0xc7, 0xf8, 0xd6,
-	0x4f, 0x0b, 0x9b, 0x05, 0x78, 0x2f, 0x3a, 0x21, 0x15, 0x39, 0xba, 0x00, 0x68, 0x2c, 0x68, 0xbc,
-	0x3b, 0xb1, 0x46, 0x7a, 0xaf, 0xee, 0x8a, 0x6c, 0x56, 0x6a, 0x4c, 0xbe, 0xa3, 0x52, 0x8d, 0xec,
-	0xaa, 0xf4, 0xff, 0x3a, 0xd0, 0x57, 0x18, 0x36, 0x77, 0xd1, 0x31, 0x8d, 0x06, 0xd4, 0x7b, 0x7d,
-	0x67, 0x68, 0x55, 0x6c, 0xf2, 0xee, 0x7a, 0xed, 0x5b, 0x37, 0x6b, 0xdf, 0xfa, 0xb3, 0xf6, 0xad,
-	0xef, 0x1b, 0xbf, 0x77, 0xb3, 0xf1, 0x7b, 0xbf, 0x36, 0x7e, 0xef, 0xf3, 0xcb, 0x74, 0xae, 0x2e,
-	0xf2, 0x19, 0x8e, 0xf9, 0x32, 0x6c, 0x3d, 0xf9, 0xd5, 0x3f, 0xff, 0x8c, 0xab, 0x8c, 0xca, 0x99,
-	0xa3, 0x5f, 0xf8, 0x9b, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x28, 0x35, 0xcb, 0x2c, 0x5d, 0x04,
-	0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0x12, 0xc2, 0x24, 0xad, 0x82, 0x55, 0x8a, 0xbb, 0x48, 0x26, 0x31, 0x97, 0x20,
+	0x2a, 0xa7, 0xb4, 0x5c, 0x39, 0x24, 0x54, 0x48, 0x80, 0xa2, 0x22, 0xc3, 0x89, 0x4b, 0xb4, 0x71,
+	0x36, 0x6e, 0x20, 0xd9, 0x35, 0xbb, 0xeb, 0x26, 0xbd, 0xf3, 0x03, 0xb8, 0xf2, 0x8f, 0x7a, 0xec,
+	0x91, 0x13, 0x42, 0xc9, 0x1f, 0x41, 0xfe, 0x8c, 0x1d, 0x84, 0x93, 0x72, 0xf2, 0xcc, 0xec, 0x9b,
+	0xf7, 0x66, 0x47, 0xcf, 0x0b, 0xc6, 0x04, 0x5f, 0x62, 0x4a, 0x64, 0xdb, 0xff, 0xb6, 0x85, 0x37,
+	0x10, 0x36, 0x1f, 0xbb, 0x72, 0xcc, 0x68, 0x5b, 0xce, 0x4d, 0x97, 0x33, 0xc9, 0xd4, 0xc3, 0x08,
+	0x63, 0xfa, 0x5f, 0x33, 0x8d, 0x41, 0x4f, 0x32, 0xed, 0x2e, 0x67, 0x9f, 0x89, 0x2d, 0x45, 0x1c,
+	0x84, 0xfd, 0x68, 0xdf, 0x61, 0x0e, 0x0b, 0xc2, 0xb6, 0x1f, 0x85, 0x55, 0xe3, 0x87, 0x02, 0xe5,
+	0x9e, 0x70, 0xba, 0xde, 0x95, 0xaa, 0xc1, 0x5d, 0x9b, 0x13, 0x2c, 0x19, 0xd7, 0x94, 0x86, 0xd2,
+	0xba, 0x67, 0xc5, 0xa9, 0x8a, 0xa0, 0x62, 0x33, 0x2a, 0xbc, 0x29, 0xe1, 0xda, 0x9d, 0xe0, 0x28,
+	0xc9, 0xd5, 0x7d, 0x28, 0x8d, 0xe9, 0x90, 0xcc, 0xb5, 0x9d, 0xe0, 0x20, 0x4c, 0xfc, 0x8e, 0xa1,
+	0xc7, 0xb1, 0x3f, 0x9d, 0x56, 0x6c, 0x28, 0xad, 0xa2, 0x95, 0xe4, 0x6a, 0x13, 0x6a, 0xd8, 0x93,
+	0xac, 0xcf, 0x09, 0x25, 0x33, 0x3c, 0xd1, 0xca, 0x0d, 0xa5, 0x55, 0xb1, 0xaa, 0x7e, 0xcd, 0x0a,
+	0x4b, 0x6f, 0x8b, 0x95, 0x52, 0xbd, 0x6c, 0xd4, 0x61, 0x2f, 0x1c, 0xcd, 0x22, 0xc2, 0x65, 0x54,
+	0x10, 0xe3, 0x12, 0x76, 0x7b, 0xc2, 0xe9, 0x0c, 0x87, 0xef, 0xc3, 0xab, 0xe5, 0xcc, 0xfc, 0x0e,
+	0x6a, 0xd1, 0xfd, 0xfb, 0x43, 0x2c, 0x71, 0x30, 0x77, 0xf5, 0xc4, 0x30, 0x33, 0x5b, 0x8c, 0x57,
+	0x65, 0x46, 0x7c, 0x67, 0x58, 0xe2, 0x6e, 0xf1, 0xfa, 0xd7, 0xe3, 0x82, 0x55, 0x75, 0x57, 0x25,
+	0xe3, 0x21, 0x3c, 0xc8, 0xe8, 0x26, 0x03, 0xbd, 0x0c, 0x06, 0x3a, 0x23, 0x93, 0xcd, 0x03, 0xa9,
+	0x50, 0xa4, 0x78, 0x4a, 0xa2, 0x05, 0x06, 0x71, 0xc4, 0xbb, 0x6a, 0x4f, 0x78, 0xbb, 0xc1, 0xd5,
+	0x3b, 0xab, 0x95, 0xe4, 0x10, 0x1f, 0x40, 0x99, 0x50, 0x3c, 0x98, 0x84, 0xd4, 0x15, 0x2b, 0xca,
+	0x0c, 0x0d, 0x0e, 0xb2, 0x1c, 0x09, 0xfb, 0x39, 0xd4, 0x7b, 0xc2, 0xf9, 0xc8, 0x31, 0x15, 0x23,
+	0xc2, 0xcf, 0x47, 0x23, 0xc2, 0x73, 0xf8, 0x9b, 0x50, 0xa3, 0x64, 0xd6, 0x5f, 0x73, 0x40, 0x95,
+	0x92, 0xd9, 0xab, 0xa8, 0x64, 0x20, 0xd0, 0xd6, 0x09, 0x13, 0xb1, 0x37, 0x70, 0x3f, 0x75, 0xd6,
+	0xb1, 0x6d, 0xe2, 0xca, 0xff, 0xf3, 0x9a, 0xf1, 0x08, 0x0e, 0xff, 0xa2, 0x8a, 0x75, 0x4e, 0xbe,
+	0x95, 0x60, 0xa7, 0x27, 0x1c, 0xf5, 0x03, 0xec, 0xf8, 0x6e, 0x6e, 0x9a, 0xff, 0xfc, 0x5f, 0xcc,
+	0xd0, 0x55, 0xe8, 0xe9, 0x46, 0x48, 0x4c, 0xae, 0x5e, 0x00, 0xa4, 0x5c, 0xd7, 0xca, 0x6f, 0x5c,
+	0x21, 0xd1, 0xf1, 0xb6, 0xc8, 0xb4, 0x52, 0xca, 0x4e, 0x1b, 0x94, 0x56, 0x48, 0x74, 0xbc, 0x2d,
+	0x32, 0x51, 0xfa, 0x02, 0xd5, 0xb4, 0xc1, 0x36, 0x6c, 0x23, 0x05, 0x45, 0xcf, 0xb7, 0x86, 0x26,
+	0x62, 0x5f, 0x61, 0x37, 0xeb, 0xb7, 0x67, 0xf9, 0x1c, 0x19, 0x30, 0x3a, 0xbd, 0x05, 0x38, 0x91,
+	0x94, 0xb0, 0xb7, 0xe6, 0xba, 0xa3, 0xed, 0x68, 0x42, 0x34, 0x7a, 0x71, 0x1b, 0x74, 0xac, 0xda,
+	0x7d, 0x7d, 0xbd, 0xd0, 0x95, 0x9b, 0x85, 0xae, 0xfc, 0x5e, 0xe8, 0xca, 0xf7, 0xa5, 0x5e, 0xb8,
+	0x59, 0xea, 0x85, 0x9f, 0x4b, 0xbd, 0xf0, 0xe9, 0xc8, 0x19, 0xcb, 0x0b, 0x6f, 0x60, 0xda, 0x6c,
+	0xda, 0xce, 0x3c, 0xd8, 0xf3, 0xb5, 0x17, 0xff, 0xca, 0x25, 0x62, 0x50, 0x0e, 0xde, 0xe7, 0xd3,
+	0x3f, 0x03, 0x00, 0xe1, 0xc0, 0x92, 0xdb, 0x1b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -470,6 +655,8 @@ type MsgClient interface {
 	AddProject(ctx context.Context, in *MsgAddProject, opts ...grpc.CallOption) (*MsgAddProjectResponse, error)
 	DelProject(ctx context.Context, in *MsgDelProject, opts ...grpc.CallOption) (*MsgDelProjectResponse, error)
 	AutoRenewal(ctx context.Context, in *MsgAutoRenewal, opts ...grpc.CallOption) (*MsgAutoRenewalResponse, error)
+	TransferOffer(ctx context.Context, in *MsgTransferOffer, opts ...grpc.CallOption) (*MsgTransferOfferResponse, error)
+	TransferAccept(ctx context.Context, in *MsgTransferAccept, opts ...grpc.CallOption) (*MsgTransferAcceptResponse, error)
 }
 
 type msgClient struct {
@@ -516,12 +703,32 @@ func (c *msgClient) AutoRenewal(ctx context.Context, in *MsgAutoRenewal, opts ..
 	return out, nil
 }
 
+func (c *msgClient) TransferOffer(ctx context.Context, in *MsgTransferOffer, opts ...grpc.CallOption) (*MsgTransferOfferResponse, error) {
+	out := new(MsgTransferOfferResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Msg/TransferOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferAccept(ctx context.Context, in *MsgTransferAccept, opts ...grpc.CallOption) (*MsgTransferAcceptResponse, error) {
+	out := new(MsgTransferAcceptResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Msg/TransferAccept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error)
 	AddProject(context.Context, *MsgAddProject) (*MsgAddProjectResponse, error)
 	DelProject(context.Context, *MsgDelProject) (*MsgDelProjectResponse, error)
 	AutoRenewal(context.Context, *MsgAutoRenewal) (*MsgAutoRenewalResponse, error)
+	TransferOffer(context.Context, *MsgTransferOffer) (*MsgTransferOfferResponse, error)
+	TransferAccept(context.Context, *MsgTransferAccept) (*MsgTransferAcceptResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -540,6 +747,12 @@ func (*UnimplementedMsgServer) DelProject(ctx context.Context, req *MsgDelProjec
 func (*UnimplementedMsgServer) AutoRenewal(ctx context.Context, req *MsgAutoRenewal) (*MsgAutoRenewalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AutoRenewal not implemented")
 }
+func (*UnimplementedMsgServer) TransferOffer(ctx context.Context, req *MsgTransferOffer) (*MsgTransferOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOffer not implemented")
+}
+func (*UnimplementedMsgServer) TransferAccept(ctx context.Context, req *MsgTransferAccept) (*MsgTransferAcceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAccept not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -617,6 +830,42 @@ func _Msg_AutoRenewal_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Msg/TransferOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOffer(ctx, req.(*MsgTransferOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAccept)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Msg/TransferAccept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAccept(ctx, req.(*MsgTransferAccept))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.subscription.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -637,6 +886,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AutoRenewal",
 			Handler:    _Msg_AutoRenewal_Handler,
 		},
+		{
+			MethodName: "TransferOffer",
+			Handler:    _Msg_TransferOffer_Handler,
+		},
+		{
+			MethodName: "TransferAccept",
+			Handler:    _Msg_TransferAccept_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/subscription/tx.proto",
@@ -910,6 +1167,126 @@ func (m *MsgAutoRenewalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewConsumer) > 0 {
+		i -= len(m.NewConsumer)
+		copy(dAtA[i:], m.NewConsumer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewConsumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAccept) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAccept) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAcceptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAcceptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAcceptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1027,18 +1404,305 @@ func (m *MsgAutoRenewalResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewConsumer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferAccept) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferAcceptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBuy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenewal = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgBuy) Unmarshal(dAtA []byte) error {
+func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1061,10 +1725,10 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddProject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddProject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1101,9 +1765,9 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1113,27 +1777,128 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Consumer = string(dAtA[iNdEx:postIndex])
+			if err := m.ProjectData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelProject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelProject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1161,13 +1926,13 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Duration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1177,31 +1942,24 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewal", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.AutoRenewal = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1223,7 +1981,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelProjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1246,10 +2004,10 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelProjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1273,7 +2031,7 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
+func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1296,10 +2054,10 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAutoRenewal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAutoRenewal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1335,10 +2093,10 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1348,25 +2106,12 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProjectData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1388,7 +2133,7 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAutoRenewalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1411,10 +2156,10 @@ func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAutoRenewalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAutoRenewalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1438,7 +2183,7 @@ func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1461,10 +2206,10 @@ func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelProject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelProject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1501,7 +2246,7 @@ func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsumer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1529,7 +2274,7 @@ func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NewConsumer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1552,7 +2297,7 @@ func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelProjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,10 +2320,10 @@ func (m *MsgDelProjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelProjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1602,7 +2347,7 @@ func (m *MsgDelProjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferAccept) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1625,10 +2370,10 @@ func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAutoRenewal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferAccept: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAutoRenewal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferAccept: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1664,10 +2409,10 @@ func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1677,12 +2422,24 @@ func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1704,7 +2461,7 @@ func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAutoRenewalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferAcceptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1727,10 +2484,10 @@ func (m *MsgAutoRenewalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAutoRenewalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferAcceptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAutoRenewalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferAcceptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
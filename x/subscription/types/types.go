@@ -5,6 +5,8 @@ const (
 	ExpireSubscriptionEventName             = "expire_subscription_event"
 	AddProjectEventName                     = "add_project_to_subscription_event"
 	DelProjectEventName                     = "del_project_to_subscription_event"
+	TransferOfferSubscriptionEventName      = "transfer_offer_subscription_event"
+	TransferSubscriptionEventName           = "transfer_subscription_event"
 	AddTrackedCuEventName                   = "add_tracked_cu_event"
 	MonthlyCuTrackerProviderRewardEventName = "monthly_cu_tracker_provider_reward"
 )